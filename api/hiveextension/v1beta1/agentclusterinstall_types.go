@@ -220,6 +220,17 @@ type AgentClusterInstallSpec struct {
 	// LoadBalancer defines the load balancer used by the cluster for ingress traffic.
 	// +optional
 	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
+
+	// InstallTimeouts overrides the timeouts of individual installation stages of the hosts and of the
+	// finalizing stages of the cluster.
+	// +optional
+	InstallTimeouts *InstallTimeouts `json:"installTimeouts,omitempty"`
+
+	// OLMOperators is the list of OLM operators to install in the cluster. Each operator can select the channel,
+	// the version and the catalog source of its subscription, for example to use mirrored catalogs in disconnected
+	// environments. When not set the operators of the cluster aren't changed.
+	// +optional
+	OLMOperators []OLMOperator `json:"olmOperators,omitempty"`
 }
 
 // IgnitionEndpoint stores the data to of the custom ignition endpoint.
@@ -233,6 +244,52 @@ type IgnitionEndpoint struct {
 	CaCertificateReference *CaCertificateReference `json:"caCertificateReference,omitempty"`
 }
 
+// InstallTimeouts defines per-cluster overrides of the installation timeouts. Stages that are not
+// listed keep the timeouts configured in the service.
+type InstallTimeouts struct {
+	// HostStages maps host installation stages, for example "Rebooting", to their timeout.
+	// +optional
+	HostStages map[string]metav1.Duration `json:"hostStages,omitempty"`
+
+	// FinalizingStages maps cluster finalizing stages, for example "Waiting for cluster operators", to
+	// their timeout.
+	// +optional
+	FinalizingStages map[string]metav1.Duration `json:"finalizingStages,omitempty"`
+}
+
+// OLMOperator describes an OLM operator to install in the cluster and the subscription used to install it.
+type OLMOperator struct {
+	// Name of the operator, as returned by the list of supported operators.
+	Name string `json:"name"`
+
+	// Properties is a blob of operator-dependent parameters that are required for installation.
+	// +optional
+	Properties string `json:"properties,omitempty"`
+
+	// Channel of the subscription. Defaults to the channel used for the OpenShift version of the cluster.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// StartingCSV is the name of the cluster service version to install, for example 'odf-operator.v4.16.3'.
+	// +optional
+	StartingCSV string `json:"startingCSV,omitempty"`
+
+	// CatalogSource is the name of the catalog source of the subscription. Defaults to the catalog source of the
+	// operator.
+	// +optional
+	CatalogSource string `json:"catalogSource,omitempty"`
+
+	// CatalogSourceImage is the index image of a catalog source that will be created in the
+	// 'openshift-marketplace' namespace for the subscription.
+	// +optional
+	CatalogSourceImage string `json:"catalogSourceImage,omitempty"`
+
+	// InstallPlanApproval is the approval mode of the install plans of the subscription.
+	// +kubebuilder:validation:Enum=Automatic
+	// +optional
+	InstallPlanApproval string `json:"installPlanApproval,omitempty"`
+}
+
 type ClusterProgressInfo struct {
 	// Estimated installation progress (in percentage)
 	TotalPercentage int64 `json:"totalPercentage"`
@@ -24,6 +24,7 @@ import (
 	"github.com/openshift/assisted-service/api/common"
 	"github.com/openshift/hive/apis/hive/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -167,6 +168,16 @@ func (in *AgentClusterInstallSpec) DeepCopyInto(out *AgentClusterInstallSpec) {
 		*out = new(LoadBalancer)
 		**out = **in
 	}
+	if in.InstallTimeouts != nil {
+		in, out := &in.InstallTimeouts, &out.InstallTimeouts
+		*out = new(InstallTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OLMOperators != nil {
+		in, out := &in.OLMOperators, &out.OLMOperators
+		*out = make([]OLMOperator, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentClusterInstallSpec.
@@ -374,6 +385,35 @@ func (in *IgnitionEndpoint) DeepCopy() *IgnitionEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallTimeouts) DeepCopyInto(out *InstallTimeouts) {
+	*out = *in
+	if in.HostStages != nil {
+		in, out := &in.HostStages, &out.HostStages
+		*out = make(map[string]metav1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FinalizingStages != nil {
+		in, out := &in.FinalizingStages, &out.FinalizingStages
+		*out = make(map[string]metav1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallTimeouts.
+func (in *InstallTimeouts) DeepCopy() *InstallTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 	*out = *in
@@ -469,6 +509,21 @@ func (in *Networking) DeepCopy() *Networking {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OLMOperator) DeepCopyInto(out *OLMOperator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OLMOperator.
+func (in *OLMOperator) DeepCopy() *OLMOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(OLMOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvisionRequirements) DeepCopyInto(out *ProvisionRequirements) {
 	*out = *in
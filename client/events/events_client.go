@@ -17,9 +17,24 @@ import (
 
 // API is the interface of the events client
 type API interface {
+	/*
+	   V2DeregisterEventSubscription Deletes an event subscription and its delivery history.*/
+	V2DeregisterEventSubscription(ctx context.Context, params *V2DeregisterEventSubscriptionParams) (*V2DeregisterEventSubscriptionNoContent, error)
+	/*
+	   V2GetEventSubscription Retrieves the details of an event subscription.*/
+	V2GetEventSubscription(ctx context.Context, params *V2GetEventSubscriptionParams) (*V2GetEventSubscriptionOK, error)
+	/*
+	   V2ListEventSubscriptionDeliveries Lists the deliveries of an event subscription, most recent first.*/
+	V2ListEventSubscriptionDeliveries(ctx context.Context, params *V2ListEventSubscriptionDeliveriesParams) (*V2ListEventSubscriptionDeliveriesOK, error)
+	/*
+	   V2ListEventSubscriptions Lists the event subscriptions of a cluster or an infra-env.*/
+	V2ListEventSubscriptions(ctx context.Context, params *V2ListEventSubscriptionsParams) (*V2ListEventSubscriptionsOK, error)
 	/*
 	   V2ListEvents Lists events for a cluster.*/
 	V2ListEvents(ctx context.Context, params *V2ListEventsParams) (*V2ListEventsOK, error)
+	/*
+	   V2RegisterEventSubscription Registers a webhook that receives the events of a cluster or an infra-env.*/
+	V2RegisterEventSubscription(ctx context.Context, params *V2RegisterEventSubscriptionParams) (*V2RegisterEventSubscriptionCreated, error)
 	/*
 	   V2TriggerEvent Add new assisted installer event.*/
 	V2TriggerEvent(ctx context.Context, params *V2TriggerEventParams) (*V2TriggerEventCreated, error)
@@ -43,6 +58,106 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+V2DeregisterEventSubscription Deletes an event subscription and its delivery history.
+*/
+func (a *Client) V2DeregisterEventSubscription(ctx context.Context, params *V2DeregisterEventSubscriptionParams) (*V2DeregisterEventSubscriptionNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeregisterEventSubscription",
+		Method:             "DELETE",
+		PathPattern:        "/v2/events/subscriptions/{subscription_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeregisterEventSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeregisterEventSubscriptionNoContent), nil
+
+}
+
+/*
+V2GetEventSubscription Retrieves the details of an event subscription.
+*/
+func (a *Client) V2GetEventSubscription(ctx context.Context, params *V2GetEventSubscriptionParams) (*V2GetEventSubscriptionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetEventSubscription",
+		Method:             "GET",
+		PathPattern:        "/v2/events/subscriptions/{subscription_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetEventSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetEventSubscriptionOK), nil
+
+}
+
+/*
+V2ListEventSubscriptionDeliveries Lists the deliveries of an event subscription, most recent first.
+*/
+func (a *Client) V2ListEventSubscriptionDeliveries(ctx context.Context, params *V2ListEventSubscriptionDeliveriesParams) (*V2ListEventSubscriptionDeliveriesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListEventSubscriptionDeliveries",
+		Method:             "GET",
+		PathPattern:        "/v2/events/subscriptions/{subscription_id}/deliveries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListEventSubscriptionDeliveriesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListEventSubscriptionDeliveriesOK), nil
+
+}
+
+/*
+V2ListEventSubscriptions Lists the event subscriptions of a cluster or an infra-env.
+*/
+func (a *Client) V2ListEventSubscriptions(ctx context.Context, params *V2ListEventSubscriptionsParams) (*V2ListEventSubscriptionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListEventSubscriptions",
+		Method:             "GET",
+		PathPattern:        "/v2/events/subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListEventSubscriptionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListEventSubscriptionsOK), nil
+
+}
+
 /*
 V2ListEvents Lists events for a cluster.
 */
@@ -68,6 +183,31 @@ func (a *Client) V2ListEvents(ctx context.Context, params *V2ListEventsParams) (
 
 }
 
+/*
+V2RegisterEventSubscription Registers a webhook that receives the events of a cluster or an infra-env.
+*/
+func (a *Client) V2RegisterEventSubscription(ctx context.Context, params *V2RegisterEventSubscriptionParams) (*V2RegisterEventSubscriptionCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RegisterEventSubscription",
+		Method:             "POST",
+		PathPattern:        "/v2/events/subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RegisterEventSubscriptionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RegisterEventSubscriptionCreated), nil
+
+}
+
 /*
 V2TriggerEvent Add new assisted installer event.
 */
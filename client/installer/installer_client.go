@@ -29,7 +29,7 @@ type API interface {
 	*/
 	DownloadMinimalInitrd(ctx context.Context, params *DownloadMinimalInitrdParams, writer io.Writer) (*DownloadMinimalInitrdOK, *DownloadMinimalInitrdNoContent, error)
 	/*
-	   GetClusterSupportedPlatforms A list of platforms that this cluster can support in its current configuration.*/
+	   GetClusterSupportedPlatforms A list of platforms that this cluster can support in its current configuration. The platform suggested by the hardware fingerprints of the hosts is listed first.*/
 	GetClusterSupportedPlatforms(ctx context.Context, params *GetClusterSupportedPlatformsParams) (*GetClusterSupportedPlatformsOK, error)
 	/*
 	   GetInfraEnv Retrieves the details of the infra-env.*/
@@ -109,6 +109,21 @@ type API interface {
 	/*
 	   V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%.*/
 	V2CompleteInstallation(ctx context.Context, params *V2CompleteInstallationParams) (*V2CompleteInstallationAccepted, error)
+	/*
+	   V2CreateClusterRoleBinding Grants a role on the cluster to a user or to a group.*/
+	V2CreateClusterRoleBinding(ctx context.Context, params *V2CreateClusterRoleBindingParams) (*V2CreateClusterRoleBindingCreated, error)
+	/*
+	   V2CreateHostDiagnostic Queues a diagnostic that the host runs the next time that it asks for instructions.*/
+	V2CreateHostDiagnostic(ctx context.Context, params *V2CreateHostDiagnosticParams) (*V2CreateHostDiagnosticCreated, error)
+	/*
+	   V2CreateInfraEnvRoleBinding Grants a role on the infra-env to a user or to a group.*/
+	V2CreateInfraEnvRoleBinding(ctx context.Context, params *V2CreateInfraEnvRoleBindingParams) (*V2CreateInfraEnvRoleBindingCreated, error)
+	/*
+	   V2DeleteClusterRoleBinding Revokes a role granted on the cluster.*/
+	V2DeleteClusterRoleBinding(ctx context.Context, params *V2DeleteClusterRoleBindingParams) (*V2DeleteClusterRoleBindingNoContent, error)
+	/*
+	   V2DeleteInfraEnvRoleBinding Revokes a role granted on the infra-env.*/
+	V2DeleteInfraEnvRoleBinding(ctx context.Context, params *V2DeleteInfraEnvRoleBindingParams) (*V2DeleteInfraEnvRoleBindingNoContent, error)
 	/*
 	   V2DeregisterCluster Deletes an OpenShift cluster definition.*/
 	V2DeregisterCluster(ctx context.Context, params *V2DeregisterClusterParams) (*V2DeregisterClusterNoContent, error)
@@ -127,6 +142,9 @@ type API interface {
 	/*
 	   V2GetClusterInstallConfig Get the cluster's install config YAML.*/
 	V2GetClusterInstallConfig(ctx context.Context, params *V2GetClusterInstallConfigParams) (*V2GetClusterInstallConfigOK, error)
+	/*
+	   V2GetClusterInstallationTimeline Retrieves the timeline of the last installation of the cluster, with the start and end of each stage of the hosts and of the finalizing stages of the cluster.*/
+	V2GetClusterInstallationTimeline(ctx context.Context, params *V2GetClusterInstallationTimelineParams) (*V2GetClusterInstallationTimelineOK, error)
 	/*
 	   V2GetHost Retrieves the details of the OpenShift host.*/
 	V2GetHost(ctx context.Context, params *V2GetHostParams) (*V2GetHostOK, error)
@@ -151,12 +169,21 @@ type API interface {
 	/*
 	   V2InstallHost install specific host for day2 cluster.*/
 	V2InstallHost(ctx context.Context, params *V2InstallHostParams) (*V2InstallHostAccepted, error)
+	/*
+	   V2ListClusterRoleBindings Lists the roles granted on the cluster.*/
+	V2ListClusterRoleBindings(ctx context.Context, params *V2ListClusterRoleBindingsParams) (*V2ListClusterRoleBindingsOK, error)
 	/*
 	   V2ListClusters Retrieves the list of OpenShift clusters.*/
 	V2ListClusters(ctx context.Context, params *V2ListClustersParams) (*V2ListClustersOK, error)
+	/*
+	   V2ListHostDiagnostics Lists the on-demand diagnostics requested for the host, with their results.*/
+	V2ListHostDiagnostics(ctx context.Context, params *V2ListHostDiagnosticsParams) (*V2ListHostDiagnosticsOK, error)
 	/*
 	   V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.*/
 	V2ListHosts(ctx context.Context, params *V2ListHostsParams) (*V2ListHostsOK, error)
+	/*
+	   V2ListInfraEnvRoleBindings Lists the roles granted on the infra-env.*/
+	V2ListInfraEnvRoleBindings(ctx context.Context, params *V2ListInfraEnvRoleBindingsParams) (*V2ListInfraEnvRoleBindingsOK, error)
 	/*
 	   V2PostStepReply Posts the result of the operations from the host agent.*/
 	V2PostStepReply(ctx context.Context, params *V2PostStepReplyParams) (*V2PostStepReplyNoContent, error)
@@ -207,6 +234,9 @@ type API interface {
 	/*
 	   V2UploadClusterIngressCert Transfer the ingress certificate for the cluster.*/
 	V2UploadClusterIngressCert(ctx context.Context, params *V2UploadClusterIngressCertParams) (*V2UploadClusterIngressCertCreated, error)
+	/*
+	   V2WatchCluster Streams the live state of a cluster, its hosts and its events as server-sent events.*/
+	V2WatchCluster(ctx context.Context, params *V2WatchClusterParams, writer io.Writer) (*V2WatchClusterOK, error)
 }
 
 // New creates a new installer API client.
@@ -309,7 +339,7 @@ func (a *Client) DownloadMinimalInitrd(ctx context.Context, params *DownloadMini
 }
 
 /*
-GetClusterSupportedPlatforms A list of platforms that this cluster can support in its current configuration.
+GetClusterSupportedPlatforms A list of platforms that this cluster can support in its current configuration. The platform suggested by the hardware fingerprints of the hosts is listed first.
 */
 func (a *Client) GetClusterSupportedPlatforms(ctx context.Context, params *GetClusterSupportedPlatformsParams) (*GetClusterSupportedPlatformsOK, error) {
 
@@ -983,6 +1013,131 @@ func (a *Client) V2CompleteInstallation(ctx context.Context, params *V2CompleteI
 
 }
 
+/*
+V2CreateClusterRoleBinding Grants a role on the cluster to a user or to a group.
+*/
+func (a *Client) V2CreateClusterRoleBinding(ctx context.Context, params *V2CreateClusterRoleBindingParams) (*V2CreateClusterRoleBindingCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2CreateClusterRoleBinding",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/role-bindings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CreateClusterRoleBindingReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CreateClusterRoleBindingCreated), nil
+
+}
+
+/*
+V2CreateHostDiagnostic Queues a diagnostic that the host runs the next time that it asks for instructions.
+*/
+func (a *Client) V2CreateHostDiagnostic(ctx context.Context, params *V2CreateHostDiagnosticParams) (*V2CreateHostDiagnosticCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2CreateHostDiagnostic",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CreateHostDiagnosticReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CreateHostDiagnosticCreated), nil
+
+}
+
+/*
+V2CreateInfraEnvRoleBinding Grants a role on the infra-env to a user or to a group.
+*/
+func (a *Client) V2CreateInfraEnvRoleBinding(ctx context.Context, params *V2CreateInfraEnvRoleBindingParams) (*V2CreateInfraEnvRoleBindingCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2CreateInfraEnvRoleBinding",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/role-bindings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CreateInfraEnvRoleBindingReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CreateInfraEnvRoleBindingCreated), nil
+
+}
+
+/*
+V2DeleteClusterRoleBinding Revokes a role granted on the cluster.
+*/
+func (a *Client) V2DeleteClusterRoleBinding(ctx context.Context, params *V2DeleteClusterRoleBindingParams) (*V2DeleteClusterRoleBindingNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeleteClusterRoleBinding",
+		Method:             "DELETE",
+		PathPattern:        "/v2/clusters/{cluster_id}/role-bindings/{role_binding_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeleteClusterRoleBindingReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeleteClusterRoleBindingNoContent), nil
+
+}
+
+/*
+V2DeleteInfraEnvRoleBinding Revokes a role granted on the infra-env.
+*/
+func (a *Client) V2DeleteInfraEnvRoleBinding(ctx context.Context, params *V2DeleteInfraEnvRoleBindingParams) (*V2DeleteInfraEnvRoleBindingNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2DeleteInfraEnvRoleBinding",
+		Method:             "DELETE",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/role-bindings/{role_binding_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DeleteInfraEnvRoleBindingReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DeleteInfraEnvRoleBindingNoContent), nil
+
+}
+
 /*
 V2DeregisterCluster Deletes an OpenShift cluster definition.
 */
@@ -1133,6 +1288,31 @@ func (a *Client) V2GetClusterInstallConfig(ctx context.Context, params *V2GetClu
 
 }
 
+/*
+V2GetClusterInstallationTimeline Retrieves the timeline of the last installation of the cluster, with the start and end of each stage of the hosts and of the finalizing stages of the cluster.
+*/
+func (a *Client) V2GetClusterInstallationTimeline(ctx context.Context, params *V2GetClusterInstallationTimelineParams) (*V2GetClusterInstallationTimelineOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterInstallationTimeline",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/installation-timeline",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterInstallationTimelineReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterInstallationTimelineOK), nil
+
+}
+
 /*
 V2GetHost Retrieves the details of the OpenShift host.
 */
@@ -1333,6 +1513,31 @@ func (a *Client) V2InstallHost(ctx context.Context, params *V2InstallHostParams)
 
 }
 
+/*
+V2ListClusterRoleBindings Lists the roles granted on the cluster.
+*/
+func (a *Client) V2ListClusterRoleBindings(ctx context.Context, params *V2ListClusterRoleBindingsParams) (*V2ListClusterRoleBindingsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterRoleBindings",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/role-bindings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterRoleBindingsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterRoleBindingsOK), nil
+
+}
+
 /*
 V2ListClusters Retrieves the list of OpenShift clusters.
 */
@@ -1358,6 +1563,31 @@ func (a *Client) V2ListClusters(ctx context.Context, params *V2ListClustersParam
 
 }
 
+/*
+V2ListHostDiagnostics Lists the on-demand diagnostics requested for the host, with their results.
+*/
+func (a *Client) V2ListHostDiagnostics(ctx context.Context, params *V2ListHostDiagnosticsParams) (*V2ListHostDiagnosticsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListHostDiagnostics",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListHostDiagnosticsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListHostDiagnosticsOK), nil
+
+}
+
 /*
 V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.
 */
@@ -1383,6 +1613,31 @@ func (a *Client) V2ListHosts(ctx context.Context, params *V2ListHostsParams) (*V
 
 }
 
+/*
+V2ListInfraEnvRoleBindings Lists the roles granted on the infra-env.
+*/
+func (a *Client) V2ListInfraEnvRoleBindings(ctx context.Context, params *V2ListInfraEnvRoleBindingsParams) (*V2ListInfraEnvRoleBindingsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListInfraEnvRoleBindings",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/role-bindings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListInfraEnvRoleBindingsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListInfraEnvRoleBindingsOK), nil
+
+}
+
 /*
 V2PostStepReply Posts the result of the operations from the host agent.
 */
@@ -1784,3 +2039,28 @@ func (a *Client) V2UploadClusterIngressCert(ctx context.Context, params *V2Uploa
 	return result.(*V2UploadClusterIngressCertCreated), nil
 
 }
+
+/*
+V2WatchCluster Streams the live state of a cluster, its hosts and its events as server-sent events.
+*/
+func (a *Client) V2WatchCluster(ctx context.Context, params *V2WatchClusterParams, writer io.Writer) (*V2WatchClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2WatchCluster",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/watch",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2WatchClusterReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2WatchClusterOK), nil
+
+}
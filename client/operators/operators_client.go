@@ -30,6 +30,10 @@ type API interface {
 	/*
 	   V2ListOfClusterOperators Lists operators to be monitored for a cluster.*/
 	V2ListOfClusterOperators(ctx context.Context, params *V2ListOfClusterOperatorsParams) (*V2ListOfClusterOperatorsOK, error)
+	/*
+	   V2ListOperatorInstallOptions Lists the channels, catalog sources and install plan approval modes that can be selected to install an
+	   operator on a given OpenShift version.*/
+	V2ListOperatorInstallOptions(ctx context.Context, params *V2ListOperatorInstallOptionsParams) (*V2ListOperatorInstallOptionsOK, error)
 	/*
 	   V2ListOperatorProperties Lists properties for an operator.*/
 	V2ListOperatorProperties(ctx context.Context, params *V2ListOperatorPropertiesParams) (*V2ListOperatorPropertiesOK, error)
@@ -138,6 +142,32 @@ func (a *Client) V2ListOfClusterOperators(ctx context.Context, params *V2ListOfC
 
 }
 
+/*
+V2ListOperatorInstallOptions Lists the channels, catalog sources and install plan approval modes that can be selected to install an
+operator on a given OpenShift version.
+*/
+func (a *Client) V2ListOperatorInstallOptions(ctx context.Context, params *V2ListOperatorInstallOptionsParams) (*V2ListOperatorInstallOptionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2ListOperatorInstallOptions",
+		Method:             "GET",
+		PathPattern:        "/v2/supported-operators/{operator_name}/install-options",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListOperatorInstallOptionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListOperatorInstallOptionsOK), nil
+
+}
+
 /*
 V2ListOperatorProperties Lists properties for an operator.
 */
@@ -21,6 +21,7 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	metal3_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/openshift/assisted-image-service/pkg/servers"
+	"github.com/openshift/assisted-service/internal/audit"
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
@@ -34,6 +35,7 @@ import (
 	"github.com/openshift/assisted-service/internal/feature"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
 	"github.com/openshift/assisted-service/internal/ignition"
@@ -49,6 +51,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/quota"
 	"github.com/openshift/assisted-service/internal/releasesources"
 	"github.com/openshift/assisted-service/internal/spec"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
@@ -73,6 +76,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/pkg/thread"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/openshift/assisted-service/restapi"
 	osclientset "github.com/openshift/client-go/config/clientset/versioned"
 	"github.com/pkg/errors"
@@ -105,6 +109,8 @@ const (
 	deployment_type_ocp    = "ocp"
 	storage_filesystem     = "filesystem"
 	storage_s3             = "s3"
+	storage_azure_blob     = "azure-blob"
+	storage_gcs            = "gcs"
 	hostFSMountDir         = "/host"
 )
 
@@ -112,9 +118,12 @@ var Options struct {
 	feature.Flags
 
 	Auth                                 auth.Config
+	AuditConfig                          audit.Config
+	QuotaConfig                          quota.Config
 	BMConfig                             bminventory.Config
 	DBConfig                             dbPkg.Config
 	HWValidatorConfig                    hardware.ValidatorCfg
+	DiskHealthConfig                     smart.Thresholds
 	GeneratorConfig                      generator.Config
 	InstructionConfig                    hostcommands.InstructionConfig
 	OperatorsConfig                      operators.Options
@@ -125,6 +134,9 @@ var Options struct {
 	ClusterStateMonitorInterval          time.Duration `envconfig:"CLUSTER_MONITOR_INTERVAL" default:"10s"`
 	ClusterEventsUploaderInterval        time.Duration `envconfig:"CLUSTER_EVENTS_UPLOADER_INTERVAL" default:"15m"`
 	S3Config                             s3wrapper.Config
+	AzureBlobConfig                      s3wrapper.AzureBlobConfig
+	GCSConfig                            s3wrapper.GCSConfig
+	ContentStoreConfig                   s3wrapper.ContentStoreConfig
 	HostStateMonitorInterval             time.Duration `envconfig:"HOST_MONITOR_INTERVAL" default:"8s"`
 	Versions                             versions.Versions
 	OsImages                             string        `envconfig:"OS_IMAGES" default:""`
@@ -159,6 +171,10 @@ var Options struct {
 	ConnMaxLifetime                      time.Duration `envconfig:"DB_CONNECTIONS_MAX_LIFETIME" default:"30m"`
 	FileSystemUsageThreshold             int           `envconfig:"FILESYSTEM_USAGE_THRESHOLD" default:"80"`
 	EnableNotificationStreaming          bool          `envconfig:"ENABLE_EVENT_STREAMING" default:"false"`
+	StreamConfig                         stream.Config
+	EventSubscriptionConfig              events.SubscriptionDispatcherConfig
+	EventSubscriptionSecurityConfig      events.SubscriptionSecurityConfig
+	DNSProvidersConfig                   string        `envconfig:"DNS_PROVIDERS_CONFIG" default:""`
 	WorkDir                              string        `envconfig:"WORK_DIR" default:"/data/"`
 	LivenessValidationTimeout            time.Duration `envconfig:"LIVENESS_VALIDATION_TIMEOUT" default:"5m"`
 	ApproveCsrsRequeueDuration           time.Duration `envconfig:"APPROVE_CSRS_REQUEUE_DURATION" default:"1m"`
@@ -300,7 +316,7 @@ func main() {
 	ctrlMgr, err := createControllerManager()
 	failOnError(err, "failed to create controller manager")
 
-	notificationStream := getNotificationStream(log)
+	notificationStream, streamWriter := getNotificationStream(log, db)
 	defer notificationStream.Close()
 
 	usageManager := usage.NewManager(log, notificationStream)
@@ -310,8 +326,11 @@ func main() {
 	failOnError(err, "failed to create authenticator")
 	authzHandler := auth.NewAuthzHandler(&Options.Auth, ocmClient, log.WithField("pkg", "authz"), db)
 
+	subscriptionSecurity, err := events.NewSubscriptionSecurity(Options.EventSubscriptionSecurityConfig)
+	failOnError(err, "failed to configure event subscriptions")
+
 	crdEventsHandler := createCRDEventsHandler()
-	eventsHandler := createEventsHandler(crdEventsHandler, db, authzHandler, notificationStream, log)
+	eventsHandler := createEventsHandler(crdEventsHandler, db, authzHandler, notificationStream, subscriptionSecurity, log)
 
 	prometheusRegistry := prometheus.DefaultRegisterer
 	metricsManagerConfig := &metrics.MetricsManagerConfig{
@@ -328,7 +347,12 @@ func main() {
 
 	Options.InstructionConfig.ReleaseImageMirror = Options.ReleaseImageMirror
 	Options.InstructionConfig.CheckClusterVersion = Options.CheckClusterVersion
+	// The polling interval of the hosts is kept below the time after which they are considered disconnected
+	Options.InstructionConfig.MaxHostDisconnectionTime = Options.HostConfig.MaxHostDisconnectionTime
 	Options.OperatorsConfig.CheckClusterVersion = Options.CheckClusterVersion
+	// The disk health thresholds are shared by the host validations and the storage operators
+	Options.HWValidatorConfig.DiskHealth = Options.DiskHealthConfig
+	Options.OperatorsConfig.DiskHealth = Options.DiskHealthConfig
 	//Initialize Provider API
 	providerRegistry := registry.InitProviderRegistry(log.WithField("pkg", "provider"))
 	// Make sure that prepare for installation timeout is more than the timeouts of all underlying tools + 2m extra
@@ -372,6 +396,7 @@ func main() {
 	)
 
 	var objectHandler = createStorageClient(Options.DeployTarget, Options.Storage, &Options.S3Config,
+		&Options.AzureBlobConfig, &Options.GCSConfig, &Options.ContentStoreConfig,
 		Options.WorkDir, log, metricsManager, Options.FileSystemUsageThreshold, xattrClient)
 	createS3Bucket(objectHandler, log)
 
@@ -454,7 +479,9 @@ func main() {
 	hostApi := host.NewManager(log.WithField("pkg", "host-state"), db, notificationStream, eventsHandler, hwValidator,
 		instructionApi, &Options.HWValidatorConfig, metricsManager, &Options.HostConfig, lead, operatorsManager, providerRegistry, Options.EnableKubeAPI, objectHandler, versionHandler,
 		Options.EnableSoftTimeouts)
-	dnsApi := dns.NewDNSHandler(Options.BMConfig.BaseDNSDomains, log)
+	dnsProviders, err := dns.ParseProvidersConfig(Options.DNSProvidersConfig, Options.BMConfig.BaseDNSDomains)
+	failOnError(err, "failed to parse the DNS providers configuration")
+	dnsApi := dns.NewDNSHandlerWithProviders(Options.BMConfig.BaseDNSDomains, log, dns.NewDNSProviderFactory(dnsProviders, log))
 	manifestsGenerator := network.NewManifestsGenerator(manifestsApi, Options.ManifestsGeneratorConfig, db)
 	clusterApi := cluster.NewManager(Options.ClusterConfig, log.WithField("pkg", "cluster-state"), db,
 		notificationStream, eventsHandler, uploadClient, hostApi, metricsManager, manifestsGenerator, lead, operatorsManager,
@@ -476,6 +503,22 @@ func main() {
 	hostStateMonitor.Start()
 	defer hostStateMonitor.Stop()
 
+	if Options.EnableNotificationStreaming && Options.StreamConfig.Outbox.Enabled {
+		outboxRelay := stream.NewOutboxRelay(db, streamWriter, lead, metricsManager, Options.StreamConfig.Outbox,
+			log.WithField("pkg", "notification-outbox"))
+		notificationOutboxRelay := thread.New(
+			log.WithField("pkg", "notification-outbox"), "Notification Outbox Relay", Options.StreamConfig.Outbox.RelayInterval, outboxRelay.Relay)
+		notificationOutboxRelay.Start()
+		defer notificationOutboxRelay.Stop()
+	}
+
+	subscriptionDispatcher := events.NewSubscriptionDispatcher(db, lead, Options.EventSubscriptionConfig, subscriptionSecurity,
+		log.WithField("pkg", "event-subscriptions"))
+	eventSubscriptionDispatcher := thread.New(
+		log.WithField("pkg", "event-subscriptions"), "Event Subscription Dispatcher", Options.EventSubscriptionConfig.Interval, subscriptionDispatcher.Dispatch)
+	eventSubscriptionDispatcher.Start()
+	defer eventSubscriptionDispatcher.Stop()
+
 	failOnError(
 		versions.AddReleaseImagesToDBIfNeeded(db, releaseImagesArray, startupLeader, log, Options.EnableKubeAPI, Options.ReleaseSourcesConfig.ReleaseSources),
 		"error occured while adding configuration release images to the DB if needed",
@@ -503,10 +546,10 @@ func main() {
 		crdUtils = controllers.NewDummyCRDUtils()
 	}
 
-	if Options.EnableDeregisterInactiveGC || Options.EnableDeletedUnregisteredGC {
-		gc := garbagecollector.NewGarbageCollectors(Options.GCConfig, db, log.WithField("pkg", "garbage_collector"),
-			hostApi, clusterApi, infraEnvApi, objectHandler, lead)
+	gc := garbagecollector.NewGarbageCollectors(Options.GCConfig, db, log.WithField("pkg", "garbage_collector"),
+		hostApi, clusterApi, infraEnvApi, objectHandler, lead)
 
+	if Options.EnableDeregisterInactiveGC || Options.EnableDeletedUnregisteredGC {
 		// In operator-deployment, ClusterDeployment is responsible for managing the lifetime of the cluster resource.
 		if !Options.EnableKubeAPI && Options.EnableDeregisterInactiveGC {
 			deregisterWorker := thread.New(
@@ -544,22 +587,57 @@ func main() {
 		}
 	}
 
+	if Options.AuditConfig.Enabled {
+		auditDeletionWorker := thread.New(
+			log.WithField("garbagecollector", "Audit Records Deletion Worker"),
+			"Audit Records Deletion Worker",
+			Options.DeletionWorkerInterval,
+			gc.DeleteExpiredAuditRecords)
+
+		auditDeletionWorker.Start()
+		defer auditDeletionWorker.Stop()
+	}
+
 	// Determine if IPXE artifact URLs need to be http
 	serverInfo := servers.New(Options.HTTPListenPort, swag.StringValue(port), Options.HTTPSKeyFile, Options.HTTPSCertFile)
 	generateInsecureIPXEURLs := serverInfo.HTTP != nil
 
+	quotaManager, err := quota.NewManager(Options.QuotaConfig, metricsManager, log.WithField("pkg", "quota"))
+	failOnError(err, "failed to create quota manager")
+
 	bm := bminventory.NewBareMetalInventory(db, notificationStream, log.WithField("pkg", "Inventory"), hostApi, clusterApi, infraEnvApi, Options.BMConfig,
-		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, authzHandler, ocpClient, ocmClient,
+		generator, eventsHandler, objectHandler, metricsManager, quotaManager, usageManager, operatorsManager, authHandler, authzHandler, ocpClient, ocmClient,
 		lead, pullSecretValidator, versionHandler, osImages, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig,
 		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs, Options.GeneratorConfig.InstallInvoker)
+	clusterApi.SetScheduledInstaller(bm)
 	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
 
+	auditRecorder := audit.NewRecorder(db, log.WithField("pkg", "audit"))
+	auditor := audit.NewAuditor(auditRecorder, Options.AuditConfig, log.WithField("pkg", "audit"))
+	authorizer := authzHandler.CreateAuthorizer()
+	if Options.QuotaConfig.Enabled {
+		authorizer = quotaManager.Authorizer(authorizer)
+	}
+	if Options.AuditConfig.Enabled {
+		authorizer = auditor.Authorizer(authorizer)
+	}
+	// controllerInstaller records the changes made by the given controller in the audit log
+	controllerInstaller := func(controller string) bminventory.InstallerInternals {
+		if !Options.AuditConfig.Enabled {
+			return bm
+		}
+		return bminventory.NewAuditedInstaller(bm, auditRecorder, controller)
+	}
+
 	//Set inner handler chain. Inner handlers requires access to the Route
 	innerHandler := func() func(http.Handler) http.Handler {
 		return func(h http.Handler) http.Handler {
 			wrapped := metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry)(h)
 
 			wrapped = paramctx.ContextHandler()(wrapped)
+			if Options.AuditConfig.Enabled {
+				wrapped = auditor.Middleware(wrapped)
+			}
 			return wrapped
 		}
 	}
@@ -578,8 +656,9 @@ func main() {
 		AuthImageAuth:       authHandler.AuthImageAuth,
 		AuthImageURLAuth:    authHandler.AuthImageAuth,
 		APIKeyAuthenticator: authHandler.CreateAuthenticator(),
-		Authorizer:          authzHandler.CreateAuthorizer(),
+		Authorizer:          authorizer,
 		InstallerAPI:        bm,
+		AuditAPI:            audit.NewApi(db, log.WithField("pkg", "audit")),
 		EventsAPI:           events,
 		Logger:              log.Printf,
 		VersionsAPI:         versionsAPIHandler,
@@ -629,7 +708,7 @@ func main() {
 				APIReader:           r,
 				Config:              Options.InfraEnvConfig,
 				Log:                 log,
-				Installer:           bm,
+				Installer:           controllerInstaller("InfraEnvReconciler"),
 				CRDEventsHandler:    crdEventsHandler,
 				ServiceBaseURL:      Options.BMConfig.ServiceBaseURL,
 				ImageServiceBaseURL: Options.BMConfig.ImageServiceBaseURL,
@@ -649,7 +728,7 @@ func main() {
 				APIReader:                     cluster_reader,
 				Log:                           log,
 				Scheme:                        ctrlMgr.GetScheme(),
-				Installer:                     bm,
+				Installer:                     controllerInstaller("ClusterDeploymentsReconciler"),
 				ClusterApi:                    clusterApi,
 				HostApi:                       hostApi,
 				CRDEventsHandler:              crdEventsHandler,
@@ -667,7 +746,7 @@ func main() {
 				APIReader:                  ctrlMgr.GetAPIReader(),
 				Log:                        log,
 				Scheme:                     ctrlMgr.GetScheme(),
-				Installer:                  bm,
+				Installer:                  controllerInstaller("AgentReconciler"),
 				CRDEventsHandler:           crdEventsHandler,
 				ServiceBaseURL:             Options.BMConfig.ServiceBaseURL,
 				AuthType:                   Options.Auth.AuthType,
@@ -682,7 +761,7 @@ func main() {
 				APIReader:             ctrlMgr.GetAPIReader(),
 				Log:                   log,
 				Scheme:                ctrlMgr.GetScheme(),
-				Installer:             bm,
+				Installer:             controllerInstaller("BMACReconciler"),
 				SpokeK8sClientFactory: spokeClientFactory,
 				ConvergedFlowEnabled:  useConvergedFlow,
 				PauseProvisionedBMHs:  Options.PauseProvisionedBMHs,
@@ -710,7 +789,7 @@ func main() {
 				failOnError((&controllers.PreprovisioningImageReconciler{
 					Client:           ctrlMgr.GetClient(),
 					Log:              log,
-					Installer:        bm,
+					Installer:        controllerInstaller("PreprovisioningImageReconciler"),
 					CRDEventsHandler: crdEventsHandler,
 					VersionsHandler:  versionHandler,
 					OcRelease:        releaseHandler,
@@ -761,6 +840,9 @@ func setupDB(log logrus.FieldLogger) *gorm.DB {
 			log.WithError(err).Info("Failed to connect to DB, retrying")
 			return
 		}
+		if err = db.Use(transaction.AfterCommitPlugin{}); err != nil {
+			log.WithError(err).Fatal("Failed to register the after commit hooks")
+		}
 		sqlDB, err := db.DB()
 		if err != nil {
 			log.WithError(err).Info("Failed to get sqlDB, retrying")
@@ -799,17 +881,34 @@ func createS3Bucket(objectHandler s3wrapper.API, log logrus.FieldLogger) {
 	}
 }
 
-func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.Config, fsWorkDir string,
+func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.Config,
+	azureBlobCfg *s3wrapper.AzureBlobConfig, gcsCfg *s3wrapper.GCSConfig, contentStoreCfg *s3wrapper.ContentStoreConfig, fsWorkDir string,
 	log logrus.FieldLogger, metricsAPI metrics.API, fsThreshold int, xattrClient s3wrapper.XattrClient) s3wrapper.API {
 	var storageClient s3wrapper.API = nil
+	var err error
+	newFSClient := func() s3wrapper.API {
+		contentStore, err := s3wrapper.NewContentStore(fsWorkDir, contentStoreCfg)
+		if err != nil {
+			log.WithError(err).Fatal("failed to create filesystem content store")
+		}
+		return s3wrapper.NewFSClient(fsWorkDir, log, metricsAPI, fsThreshold, xattrClient, contentStore)
+	}
 	if storage != "" {
 		switch storage {
 		case storage_s3:
 			if storageClient = s3wrapper.NewS3Client(s3cfg, log); storageClient == nil { //nolint:staticcheck
 				log.Fatal("failed to create S3 client")
 			}
+		case storage_azure_blob:
+			if storageClient, err = s3wrapper.NewAzureBlobClient(azureBlobCfg, log); err != nil {
+				log.WithError(err).Fatal("failed to create Azure Blob Storage client")
+			}
+		case storage_gcs:
+			if storageClient, err = s3wrapper.NewGCSClient(gcsCfg, log); err != nil {
+				log.WithError(err).Fatal("failed to create GCS client")
+			}
 		case storage_filesystem:
-			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, metricsAPI, fsThreshold, xattrClient)
+			storageClient = newFSClient()
 		default:
 			log.Fatalf("unsupported storage client: %s", storage)
 		}
@@ -821,7 +920,7 @@ func createStorageClient(deployTarget string, storage string, s3cfg *s3wrapper.C
 				log.Fatal("failed to create S3 client")
 			}
 		case deployment_type_onprem, deployment_type_ocp:
-			storageClient = s3wrapper.NewFSClient(fsWorkDir, log, metricsAPI, fsThreshold, xattrClient)
+			storageClient = newFSClient()
 		default:
 			log.Fatalf("unsupported deploy target %s", deployTarget)
 		}
@@ -859,8 +958,9 @@ func autoMigrationWithLeader(migrationLeader leader.ElectorInterface, db *gorm.D
 	})
 }
 
-func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, db *gorm.DB, authzHandler auth.Authorizer, notificationStream stream.Notifier, log logrus.FieldLogger) eventsapi.Handler {
-	eventsHandler := events.New(db, authzHandler, notificationStream, log.WithField("pkg", "events"))
+func createEventsHandler(crdEventsHandler controllers.CRDEventsHandler, db *gorm.DB, authzHandler auth.Authorizer, notificationStream stream.Notifier,
+	subscriptionSecurity *events.SubscriptionSecurity, log logrus.FieldLogger) eventsapi.Handler {
+	eventsHandler := events.NewWithSubscriptionSecurity(db, authzHandler, notificationStream, subscriptionSecurity, log.WithField("pkg", "events"))
 
 	if crdEventsHandler != nil {
 		return controllers.NewControllerEventsWrapper(crdEventsHandler, eventsHandler, db, log)
@@ -957,15 +1057,25 @@ func createVersionHandlers(
 	return versionsHandler, versionsAPIHandler, nil
 }
 
-func getNotificationStream(log *logrus.Logger) *stream.NotificationStream {
+// getNotificationStream returns the notifier used by all components. It also delivers the
+// notifications to the in-process subscribers that watch clusters through the REST API.
+func getNotificationStream(log *logrus.Logger, db *gorm.DB) (*stream.Broadcaster, stream.StreamWriter) {
 	metadata := map[string]interface{}{
 		"versions": versions.GetListVersionsFromVersions(Options.Versions),
 	}
-	writer, err := stream.NewWriter(log, Options.EnableNotificationStreaming)
+	writer, err := stream.NewWriter(log, Options.EnableNotificationStreaming, Options.StreamConfig)
 	if err != nil {
-		log.WithError(err).Fatal("kafka writer failed to initialize")
+		log.WithError(err).Fatalf("%s event stream writer failed to initialize", Options.StreamConfig.WriterType)
+	}
+	var notificationStream *stream.NotificationStream
+	if Options.EnableNotificationStreaming && Options.StreamConfig.Outbox.Enabled {
+		log.Info("Storing event stream notifications in the outbox")
+		notificationStream = stream.NewOutboxNotificationStream(db, writer, log, metadata)
+	} else {
+		notificationStream = stream.NewNotificationStream(writer, log, metadata)
 	}
-	return stream.NewNotificationStream(writer, log, metadata)
+	return stream.NewBroadcaster(notificationStream, Options.StreamConfig.SubscriptionBufferSize,
+		log.WithField("pkg", "stream-broadcaster")), writer
 }
 
 func doesBMHCRDExist(mgr manager.Manager) error {
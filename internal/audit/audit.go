@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/secretdump"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"ENABLE_AUDIT_LOG" default:"true"`
+
+	// ExcludedOperations are the operation IDs that are not audited, e.g. v2PostStepReply to
+	// leave out the periodic reports of the agents
+	ExcludedOperations []string `envconfig:"AUDIT_EXCLUDED_OPERATIONS" default:""`
+
+	// AgentOperations records the calls of the agents too. Their bodies, such as the inventories
+	// and the step replies, are never recorded.
+	AgentOperations bool `envconfig:"AUDIT_AGENT_OPERATIONS" default:"false"`
+}
+
+// AuthTypeController is the auth type of the changes made by the controllers
+const AuthTypeController = "controller"
+
+//go:generate mockgen --build_flags=--mod=mod -package=audit -destination=mock_recorder.go . Recorder
+type Recorder interface {
+	// Record stores an audit record. Failing to store it is logged and does not fail the change
+	// that it records.
+	Record(ctx context.Context, record *models.AuditRecord)
+}
+
+type recorder struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func NewRecorder(db *gorm.DB, log logrus.FieldLogger) Recorder {
+	return &recorder{
+		db:  db,
+		log: log,
+	}
+}
+
+func (r *recorder) Record(ctx context.Context, record *models.AuditRecord) {
+	log := logutil.FromContext(ctx, r.log)
+	if err := r.db.Create(&common.AuditRecord{AuditRecord: *record}).Error; err != nil {
+		log.WithError(err).Errorf("failed to record operation %s of %s", record.OperationID, record.Actor)
+	}
+}
+
+// Changes generates the JSON document of a requested change with its secrets redacted
+func Changes(change interface{}) string {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return ""
+	}
+	return redactChanges(data)
+}
+
+func redactChanges(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	redacted, err := secretdump.RedactJSON(data)
+	if err != nil {
+		// Not a JSON document, it is not recorded since its secrets can not be found
+		return ""
+	}
+	return string(redacted)
+}
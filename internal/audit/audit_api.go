@@ -0,0 +1,79 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ restapi.AuditAPI = &Api{}
+
+type Api struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+func NewApi(db *gorm.DB, log logrus.FieldLogger) *Api {
+	return &Api{
+		db:  db,
+		log: log,
+	}
+}
+
+func (a *Api) V2ListAuditRecords(ctx context.Context, params audit.V2ListAuditRecordsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, a.log)
+	if swag.Int64Value(params.Limit) < 0 || swag.Int64Value(params.Offset) < 0 {
+		return common.NewApiError(http.StatusBadRequest, errors.New("limit and offset must not be negative"))
+	}
+
+	query := a.db.Model(&common.AuditRecord{})
+	if params.ClusterID != nil {
+		query = query.Where("cluster_id = ?", params.ClusterID.String())
+	}
+	if params.InfraEnvID != nil {
+		query = query.Where("infra_env_id = ?", params.InfraEnvID.String())
+	}
+	if params.HostID != nil {
+		query = query.Where("host_id = ?", params.HostID.String())
+	}
+	if params.Actor != nil {
+		query = query.Where("actor = ?", *params.Actor)
+	}
+	if params.OperationID != nil {
+		query = query.Where("operation_id = ?", *params.OperationID)
+	}
+	if params.Since != nil {
+		query = query.Where("created_at >= ?", time.Time(*params.Since))
+	}
+	if params.Until != nil {
+		query = query.Where("created_at < ?", time.Time(*params.Until))
+	}
+	if params.Limit != nil {
+		query = query.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		query = query.Offset(int(*params.Offset))
+	}
+
+	var records []*common.AuditRecord
+	if err := query.Order("created_at desc, id desc").Find(&records).Error; err != nil {
+		log.WithError(err).Error("failed to list audit records")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.AuditRecordList, 0, len(records))
+	for _, record := range records {
+		ret = append(ret, &record.AuditRecord)
+	}
+	return audit.NewV2ListAuditRecordsOK().WithPayload(ret)
+}
@@ -0,0 +1,136 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/restapi/operations/audit"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("V2ListAuditRecords", func() {
+	var (
+		db         *gorm.DB
+		dbName     string
+		api        *Api
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+		now        time.Time
+	)
+
+	createRecord := func(operationID, actor string, clusterID, infraEnvID *strfmt.UUID, createdAt time.Time) {
+		Expect(db.Create(&common.AuditRecord{AuditRecord: models.AuditRecord{
+			Actor:       actor,
+			OperationID: operationID,
+			ClusterID:   clusterID,
+			InfraEnvID:  infraEnvID,
+			CreatedAt:   createdAt,
+		}}).Error).ToNot(HaveOccurred())
+	}
+
+	list := func(params audit.V2ListAuditRecordsParams) models.AuditRecordList {
+		reply := api.V2ListAuditRecords(context.Background(), params)
+		Expect(reply).To(BeAssignableToTypeOf(&audit.V2ListAuditRecordsOK{}))
+		return reply.(*audit.V2ListAuditRecordsOK).Payload
+	}
+
+	operationIDs := func(records models.AuditRecordList) []string {
+		ret := make([]string, 0, len(records))
+		for _, record := range records {
+			ret = append(ret, record.OperationID)
+		}
+		return ret
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		api = NewApi(db, logrus.New())
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		now = time.Now()
+		createRecord("v2RegisterCluster", "jdoe", &clusterID, nil, now.Add(-3*time.Hour))
+		createRecord("RegisterInfraEnv", "jdoe", nil, &infraEnvID, now.Add(-2*time.Hour))
+		createRecord("v2InstallCluster", "InfraEnvReconciler", &clusterID, nil, now.Add(-time.Hour))
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("lists the newest records first", func() {
+		Expect(operationIDs(list(audit.V2ListAuditRecordsParams{}))).To(Equal(
+			[]string{"v2InstallCluster", "RegisterInfraEnv", "v2RegisterCluster"}))
+	})
+
+	It("filters the records", func() {
+		Expect(operationIDs(list(audit.V2ListAuditRecordsParams{ClusterID: &clusterID}))).To(Equal(
+			[]string{"v2InstallCluster", "v2RegisterCluster"}))
+		Expect(operationIDs(list(audit.V2ListAuditRecordsParams{InfraEnvID: &infraEnvID}))).To(Equal(
+			[]string{"RegisterInfraEnv"}))
+		Expect(operationIDs(list(audit.V2ListAuditRecordsParams{Actor: swag.String("jdoe")}))).To(Equal(
+			[]string{"RegisterInfraEnv", "v2RegisterCluster"}))
+		Expect(operationIDs(list(audit.V2ListAuditRecordsParams{OperationID: swag.String("v2InstallCluster")}))).To(Equal(
+			[]string{"v2InstallCluster"}))
+	})
+
+	It("filters the records by time", func() {
+		since := strfmt.DateTime(now.Add(-150 * time.Minute))
+		until := strfmt.DateTime(now.Add(-90 * time.Minute))
+		Expect(operationIDs(list(audit.V2ListAuditRecordsParams{Since: &since, Until: &until}))).To(Equal(
+			[]string{"RegisterInfraEnv"}))
+	})
+
+	It("pages the records", func() {
+		Expect(operationIDs(list(audit.V2ListAuditRecordsParams{Limit: swag.Int64(2)}))).To(Equal(
+			[]string{"v2InstallCluster", "RegisterInfraEnv"}))
+		Expect(operationIDs(list(audit.V2ListAuditRecordsParams{Limit: swag.Int64(2), Offset: swag.Int64(2)}))).To(Equal(
+			[]string{"v2RegisterCluster"}))
+	})
+
+	It("rejects a negative limit", func() {
+		reply := api.V2ListAuditRecords(context.Background(), audit.V2ListAuditRecordsParams{Limit: swag.Int64(-1)})
+		Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+		Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
+
+var _ = Describe("Recorder", func() {
+	var (
+		db     *gorm.DB
+		dbName string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("stores the records", func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		NewRecorder(db, logrus.New()).Record(context.Background(), &models.AuditRecord{
+			Actor:       "ClusterDeploymentsReconciler",
+			AuthType:    AuthTypeController,
+			OperationID: "V2UpdateCluster",
+			ClusterID:   &clusterID,
+			Changes:     Changes(&models.V2ClusterUpdateParams{PullSecret: swag.String("top-secret")}),
+		})
+
+		var records []*common.AuditRecord
+		Expect(db.Find(&records).Error).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].ClusterID.String()).To(Equal(clusterID.String()))
+		Expect(records[0].Changes).To(Equal(`{"pull_secret":"<SECRET>"}`))
+	})
+})
@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+func TestAudit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Audit Suite")
+}
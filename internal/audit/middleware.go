@@ -0,0 +1,186 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/sirupsen/logrus"
+)
+
+type contextKey string
+
+const (
+	recordKey contextKey = "audit-record"
+
+	agentAuthScheme = "agentAuth"
+)
+
+// createdIDs maps the operations that create a cluster, an infra-env or a host to the
+// field of the audit record that the ID of the created object is written to
+var createdIDs = map[string]func(record *models.AuditRecord, id *strfmt.UUID){
+	"v2RegisterCluster": func(record *models.AuditRecord, id *strfmt.UUID) { record.ClusterID = id },
+	"v2ImportCluster":   func(record *models.AuditRecord, id *strfmt.UUID) { record.ClusterID = id },
+	"RegisterInfraEnv":  func(record *models.AuditRecord, id *strfmt.UUID) { record.InfraEnvID = id },
+	"v2RegisterHost":    func(record *models.AuditRecord, id *strfmt.UUID) { record.HostID = id },
+}
+
+// Auditor records the calls to the REST API that are not reads
+type Auditor struct {
+	recorder        Recorder
+	excluded        map[string]bool
+	agentOperations bool
+	log             logrus.FieldLogger
+}
+
+func NewAuditor(recorder Recorder, config Config, log logrus.FieldLogger) *Auditor {
+	excluded := make(map[string]bool)
+	for _, operationID := range config.ExcludedOperations {
+		excluded[operationID] = true
+	}
+	return &Auditor{
+		recorder:        recorder,
+		excluded:        excluded,
+		agentOperations: config.AgentOperations,
+		log:             log,
+	}
+}
+
+// Middleware is an inner middleware: it requires the matched route to know the operation and its
+// path params. It runs before the authentication, so the actor of the record is only filled in
+// by the Authorizer.
+func (a *Auditor) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := middleware.MatchedRouteFrom(r)
+		if !isChange(r.Method) || route == nil || route.Operation == nil || a.excluded[route.Operation.ID] {
+			next.ServeHTTP(w, r)
+			return
+		}
+		agentOperation := isAgentOperation(route)
+		if agentOperation && !a.agentOperations {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		record := &models.AuditRecord{
+			Method:      r.Method,
+			OperationID: route.Operation.ID,
+			RequestID:   requestid.FromContext(r.Context()),
+			ClusterID:   routeID(route, "cluster_id"),
+			InfraEnvID:  routeID(route, "infra_env_id"),
+			HostID:      routeID(route, "host_id"),
+		}
+		if !agentOperation && isJSON(r) && r.Body != nil {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				a.log.WithError(err).Warnf("failed to read the body of operation %s", record.OperationID)
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			record.Changes = redactChanges(body)
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w}
+		setCreatedID, isCreation := createdIDs[record.OperationID]
+		if isCreation {
+			recorder.body = &bytes.Buffer{}
+		}
+		next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), recordKey, record)))
+
+		record.StatusCode = int64(recorder.statusCode)
+		if isCreation && recorder.statusCode == http.StatusCreated {
+			var created struct {
+				ID *strfmt.UUID `json:"id"`
+			}
+			if err := json.Unmarshal(recorder.body.Bytes(), &created); err == nil && created.ID != nil {
+				setCreatedID(record, created.ID)
+			}
+		}
+		a.recorder.Record(r.Context(), record)
+	})
+}
+
+// Authorizer wraps the authorizer of the API to fill in the actor of the audit record, it is the
+// first place where the principal of the request is known
+func (a *Auditor) Authorizer(authorizer func(*http.Request) error) func(*http.Request) error {
+	return func(r *http.Request) error {
+		if record, ok := r.Context().Value(recordKey).(*models.AuditRecord); ok {
+			record.Actor = ocm.UserNameFromContext(r.Context())
+			if route := middleware.MatchedRouteFrom(r); route != nil && route.Authenticator != nil && len(route.Authenticator.Schemes) > 0 {
+				record.AuthType = route.Authenticator.Schemes[0]
+			}
+		}
+		if authorizer == nil {
+			return nil
+		}
+		return authorizer(r)
+	}
+}
+
+func isChange(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// isAgentOperation returns whether the operation is only allowed to the agents
+func isAgentOperation(route *middleware.MatchedRoute) bool {
+	if len(route.Authenticators) == 0 {
+		return false
+	}
+	for _, authenticator := range route.Authenticators {
+		for _, scheme := range authenticator.Schemes {
+			if scheme != agentAuthScheme {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isJSON(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "application/json"
+}
+
+func routeID(route *middleware.MatchedRoute, name string) *strfmt.UUID {
+	value := route.Params.Get(name)
+	if value == "" {
+		return nil
+	}
+	id := strfmt.UUID(value)
+	return &id
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+
+	// body is only kept for the operations that create an object, to find its ID
+	body *bytes.Buffer
+}
+
+func (w *responseRecorder) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	if w.body != nil {
+		w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,212 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/security"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/client"
+	clientInstaller "github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/mocks"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Auditor", func() {
+	var (
+		ctrl             *gomock.Controller
+		mockInstallerAPI *mocks.MockInstallerAPI
+		mockRecorder     *MockRecorder
+		server           *httptest.Server
+		bmclient         *client.AssistedInstall
+		clusterID        strfmt.UUID
+		recorded         []*models.AuditRecord
+	)
+
+	authenticator := func(name string, _ string, authenticate security.TokenAuthentication) runtime.Authenticator {
+		return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
+			return true, &ocm.AuthPayload{Username: "jdoe", Role: ocm.UserRole}, nil
+		})
+	}
+
+	createServer := func(config Config) {
+		auditor := NewAuditor(mockRecorder, config, logrus.New())
+		h, err := restapi.Handler(restapi.Config{
+			APIKeyAuthenticator: authenticator,
+			Authorizer:          auditor.Authorizer(func(*http.Request) error { return nil }),
+			InstallerAPI:        mockInstallerAPI,
+			Logger:              logrus.Printf,
+			InnerMiddleware:     auditor.Middleware,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		server = httptest.NewServer(h)
+		bmclient = client.New(client.Config{
+			URL: &url.URL{
+				Scheme: client.DefaultSchemes[0],
+				Host:   strings.TrimPrefix(server.URL, "http://"),
+				Path:   client.DefaultBasePath,
+			},
+		})
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstallerAPI = mocks.NewMockInstallerAPI(ctrl)
+		mockRecorder = NewMockRecorder(ctrl)
+		clusterID = strfmt.UUID(uuid.New().String())
+		recorded = nil
+		mockRecorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, record *models.AuditRecord) {
+			recorded = append(recorded, record)
+		}).AnyTimes()
+	})
+
+	AfterEach(func() {
+		server.Close()
+		ctrl.Finish()
+	})
+
+	It("records the changes with their secrets redacted", func() {
+		createServer(Config{})
+		mockInstallerAPI.EXPECT().V2UpdateCluster(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, params installer.V2UpdateClusterParams) middleware.Responder {
+				Expect(swag.StringValue(params.ClusterUpdateParams.PullSecret)).To(Equal("top-secret"))
+				return installer.NewV2UpdateClusterCreated().WithPayload(&models.Cluster{ID: &clusterID})
+			})
+
+		_, err := bmclient.Installer.V2UpdateCluster(context.Background(), &clientInstaller.V2UpdateClusterParams{
+			ClusterID: clusterID,
+			ClusterUpdateParams: &models.V2ClusterUpdateParams{
+				Name:       swag.String("audited"),
+				PullSecret: swag.String("top-secret"),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(recorded).To(HaveLen(1))
+		record := recorded[0]
+		Expect(record.Actor).To(Equal("jdoe"))
+		Expect(record.AuthType).To(Equal("userAuth"))
+		Expect(record.Method).To(Equal(http.MethodPatch))
+		Expect(record.OperationID).To(Equal("V2UpdateCluster"))
+		Expect(record.ClusterID).To(Equal(&clusterID))
+		Expect(record.StatusCode).To(Equal(int64(http.StatusCreated)))
+		Expect(record.Changes).To(ContainSubstring(`"name":"audited"`))
+		Expect(record.Changes).To(ContainSubstring(`"pull_secret":"<SECRET>"`))
+		Expect(record.Changes).ToNot(ContainSubstring("top-secret"))
+	})
+
+	It("records the ID of the created objects", func() {
+		createServer(Config{})
+		mockInstallerAPI.EXPECT().V2RegisterCluster(gomock.Any(), gomock.Any()).Return(
+			installer.NewV2RegisterClusterCreated().WithPayload(&models.Cluster{ID: &clusterID}))
+
+		_, err := bmclient.Installer.V2RegisterCluster(context.Background(), &clientInstaller.V2RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("audited"),
+				OpenshiftVersion: swag.String("4.14"),
+				PullSecret:       swag.String("top-secret"),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(recorded).To(HaveLen(1))
+		Expect(recorded[0].OperationID).To(Equal("v2RegisterCluster"))
+		Expect(recorded[0].ClusterID).To(Equal(&clusterID))
+	})
+
+	It("records the failed changes", func() {
+		createServer(Config{})
+		mockInstallerAPI.EXPECT().V2DeregisterCluster(gomock.Any(), gomock.Any()).Return(
+			common.NewApiError(http.StatusNotFound, errors.New("cluster not found")))
+
+		_, err := bmclient.Installer.V2DeregisterCluster(context.Background(), &clientInstaller.V2DeregisterClusterParams{
+			ClusterID: clusterID,
+		})
+		Expect(err).To(HaveOccurred())
+
+		Expect(recorded).To(HaveLen(1))
+		Expect(recorded[0].OperationID).To(Equal("v2DeregisterCluster"))
+		Expect(recorded[0].StatusCode).To(Equal(int64(http.StatusNotFound)))
+		Expect(recorded[0].Changes).To(BeEmpty())
+	})
+
+	It("does not record the reads", func() {
+		createServer(Config{})
+		mockInstallerAPI.EXPECT().V2GetCluster(gomock.Any(), gomock.Any()).Return(
+			installer.NewV2GetClusterOK().WithPayload(&models.Cluster{ID: &clusterID}))
+
+		_, err := bmclient.Installer.V2GetCluster(context.Background(), &clientInstaller.V2GetClusterParams{
+			ClusterID: clusterID,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorded).To(BeEmpty())
+	})
+
+	It("does not record the excluded operations", func() {
+		createServer(Config{ExcludedOperations: []string{"v2DeregisterCluster"}})
+		mockInstallerAPI.EXPECT().V2DeregisterCluster(gomock.Any(), gomock.Any()).Return(
+			installer.NewV2DeregisterClusterNoContent())
+
+		_, err := bmclient.Installer.V2DeregisterCluster(context.Background(), &clientInstaller.V2DeregisterClusterParams{
+			ClusterID: clusterID,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorded).To(BeEmpty())
+	})
+
+	Context("agent operations", func() {
+		var hostID strfmt.UUID
+
+		updateProgress := func() {
+			mockInstallerAPI.EXPECT().V2UpdateHostInstallProgress(gomock.Any(), gomock.Any()).Return(
+				installer.NewV2UpdateHostInstallProgressOK())
+
+			_, err := bmclient.Installer.V2UpdateHostInstallProgress(context.Background(), &clientInstaller.V2UpdateHostInstallProgressParams{
+				InfraEnvID: clusterID,
+				HostID:     hostID,
+				HostProgress: &models.HostProgress{
+					CurrentStage: models.HostStageRebooting,
+					ProgressInfo: "rebooting",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			hostID = strfmt.UUID(uuid.New().String())
+		})
+
+		It("are not recorded by default", func() {
+			createServer(Config{})
+			updateProgress()
+			Expect(recorded).To(BeEmpty())
+		})
+
+		It("are recorded without their bodies when enabled", func() {
+			createServer(Config{AgentOperations: true})
+			updateProgress()
+
+			Expect(recorded).To(HaveLen(1))
+			Expect(recorded[0].OperationID).To(Equal("v2UpdateHostInstallProgress"))
+			Expect(recorded[0].AuthType).To(Equal("agentAuth"))
+			Expect(recorded[0].HostID).To(Equal(&hostID))
+			Expect(recorded[0].Changes).To(BeEmpty())
+		})
+	})
+})
@@ -33,6 +33,7 @@ import (
 	"github.com/openshift/assisted-service/internal/garbagecollector"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/hardware/fingerprint"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
@@ -49,6 +50,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/provider"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/quota"
 	"github.com/openshift/assisted-service/internal/stream"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
@@ -124,6 +126,8 @@ type Config struct {
 	IPv6Support                         bool              `envconfig:"IPV6_SUPPORT" default:"true"`
 	DiskEncryptionSupport               bool              `envconfig:"DISK_ENCRYPTION_SUPPORT" default:"true"`
 	TNAClustersSupport                  bool              `envconfig:"TNA_CLUSTERS_SUPPORT" default:"false"`
+	ClusterWatchResyncInterval          time.Duration     `envconfig:"CLUSTER_WATCH_RESYNC_INTERVAL" default:"10s"`
+	ClusterWatchKeepAliveInterval       time.Duration     `envconfig:"CLUSTER_WATCH_KEEPALIVE_INTERVAL" default:"15s"`
 
 	// InfraEnv ID for the ephemeral installer. Should not be set explicitly.Ephemeral (agent) installer sets this env var
 	InfraEnvID strfmt.UUID `envconfig:"INFRA_ENV_ID" default:""`
@@ -162,6 +166,7 @@ type InstallerInternals interface {
 	UpdateHostApprovedInternal(ctx context.Context, infraEnvId string, hostId string, approved bool) error
 	V2UpdateHostInstallerArgsInternal(ctx context.Context, params installer.V2UpdateHostInstallerArgsParams) (*models.Host, error)
 	V2UpdateHostIgnitionInternal(ctx context.Context, params installer.V2UpdateHostIgnitionParams) (*models.Host, error)
+	V2CreateHostDiagnosticInternal(ctx context.Context, params installer.V2CreateHostDiagnosticParams) (*models.HostDiagnostic, error)
 	GetCredentialsInternal(ctx context.Context, params installer.V2GetCredentialsParams) (*models.Credentials, error)
 	V2DownloadClusterFilesInternal(ctx context.Context, params installer.V2DownloadClusterFilesParams) (io.ReadCloser, int64, error)
 	V2DownloadClusterCredentialsInternal(ctx context.Context, params installer.V2DownloadClusterCredentialsParams) (io.ReadCloser, int64, error)
@@ -204,6 +209,7 @@ type bareMetalInventory struct {
 	eventsHandler        eventsapi.Handler
 	objectHandler        s3wrapper.API
 	metricApi            metrics.API
+	quotaApi             quota.API
 	usageApi             usage.API
 	operatorManagerApi   operators.API
 	generator            generator.InstallConfigGenerator
@@ -238,6 +244,7 @@ func NewBareMetalInventory(
 	eventsHandler eventsapi.Handler,
 	objectHandler s3wrapper.API,
 	metricApi metrics.API,
+	quotaApi quota.API,
 	usageApi usage.API,
 	operatorManagerApi operators.API,
 	authHandler auth.Authenticator,
@@ -272,6 +279,7 @@ func NewBareMetalInventory(
 		eventsHandler:        eventsHandler,
 		objectHandler:        objectHandler,
 		metricApi:            metricApi,
+		quotaApi:             quotaApi,
 		usageApi:             usageApi,
 		operatorManagerApi:   operatorManagerApi,
 		authHandler:          authHandler,
@@ -510,6 +518,11 @@ func MarshalNewClusterParamsNoPullSecret(params installer.V2RegisterClusterParam
 		newSecret := "***"
 		clusterParamsNoPullSecret.PullSecret = &newSecret
 	}
+	if platform := clusterParamsNoPullSecret.Platform; platform != nil && platform.Openstack != nil && platform.Openstack.CloudsYaml != nil {
+		openstack := *platform.Openstack
+		openstack.CloudsYaml = swag.String("***")
+		clusterParamsNoPullSecret.Platform = &models.Platform{Type: platform.Type, External: platform.External, Openstack: &openstack}
+	}
 
 	jsonNewClusterParams, err := json.Marshal(clusterParamsNoPullSecret)
 	if err != nil {
@@ -660,6 +673,12 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 		MachineNetworkCidrUpdatedAt: time.Now(),
 	}
 
+	if cluster.Platform != nil && cluster.Platform.Openstack != nil {
+		// The clouds.yaml is stored with the secrets of the cluster, it is never returned
+		cluster.OpenstackCloudsYaml = swag.StringValue(cluster.Platform.Openstack.CloudsYaml)
+		cluster.Platform.Openstack.CloudsYaml = nil
+	}
+
 	if err = cluster.SetMirrorRegistryConfiguration(mirrorRegistryConfiguration); err != nil {
 		return nil, err
 	}
@@ -707,8 +726,14 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
-	err = b.clusterApi.RegisterCluster(ctx, cluster)
+	err = b.clusterApi.RegisterCluster(ctx, cluster, func(tx *gorm.DB) error {
+		return b.quotaApi.CheckActiveClusters(ctx, tx)
+	})
 	if err != nil {
+		var apiErr *common.ApiErrorResponse
+		if errors.As(err, &apiErr) {
+			return nil, err
+		}
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
@@ -1367,6 +1392,10 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 		return nil, common.NewApiError(http.StatusConflict,
 			errors.Errorf("Cluster is not ready for installation, %s validation_info=%s", reason, cluster.ValidationsInfo))
 	}
+	if cluster.Platform != nil && common.PlatformTypeValue(cluster.Platform.Type) == models.PlatformTypeOpenstack && cluster.OpenstackCloudsYaml == "" {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.New("The OpenStack clouds.yaml must be set in the platform settings to install the cluster"))
+	}
 
 	// prepare cluster and hosts for installation
 	err = b.db.Transaction(func(tx *gorm.DB) error {
@@ -1683,10 +1712,33 @@ func (b *bareMetalInventory) GetClusterSupportedPlatformsInternal(
 		}
 	}
 
+	environment, err := fingerprint.ForHosts(cluster.Hosts)
+	if err != nil {
+		err2 := fmt.Errorf("error while classifying the hosts hardware, error: %w", err)
+		b.log.Error(err2.Error())
+		return nil, err2
+	}
+	supportedPlatforms = suggestPlatformFirst(supportedPlatforms, provider.GetPlatformTypeForEnvironment(environment))
+
 	b.log.Infof("Found %d supported-platforms for cluster %s", len(supportedPlatforms), cluster.ID)
 	return &supportedPlatforms, nil
 }
 
+// suggestPlatformFirst moves the platform matching the hardware of the hosts to the head of the supported platforms,
+// the order of the other platforms is kept
+func suggestPlatformFirst(supportedPlatforms []models.PlatformType, suggested models.PlatformType) []models.PlatformType {
+	if suggested == "" || !funk.Contains(supportedPlatforms, suggested) {
+		return supportedPlatforms
+	}
+	platforms := []models.PlatformType{suggested}
+	for _, platformType := range supportedPlatforms {
+		if platformType != suggested {
+			platforms = append(platforms, platformType)
+		}
+	}
+	return platforms
+}
+
 func (b *bareMetalInventory) GetClusterSupportedPlatforms(ctx context.Context, params installer.GetClusterSupportedPlatformsParams) middleware.Responder {
 	supportedPlatforms, err := b.GetClusterSupportedPlatformsInternal(ctx, params)
 	if err != nil {
@@ -2112,6 +2164,16 @@ func (b *bareMetalInventory) validateUpdateCluster(
 		return params, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if params.ClusterUpdateParams.InstallTimeouts != nil {
+		if _, err = common.ParseInstallTimeouts(*params.ClusterUpdateParams.InstallTimeouts); err != nil {
+			return params, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	if err = validateInstallSchedule(params.ClusterUpdateParams.InstallSchedule, time.Now()); err != nil {
+		return params, common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	err = b.validateFeatureSupportLevel(ctx, cluster, params.ClusterUpdateParams)
 	if err != nil {
 		return params, err
@@ -2185,6 +2247,11 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 			}
 		}
 
+		if err = hostutil.RecordClusterConfigUpdate(tx, *cluster.ID); err != nil {
+			log.WithError(err).Errorf("failed to record the configuration update of the hosts of cluster %s", cluster.ID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+
 		b.updateClusterNetworkVMUsage(cluster, params.ClusterUpdateParams, usages, log)
 
 		b.updateClusterCPUFeatureUsage(cluster.CPUArchitecture, usages)
@@ -2484,6 +2551,7 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 	optionalParam(params.ClusterUpdateParams.NoProxy, "no_proxy", updates)
 	optionalParam(params.ClusterUpdateParams.SSHPublicKey, "ssh_public_key", updates)
 	optionalParam(params.ClusterUpdateParams.Hyperthreading, "hyperthreading", updates)
+	optionalParam(params.ClusterUpdateParams.SecureBootMode, "secure_boot_mode", updates)
 
 	b.setProxyUsage(params.ClusterUpdateParams.HTTPProxy, params.ClusterUpdateParams.HTTPSProxy, params.ClusterUpdateParams.NoProxy, usages)
 
@@ -2576,6 +2644,19 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		updates["control_plane_count"] = *params.ClusterUpdateParams.ControlPlaneCount
 	}
 
+	if params.ClusterUpdateParams.InstallTimeouts != nil {
+		var installTimeouts *common.InstallTimeouts
+		if installTimeouts, err = common.ParseInstallTimeouts(*params.ClusterUpdateParams.InstallTimeouts); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		updates["install_timeouts"] = installTimeouts.String()
+	}
+
+	if params.ClusterUpdateParams.InstallSchedule != nil {
+		updates["install_schedule_not_before"] = params.ClusterUpdateParams.InstallSchedule.NotBefore
+		updates["install_schedule_not_after"] = params.ClusterUpdateParams.InstallSchedule.NotAfter
+	}
+
 	if len(updates) > 0 {
 		updates["trigger_monitor_timestamp"] = time.Now()
 		err = db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(updates).Error
@@ -2779,6 +2860,7 @@ func (b *bareMetalInventory) updateNetworkTables(db *gorm.DB, cluster *common.Cl
 
 func setUpdatesForPlatformParams(params installer.V2UpdateClusterParams, updates map[string]interface{}) {
 	updates["platform_type"] = params.ClusterUpdateParams.Platform.Type
+	setUpdatesForOpenstackPlatformParams(params.ClusterUpdateParams.Platform, updates)
 	if *params.ClusterUpdateParams.Platform.Type != models.PlatformTypeExternal {
 		// clear any existing values in external settings
 		updates["platform_external_platform_name"] = nil
@@ -2794,6 +2876,37 @@ func setUpdatesForPlatformParams(params installer.V2UpdateClusterParams, updates
 	}
 }
 
+func setUpdatesForOpenstackPlatformParams(platform *models.Platform, updates map[string]interface{}) {
+	if *platform.Type != models.PlatformTypeOpenstack {
+		// clear any existing values in openstack settings
+		updates["platform_openstack_cloud"] = nil
+		updates["platform_openstack_external_network"] = nil
+		updates["platform_openstack_api_floating_ip"] = nil
+		updates["platform_openstack_ingress_floating_ip"] = nil
+		updates["openstack_clouds_yaml"] = ""
+		return
+	}
+
+	if platform.Openstack == nil {
+		return
+	}
+	if platform.Openstack.Cloud != nil {
+		updates["platform_openstack_cloud"] = platform.Openstack.Cloud
+	}
+	if platform.Openstack.ExternalNetwork != nil {
+		updates["platform_openstack_external_network"] = platform.Openstack.ExternalNetwork
+	}
+	if platform.Openstack.APIFloatingIP != nil {
+		updates["platform_openstack_api_floating_ip"] = platform.Openstack.APIFloatingIP
+	}
+	if platform.Openstack.IngressFloatingIP != nil {
+		updates["platform_openstack_ingress_floating_ip"] = platform.Openstack.IngressFloatingIP
+	}
+	if platform.Openstack.CloudsYaml != nil {
+		updates["openstack_clouds_yaml"] = *platform.Openstack.CloudsYaml
+	}
+}
+
 func (b *bareMetalInventory) updateClusterMirrorRegistry(cluster *common.Cluster, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, updates map[string]interface{}) error {
 	mirrorConfigString, err := common.ConvertMirrorRegistryConfigToString(mirrorRegistryConfiguration)
 	if err != nil {
@@ -2948,6 +3061,26 @@ func validateUserManagedNetworkConflicts(params *models.V2ClusterUpdateParams, l
 	return nil
 }
 
+// validateInstallSchedule accepts an empty schedule, which removes the schedule of the cluster
+func validateInstallSchedule(schedule *models.InstallSchedule, now time.Time) error {
+	if schedule == nil || (schedule.NotBefore == nil && schedule.NotAfter == nil) {
+		return nil
+	}
+	if schedule.NotBefore == nil {
+		return errors.New("The install schedule must set not_before when not_after is set")
+	}
+	if schedule.NotAfter != nil {
+		if !time.Time(*schedule.NotAfter).After(time.Time(*schedule.NotBefore)) {
+			return errors.Errorf("The install schedule not_after (%s) must be later than not_before (%s)",
+				schedule.NotAfter.String(), schedule.NotBefore.String())
+		}
+		if !time.Time(*schedule.NotAfter).After(now) {
+			return errors.Errorf("The install schedule not_after (%s) is in the past", schedule.NotAfter.String())
+		}
+	}
+	return nil
+}
+
 func optionalParam(data *string, field string, updates map[string]interface{}) {
 	if data != nil {
 		updates[field] = swag.StringValue(data)
@@ -3212,6 +3345,11 @@ func (b *bareMetalInventory) getOLMOperators(cluster *common.Cluster, newOperato
 		}
 
 		operator.Properties = newOperator.Properties
+		operator.Channel = newOperator.Channel
+		operator.StartingCsv = newOperator.StartingCsv
+		operator.CatalogSource = newOperator.CatalogSource
+		operator.CatalogSourceImage = newOperator.CatalogSourceImage
+		operator.InstallPlanApproval = newOperator.InstallPlanApproval
 		monitoredOperators = append(monitoredOperators, operator)
 	}
 
@@ -3318,7 +3456,7 @@ func (b *bareMetalInventory) listClustersInternal(ctx context.Context, params in
 		db = db.Unscoped()
 	}
 
-	db = b.authzHandler.OwnedByUser(ctx, db, swag.StringValue(params.Owner))
+	db = b.authzHandler.OwnedByUserOrBound(ctx, db, swag.StringValue(params.Owner), &common.Cluster{})
 
 	if params.OpenshiftClusterID != nil {
 		db = db.Where("openshift_cluster_id = ?", *params.OpenshiftClusterID)
@@ -3543,6 +3681,12 @@ func (b *bareMetalInventory) handleReplyError(params installer.V2PostStepReplyPa
 	case models.StepTypeTangConnectivityCheck:
 		return b.hostApi.UpdateTangConnectivityReport(ctx, h, params.Reply.Error)
 
+	case models.StepTypeFirmwareInspection:
+		// Agents that don't support the step fail it. Store an empty report, which validates no setting, so that the
+		// step isn't sent again until the host registers again.
+		log.Warnf("Failed to inspect the firmware of host %s, its firmware settings won't be validated: %s", h.ID, params.Reply.Error)
+		return b.hostApi.UpdateFirmwareReport(ctx, h, "{}")
+
 	case models.StepTypeDownloadBootArtifacts:
 		log.Errorf("Failed to download boot artifacts to reclaim host %s, output: %s, error: %s", h.ID, params.Reply.Output, params.Reply.Error)
 		return b.hostApi.HandleReclaimFailure(ctx, h)
@@ -3789,6 +3933,10 @@ func handleReplyByType(params installer.V2PostStepReplyParams, b *bareMetalInven
 		err = b.hostApi.HandleReclaimBootArtifactDownload(ctx, &host)
 	case models.StepTypeVerifyVips:
 		err = b.HandleVerifyVipsResponse(ctx, &host, stepReply)
+	case models.StepTypeNetworkThroughput:
+		err = b.hostApi.UpdateNetworkThroughputReport(ctx, &host, stepReply)
+	case models.StepTypeFirmwareInspection:
+		err = b.hostApi.UpdateFirmwareReport(ctx, &host, stepReply)
 	}
 	return err
 }
@@ -3851,6 +3999,10 @@ func filterReplyByType(params installer.V2PostStepReplyParams) (string, error) {
 		stepReply, err = filterReply(&models.UpgradeAgentResponse{}, params.Reply.Output)
 	case models.StepTypeVerifyVips:
 		stepReply, err = filterReply(&models.VerifyVipsResponse{}, params.Reply.Output)
+	case models.StepTypeNetworkThroughput:
+		stepReply, err = filterReply(&models.NetworkThroughputResponse{}, params.Reply.Output)
+	case models.StepTypeFirmwareInspection:
+		stepReply, err = filterReply(&models.FirmwareInspectionResponse{}, params.Reply.Output)
 	}
 
 	return stepReply, err
@@ -4708,7 +4860,7 @@ func (b *bareMetalInventory) ListInfraEnvsInternal(ctx context.Context, clusterI
 	var dbInfraEnvs []*common.InfraEnv
 	var infraEnvs []*models.InfraEnv
 
-	db = b.authzHandler.OwnedByUser(ctx, db, swag.StringValue(owner))
+	db = b.authzHandler.OwnedByUserOrBound(ctx, db, swag.StringValue(owner), &common.InfraEnv{})
 
 	if clusterId != nil {
 		db = db.Where("cluster_id = ?", clusterId)
@@ -5615,6 +5767,10 @@ func (b *bareMetalInventory) V2RegisterHost(ctx context.Context, params installe
 					eventgen.SendHostRegistrationFailedEvent(ctx, b.eventsHandler, *params.NewHostParams.HostID, params.InfraEnvID, cluster.ID, err.Error())
 					return common.NewApiError(http.StatusConflict, err)
 				}
+				if err = b.quotaApi.CheckClusterHosts(ctx, tx, *cluster.ID); err != nil {
+					eventgen.SendHostRegistrationFailedEvent(ctx, b.eventsHandler, *params.NewHostParams.HostID, params.InfraEnvID, cluster.ID, err.Error())
+					return err
+				}
 			}
 
 			if common.IsDay2Cluster(cluster) {
@@ -5745,7 +5901,7 @@ func (b *bareMetalInventory) V2GetNextSteps(ctx context.Context, params installe
 		return common.GenerateErrorResponder(err)
 	}
 
-	steps, err = b.hostApi.GetNextSteps(ctx, &host.Host)
+	steps, err = b.hostApi.GetNextSteps(ctx, host)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get steps for host %s infra-env %s", params.HostID.String(), params.InfraEnvID.String())
 	}
@@ -5767,6 +5923,20 @@ func (b *bareMetalInventory) V2PostStepReply(ctx context.Context, params install
 
 	logReplyReceived(params, log, host)
 
+	// The replies to the diagnostics requested by the users are only stored, they don't update the host:
+	if host.Diagnostics != "" {
+		handled, err := b.hostApi.UpdateDiagnosticResult(ctx, &host.Host, params.Reply, b.db)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to update the diagnostic of host <%s> infra-env <%s> step <%s>",
+				params.HostID, params.InfraEnvID, params.Reply.StepID)
+			return installer.NewV2PostStepReplyInternalServerError().
+				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
+		if handled {
+			return installer.NewV2PostStepReplyNoContent()
+		}
+	}
+
 	if params.Reply.ExitCode != 0 {
 		handlingError := b.handleReplyError(params, ctx, log, &host.Host, params.Reply.ExitCode)
 		if handlingError != nil {
@@ -5930,6 +6100,10 @@ func (b *bareMetalInventory) BindHostInternal(ctx context.Context, params instal
 		return nil, common.NewApiError(http.StatusConflict, err)
 	}
 
+	if err = b.quotaApi.CheckClusterHosts(ctx, b.db, *params.BindHostParams.ClusterID); err != nil {
+		return nil, err
+	}
+
 	if err = b.hostApi.BindHost(ctx, &host.Host, *params.BindHostParams.ClusterID, b.db); err != nil {
 		log.WithError(err).Errorf("Failed to bind host <%s> to cluster <%s>",
 			params.HostID, *params.BindHostParams.ClusterID)
@@ -6094,6 +6268,47 @@ func (b *bareMetalInventory) V2UpdateHostInstallerArgsInternal(ctx context.Conte
 	return &h.Host, nil
 }
 
+func (b *bareMetalInventory) V2CreateHostDiagnostic(ctx context.Context, params installer.V2CreateHostDiagnosticParams) middleware.Responder {
+	diagnostic, err := b.V2CreateHostDiagnosticInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2CreateHostDiagnosticCreated().WithPayload(diagnostic)
+}
+
+func (b *bareMetalInventory) V2CreateHostDiagnosticInternal(ctx context.Context, params installer.V2CreateHostDiagnosticParams) (*models.HostDiagnostic, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	h, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to find host %s", params.HostID)
+		return nil, err
+	}
+
+	if err = b.checkUpdateAccessToObj(ctx, h, "host", &params.HostID); err != nil {
+		return nil, err
+	}
+
+	return b.hostApi.AddDiagnostic(ctx, &h.Host, params.DiagnosticCreateParams, b.db)
+}
+
+func (b *bareMetalInventory) V2ListHostDiagnostics(ctx context.Context, params installer.V2ListHostDiagnosticsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+
+	h, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to find host %s", params.HostID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	diagnostics, err := hostutil.UnmarshalDiagnostics(h.Diagnostics)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the diagnostics of host %s", params.HostID)
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListHostDiagnosticsOK().WithPayload(diagnostics)
+}
+
 func (b *bareMetalInventory) V2UpdateHostIgnition(ctx context.Context, params installer.V2UpdateHostIgnitionParams) middleware.Responder {
 	_, err := b.V2UpdateHostIgnitionInternal(ctx, params)
 	if err != nil {
@@ -6171,6 +6386,9 @@ func (b *bareMetalInventory) V2DownloadInfraEnvFiles(ctx context.Context, params
 	var content, filename string
 	switch params.FileName {
 	case "discovery.ign":
+		if err = b.quotaApi.CheckISOGeneration(ctx, infraEnv); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
 		discoveryIsoType := swag.StringValue(params.DiscoveryIsoType)
 		content, err = b.IgnitionBuilder.FormatDiscoveryIgnitionFile(ctx, infraEnv, b.IgnitionConfig, false, b.authHandler.AuthType(), discoveryIsoType)
 		if err != nil {
@@ -6402,6 +6620,11 @@ func (b *bareMetalInventory) V2UpdateHostInternal(ctx context.Context, params in
 			}
 		}
 
+		if err = hostutil.RecordHostConfigUpdate(tx, host.InfraEnvID, *host.ID); err != nil {
+			log.WithError(err).Errorf("Failed to record the configuration update of host %s, infra env %s", host.ID, host.InfraEnvID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+
 		return nil
 	})
 	if err != nil {
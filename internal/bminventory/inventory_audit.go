@@ -0,0 +1,223 @@
+package bminventory
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/audit"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// auditedInstaller records the changes that a controller makes through the InstallerInternals in
+// the audit log. The changes are recorded under the ID of the matching REST API operation, once
+// they succeed, since the controllers retry the failed ones on every reconcile.
+type auditedInstaller struct {
+	InstallerInternals
+	recorder audit.Recorder
+	actor    string
+}
+
+func NewAuditedInstaller(installer InstallerInternals, recorder audit.Recorder, actor string) InstallerInternals {
+	return &auditedInstaller{
+		InstallerInternals: installer,
+		recorder:           recorder,
+		actor:              actor,
+	}
+}
+
+func (a *auditedInstaller) record(ctx context.Context, operationID string, clusterID, infraEnvID, hostID *strfmt.UUID, change interface{}) {
+	requestID := requestid.FromContext(ctx)
+	if requestID == "" {
+		requestID = requestid.NewID()
+	}
+	record := &models.AuditRecord{
+		Actor:       a.actor,
+		AuthType:    audit.AuthTypeController,
+		RequestID:   requestID,
+		OperationID: operationID,
+		ClusterID:   clusterID,
+		InfraEnvID:  infraEnvID,
+		HostID:      hostID,
+	}
+	if change != nil {
+		record.Changes = audit.Changes(change)
+	}
+	a.recorder.Record(ctx, record)
+}
+
+func (a *auditedInstaller) RegisterClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, params installer.V2RegisterClusterParams) (*common.Cluster, error) {
+	cluster, err := a.InstallerInternals.RegisterClusterInternal(ctx, kubeKey, mirrorRegistryConfiguration, params)
+	if err == nil {
+		a.record(ctx, "v2RegisterCluster", cluster.ID, nil, nil, params.NewClusterParams)
+	}
+	return cluster, err
+}
+
+func (a *auditedInstaller) UpdateClusterNonInteractive(ctx context.Context, params installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, error) {
+	cluster, err := a.InstallerInternals.UpdateClusterNonInteractive(ctx, params, mirrorRegistryConfiguration)
+	if err == nil {
+		a.record(ctx, "V2UpdateCluster", &params.ClusterID, nil, nil, params.ClusterUpdateParams)
+	}
+	return cluster, err
+}
+
+func (a *auditedInstaller) InstallClusterInternal(ctx context.Context, params installer.V2InstallClusterParams) (*common.Cluster, error) {
+	cluster, err := a.InstallerInternals.InstallClusterInternal(ctx, params)
+	if err == nil {
+		a.record(ctx, "v2InstallCluster", &params.ClusterID, nil, nil, nil)
+	}
+	return cluster, err
+}
+
+func (a *auditedInstaller) DeregisterClusterInternal(ctx context.Context, cluster *common.Cluster) error {
+	err := a.InstallerInternals.DeregisterClusterInternal(ctx, cluster)
+	if err == nil {
+		a.record(ctx, "v2DeregisterCluster", cluster.ID, nil, nil, nil)
+	}
+	return err
+}
+
+func (a *auditedInstaller) V2DeregisterHostInternal(ctx context.Context, params installer.V2DeregisterHostParams, interactivity Interactivity) error {
+	err := a.InstallerInternals.V2DeregisterHostInternal(ctx, params, interactivity)
+	if err == nil {
+		a.record(ctx, "v2DeregisterHost", nil, &params.InfraEnvID, &params.HostID, nil)
+	}
+	return err
+}
+
+func (a *auditedInstaller) UpdateHostApprovedInternal(ctx context.Context, infraEnvId string, hostId string, approved bool) error {
+	err := a.InstallerInternals.UpdateHostApprovedInternal(ctx, infraEnvId, hostId, approved)
+	if err == nil {
+		infraEnvID, hostID := strfmt.UUID(infraEnvId), strfmt.UUID(hostId)
+		a.record(ctx, "v2UpdateHost", nil, &infraEnvID, &hostID, map[string]bool{"approved": approved})
+	}
+	return err
+}
+
+func (a *auditedInstaller) V2UpdateHostInstallerArgsInternal(ctx context.Context, params installer.V2UpdateHostInstallerArgsParams) (*models.Host, error) {
+	host, err := a.InstallerInternals.V2UpdateHostInstallerArgsInternal(ctx, params)
+	if err == nil {
+		a.record(ctx, "v2UpdateHostInstallerArgs", nil, &params.InfraEnvID, &params.HostID, params.InstallerArgsParams)
+	}
+	return host, err
+}
+
+func (a *auditedInstaller) V2CreateHostDiagnosticInternal(ctx context.Context, params installer.V2CreateHostDiagnosticParams) (*models.HostDiagnostic, error) {
+	diagnostic, err := a.InstallerInternals.V2CreateHostDiagnosticInternal(ctx, params)
+	if err == nil {
+		a.record(ctx, "v2CreateHostDiagnostic", nil, &params.InfraEnvID, &params.HostID, params.DiagnosticCreateParams)
+	}
+	return diagnostic, err
+}
+
+func (a *auditedInstaller) V2UpdateHostIgnitionInternal(ctx context.Context, params installer.V2UpdateHostIgnitionParams) (*models.Host, error) {
+	host, err := a.InstallerInternals.V2UpdateHostIgnitionInternal(ctx, params)
+	if err == nil {
+		// The overrides are recorded under the key of the host field, which is redacted
+		a.record(ctx, "v2UpdateHostIgnition", nil, &params.InfraEnvID, &params.HostID,
+			map[string]string{"ignition_config_overrides": params.HostIgnitionParams.Config})
+	}
+	return host, err
+}
+
+func (a *auditedInstaller) V2ImportClusterInternal(ctx context.Context, kubeKey *types.NamespacedName, id *strfmt.UUID, params installer.V2ImportClusterParams) (*common.Cluster, error) {
+	cluster, err := a.InstallerInternals.V2ImportClusterInternal(ctx, kubeKey, id, params)
+	if err == nil {
+		a.record(ctx, "v2ImportCluster", cluster.ID, nil, nil, params.NewImportClusterParams)
+	}
+	return cluster, err
+}
+
+func (a *auditedInstaller) InstallSingleDay2HostInternal(ctx context.Context, clusterId strfmt.UUID, infraEnvId strfmt.UUID, hostId strfmt.UUID) error {
+	err := a.InstallerInternals.InstallSingleDay2HostInternal(ctx, clusterId, infraEnvId, hostId)
+	if err == nil {
+		a.record(ctx, "v2InstallHost", &clusterId, &infraEnvId, &hostId, nil)
+	}
+	return err
+}
+
+func (a *auditedInstaller) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) (*common.Cluster, error) {
+	cluster, err := a.InstallerInternals.UpdateClusterInstallConfigInternal(ctx, params)
+	if err == nil {
+		// The overrides are recorded under the key of the cluster field, which is redacted
+		a.record(ctx, "v2UpdateClusterInstallConfig", &params.ClusterID, nil, nil,
+			map[string]string{"install_config_overrides": params.InstallConfigParams})
+	}
+	return cluster, err
+}
+
+func (a *auditedInstaller) CancelInstallationInternal(ctx context.Context, params installer.V2CancelInstallationParams) (*common.Cluster, error) {
+	cluster, err := a.InstallerInternals.CancelInstallationInternal(ctx, params)
+	if err == nil {
+		a.record(ctx, "V2CancelInstallation", &params.ClusterID, nil, nil, nil)
+	}
+	return cluster, err
+}
+
+func (a *auditedInstaller) TransformClusterToDay2Internal(ctx context.Context, clusterID strfmt.UUID) (*common.Cluster, error) {
+	cluster, err := a.InstallerInternals.TransformClusterToDay2Internal(ctx, clusterID)
+	if err == nil {
+		a.record(ctx, "TransformClusterToDay2", &clusterID, nil, nil, nil)
+	}
+	return cluster, err
+}
+
+func (a *auditedInstaller) V2UpdateHostInternal(ctx context.Context, params installer.V2UpdateHostParams, interactivity Interactivity) (*common.Host, error) {
+	host, err := a.InstallerInternals.V2UpdateHostInternal(ctx, params, interactivity)
+	if err == nil {
+		a.record(ctx, "v2UpdateHost", nil, &params.InfraEnvID, &params.HostID, params.HostUpdateParams)
+	}
+	return host, err
+}
+
+func (a *auditedInstaller) UpdateInfraEnvInternal(ctx context.Context, params installer.UpdateInfraEnvParams, internalIgnitionConfig *string, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.InfraEnv, error) {
+	infraEnv, err := a.InstallerInternals.UpdateInfraEnvInternal(ctx, params, internalIgnitionConfig, mirrorRegistryConfiguration)
+	if err == nil {
+		a.record(ctx, "UpdateInfraEnv", nil, &params.InfraEnvID, nil, params.InfraEnvUpdateParams)
+	}
+	return infraEnv, err
+}
+
+func (a *auditedInstaller) RegisterInfraEnvInternal(ctx context.Context, kubeKey *types.NamespacedName, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, params installer.RegisterInfraEnvParams) (*common.InfraEnv, error) {
+	infraEnv, err := a.InstallerInternals.RegisterInfraEnvInternal(ctx, kubeKey, mirrorRegistryConfiguration, params)
+	if err == nil {
+		a.record(ctx, "RegisterInfraEnv", nil, infraEnv.ID, nil, params.InfraenvCreateParams)
+	}
+	return infraEnv, err
+}
+
+func (a *auditedInstaller) DeregisterInfraEnvInternal(ctx context.Context, params installer.DeregisterInfraEnvParams) error {
+	err := a.InstallerInternals.DeregisterInfraEnvInternal(ctx, params)
+	if err == nil {
+		a.record(ctx, "DeregisterInfraEnv", nil, &params.InfraEnvID, nil, nil)
+	}
+	return err
+}
+
+func (a *auditedInstaller) UnbindHostInternal(ctx context.Context, params installer.UnbindHostParams, reclaimHost bool, interactivity Interactivity) (*common.Host, error) {
+	host, err := a.InstallerInternals.UnbindHostInternal(ctx, params, reclaimHost, interactivity)
+	if err == nil {
+		a.record(ctx, "UnbindHost", nil, &params.InfraEnvID, &params.HostID, nil)
+	}
+	return host, err
+}
+
+func (a *auditedInstaller) BindHostInternal(ctx context.Context, params installer.BindHostParams) (*common.Host, error) {
+	host, err := a.InstallerInternals.BindHostInternal(ctx, params)
+	if err == nil {
+		a.record(ctx, "BindHost", params.BindHostParams.ClusterID, &params.InfraEnvID, &params.HostID, params.BindHostParams)
+	}
+	return host, err
+}
+
+func (a *auditedInstaller) V2UpdateHostInstallProgressInternal(ctx context.Context, params installer.V2UpdateHostInstallProgressParams) error {
+	err := a.InstallerInternals.V2UpdateHostInstallProgressInternal(ctx, params)
+	if err == nil {
+		a.record(ctx, "v2UpdateHostInstallProgress", nil, &params.InfraEnvID, &params.HostID, params.HostProgress)
+	}
+	return err
+}
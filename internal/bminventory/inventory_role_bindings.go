@@ -0,0 +1,162 @@
+package bminventory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+func (b *bareMetalInventory) V2ListClusterRoleBindings(ctx context.Context, params installer.V2ListClusterRoleBindingsParams) middleware.Responder {
+	roleBindings, err := b.listRoleBindings(ctx, &params.ClusterID, nil)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListClusterRoleBindingsOK().WithPayload(roleBindings)
+}
+
+func (b *bareMetalInventory) V2CreateClusterRoleBinding(ctx context.Context, params installer.V2CreateClusterRoleBindingParams) middleware.Responder {
+	roleBinding, err := b.createRoleBinding(ctx, &params.ClusterID, nil, params.RoleBindingCreateParams)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2CreateClusterRoleBindingCreated().WithPayload(roleBinding)
+}
+
+func (b *bareMetalInventory) V2DeleteClusterRoleBinding(ctx context.Context, params installer.V2DeleteClusterRoleBindingParams) middleware.Responder {
+	if err := b.deleteRoleBinding(ctx, &params.ClusterID, nil, params.RoleBindingID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2DeleteClusterRoleBindingNoContent()
+}
+
+func (b *bareMetalInventory) V2ListInfraEnvRoleBindings(ctx context.Context, params installer.V2ListInfraEnvRoleBindingsParams) middleware.Responder {
+	roleBindings, err := b.listRoleBindings(ctx, nil, &params.InfraEnvID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListInfraEnvRoleBindingsOK().WithPayload(roleBindings)
+}
+
+func (b *bareMetalInventory) V2CreateInfraEnvRoleBinding(ctx context.Context, params installer.V2CreateInfraEnvRoleBindingParams) middleware.Responder {
+	roleBinding, err := b.createRoleBinding(ctx, nil, &params.InfraEnvID, params.RoleBindingCreateParams)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2CreateInfraEnvRoleBindingCreated().WithPayload(roleBinding)
+}
+
+func (b *bareMetalInventory) V2DeleteInfraEnvRoleBinding(ctx context.Context, params installer.V2DeleteInfraEnvRoleBindingParams) middleware.Responder {
+	if err := b.deleteRoleBinding(ctx, nil, &params.InfraEnvID, params.RoleBindingID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2DeleteInfraEnvRoleBindingNoContent()
+}
+
+func (b *bareMetalInventory) listRoleBindings(ctx context.Context, clusterID *strfmt.UUID, infraEnvID *strfmt.UUID) (models.RoleBindingList, error) {
+	if err := b.checkRoleBindingTarget(clusterID, infraEnvID); err != nil {
+		return nil, err
+	}
+	roleBindings := make([]*common.RoleBinding, 0)
+	if err := roleBindingTargetQuery(b.db, clusterID, infraEnvID).Order("created_at").Find(&roleBindings).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.RoleBindingList, 0, len(roleBindings))
+	for _, roleBinding := range roleBindings {
+		ret = append(ret, &roleBinding.RoleBinding)
+	}
+	return ret, nil
+}
+
+func (b *bareMetalInventory) createRoleBinding(ctx context.Context, clusterID *strfmt.UUID, infraEnvID *strfmt.UUID, params *models.RoleBindingCreateParams) (*models.RoleBinding, error) {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.checkRoleBindingTarget(clusterID, infraEnvID); err != nil {
+		return nil, err
+	}
+
+	var count int64
+	if err := roleBindingTargetQuery(b.db, clusterID, infraEnvID).Model(&common.RoleBinding{}).
+		Where("subject_kind = ? and subject = ?", *params.SubjectKind, *params.Subject).Count(&count).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if count > 0 {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("%s %s already has a role on %s", *params.SubjectKind, *params.Subject, roleBindingTarget(clusterID, infraEnvID)))
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	roleBinding := &common.RoleBinding{
+		RoleBinding: models.RoleBinding{
+			ID:          &id,
+			ClusterID:   clusterID,
+			InfraEnvID:  infraEnvID,
+			Role:        params.Role,
+			Subject:     params.Subject,
+			SubjectKind: params.SubjectKind,
+			UserName:    ocm.UserNameFromContext(ctx),
+		},
+	}
+	if err := b.db.Create(roleBinding).Error; err != nil {
+		log.WithError(err).Errorf("failed to create role binding on %s", roleBindingTarget(clusterID, infraEnvID))
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Granted role %s on %s to %s %s", *params.Role, roleBindingTarget(clusterID, infraEnvID), *params.SubjectKind, *params.Subject)
+	return &roleBinding.RoleBinding, nil
+}
+
+func (b *bareMetalInventory) deleteRoleBinding(ctx context.Context, clusterID *strfmt.UUID, infraEnvID *strfmt.UUID, roleBindingID strfmt.UUID) error {
+	log := logutil.FromContext(ctx, b.log)
+	reply := roleBindingTargetQuery(b.db, clusterID, infraEnvID).Where("id = ?", roleBindingID.String()).Delete(&common.RoleBinding{})
+	if reply.Error != nil {
+		log.WithError(reply.Error).Errorf("failed to delete role binding %s", roleBindingID)
+		return common.NewApiError(http.StatusInternalServerError, reply.Error)
+	}
+	if reply.RowsAffected == 0 {
+		return common.NewApiError(http.StatusNotFound,
+			errors.Errorf("role binding %s not found on %s", roleBindingID, roleBindingTarget(clusterID, infraEnvID)))
+	}
+	log.Infof("Deleted role binding %s of %s", roleBindingID, roleBindingTarget(clusterID, infraEnvID))
+	return nil
+}
+
+// checkRoleBindingTarget checks that the cluster or the infra-env exists. Access to it is checked by
+// the authorizer, that only lets their owners and the admins manage its role bindings.
+func (b *bareMetalInventory) checkRoleBindingTarget(clusterID *strfmt.UUID, infraEnvID *strfmt.UUID) error {
+	var err error
+	if clusterID != nil {
+		_, err = common.GetClusterFromDB(b.db, *clusterID, common.SkipEagerLoading)
+	} else {
+		_, err = common.GetInfraEnvFromDB(b.db, *infraEnvID)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("%s not found", roleBindingTarget(clusterID, infraEnvID)))
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
+
+func roleBindingTargetQuery(db *gorm.DB, clusterID *strfmt.UUID, infraEnvID *strfmt.UUID) *gorm.DB {
+	if clusterID != nil {
+		return db.Where("cluster_id = ?", clusterID.String())
+	}
+	return db.Where("infra_env_id = ?", infraEnvID.String())
+}
+
+func roleBindingTarget(clusterID *strfmt.UUID, infraEnvID *strfmt.UUID) string {
+	if clusterID != nil {
+		return fmt.Sprintf("cluster %s", clusterID)
+	}
+	return fmt.Sprintf("infra-env %s", infraEnvID)
+}
@@ -19,6 +19,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	gomega_format "github.com/onsi/gomega/format"
 	amgmtv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/audit"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/cluster/validations"
 	"github.com/openshift/assisted-service/internal/common"
@@ -61,6 +63,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/internal/quota"
 	"github.com/openshift/assisted-service/internal/stream"
 	testutils "github.com/openshift/assisted-service/internal/testing"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -1258,6 +1261,127 @@ var _ = Describe("v2PostStepReply", func() {
 		})
 	})
 
+	Context("Network throughput", func() {
+		var (
+			clusterId *strfmt.UUID
+			hostId    *strfmt.UUID
+		)
+
+		var makeStepReply = func(clusterID, hostID strfmt.UUID, output string) installer.V2PostStepReplyParams {
+			return installer.V2PostStepReplyParams{
+				InfraEnvID: clusterID,
+				HostID:     hostID,
+				Reply: &models.StepReply{
+					Output:   output,
+					StepType: models.StepTypeNetworkThroughput,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			clusterId = strToUUID(uuid.New().String())
+			hostId = strToUUID(uuid.New().String())
+
+			host := models.Host{
+				ID:         hostId,
+				InfraEnvID: *clusterId,
+				ClusterID:  clusterId,
+				Status:     swag.String("known"),
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("Network throughput success", func() {
+			response := models.NetworkThroughputResponse{
+				RemoteHosts: []*models.ConnectivityRemoteHost{
+					{
+						HostID: strfmt.UUID(uuid.New().String()),
+						ThroughputReport: []*models.ThroughputReport{
+							{RemoteIPAddress: "1.2.3.5", ThroughputMbps: 9400, Successful: true},
+						},
+					},
+				},
+			}
+			b, err := json.Marshal(&response)
+			Expect(err).ToNot(HaveOccurred())
+
+			mockHostApi.EXPECT().UpdateNetworkThroughputReport(gomock.Any(), gomock.Any(), string(b)).Return(nil).Times(1)
+
+			reply := bm.V2PostStepReply(ctx, makeStepReply(*clusterId, *hostId, string(b)))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+
+		It("Network throughput error", func() {
+			mockHostApi.EXPECT().UpdateNetworkThroughputReport(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.Errorf("Some error")).Times(1)
+
+			reply := bm.V2PostStepReply(ctx, makeStepReply(*clusterId, *hostId, `{"remote_hosts":[]}`))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyInternalServerError()))
+		})
+	})
+
+	Context("Firmware inspection", func() {
+		var (
+			clusterId *strfmt.UUID
+			hostId    *strfmt.UUID
+		)
+
+		var makeStepReply = func(clusterID, hostID strfmt.UUID, output string) installer.V2PostStepReplyParams {
+			return installer.V2PostStepReplyParams{
+				InfraEnvID: clusterID,
+				HostID:     hostID,
+				Reply: &models.StepReply{
+					Output:   output,
+					StepType: models.StepTypeFirmwareInspection,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			clusterId = strToUUID(uuid.New().String())
+			hostId = strToUUID(uuid.New().String())
+
+			host := models.Host{
+				ID:         hostId,
+				InfraEnvID: *clusterId,
+				ClusterID:  clusterId,
+				Status:     swag.String("known"),
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("Firmware inspection success", func() {
+			response := models.FirmwareInspectionResponse{
+				VirtualizationEnabled: swag.Bool(false),
+				IommuEnabled:          swag.Bool(true),
+				SriovInterfaces:       []*models.FirmwareSriovInterface{{Name: "eth0", TotalVfs: 64}},
+			}
+			b, err := json.Marshal(&response)
+			Expect(err).ToNot(HaveOccurred())
+
+			mockHostApi.EXPECT().UpdateFirmwareReport(gomock.Any(), gomock.Any(), string(b)).Return(nil).Times(1)
+
+			reply := bm.V2PostStepReply(ctx, makeStepReply(*clusterId, *hostId, string(b)))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+
+		It("Firmware inspection error", func() {
+			mockHostApi.EXPECT().UpdateFirmwareReport(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.Errorf("Some error")).Times(1)
+
+			reply := bm.V2PostStepReply(ctx, makeStepReply(*clusterId, *hostId, `{"virtualization_enabled":true}`))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyInternalServerError()))
+		})
+
+		It("stores an empty report when the agent fails the step", func() {
+			mockHostApi.EXPECT().UpdateFirmwareReport(gomock.Any(), gomock.Any(), "{}").Return(nil).Times(1)
+
+			params := makeStepReply(*clusterId, *hostId, "")
+			params.Reply.ExitCode = -1
+			params.Reply.Error = "unknown step type firmware-inspection"
+			reply := bm.V2PostStepReply(ctx, params)
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+	})
+
 	Context("Image availability", func() {
 		var (
 			clusterId *strfmt.UUID
@@ -1385,6 +1509,60 @@ var _ = Describe("v2PostStepReply", func() {
 		})
 	})
 
+	Context("Diagnostics", func() {
+		var (
+			clusterId *strfmt.UUID
+			hostId    *strfmt.UUID
+		)
+
+		var makeStepReply = func(clusterID, hostID strfmt.UUID, stepID string) installer.V2PostStepReplyParams {
+			return installer.V2PostStepReplyParams{
+				InfraEnvID: clusterID,
+				HostID:     hostID,
+				Reply: &models.StepReply{
+					Output:   `{"path":"/dev/sda","io_sync_duration":5}`,
+					StepID:   stepID,
+					StepType: models.StepTypeInstallationDiskSpeedCheck,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			clusterId = strToUUID(uuid.New().String())
+			hostId = strToUUID(uuid.New().String())
+
+			host := models.Host{
+				ID:          hostId,
+				InfraEnvID:  *clusterId,
+				ClusterID:   clusterId,
+				Status:      swag.String("known"),
+				Diagnostics: `[{"id":"` + uuid.New().String() + `","type":"disk-performance","status":"running","step_id":"diagnostic-step"}]`,
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("stores the replies of diagnostics without processing them", func() {
+			mockHostApi.EXPECT().UpdateDiagnosticResult(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
+			reply := bm.V2PostStepReply(ctx, makeStepReply(*clusterId, *hostId, "diagnostic-step"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+
+		It("processes the replies of other steps", func() {
+			mockHostApi.EXPECT().UpdateDiagnosticResult(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+			mockHostApi.EXPECT().SetDiskSpeed(gomock.Any(), gomock.Any(), "/dev/sda", int64(5), int64(0), gomock.Any()).Return(nil).Times(1)
+			mockMetric.EXPECT().DiskSyncDuration(gomock.Any()).Times(1)
+			mockHwValidator.EXPECT().GetInstallationDiskSpeedThresholdMs(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(10), nil).Times(1)
+			reply := bm.V2PostStepReply(ctx, makeStepReply(*clusterId, *hostId, "other-step"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+
+		It("fails when the diagnostic can't be updated", func() {
+			mockHostApi.EXPECT().UpdateDiagnosticResult(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, errors.New("some error")).Times(1)
+			reply := bm.V2PostStepReply(ctx, makeStepReply(*clusterId, *hostId, "diagnostic-step"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyInternalServerError()))
+		})
+	})
+
 	Context("download boot artifacts", func() {
 		var (
 			infraEnvID strfmt.UUID
@@ -8149,6 +8327,147 @@ var _ = Describe("V2UpdateCluster", func() {
 				})
 			})
 		})
+
+		Context("install_timeouts", func() {
+			BeforeEach(func() {
+				cluster := &common.Cluster{
+					Cluster: models.Cluster{
+						ID:               &clusterID,
+						OpenshiftVersion: "4.16",
+					},
+				}
+				Expect(db.Create(cluster).Error).ShouldNot(HaveOccurred())
+			})
+
+			It("stores valid overrides", func() {
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				mockDetectAndStoreCollidingIPsForCluster(mockClusterApi, 1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						InstallTimeouts: swag.String(`{"host_stages": {"Rebooting": "2h"}}`),
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+
+				var newCluster *common.Cluster
+				Expect(db.Where("id = ?", clusterID).Take(&newCluster).Error).ToNot(HaveOccurred())
+				Expect(newCluster.InstallTimeouts).To(MatchJSON(`{"host_stages": {"Rebooting": "2h"}}`))
+			})
+
+			It("fails with an unknown stage", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						InstallTimeouts: swag.String(`{"host_stages": {"Sleeping": "2h"}}`),
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "unknown host stage 'Sleeping'")
+			})
+		})
+
+		Context("install_schedule", func() {
+			BeforeEach(func() {
+				cluster := &common.Cluster{
+					Cluster: models.Cluster{
+						ID:               &clusterID,
+						OpenshiftVersion: "4.16",
+					},
+				}
+				Expect(db.Create(cluster).Error).ShouldNot(HaveOccurred())
+			})
+
+			updateSchedule := func(schedule *models.InstallSchedule) middleware.Responder {
+				return bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						InstallSchedule: schedule,
+					},
+				})
+			}
+
+			mockSuccessfulUpdate := func() {
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				mockDetectAndStoreCollidingIPsForCluster(mockClusterApi, 1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+			}
+
+			It("stores and removes the schedule", func() {
+				notBefore := strfmt.DateTime(time.Now().Add(time.Hour).Truncate(time.Second))
+				notAfter := strfmt.DateTime(time.Now().Add(2 * time.Hour).Truncate(time.Second))
+
+				mockSuccessfulUpdate()
+				reply := updateSchedule(&models.InstallSchedule{NotBefore: &notBefore, NotAfter: &notAfter})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+
+				var newCluster *common.Cluster
+				Expect(db.Where("id = ?", clusterID).Take(&newCluster).Error).ToNot(HaveOccurred())
+				Expect(time.Time(*newCluster.InstallSchedule.NotBefore)).To(BeTemporally("==", time.Time(notBefore)))
+				Expect(time.Time(*newCluster.InstallSchedule.NotAfter)).To(BeTemporally("==", time.Time(notAfter)))
+
+				mockSuccessfulUpdate()
+				reply = updateSchedule(&models.InstallSchedule{})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+
+				newCluster = nil
+				Expect(db.Where("id = ?", clusterID).Take(&newCluster).Error).ToNot(HaveOccurred())
+				Expect(newCluster.InstallSchedule).To(Or(BeNil(), Equal(&models.InstallSchedule{})))
+			})
+
+			It("fails without not_before", func() {
+				notAfter := strfmt.DateTime(time.Now().Add(time.Hour))
+				reply := updateSchedule(&models.InstallSchedule{NotAfter: &notAfter})
+				verifyApiErrorString(reply, http.StatusBadRequest, "must set not_before")
+			})
+
+			It("fails when not_after is before not_before", func() {
+				notBefore := strfmt.DateTime(time.Now().Add(2 * time.Hour))
+				notAfter := strfmt.DateTime(time.Now().Add(time.Hour))
+				reply := updateSchedule(&models.InstallSchedule{NotBefore: &notBefore, NotAfter: &notAfter})
+				verifyApiErrorString(reply, http.StatusBadRequest, "must be later than not_before")
+			})
+
+			It("fails when not_after is in the past", func() {
+				notBefore := strfmt.DateTime(time.Now().Add(-2 * time.Hour))
+				notAfter := strfmt.DateTime(time.Now().Add(-time.Hour))
+				reply := updateSchedule(&models.InstallSchedule{NotBefore: &notBefore, NotAfter: &notAfter})
+				verifyApiErrorString(reply, http.StatusBadRequest, "is in the past")
+			})
+		})
+
+		Context("secure_boot_mode", func() {
+			BeforeEach(func() {
+				cluster := &common.Cluster{
+					Cluster: models.Cluster{
+						ID:               &clusterID,
+						OpenshiftVersion: "4.16",
+					},
+				}
+				Expect(db.Create(cluster).Error).ShouldNot(HaveOccurred())
+			})
+
+			It("stores the requested secure boot mode", func() {
+				mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+				mockDetectAndStoreCollidingIPsForCluster(mockClusterApi, 1)
+				mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						SecureBootMode: swag.String(models.V2ClusterUpdateParamsSecureBootModeEnabled),
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+
+				var newCluster *common.Cluster
+				Expect(db.Where("id = ?", clusterID).Take(&newCluster).Error).ToNot(HaveOccurred())
+				Expect(newCluster.SecureBootMode).To(Equal(models.ClusterSecureBootModeEnabled))
+			})
+		})
 	})
 
 	Context("load_balancer", func() {
@@ -13349,6 +13668,29 @@ var _ = Describe("GetSupportedPlatformsFromInventory", func() {
 		fmt.Fprintf(GinkgoWriter, "platforms: %v\n", platforms)
 		Expect(len(platforms)).Should(Equal(3))
 		Expect(platforms).To(ContainElement(models.PlatformTypeNutanix))
+		Expect(platforms[0]).Should(Equal(models.PlatformTypeNutanix))
+	})
+
+	It("vsphere hosts - vsphere is suggested first", func() {
+		addVsphereHost(clusterID, models.HostRoleMaster)
+		addVsphereHost(clusterID, models.HostRoleMaster)
+		addVsphereHost(clusterID, models.HostRoleMaster)
+		expectedPlatforms := []models.PlatformType{"baremetal", "none", "vsphere"}
+		mockProviderRegistry.EXPECT().GetSupportedProvidersByHosts(gomock.Any()).Return(expectedPlatforms, nil)
+		platformReplay := bm.GetClusterSupportedPlatforms(ctx, installer.GetClusterSupportedPlatformsParams{ClusterID: clusterID})
+		platforms := platformReplay.(*installer.GetClusterSupportedPlatformsOK).Payload
+		Expect(platforms).Should(Equal([]models.PlatformType{"vsphere", "baremetal", "none"}))
+	})
+
+	It("mixed hosts - the platforms order is kept", func() {
+		addVsphereHost(clusterID, models.HostRoleMaster)
+		addVsphereHost(clusterID, models.HostRoleMaster)
+		addNutanixHost(clusterID, models.HostRoleMaster)
+		expectedPlatforms := []models.PlatformType{"baremetal", "none"}
+		mockProviderRegistry.EXPECT().GetSupportedProvidersByHosts(gomock.Any()).Return(expectedPlatforms, nil)
+		platformReplay := bm.GetClusterSupportedPlatforms(ctx, installer.GetClusterSupportedPlatformsParams{ClusterID: clusterID})
+		platforms := platformReplay.(*installer.GetClusterSupportedPlatformsOK).Payload
+		Expect(platforms).Should(Equal(expectedPlatforms))
 	})
 
 	It("Unsupported platform - external", func() {
@@ -15809,7 +16151,7 @@ var _ = Describe("RegisterCluster", func() {
 
 	It("cluster api failed to register", func() {
 		bm.clusterApi = mockClusterApi
-		mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any()).Return(errors.Errorf("error")).Times(1)
+		mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any(), gomock.Any()).Return(errors.Errorf("error")).Times(1)
 		mockClusterRegisterSteps()
 
 		reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
@@ -16940,7 +17282,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("register cluster - deregister if we failed to create AMS subscription", func() {
 			bm.clusterApi = mockClusterApi
-			mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any()).Return(nil)
+			mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any(), gomock.Any()).Return(nil)
 			mockClusterRegisterSteps()
 			mockAccountsMgmt.EXPECT().CreateSubscription(ctx, gomock.Any(), clusterName).Return(nil, errors.New("dummy"))
 			mockClusterApi.EXPECT().DeregisterCluster(ctx, gomock.Any())
@@ -16955,7 +17297,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("register cluster - delete AMS subscription if we failed to patch DB with ams_subscription_id", func() {
 			bm.clusterApi = mockClusterApi
-			mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any()).Return(nil)
+			mockClusterApi.EXPECT().RegisterCluster(ctx, gomock.Any(), gomock.Any()).Return(nil)
 			mockClusterRegisterSteps()
 			mockAMSSubscription(ctx)
 			mockClusterApi.EXPECT().UpdateAmsSubscriptionID(ctx, gomock.Any(), strfmt.UUID("")).Return(common.NewApiError(http.StatusInternalServerError, errors.New("dummy")))
@@ -18443,40 +18785,27 @@ var _ = Describe("V2UpdateHostInstallerArgs", func() {
 	})
 })
 
-var _ = Describe("V2UpdateHostInstallerArgs - with rhsso auth", func() {
+var _ = Describe("Host diagnostics", func() {
 	var (
-		authCtx      context.Context
-		bm           *bareMetalInventory
-		db           *gorm.DB
-		ctx          = context.Background()
-		clusterID    strfmt.UUID
-		hostID       strfmt.UUID
-		infraEnvID   strfmt.UUID
-		dbName       string
-		userName1    = "test_user_1"
-		userName2    = "test_user_2"
-		mockOcmAuthz *ocm.MockOCMAuthorization
-		payload      *ocm.AuthPayload
+		bm             *bareMetalInventory
+		cfg            Config
+		db             *gorm.DB
+		ctx            = context.Background()
+		clusterID      strfmt.UUID
+		infraEnvID     strfmt.UUID
+		hostID         strfmt.UUID
+		dbName         string
+		diagnosticType = models.HostDiagnosticTypeDiskPerformance
 	)
 
 	BeforeEach(func() {
 		db, dbName = common.PrepareTestDB()
 		clusterID = strfmt.UUID(uuid.New().String())
 		infraEnvID = strfmt.UUID(uuid.New().String())
-
-		cfg := auth.GetConfigRHSSO()
-		bm = createInventory(db, Config{})
-		mockOcmAuthz = ocm.NewMockOCMAuthorization(ctrl)
-		mockOcmClient := &ocm.Client{Cache: cache.New(10*time.Minute, 30*time.Minute), Authorization: mockOcmAuthz}
-		bm.authHandler = auth.NewRHSSOAuthenticator(cfg, mockOcmClient, common.GetTestLog().WithField("pkg", "auth"), db)
-		bm.authzHandler = auth.NewAuthzHandler(cfg, mockOcmClient, common.GetTestLog().WithField("pkg", "auth"), db)
-		payload = &ocm.AuthPayload{Role: ocm.UserRole}
-
-		clusterKind := models.ClusterKindCluster
-		err := db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: userName1, Kind: &clusterKind}}).Error
+		bm = createInventory(db, cfg)
+		err := db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error
 		Expect(err).ShouldNot(HaveOccurred())
 
-		// add a host
 		hostID = strfmt.UUID(uuid.New().String())
 		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, "{}", db)
 	})
@@ -18485,7 +18814,121 @@ var _ = Describe("V2UpdateHostInstallerArgs - with rhsso auth", func() {
 		common.DeleteTestDB(db, dbName)
 	})
 
-	It("successful update host installer args - cluster owner", func() {
+	It("creates a diagnostic", func() {
+		diagnosticID := strfmt.UUID(uuid.New().String())
+		diagnostic := &models.HostDiagnostic{
+			ID:     &diagnosticID,
+			Type:   &diagnosticType,
+			Status: swag.String(models.HostDiagnosticStatusPending),
+		}
+		params := installer.V2CreateHostDiagnosticParams{
+			InfraEnvID:             infraEnvID,
+			HostID:                 hostID,
+			DiagnosticCreateParams: &models.HostDiagnosticCreateParams{Type: &diagnosticType},
+		}
+		mockHostApi.EXPECT().AddDiagnostic(gomock.Any(), gomock.Any(), params.DiagnosticCreateParams, gomock.Any()).Return(diagnostic, nil).Times(1)
+		response := bm.V2CreateHostDiagnostic(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2CreateHostDiagnosticCreated{}))
+		Expect(response.(*installer.V2CreateHostDiagnosticCreated).Payload).To(Equal(diagnostic))
+	})
+
+	It("returns the error of the host API", func() {
+		params := installer.V2CreateHostDiagnosticParams{
+			InfraEnvID:             infraEnvID,
+			HostID:                 hostID,
+			DiagnosticCreateParams: &models.HostDiagnosticCreateParams{Type: &diagnosticType},
+		}
+		mockHostApi.EXPECT().AddDiagnostic(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, common.NewApiError(http.StatusConflict, errors.New("host is installing"))).Times(1)
+		response := bm.V2CreateHostDiagnostic(ctx, params)
+		verifyApiError(response, http.StatusConflict)
+	})
+
+	It("returns not found with a non-existent host", func() {
+		params := installer.V2CreateHostDiagnosticParams{
+			InfraEnvID:             infraEnvID,
+			HostID:                 strfmt.UUID(uuid.New().String()),
+			DiagnosticCreateParams: &models.HostDiagnosticCreateParams{Type: &diagnosticType},
+		}
+		response := bm.V2CreateHostDiagnostic(ctx, params)
+		verifyApiError(response, http.StatusNotFound)
+	})
+
+	It("lists the diagnostics of the host", func() {
+		response := bm.V2ListHostDiagnostics(ctx, installer.V2ListHostDiagnosticsParams{InfraEnvID: infraEnvID, HostID: hostID})
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2ListHostDiagnosticsOK{}))
+		Expect(response.(*installer.V2ListHostDiagnosticsOK).Payload).To(BeEmpty())
+
+		diagnosticID := strfmt.UUID(uuid.New().String())
+		diagnostics, err := hostutil.MarshalDiagnostics(models.HostDiagnosticList{{
+			ID:     &diagnosticID,
+			Type:   &diagnosticType,
+			Status: swag.String(models.HostDiagnosticStatusSucceeded),
+			Result: `{"path":"/dev/sda","io_sync_duration":5}`,
+		}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).Update("diagnostics", diagnostics).Error).ToNot(HaveOccurred())
+
+		response = bm.V2ListHostDiagnostics(ctx, installer.V2ListHostDiagnosticsParams{InfraEnvID: infraEnvID, HostID: hostID})
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2ListHostDiagnosticsOK{}))
+		payload := response.(*installer.V2ListHostDiagnosticsOK).Payload
+		Expect(payload).To(HaveLen(1))
+		Expect(*payload[0].ID).To(Equal(diagnosticID))
+		Expect(payload[0].Result).To(Equal(`{"path":"/dev/sda","io_sync_duration":5}`))
+	})
+
+	It("returns not found when listing the diagnostics of a non-existent host", func() {
+		response := bm.V2ListHostDiagnostics(ctx, installer.V2ListHostDiagnosticsParams{
+			InfraEnvID: infraEnvID,
+			HostID:     strfmt.UUID(uuid.New().String()),
+		})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
+var _ = Describe("V2UpdateHostInstallerArgs - with rhsso auth", func() {
+	var (
+		authCtx      context.Context
+		bm           *bareMetalInventory
+		db           *gorm.DB
+		ctx          = context.Background()
+		clusterID    strfmt.UUID
+		hostID       strfmt.UUID
+		infraEnvID   strfmt.UUID
+		dbName       string
+		userName1    = "test_user_1"
+		userName2    = "test_user_2"
+		mockOcmAuthz *ocm.MockOCMAuthorization
+		payload      *ocm.AuthPayload
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+
+		cfg := auth.GetConfigRHSSO()
+		bm = createInventory(db, Config{})
+		mockOcmAuthz = ocm.NewMockOCMAuthorization(ctrl)
+		mockOcmClient := &ocm.Client{Cache: cache.New(10*time.Minute, 30*time.Minute), Authorization: mockOcmAuthz}
+		bm.authHandler = auth.NewRHSSOAuthenticator(cfg, mockOcmClient, common.GetTestLog().WithField("pkg", "auth"), db)
+		bm.authzHandler = auth.NewAuthzHandler(cfg, mockOcmClient, common.GetTestLog().WithField("pkg", "auth"), db)
+		payload = &ocm.AuthPayload{Role: ocm.UserRole}
+
+		clusterKind := models.ClusterKindCluster
+		err := db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, UserName: userName1, Kind: &clusterKind}}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// add a host
+		hostID = strfmt.UUID(uuid.New().String())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, infraEnvID, clusterID, "{}", db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("successful update host installer args - cluster owner", func() {
 		payload.Username = userName1
 		authCtx = context.WithValue(ctx, restapi.AuthKey, payload)
 
@@ -18804,9 +19247,11 @@ func createInventory(db *gorm.DB, cfg Config) *bareMetalInventory {
 	mockStaticNetworkConfig = staticnetworkconfig.NewMockStaticNetworkConfig(ctrl)
 	dnsApi := dns.NewDNSHandler(cfg.BaseDNSDomains, common.GetTestLog())
 	gcConfig := garbagecollector.Config{DeregisterInactiveAfter: 20 * 24 * time.Hour}
+	quotaManager, err := quota.NewManager(quota.Config{}, mockMetric, common.GetTestLog())
+	Expect(err).ToNot(HaveOccurred())
 
 	bm := NewBareMetalInventory(db, mockStream, common.GetTestLog(), mockHostApi, mockClusterApi, mockInfraEnvApi, cfg,
-		mockGenerator, mockEvents, mockS3Client, mockMetric, mockUsage, mockOperatorManager,
+		mockGenerator, mockEvents, mockS3Client, mockMetric, quotaManager, mockUsage, mockOperatorManager,
 		getTestAuthHandler(), getTestAuthzHandler(), mockK8sClient, ocmClient, nil, mockSecretValidator, mockVersions,
 		mockOSImages, mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder,
 		mockStaticNetworkConfig, gcConfig, mockProviderRegistry, true, "")
@@ -19369,6 +19814,37 @@ var _ = Describe("Platform tests", func() {
 			Expect(swag.BoolValue(cluster.UserManagedNetworking)).Should(BeTrue())
 		})
 
+		It("openstack platform", func() {
+			mockClusterRegisterSuccessWithVersion(models.ClusterCPUArchitectureX8664, "4.14.0")
+			registerParams.NewClusterParams.OpenshiftVersion = swag.String("4.14.0")
+			registerParams.NewClusterParams.Platform = &models.Platform{
+				Type: common.PlatformTypePtr(models.PlatformTypeOpenstack),
+				Openstack: &models.PlatformOpenstack{
+					Cloud:             swag.String("private-cloud"),
+					CloudsYaml:        swag.String("clouds:\n  private-cloud:\n    auth:\n      password: top-secret\n"),
+					ExternalNetwork:   swag.String("public"),
+					APIFloatingIP:     swag.String("203.0.113.10"),
+					IngressFloatingIP: swag.String("203.0.113.11"),
+				},
+			}
+
+			reply := bm.V2RegisterCluster(ctx, *registerParams)
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2RegisterClusterCreated()))
+			cluster := reply.(*installer.V2RegisterClusterCreated).Payload
+			Expect(common.PlatformTypeValue(cluster.Platform.Type)).Should(BeEquivalentTo(models.PlatformTypeOpenstack))
+			Expect(cluster.Platform.Openstack).ShouldNot(BeNil())
+			Expect(swag.StringValue(cluster.Platform.Openstack.Cloud)).Should(Equal("private-cloud"))
+			Expect(swag.StringValue(cluster.Platform.Openstack.ExternalNetwork)).Should(Equal("public"))
+			Expect(swag.StringValue(cluster.Platform.Openstack.APIFloatingIP)).Should(Equal("203.0.113.10"))
+			Expect(swag.StringValue(cluster.Platform.Openstack.IngressFloatingIP)).Should(Equal("203.0.113.11"))
+
+			By("storing the clouds.yaml with the secrets of the cluster")
+			Expect(cluster.Platform.Openstack.CloudsYaml).Should(BeNil())
+			dbCluster, err := common.GetClusterFromDB(db, *cluster.ID, common.SkipEagerLoading)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(dbCluster.OpenstackCloudsYaml).Should(ContainSubstring("top-secret"))
+		})
+
 		It("external platform - SNO", func() {
 			MinimalOpenShiftVersionForExternal := "4.14.0"
 			platformName := "platform-name"
@@ -19578,6 +20054,55 @@ var _ = Describe("Platform tests", func() {
 			verifyApiErrorString(reply, http.StatusBadRequest, "Cloud controller manager must be enabled when using oci external integration")
 		})
 
+		It("openstack platform - fail if the floating IP is invalid", func() {
+			registerParams.NewClusterParams.Platform = &models.Platform{
+				Type: common.PlatformTypePtr(models.PlatformTypeOpenstack),
+				Openstack: &models.PlatformOpenstack{
+					ExternalNetwork: swag.String("public"),
+					APIFloatingIP:   swag.String("not-an-ip"),
+				},
+			}
+
+			reply := bm.V2RegisterCluster(ctx, *registerParams)
+			verifyApiErrorString(reply, http.StatusBadRequest, "API floating IP not-an-ip is not a valid IP address")
+		})
+
+		It("openstack platform - fail if the floating IP is set without external network", func() {
+			registerParams.NewClusterParams.Platform = &models.Platform{
+				Type: common.PlatformTypePtr(models.PlatformTypeOpenstack),
+				Openstack: &models.PlatformOpenstack{
+					IngressFloatingIP: swag.String("203.0.113.11"),
+				},
+			}
+
+			reply := bm.V2RegisterCluster(ctx, *registerParams)
+			verifyApiErrorString(reply, http.StatusBadRequest, "External network must be set when the Ingress floating IP is set")
+		})
+
+		It("openstack platform - fail if the clouds.yaml does not define the cloud", func() {
+			registerParams.NewClusterParams.Platform = &models.Platform{
+				Type: common.PlatformTypePtr(models.PlatformTypeOpenstack),
+				Openstack: &models.PlatformOpenstack{
+					CloudsYaml: swag.String("clouds:\n  other-cloud: {}\n"),
+				},
+			}
+
+			reply := bm.V2RegisterCluster(ctx, *registerParams)
+			verifyApiErrorString(reply, http.StatusBadRequest, "OpenStack clouds.yaml does not define the openstack cloud")
+		})
+
+		It("baremetal platform - fail if openstack settings are set", func() {
+			registerParams.NewClusterParams.Platform = &models.Platform{
+				Type: common.PlatformTypePtr(models.PlatformTypeBaremetal),
+				Openstack: &models.PlatformOpenstack{
+					Cloud: swag.String("private-cloud"),
+				},
+			}
+
+			reply := bm.V2RegisterCluster(ctx, *registerParams)
+			verifyApiErrorString(reply, http.StatusBadRequest, "OpenStack settings can only be set with openstack platform type")
+		})
+
 		It("baremetal platform - fail if external settings are set", func() {
 			platformName := "platform-name"
 			cloudControllerManager := models.PlatformExternalCloudControllerManagerEmpty
@@ -20725,3 +21250,626 @@ func getMirrorRegistryConfigurations(registriesToml, certificate string) (*commo
 
 	return mirrors, imageDigestMirrors
 }
+
+type clusterWatchRecorder struct {
+	*httptest.ResponseRecorder
+	lock sync.Mutex
+}
+
+func (r *clusterWatchRecorder) Write(buf []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.ResponseRecorder.Write(buf)
+}
+
+func (r *clusterWatchRecorder) Flush() {}
+
+func (r *clusterWatchRecorder) body() string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.ResponseRecorder.Body.String()
+}
+
+var _ = Describe("V2WatchCluster", func() {
+	var (
+		ctx         context.Context
+		cancel      context.CancelFunc
+		cfg         = Config{ClusterWatchResyncInterval: 100 * time.Millisecond}
+		bm          *bareMetalInventory
+		db          *gorm.DB
+		dbName      string
+		broadcaster *stream.Broadcaster
+		c           *common.Cluster
+		hostID      strfmt.UUID
+		recorder    *clusterWatchRecorder
+		done        chan struct{}
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		broadcaster = stream.NewBroadcaster(mockStream, 0, common.GetTestLog())
+		bm.stream = broadcaster
+		c = createCluster(db, models.ClusterStatusReady)
+		hostID = strfmt.UUID(uuid.New().String())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, *c.ID, *c.ID, "", db)
+		recorder = &clusterWatchRecorder{ResponseRecorder: httptest.NewRecorder()}
+		done = make(chan struct{})
+	})
+
+	AfterEach(func() {
+		cancel()
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	watch := func() {
+		reply := bm.V2WatchCluster(ctx, installer.V2WatchClusterParams{ClusterID: *c.ID})
+		Expect(reply).To(BeAssignableToTypeOf(&clusterWatch{}))
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			reply.WriteResponse(recorder, runtime.JSONProducer())
+		}()
+	}
+
+	It("fails when live updates are not available", func() {
+		bm.stream = mockStream
+		reply := bm.V2WatchCluster(ctx, installer.V2WatchClusterParams{ClusterID: *c.ID})
+		verifyApiError(reply, http.StatusServiceUnavailable)
+	})
+
+	It("fails for a missing cluster", func() {
+		reply := bm.V2WatchCluster(ctx, installer.V2WatchClusterParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("streams the current state and later notifications", func() {
+		watch()
+		Eventually(recorder.body).Should(ContainSubstring("event: " + common.NotificationTypeCluster))
+		Eventually(recorder.body).Should(ContainSubstring(hostID.String()))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("text/event-stream"))
+
+		event := &common.Event{Event: models.Event{
+			ClusterID: c.ID,
+			Name:      "watched_event",
+			Category:  models.EventCategoryUser,
+			Severity:  swag.String(models.EventSeverityInfo),
+			Message:   swag.String("watched event"),
+		}}
+		Expect(db.Create(event).Error).ToNot(HaveOccurred())
+		Expect(broadcaster.Notify(ctx, event)).To(Succeed())
+		Eventually(recorder.body).Should(ContainSubstring("watched_event"))
+
+		cancel()
+		Eventually(done).Should(BeClosed())
+		Expect(strings.Count(recorder.body(), "watched_event")).To(Equal(1))
+	})
+
+	It("does not send events that happened before the watch started", func() {
+		Expect(db.Create(&common.Event{Event: models.Event{
+			ClusterID: c.ID,
+			Name:      "old_event",
+			Category:  models.EventCategoryUser,
+			Severity:  swag.String(models.EventSeverityInfo),
+			Message:   swag.String("old event"),
+		}}).Error).ToNot(HaveOccurred())
+		watch()
+		Eventually(recorder.body).Should(ContainSubstring(hostID.String()))
+		Consistently(recorder.body, 300*time.Millisecond).ShouldNot(ContainSubstring("old_event"))
+	})
+
+	It("sends events that commit after events with higher IDs were sent", func() {
+		newEvent := func(name string) *common.Event {
+			eventTime := strfmt.DateTime(time.Now())
+			return &common.Event{Event: models.Event{
+				ClusterID: c.ID,
+				Name:      name,
+				Category:  models.EventCategoryUser,
+				Severity:  swag.String(models.EventSeverityInfo),
+				Message:   swag.String(name),
+				EventTime: &eventTime,
+			}}
+		}
+		watch()
+		Eventually(recorder.body).Should(ContainSubstring(hostID.String()))
+
+		tx := db.Begin()
+		Expect(tx.Create(newEvent("late_event")).Error).ToNot(HaveOccurred())
+		early := newEvent("early_event")
+		Expect(db.Create(early).Error).ToNot(HaveOccurred())
+		Expect(broadcaster.Notify(ctx, early)).To(Succeed())
+		Eventually(recorder.body).Should(ContainSubstring("early_event"))
+		Expect(tx.Commit().Error).ToNot(HaveOccurred())
+
+		Eventually(recorder.body).Should(ContainSubstring("late_event"))
+		Consistently(recorder.body, 300*time.Millisecond).ShouldNot(MatchRegexp("(?s)late_event.*late_event"))
+		Expect(strings.Count(recorder.body(), "early_event")).To(Equal(1))
+	})
+
+	It("picks up changes made by other replicas", func() {
+		watch()
+		Eventually(recorder.body).Should(ContainSubstring(hostID.String()))
+		Expect(db.Where("id = ?", hostID.String()).Delete(&models.Host{}).Error).ToNot(HaveOccurred())
+		Eventually(recorder.body).Should(ContainSubstring("event: " + ClusterWatchHostRemoved))
+	})
+
+	It("ends when the cluster is deregistered", func() {
+		watch()
+		Eventually(recorder.body).Should(ContainSubstring(hostID.String()))
+		Expect(db.Unscoped().Delete(&common.Cluster{}, "id = ?", c.ID.String()).Error).ToNot(HaveOccurred())
+		Eventually(done).Should(BeClosed())
+	})
+
+	Context("with role bindings", func() {
+		BeforeEach(func() {
+			bm.authzHandler = auth.NewAuthzHandler(&auth.Config{AuthType: auth.TypeOIDC}, nil, common.GetTestLog().WithField("pkg", "auth"), db)
+			ctx = context.WithValue(ctx, restapi.AuthKey, &ocm.AuthPayload{Username: "technician", Role: ocm.UserRole})
+		})
+
+		It("keeps streaming to users with a role binding on the cluster", func() {
+			id := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.RoleBinding{RoleBinding: models.RoleBinding{
+				ID:          &id,
+				ClusterID:   c.ID,
+				Role:        swag.String(models.RoleBindingRoleViewer),
+				SubjectKind: swag.String(models.RoleBindingSubjectKindUser),
+				Subject:     swag.String("technician"),
+			}}).Error).ToNot(HaveOccurred())
+			watch()
+			Eventually(recorder.body).Should(ContainSubstring(hostID.String()))
+			Consistently(done, 300*time.Millisecond).ShouldNot(BeClosed())
+		})
+
+		It("ends for users without access to the cluster", func() {
+			watch()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+})
+
+var _ = Describe("V2GetClusterInstallationTimeline", func() {
+	var (
+		ctx       = context.Background()
+		bm        *bareMetalInventory
+		db        *gorm.DB
+		dbName    string
+		c         *common.Cluster
+		hostID    strfmt.UUID
+		startedAt time.Time
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, Config{})
+		c = createCluster(db, models.ClusterStatusFinalizing)
+		startedAt = time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+		Expect(db.Model(c).Update("install_started_at", startedAt).Error).ToNot(HaveOccurred())
+		hostID = strfmt.UUID(uuid.New().String())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusInstallingInProgress, models.HostKindHost, *c.ID, *c.ID, "", db)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	addHostStageEvent := func(stage models.HostStage, result models.HostStage, endedAt time.Time, duration time.Duration) {
+		props, err := json.Marshal(map[string]interface{}{
+			"host_stage": stage,
+			"result":     result,
+			"duration":   duration.Seconds(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		eventTime := strfmt.DateTime(endedAt)
+		Expect(db.Create(&common.Event{Event: models.Event{
+			ClusterID: c.ID,
+			HostID:    &hostID,
+			Category:  models.EventCategoryMetrics,
+			Severity:  swag.String(models.EventSeverityInfo),
+			Message:   swag.String("host.stage.duration"),
+			EventTime: &eventTime,
+			Props:     string(props),
+		}}).Error).ToNot(HaveOccurred())
+	}
+
+	// The message differs from the one of the event on purpose, the stage is read from the properties
+	addFinalizingStageEvent := func(stage models.FinalizingStage, startedAt time.Time) {
+		eventTime := strfmt.DateTime(startedAt)
+		Expect(db.Create(&common.Event{Event: models.Event{
+			ClusterID: c.ID,
+			Name:      eventgen.ClusterFinalizingStageUpdatedEventName,
+			Category:  models.EventCategoryUser,
+			Severity:  swag.String(models.EventSeverityInfo),
+			Message:   swag.String(fmt.Sprintf("Finalizing stage changed to %s", stage)),
+			EventTime: &eventTime,
+			Props:     fmt.Sprintf(`{"finalizing_stage": "%s"}`, stage),
+		}}).Error).ToNot(HaveOccurred())
+	}
+
+	getTimeline := func() *models.InstallationTimeline {
+		reply := bm.V2GetClusterInstallationTimeline(ctx, installer.V2GetClusterInstallationTimelineParams{ClusterID: *c.ID})
+		Expect(reply).To(BeAssignableToTypeOf(&installer.V2GetClusterInstallationTimelineOK{}))
+		timeline := reply.(*installer.V2GetClusterInstallationTimelineOK).Payload
+		Expect(timeline.Validate(strfmt.Default)).To(Succeed())
+		return timeline
+	}
+
+	It("fails for a missing cluster", func() {
+		reply := bm.V2GetClusterInstallationTimeline(ctx, installer.V2GetClusterInstallationTimelineParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("is empty before the installation starts", func() {
+		Expect(db.Model(c).Update("install_started_at", time.Time{}).Error).ToNot(HaveOccurred())
+		timeline := getTimeline()
+		Expect(timeline.ClusterID).To(Equal(*c.ID))
+		Expect(timeline.Hosts).To(BeEmpty())
+		Expect(timeline.FinalizingStages).To(BeEmpty())
+	})
+
+	It("builds the host stages from the metrics events and the host progress", func() {
+		addHostStageEvent(models.HostStageStartingInstallation, models.HostStageDone, startedAt.Add(2*time.Minute), time.Minute)
+		addHostStageEvent(models.HostStageInstalling, models.HostStageDone, startedAt.Add(10*time.Minute), 8*time.Minute)
+		// Stages of a previous installation are ignored
+		addHostStageEvent(models.HostStageRebooting, models.HostStageFailed, startedAt.Add(-time.Minute), time.Minute)
+		stageStartedAt := startedAt.Add(10 * time.Minute)
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).Updates(map[string]interface{}{
+			"progress_current_stage":    models.HostStageWritingImageToDisk,
+			"progress_stage_started_at": stageStartedAt,
+		}).Error).ToNot(HaveOccurred())
+
+		timeline := getTimeline()
+		Expect(time.Time(timeline.InstallStartedAt)).To(BeTemporally("==", startedAt))
+		Expect(timeline.InstallCompletedAt).To(BeZero())
+		Expect(timeline.Hosts).To(HaveLen(1))
+		Expect(timeline.Hosts[0].HostID).To(Equal(hostID))
+		Expect(timeline.Hosts[0].Role).To(Equal(models.HostRoleMaster))
+		stages := timeline.Hosts[0].Stages
+		Expect(stages).To(HaveLen(3))
+
+		Expect(stages[0].Name).To(Equal(string(models.HostStageStartingInstallation)))
+		Expect(time.Time(stages[0].StartedAt)).To(BeTemporally("~", startedAt.Add(time.Minute), time.Millisecond))
+		Expect(time.Time(stages[0].EndedAt)).To(BeTemporally("==", startedAt.Add(2*time.Minute)))
+		Expect(stages[0].DurationSeconds).To(BeEquivalentTo(60))
+		Expect(stages[0].Result).To(Equal(models.InstallationTimelineStageResultSucceeded))
+
+		Expect(stages[1].Name).To(Equal(string(models.HostStageInstalling)))
+		Expect(stages[1].Result).To(Equal(models.InstallationTimelineStageResultSucceeded))
+
+		Expect(stages[2].Name).To(Equal(string(models.HostStageWritingImageToDisk)))
+		Expect(time.Time(stages[2].StartedAt)).To(BeTemporally("==", stageStartedAt))
+		Expect(stages[2].EndedAt).To(BeZero())
+		Expect(stages[2].DurationSeconds).To(BeNumerically("~", time.Since(stageStartedAt).Seconds(), 5))
+		Expect(stages[2].Result).To(Equal(models.InstallationTimelineStageResultInProgress))
+	})
+
+	It("reports the failed host stages", func() {
+		addHostStageEvent(models.HostStageInstalling, models.HostStageFailed, startedAt.Add(10*time.Minute), 10*time.Minute)
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).Updates(map[string]interface{}{
+			"status":                    models.HostStatusError,
+			"progress_current_stage":    models.HostStageFailed,
+			"progress_stage_started_at": startedAt.Add(10 * time.Minute),
+		}).Error).ToNot(HaveOccurred())
+
+		timeline := getTimeline()
+		Expect(timeline.Hosts).To(HaveLen(1))
+		Expect(timeline.Hosts[0].Stages).To(HaveLen(1))
+		Expect(timeline.Hosts[0].Stages[0].Name).To(Equal(string(models.HostStageInstalling)))
+		Expect(timeline.Hosts[0].Stages[0].Result).To(Equal(models.InstallationTimelineStageResultFailed))
+	})
+
+	It("ends the current stage of hosts that left the installation", func() {
+		statusUpdatedAt := startedAt.Add(30 * time.Minute)
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID.String()).Updates(map[string]interface{}{
+			"status":                    models.HostStatusError,
+			"status_updated_at":         statusUpdatedAt,
+			"progress_current_stage":    models.HostStageRebooting,
+			"progress_stage_started_at": startedAt.Add(20 * time.Minute),
+		}).Error).ToNot(HaveOccurred())
+
+		timeline := getTimeline()
+		Expect(timeline.Hosts).To(HaveLen(1))
+		stage := timeline.Hosts[0].Stages[0]
+		Expect(time.Time(stage.EndedAt)).To(BeTemporally("==", statusUpdatedAt))
+		Expect(stage.DurationSeconds).To(BeEquivalentTo(600))
+		Expect(stage.Result).To(Equal(models.InstallationTimelineStageResultFailed))
+	})
+
+	It("builds the finalizing stages from the events and the cluster progress", func() {
+		addFinalizingStageEvent(models.FinalizingStageWaitingForClusterOperators, startedAt.Add(30*time.Minute))
+		addFinalizingStageEvent(models.FinalizingStageAddingRouterCa, startedAt.Add(40*time.Minute))
+		// The event of the last stage was discarded
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Updates(map[string]interface{}{
+			"progress_finalizing_stage":            models.FinalizingStageApplyingOlmManifests,
+			"progress_finalizing_stage_started_at": startedAt.Add(45 * time.Minute),
+		}).Error).ToNot(HaveOccurred())
+
+		stages := getTimeline().FinalizingStages
+		Expect(stages).To(HaveLen(3))
+		Expect(stages[0].Name).To(Equal(string(models.FinalizingStageWaitingForClusterOperators)))
+		Expect(time.Time(stages[0].StartedAt)).To(BeTemporally("==", startedAt.Add(30*time.Minute)))
+		Expect(time.Time(stages[0].EndedAt)).To(BeTemporally("==", startedAt.Add(40*time.Minute)))
+		Expect(stages[0].DurationSeconds).To(BeEquivalentTo(600))
+		Expect(stages[0].Result).To(Equal(models.InstallationTimelineStageResultSucceeded))
+		Expect(stages[1].Name).To(Equal(string(models.FinalizingStageAddingRouterCa)))
+		Expect(time.Time(stages[1].EndedAt)).To(BeTemporally("==", startedAt.Add(45*time.Minute)))
+		Expect(stages[2].Name).To(Equal(string(models.FinalizingStageApplyingOlmManifests)))
+		Expect(stages[2].EndedAt).To(BeZero())
+		Expect(stages[2].Result).To(Equal(models.InstallationTimelineStageResultInProgress))
+	})
+
+	It("ends the last finalizing stage when the installation completes", func() {
+		completedAt := startedAt.Add(50 * time.Minute)
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Updates(map[string]interface{}{
+			"status":               models.ClusterStatusInstalled,
+			"install_completed_at": completedAt,
+		}).Error).ToNot(HaveOccurred())
+		addFinalizingStageEvent(models.FinalizingStageDone, startedAt.Add(48*time.Minute))
+
+		timeline := getTimeline()
+		Expect(time.Time(timeline.InstallCompletedAt)).To(BeTemporally("==", completedAt))
+		Expect(timeline.FinalizingStages).To(HaveLen(1))
+		Expect(time.Time(timeline.FinalizingStages[0].EndedAt)).To(BeTemporally("==", completedAt))
+		Expect(timeline.FinalizingStages[0].Result).To(Equal(models.InstallationTimelineStageResultSucceeded))
+	})
+})
+
+var _ = Describe("NewAuditedInstaller", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockInstaller   *MockInstallerInternals
+		mockRecorder    *audit.MockRecorder
+		audited         InstallerInternals
+		clusterID       strfmt.UUID
+		infraEnvID      strfmt.UUID
+		hostID          strfmt.UUID
+		recordedChanges []*models.AuditRecord
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockInstaller = NewMockInstallerInternals(ctrl)
+		mockRecorder = audit.NewMockRecorder(ctrl)
+		audited = NewAuditedInstaller(mockInstaller, mockRecorder, "ClusterDeploymentsReconciler")
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		recordedChanges = nil
+		mockRecorder.EXPECT().Record(gomock.Any(), gomock.Any()).Do(func(_ context.Context, record *models.AuditRecord) {
+			recordedChanges = append(recordedChanges, record)
+		}).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("records the successful changes of the controllers", func() {
+		params := installer.V2UpdateClusterParams{
+			ClusterID: clusterID,
+			ClusterUpdateParams: &models.V2ClusterUpdateParams{
+				Name:       swag.String("audited"),
+				PullSecret: swag.String("top-secret"),
+			},
+		}
+		mockInstaller.EXPECT().UpdateClusterNonInteractive(gomock.Any(), params, nil).Return(&common.Cluster{}, nil)
+
+		_, err := audited.UpdateClusterNonInteractive(context.Background(), params, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(recordedChanges).To(HaveLen(1))
+		record := recordedChanges[0]
+		Expect(record.Actor).To(Equal("ClusterDeploymentsReconciler"))
+		Expect(record.AuthType).To(Equal(audit.AuthTypeController))
+		Expect(record.OperationID).To(Equal("V2UpdateCluster"))
+		Expect(record.ClusterID).To(Equal(&clusterID))
+		Expect(record.RequestID).ToNot(BeEmpty())
+		Expect(record.Changes).To(ContainSubstring(`"pull_secret":"<SECRET>"`))
+		Expect(record.Changes).ToNot(ContainSubstring("top-secret"))
+	})
+
+	It("records the ID of the created clusters", func() {
+		params := installer.V2RegisterClusterParams{NewClusterParams: &models.ClusterCreateParams{Name: swag.String("audited")}}
+		mockInstaller.EXPECT().RegisterClusterInternal(gomock.Any(), nil, nil, params).
+			Return(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, nil)
+
+		_, err := audited.RegisterClusterInternal(context.Background(), nil, nil, params)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(recordedChanges).To(HaveLen(1))
+		Expect(recordedChanges[0].OperationID).To(Equal("v2RegisterCluster"))
+		Expect(recordedChanges[0].ClusterID).To(Equal(&clusterID))
+	})
+
+	It("records the approval of the hosts", func() {
+		mockInstaller.EXPECT().UpdateHostApprovedInternal(gomock.Any(), infraEnvID.String(), hostID.String(), true).Return(nil)
+
+		Expect(audited.UpdateHostApprovedInternal(context.Background(), infraEnvID.String(), hostID.String(), true)).To(Succeed())
+
+		Expect(recordedChanges).To(HaveLen(1))
+		Expect(recordedChanges[0].OperationID).To(Equal("v2UpdateHost"))
+		Expect(recordedChanges[0].InfraEnvID).To(Equal(&infraEnvID))
+		Expect(recordedChanges[0].HostID).To(Equal(&hostID))
+		Expect(recordedChanges[0].Changes).To(Equal(`{"approved":true}`))
+	})
+
+	It("redacts the install-config and ignition overrides", func() {
+		installConfigParams := installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: `{"pullSecret": "top-secret"}`,
+		}
+		mockInstaller.EXPECT().UpdateClusterInstallConfigInternal(gomock.Any(), installConfigParams).Return(&common.Cluster{}, nil)
+		ignitionParams := installer.V2UpdateHostIgnitionParams{
+			InfraEnvID:         infraEnvID,
+			HostID:             hostID,
+			HostIgnitionParams: &models.HostIgnitionParams{Config: `{"ignition": {"version": "3.1.0"}, "passwd": "top-secret"}`},
+		}
+		mockInstaller.EXPECT().V2UpdateHostIgnitionInternal(gomock.Any(), ignitionParams).Return(&models.Host{}, nil)
+
+		_, err := audited.UpdateClusterInstallConfigInternal(context.Background(), installConfigParams)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = audited.V2UpdateHostIgnitionInternal(context.Background(), ignitionParams)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(recordedChanges).To(HaveLen(2))
+		Expect(recordedChanges[0].Changes).To(Equal(`{"install_config_overrides":"<SECRET>"}`))
+		Expect(recordedChanges[1].Changes).To(Equal(`{"ignition_config_overrides":"<SECRET>"}`))
+	})
+
+	It("does not record the failed changes", func() {
+		params := installer.DeregisterInfraEnvParams{InfraEnvID: infraEnvID}
+		mockInstaller.EXPECT().DeregisterInfraEnvInternal(gomock.Any(), params).Return(errors.New("failed"))
+
+		Expect(audited.DeregisterInfraEnvInternal(context.Background(), params)).ToNot(Succeed())
+		Expect(recordedChanges).To(BeEmpty())
+	})
+
+	It("forwards the calls that are not changes", func() {
+		mockInstaller.EXPECT().GetClusterInternal(gomock.Any(), installer.V2GetClusterParams{ClusterID: clusterID}).
+			Return(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, nil)
+
+		cluster, err := audited.GetClusterInternal(context.Background(), installer.V2GetClusterParams{ClusterID: clusterID})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.ID).To(Equal(&clusterID))
+		Expect(recordedChanges).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Quotas", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		dbName    string
+		mockQuota *quota.MockAPI
+		ctx       = context.Background()
+	)
+
+	quotaExceeded := common.NewApiError(http.StatusTooManyRequests, errors.New("quota exceeded"))
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		mockQuota = quota.NewMockAPI(ctrl)
+		bm.quotaApi = mockQuota
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("rejects the registration of clusters over the quota", func() {
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
+			db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil, nil, false, nil)
+		mockClusterRegisterSteps()
+		mockUsageReports()
+		mockQuota.EXPECT().CheckActiveClusters(gomock.Any(), gomock.Any()).Return(quotaExceeded)
+		reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("some-cluster-name"),
+				OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				PullSecret:       swag.String(fakePullSecret),
+			},
+		})
+		verifyApiErrorString(reply, http.StatusTooManyRequests, "quota exceeded")
+		var count int64
+		Expect(db.Model(&common.Cluster{}).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+
+	It("rejects the downloads of discovery ignitions over the ISO generations quota", func() {
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, OrgID: "org1"}}).Error).ToNot(HaveOccurred())
+		mockQuota.EXPECT().CheckISOGeneration(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, infraEnv *common.InfraEnv) error {
+				Expect(infraEnv.OrgID).To(Equal("org1"))
+				return quotaExceeded
+			})
+		reply := bm.V2DownloadInfraEnvFiles(ctx, installer.V2DownloadInfraEnvFilesParams{
+			InfraEnvID: infraEnvID,
+			FileName:   "discovery.ign",
+		})
+		verifyApiErrorString(reply, http.StatusTooManyRequests, "quota exceeded")
+	})
+})
+
+var _ = Describe("Role bindings in handler access checks", func() {
+	var (
+		bm      *bareMetalInventory
+		db      *gorm.DB
+		dbName  string
+		c       *common.Cluster
+		hostID  strfmt.UUID
+		authCtx context.Context
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, Config{})
+		bm.authzHandler = auth.NewAuthzHandler(&auth.Config{AuthType: auth.TypeOIDC}, nil, common.GetTestLog().WithField("pkg", "auth"), db)
+		c = createCluster(db, models.ClusterStatusReady)
+		hostID = strfmt.UUID(uuid.New().String())
+		addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost, *c.ID, *c.ID, "{}", db)
+		authCtx = context.WithValue(context.Background(), restapi.AuthKey, &ocm.AuthPayload{Username: "technician", Role: ocm.UserRole})
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	grant := func(role string) {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.RoleBinding{RoleBinding: models.RoleBinding{
+			ID:          &id,
+			ClusterID:   c.ID,
+			Role:        swag.String(role),
+			SubjectKind: swag.String(models.RoleBindingSubjectKindUser),
+			Subject:     swag.String("technician"),
+		}}).Error).ToNot(HaveOccurred())
+	}
+
+	expectStatus := func(err error, status int32) {
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(status))
+	}
+
+	It("allows editors to update the cluster", func() {
+		grant(models.RoleBindingRoleEditor)
+		Expect(bm.checkUpdateAccessToObj(authCtx, c, "cluster", c.ID)).To(Succeed())
+	})
+
+	It("forbids viewers to update the cluster", func() {
+		grant(models.RoleBindingRoleViewer)
+		expectStatus(bm.checkUpdateAccessToObj(authCtx, c, "cluster", c.ID), http.StatusForbidden)
+	})
+
+	It("forbids operators to update the cluster outside of their operations", func() {
+		grant(models.RoleBindingRoleOperator)
+		expectStatus(bm.checkUpdateAccessToObj(authCtx, c, "cluster", c.ID), http.StatusForbidden)
+	})
+
+	It("hides the cluster from users without role bindings", func() {
+		expectStatus(bm.checkUpdateAccessToObj(authCtx, c, "cluster", c.ID), http.StatusNotFound)
+	})
+
+	It("lets editors create host diagnostics", func() {
+		grant(models.RoleBindingRoleEditor)
+		diagnosticType := models.HostDiagnosticTypeDiskPerformance
+		diagnosticID := strfmt.UUID(uuid.New().String())
+		diagnostic := &models.HostDiagnostic{
+			ID:     &diagnosticID,
+			Type:   &diagnosticType,
+			Status: swag.String(models.HostDiagnosticStatusPending),
+		}
+		mockHostApi.EXPECT().AddDiagnostic(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(diagnostic, nil).Times(1)
+		response := bm.V2CreateHostDiagnostic(authCtx, installer.V2CreateHostDiagnosticParams{
+			InfraEnvID:             *c.ID,
+			HostID:                 hostID,
+			DiagnosticCreateParams: &models.HostDiagnosticCreateParams{Type: &diagnosticType},
+		})
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2CreateHostDiagnosticCreated{}))
+	})
+})
@@ -0,0 +1,216 @@
+package bminventory
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/thoas/go-funk"
+)
+
+// hostStageDurationMetric is the message of the metrics event reported when a host leaves an
+// installation stage, see MetricsManager.ReportHostInstallationMetrics
+const hostStageDurationMetric = "host.stage.duration"
+
+type hostStageDurationProps struct {
+	HostStage string  `json:"host_stage"`
+	Duration  float64 `json:"duration"`
+	Result    string  `json:"result"`
+}
+
+func (b *bareMetalInventory) V2GetClusterInstallationTimeline(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) middleware.Responder {
+	timeline, err := b.GetClusterInstallationTimelineInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetClusterInstallationTimelineOK().WithPayload(timeline)
+}
+
+// GetClusterInstallationTimelineInternal builds the timeline of the last installation of the cluster.
+// Nothing is recorded for the purpose of the timeline: the stages that a host left are taken from the
+// metrics events reported by the host progress updates, the stages of the cluster from the events of
+// the finalizing progress updates, and the stages in progress from the progress of the cluster and hosts.
+func (b *bareMetalInventory) GetClusterInstallationTimelineInternal(ctx context.Context, clusterID strfmt.UUID) (*models.InstallationTimeline, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := common.GetClusterFromDBWithHosts(b.db, clusterID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", clusterID)
+		return nil, err
+	}
+
+	timeline := &models.InstallationTimeline{
+		ClusterID:        clusterID,
+		InstallStartedAt: cluster.InstallStartedAt,
+		FinalizingStages: []*models.InstallationTimelineStage{},
+		Hosts:            []*models.HostInstallationTimeline{},
+	}
+	startedAt := time.Time(cluster.InstallStartedAt)
+	if startedAt.IsZero() {
+		return timeline, nil
+	}
+	// The completion time is kept when the installation is reset, so it only applies if it is newer
+	var completedAt time.Time
+	if time.Time(cluster.InstallCompletedAt).After(startedAt) {
+		completedAt = time.Time(cluster.InstallCompletedAt)
+		timeline.InstallCompletedAt = cluster.InstallCompletedAt
+	}
+
+	var hostStageEvents []*common.Event
+	if err = b.db.Where("cluster_id = ? AND category = ? AND message = ? AND event_time >= ?",
+		clusterID.String(), models.EventCategoryMetrics, hostStageDurationMetric, startedAt).
+		Order("event_time, id").Find(&hostStageEvents).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the host stage events of cluster %s", clusterID)
+		return nil, err
+	}
+	hostStages := make(map[strfmt.UUID][]*models.InstallationTimelineStage)
+	for _, event := range hostStageEvents {
+		if event.HostID == nil || event.EventTime == nil {
+			continue
+		}
+		var props hostStageDurationProps
+		if err = json.Unmarshal([]byte(event.Props), &props); err != nil || props.HostStage == "" {
+			log.WithError(err).Warnf("ignoring malformed host stage event %d of cluster %s", event.ID, clusterID)
+			continue
+		}
+		endedAt := time.Time(*event.EventTime)
+		stage := &models.InstallationTimelineStage{
+			Name:            props.HostStage,
+			StartedAt:       strfmt.DateTime(endedAt.Add(-time.Duration(props.Duration * float64(time.Second)))),
+			EndedAt:         strfmt.DateTime(endedAt),
+			DurationSeconds: props.Duration,
+			Result:          models.InstallationTimelineStageResultSucceeded,
+		}
+		if props.Result == string(models.HostStageFailed) {
+			stage.Result = models.InstallationTimelineStageResultFailed
+		}
+		hostStages[*event.HostID] = append(hostStages[*event.HostID], stage)
+	}
+
+	for _, h := range cluster.Hosts {
+		stages := hostStages[*h.ID]
+		if stage := currentHostStage(h, startedAt); stage != nil {
+			stages = append(stages, stage)
+		}
+		if len(stages) == 0 {
+			continue
+		}
+		timeline.Hosts = append(timeline.Hosts, &models.HostInstallationTimeline{
+			HostID:   *h.ID,
+			Hostname: hostutil.GetHostnameForMsg(h),
+			Role:     h.Role,
+			Stages:   stages,
+		})
+	}
+
+	timeline.FinalizingStages, err = b.getFinalizingStagesTimeline(ctx, cluster, startedAt, completedAt)
+	if err != nil {
+		return nil, err
+	}
+	return timeline, nil
+}
+
+// currentHostStage returns the stage the host is in, which has no metrics event yet. The stage is
+// in progress while the host is installing, otherwise it ended when the host left the installation.
+func currentHostStage(h *models.Host, installStartedAt time.Time) *models.InstallationTimelineStage {
+	if h.Progress == nil || h.Progress.CurrentStage == "" ||
+		h.Progress.CurrentStage == models.HostStageDone || h.Progress.CurrentStage == models.HostStageFailed {
+		return nil
+	}
+	stageStartedAt := time.Time(h.Progress.StageStartedAt)
+	if stageStartedAt.Before(installStartedAt) {
+		return nil
+	}
+	stage := &models.InstallationTimelineStage{
+		Name:      string(h.Progress.CurrentStage),
+		StartedAt: h.Progress.StageStartedAt,
+		Result:    models.InstallationTimelineStageResultInProgress,
+	}
+	endedAt := time.Now()
+	if !funk.ContainsString(host.HostInstallingStatuses, swag.StringValue(h.Status)) {
+		endedAt = time.Time(h.StatusUpdatedAt)
+		stage.EndedAt = h.StatusUpdatedAt
+		stage.Result = models.InstallationTimelineStageResultFailed
+	}
+	stage.DurationSeconds = endedAt.Sub(stageStartedAt).Seconds()
+	return stage
+}
+
+// getFinalizingStagesTimeline returns the finalizing stages of the cluster. Each stage ends when the
+// next one starts, and the last one when the installation completes.
+func (b *bareMetalInventory) getFinalizingStagesTimeline(ctx context.Context, cluster *common.Cluster,
+	installStartedAt, installCompletedAt time.Time) ([]*models.InstallationTimelineStage, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	var events []*common.Event
+	if err := b.db.Where("cluster_id = ? AND name = ? AND event_time >= ?",
+		cluster.ID.String(), eventgen.ClusterFinalizingStageUpdatedEventName, installStartedAt).
+		Order("event_time, id").Find(&events).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the finalizing stage events of cluster %s", cluster.ID)
+		return nil, err
+	}
+	type stageStart struct {
+		stage     models.FinalizingStage
+		startedAt time.Time
+	}
+	var starts []stageStart
+	stages := clusterPkg.FinalizingStages()
+	for _, event := range events {
+		if event.EventTime == nil {
+			continue
+		}
+		var props map[string]string
+		if err := json.Unmarshal([]byte(event.Props), &props); err != nil ||
+			!funk.Contains(stages, models.FinalizingStage(props[clusterPkg.FinalizingStageEventProperty])) {
+			log.WithError(err).Warnf("ignoring malformed finalizing stage event %d of cluster %s", event.ID, cluster.ID)
+			continue
+		}
+		stage := models.FinalizingStage(props[clusterPkg.FinalizingStageEventProperty])
+		starts = append(starts, stageStart{stage: stage, startedAt: time.Time(*event.EventTime)})
+	}
+	// The event might have been discarded, while the progress of the cluster is always updated
+	if cluster.Progress != nil && cluster.Progress.FinalizingStage != "" {
+		progressStartedAt := time.Time(cluster.Progress.FinalizingStageStartedAt)
+		if !progressStartedAt.Before(installStartedAt) &&
+			(len(starts) == 0 || starts[len(starts)-1].stage != cluster.Progress.FinalizingStage) {
+			starts = append(starts, stageStart{stage: cluster.Progress.FinalizingStage, startedAt: progressStartedAt})
+		}
+	}
+
+	timeline := make([]*models.InstallationTimelineStage, 0, len(starts))
+	for i, start := range starts {
+		stage := &models.InstallationTimelineStage{
+			Name:      string(start.stage),
+			StartedAt: strfmt.DateTime(start.startedAt),
+			Result:    models.InstallationTimelineStageResultSucceeded,
+		}
+		endedAt := time.Now()
+		switch {
+		case i+1 < len(starts):
+			endedAt = starts[i+1].startedAt
+			stage.EndedAt = strfmt.DateTime(endedAt)
+		case !installCompletedAt.IsZero():
+			endedAt = installCompletedAt
+			stage.EndedAt = strfmt.DateTime(endedAt)
+			if swag.StringValue(cluster.Status) != models.ClusterStatusInstalled {
+				stage.Result = models.InstallationTimelineStageResultFailed
+			}
+		default:
+			stage.Result = models.InstallationTimelineStageResultInProgress
+		}
+		stage.DurationSeconds = endedAt.Sub(start.startedAt).Seconds()
+		timeline = append(timeline, stage)
+	}
+	return timeline, nil
+}
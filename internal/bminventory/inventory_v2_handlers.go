@@ -763,7 +763,7 @@ func (b *bareMetalInventory) GetInfraEnvDownloadURL(ctx context.Context, params
 
 func (b *bareMetalInventory) generateShortImageDownloadURL(infraEnvID, imageType, version, arch, imageTokenKey string) (string, *strfmt.DateTime, error) {
 	switch b.authHandler.AuthType() {
-	case auth.TypeLocal:
+	case auth.TypeLocal, auth.TypeOIDC:
 		return b.generateShortImageDownloadURLByAPIKey(infraEnvID, imageType, version, arch)
 	case auth.TypeRHSSO:
 		return b.generateShortImageDownloadURLByToken(infraEnvID, imageType, version, arch, imageTokenKey)
@@ -810,7 +810,7 @@ func (b *bareMetalInventory) generateShortImageDownloadURLByID(infraEnvID, image
 func (b *bareMetalInventory) signURL(ctx context.Context, infraEnvID, urlString, imageTokenKey string) (string, error) {
 	log := logutil.FromContext(ctx, b.log)
 
-	if b.authHandler.AuthType() == auth.TypeLocal {
+	if b.authHandler.AuthType() == auth.TypeLocal || b.authHandler.AuthType() == auth.TypeOIDC {
 		var err error
 		urlString, err = gencrypto.SignURL(urlString, infraEnvID, gencrypto.InfraEnvKey)
 		if err != nil {
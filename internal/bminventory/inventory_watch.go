@@ -0,0 +1,294 @@
+package bminventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+const (
+	// ClusterWatchHostRemoved is sent when a host is deregistered or unbound from the watched cluster
+	ClusterWatchHostRemoved = "HostRemoved"
+
+	clusterWatchRetryMilliseconds        = 5000
+	clusterWatchEventsBatchSize          = 500
+	clusterWatchEventsLookback           = 2 * time.Minute
+	defaultClusterWatchResyncInterval    = 10 * time.Second
+	defaultClusterWatchKeepAliveInterval = 15 * time.Second
+)
+
+var errClusterWatchEnded = errors.New("cluster watch ended")
+
+type clusterWatchHostRemoved struct {
+	ID        strfmt.UUID `json:"id"`
+	ClusterID strfmt.UUID `json:"cluster_id"`
+}
+
+// clusterWatch streams the state of a cluster as server-sent events. It starts with the current
+// cluster and hosts and then forwards the notifications sent by this replica as they happen.
+// The state is also reloaded from the database periodically, to pick up the changes made by other
+// replicas and the notifications dropped when the client could not keep up. Only the events created
+// after the watch started are reloaded, so the stream is not a replacement for v2ListEvents.
+//
+// Event IDs are taken before their transaction commits, so an event may become visible after
+// events with higher IDs were sent. The reload therefore also looks back at the events of the last
+// clusterWatchEventsLookback, and skips the ones that were already sent.
+type clusterWatch struct {
+	b                 *bareMetalInventory
+	ctx               context.Context
+	log               logrus.FieldLogger
+	clusterID         strfmt.UUID
+	subscription      *stream.Subscription
+	resyncInterval    time.Duration
+	keepAliveInterval time.Duration
+	startedAt         time.Time
+
+	clusterUpdatedAt time.Time
+	hostsUpdatedAt   map[strfmt.UUID]time.Time
+	lastEventID      uint
+	// The events sent in the lookback window, by the time they were sent
+	sentEvents map[uint]time.Time
+}
+
+func (b *bareMetalInventory) V2WatchCluster(ctx context.Context, params installer.V2WatchClusterParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	subscriber, ok := b.stream.(stream.Subscriber)
+	if !ok {
+		return installer.NewV2WatchClusterServiceUnavailable().WithPayload(
+			common.GenerateError(http.StatusServiceUnavailable, errors.New("live cluster updates are not available")))
+	}
+	if _, err := b.getCluster(ctx, params.ClusterID.String()); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	// Events that happened before the watch started are available through v2ListEvents
+	startedAt := time.Now()
+	var lastEventID uint
+	if err := b.db.Model(&common.Event{}).Where("cluster_id = ?", params.ClusterID.String()).
+		Select("COALESCE(MAX(id), 0)").Scan(&lastEventID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the last event of cluster %s", params.ClusterID)
+		return common.GenerateErrorResponder(err)
+	}
+
+	resyncInterval := b.ClusterWatchResyncInterval
+	if resyncInterval <= 0 {
+		resyncInterval = defaultClusterWatchResyncInterval
+	}
+	keepAliveInterval := b.ClusterWatchKeepAliveInterval
+	if keepAliveInterval <= 0 {
+		keepAliveInterval = defaultClusterWatchKeepAliveInterval
+	}
+	// Subscribe before the state is loaded so that no change is missed in between
+	return &clusterWatch{
+		b:                 b,
+		ctx:               ctx,
+		log:               log.WithField("cluster_id", params.ClusterID),
+		clusterID:         params.ClusterID,
+		subscription:      subscriber.Subscribe(params.ClusterID),
+		resyncInterval:    resyncInterval,
+		keepAliveInterval: keepAliveInterval,
+		startedAt:         startedAt,
+		hostsUpdatedAt:    make(map[strfmt.UUID]time.Time),
+		lastEventID:       lastEventID,
+		sentEvents:        make(map[uint]time.Time),
+	}
+}
+
+func (w *clusterWatch) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	defer w.subscription.Close()
+
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		w.log.Error("the response writer does not support streaming")
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	header := rw.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	// Keep compressing and buffering proxies from holding messages back
+	header.Set("Content-Encoding", "identity")
+	header.Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+
+	w.log.Info("Started watching cluster")
+	defer w.log.Info("Stopped watching cluster")
+
+	_, err := fmt.Fprintf(rw, "retry: %d\n\n", clusterWatchRetryMilliseconds)
+	if err == nil {
+		err = w.resync(rw)
+	}
+	resyncTicker := time.NewTicker(w.resyncInterval)
+	defer resyncTicker.Stop()
+	keepAliveTicker := time.NewTicker(w.keepAliveInterval)
+	defer keepAliveTicker.Stop()
+	for err == nil {
+		flusher.Flush()
+		select {
+		case <-w.ctx.Done():
+			return
+		case msg, ok := <-w.subscription.Messages():
+			if !ok {
+				return
+			}
+			err = w.forward(rw, msg)
+		case <-w.subscription.Lagged():
+			err = w.resync(rw)
+		case <-resyncTicker.C:
+			err = w.resync(rw)
+		case <-keepAliveTicker.C:
+			_, err = io.WriteString(rw, ": keep-alive\n\n")
+		}
+	}
+	if !errors.Is(err, errClusterWatchEnded) && w.ctx.Err() == nil {
+		w.log.WithError(err).Warn("failed to stream cluster updates")
+	}
+}
+
+// resync sends the cluster, hosts and events that changed since they were last sent
+func (w *clusterWatch) resync(rw io.Writer) error {
+	cluster, err := common.GetClusterFromDB(w.b.db, w.clusterID, common.SkipEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			w.log.Info("Cluster was deregistered")
+			return errClusterWatchEnded
+		}
+		return err
+	}
+	// Access is checked again as it may have been revoked since the watch started
+	canRead, err := w.b.authzHandler.HasAccessTo(w.ctx, cluster, auth.ReadAction)
+	if err != nil {
+		return err
+	}
+	if !canRead {
+		w.log.Info("Access to the cluster was revoked")
+		return errClusterWatchEnded
+	}
+	if cluster.UpdatedAt.After(w.clusterUpdatedAt) {
+		if err = w.send(rw, stream.GetNotifiableCluster(cluster)); err != nil {
+			return err
+		}
+	}
+
+	hosts, err := common.GetHostsFromDBWhere(w.b.db, "cluster_id = ?", w.clusterID.String())
+	if err != nil {
+		return err
+	}
+	present := make(map[strfmt.UUID]bool, len(hosts))
+	for _, host := range hosts {
+		present[*host.ID] = true
+		if updatedAt, ok := w.hostsUpdatedAt[*host.ID]; ok && !host.UpdatedAt.After(updatedAt) {
+			continue
+		}
+		if err = w.send(rw, host); err != nil {
+			return err
+		}
+	}
+	for hostID := range w.hostsUpdatedAt {
+		if present[hostID] {
+			continue
+		}
+		delete(w.hostsUpdatedAt, hostID)
+		payload, err := json.Marshal(&clusterWatchHostRemoved{ID: hostID, ClusterID: w.clusterID})
+		if err != nil {
+			return err
+		}
+		if err = writeServerSentEvent(rw, ClusterWatchHostRemoved, payload); err != nil {
+			return err
+		}
+	}
+
+	lookbackStart := time.Now().Add(-clusterWatchEventsLookback)
+	for id, sentAt := range w.sentEvents {
+		if sentAt.Before(lookbackStart) {
+			delete(w.sentEvents, id)
+		}
+	}
+	if lookbackStart.Before(w.startedAt) {
+		lookbackStart = w.startedAt
+	}
+	query := w.b.db.Where("cluster_id = ? AND category IN (?)", w.clusterID.String(), eventsapi.DefaultEventCategories).
+		Where("id > ? OR event_time >= ?", w.lastEventID, lookbackStart)
+	if len(w.sentEvents) > 0 {
+		query = query.Where("id NOT IN (?)", funk.Keys(w.sentEvents))
+	}
+	var events []*common.Event
+	if err = query.Order("id").Limit(clusterWatchEventsBatchSize).Find(&events).Error; err != nil {
+		return err
+	}
+	for _, event := range events {
+		if err = w.send(rw, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *clusterWatch) send(rw io.Writer, notifiable common.Notifiable) error {
+	msg, err := stream.NewMessage(notifiable)
+	if err != nil || msg == nil {
+		return err
+	}
+	return w.forward(rw, msg)
+}
+
+func (w *clusterWatch) forward(rw io.Writer, msg *stream.Message) error {
+	switch msg.Name {
+	case common.NotificationTypeCluster:
+		if msg.UpdatedAt.After(w.clusterUpdatedAt) {
+			w.clusterUpdatedAt = msg.UpdatedAt
+		}
+	case common.NotificationTypeHost:
+		if msg.HostID == nil {
+			return nil
+		}
+		if msg.UpdatedAt.After(w.hostsUpdatedAt[*msg.HostID]) {
+			w.hostsUpdatedAt[*msg.HostID] = msg.UpdatedAt
+		}
+	case common.NotificationTypeEvent:
+		if msg.EventID != 0 {
+			if _, sent := w.sentEvents[msg.EventID]; sent {
+				return nil
+			}
+			w.sentEvents[msg.EventID] = time.Now()
+			if msg.EventID > w.lastEventID {
+				w.lastEventID = msg.EventID
+			}
+		}
+		var event struct {
+			Category string `json:"category"`
+		}
+		if err := json.Unmarshal(msg.Payload, &event); err != nil {
+			return err
+		}
+		if !funk.ContainsString(eventsapi.DefaultEventCategories, event.Category) {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return writeServerSentEvent(rw, msg.Name, msg.Payload)
+}
+
+// writeServerSentEvent writes a single server-sent event. The data must not contain line breaks,
+// which holds for compact JSON.
+func writeServerSentEvent(rw io.Writer, name string, data []byte) error {
+	_, err := fmt.Fprintf(rw, "event: %s\ndata: %s\n\n", name, data)
+	return err
+}
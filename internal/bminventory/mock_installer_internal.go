@@ -441,6 +441,21 @@ func (mr *MockInstallerInternalsMockRecorder) UpdateInfraEnvInternal(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfraEnvInternal", reflect.TypeOf((*MockInstallerInternals)(nil).UpdateInfraEnvInternal), arg0, arg1, arg2, arg3)
 }
 
+// V2CreateHostDiagnosticInternal mocks base method.
+func (m *MockInstallerInternals) V2CreateHostDiagnosticInternal(arg0 context.Context, arg1 installer.V2CreateHostDiagnosticParams) (*models.HostDiagnostic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2CreateHostDiagnosticInternal", arg0, arg1)
+	ret0, _ := ret[0].(*models.HostDiagnostic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// V2CreateHostDiagnosticInternal indicates an expected call of V2CreateHostDiagnosticInternal.
+func (mr *MockInstallerInternalsMockRecorder) V2CreateHostDiagnosticInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CreateHostDiagnosticInternal", reflect.TypeOf((*MockInstallerInternals)(nil).V2CreateHostDiagnosticInternal), arg0, arg1)
+}
+
 // V2DeregisterHostInternal mocks base method.
 func (m *MockInstallerInternals) V2DeregisterHostInternal(arg0 context.Context, arg1 installer.V2DeregisterHostParams, arg2 Interactivity) error {
 	m.ctrl.T.Helper()
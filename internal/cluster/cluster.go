@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/filanov/stateswitch"
@@ -76,8 +77,8 @@ var ClusterOwnerFileNames = []string{
 //go:generate mockgen -source=cluster.go -package=cluster -destination=mock_cluster_api.go
 
 type RegistrationAPI interface {
-	// Register a new cluster
-	RegisterCluster(ctx context.Context, c *common.Cluster) error
+	// Register a new cluster, beforeCreate, when set, runs in the transaction that creates it
+	RegisterCluster(ctx context.Context, c *common.Cluster, beforeCreate func(tx *gorm.DB) error) error
 	// Register a new add-host cluster
 	RegisterAddHostsCluster(ctx context.Context, c *common.Cluster) error
 	// Register a new add-host-ocp cluster
@@ -173,6 +174,8 @@ type Manager struct {
 	authHandler           auth.Authenticator
 	uploadClient          uploader.Client
 	manifestApi           manifestsapi.ManifestsAPI
+	scheduledInstaller    ScheduledInstaller
+	scheduledInstalls     *sync.Map
 }
 
 func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, stream stream.Notifier, eventsHandler eventsapi.Handler,
@@ -211,11 +214,12 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, stream stream.N
 		authHandler:           authHandler,
 		uploadClient:          uploadClient,
 		manifestApi:           manifestApi,
+		scheduledInstalls:     &sync.Map{},
 	}
 }
 
-func (m *Manager) RegisterCluster(ctx context.Context, c *common.Cluster) error {
-	return m.registrationAPI.RegisterCluster(ctx, c)
+func (m *Manager) RegisterCluster(ctx context.Context, c *common.Cluster, beforeCreate func(tx *gorm.DB) error) error {
+	return m.registrationAPI.RegisterCluster(ctx, c, beforeCreate)
 }
 
 func (m *Manager) RegisterAddHostsCluster(ctx context.Context, c *common.Cluster) error {
@@ -652,6 +656,8 @@ func (m *Manager) ClusterMonitoring() {
 						swag.StringValue(cluster.Status), swag.StringValue(clusterAfterRefresh.Status))
 				}
 
+				m.handleInstallSchedule(ctx, log, clusterAfterRefresh, curMonitorInvokedAt)
+
 				if m.shouldTriggerLeaseTimeoutEvent(cluster, curMonitorInvokedAt) {
 					m.triggerLeaseTimeoutEvent(ctx, cluster)
 				}
@@ -1616,6 +1622,12 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 				m.log.WithError(err).Warnf("Failed deleting cluster records from db for cluster %s", c.ID.String())
 			}
 		}
+		if err := common.DeleteEventSubscriptions(m.db, *c.ID); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting event subscriptions of cluster %s", c.ID.String())
+		}
+		if err := common.DeleteRoleBindings(m.db, *c.ID); err != nil {
+			m.log.WithError(err).Warnf("Failed deleting role bindings of cluster %s", c.ID.String())
+		}
 
 		if reply := m.db.Unscoped().Delete(&common.Cluster{}, "id = ?", c.ID.String()); reply.Error != nil {
 			m.log.WithError(reply.Error).Warnf("Failed deleting cluster from db %s", c.ID.String())
@@ -1848,7 +1860,10 @@ func (m *Manager) UpdateFinalizingStage(ctx context.Context, clusterID strfmt.UU
 		}).Error; err != nil {
 			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "update finalizing stage for cluster %s", clusterID.String()))
 		}
-		eventgen.SendClusterFinalizingStageUpdatedEvent(ctx, m.eventsHandler, clusterID, string(finalizingStage))
+		// The stage is also recorded as a property of the event, the installation timeline is built from it
+		event := eventgen.NewClusterFinalizingStageUpdatedEvent(clusterID, string(finalizingStage))
+		m.eventsHandler.V2AddEvent(ctx, &clusterID, nil, nil, event.GetName(), event.GetSeverity(), event.FormatMessage(),
+			time.Now(), FinalizingStageEventProperty, string(finalizingStage))
 	}
 	return nil
 }
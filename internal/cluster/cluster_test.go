@@ -2026,6 +2026,23 @@ var _ = Describe("PrepareForInstallation", func() {
 			t.validation(&cluster)
 		})
 	}
+
+	It("removes the install schedule", func() {
+		notBefore := strfmt.DateTime(time.Now().Add(-time.Hour))
+		notAfter := strfmt.DateTime(time.Now().Add(time.Hour))
+		cluster := common.Cluster{
+			Cluster: models.Cluster{
+				ID:              &clusterId,
+				Status:          swag.String(models.ClusterStatusReady),
+				InstallSchedule: &models.InstallSchedule{NotBefore: &notBefore, NotAfter: &notAfter},
+			}}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		Expect(db.Take(&cluster, "id = ?", clusterId).Error).ShouldNot(HaveOccurred())
+		success(&cluster)
+		var reloaded common.Cluster
+		Expect(db.Take(&reloaded, "id = ?", clusterId).Error).ShouldNot(HaveOccurred())
+		Expect(reloaded.InstallSchedule).To(Or(BeNil(), Equal(&models.InstallSchedule{})))
+	})
 })
 
 var _ = Describe("HandlePreInstallationChanges", func() {
@@ -2787,7 +2804,7 @@ var _ = Describe("insufficient_state", func() {
 	})
 
 	It("works", func() {
-		replyErr := clusterApi.RegisterCluster(ctx, &cluster)
+		replyErr := clusterApi.RegisterCluster(ctx, &cluster, nil)
 		Expect(replyErr).Should(BeNil())
 		Expect(swag.StringValue(cluster.Status)).Should(Equal(models.ClusterStatusInsufficient))
 		c := getClusterFromDB(*cluster.ID, db)
@@ -3647,7 +3664,7 @@ var _ = Describe("Update AMS subscription ID", func() {
 				ID: &clusterID,
 			},
 		}
-		err := api.RegisterCluster(ctx, &c)
+		err := api.RegisterCluster(ctx, &c, nil)
 		Expect(err).ShouldNot(HaveOccurred())
 
 		subID := strfmt.UUID(uuid.New().String())
@@ -3705,7 +3722,7 @@ var _ = Describe("Validation metrics and events", func() {
 		bytes, err := json.Marshal(validationRes)
 		Expect(err).ShouldNot(HaveOccurred())
 		c.ValidationsInfo = string(bytes)
-		err = m.RegisterCluster(ctx, &c)
+		err = m.RegisterCluster(ctx, &c, nil)
 		Expect(err).ShouldNot(HaveOccurred())
 
 		createHost(clusterID, models.HostStatusInsufficient, db)
@@ -4291,7 +4308,8 @@ var _ = Describe("update finalizing stage", func() {
 		stage := st
 		It(fmt.Sprintf("success for stage '%s'", stage), func() {
 			createCluster(models.ClusterStatusFinalizing)
-			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), gomock.Any()).Times(1)
+			mockEvents.EXPECT().V2AddEvent(gomock.Any(), &clusterID, nil, nil, eventgen.ClusterFinalizingStageUpdatedEventName,
+				models.EventSeverityInfo, gomock.Any(), gomock.Any(), FinalizingStageEventProperty, string(stage)).Times(1)
 			start := time.Now()
 			Expect(capi.UpdateFinalizingStage(ctx, clusterID, stage)).ToNot(HaveOccurred())
 			duration := time.Since(start)
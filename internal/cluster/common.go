@@ -163,8 +163,8 @@ func UpdateCluster(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, not
 	cluster, err := common.GetClusterFromDB(db, clusterId, common.UseEagerLoading)
 	if err == nil {
 		notifiableCluster := stream.GetNotifiableCluster(cluster)
-		if err = notificationStream.Notify(ctx, notifiableCluster); err != nil {
-			log.WithError(err).Warning("failed to notify cluster update event")
+		if err = stream.NotifyWithDB(ctx, notificationStream, db, notifiableCluster); err != nil {
+			return nil, errors.Wrapf(err, "failed to notify cluster %s update", clusterId)
 		}
 		return cluster, nil
 	}
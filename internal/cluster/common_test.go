@@ -12,7 +12,9 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	commontesting "github.com/openshift/assisted-service/internal/common/testing"
+	"github.com/openshift/assisted-service/internal/stream"
 	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
@@ -74,6 +76,18 @@ var _ = Describe("update_cluster_state", func() {
 			})
 		})
 
+		It("rolls back the update when the notification can't be recorded", func() {
+			notifier := stream.NewMockTransactionalNotifier(ctrl)
+			notifier.EXPECT().NotifyWithDB(ctx, gomock.Any(), gomock.Any()).Return(errors.New("outbox failure")).Times(1)
+			err = db.Transaction(func(tx *gorm.DB) error {
+				_, updateErr := UpdateCluster(ctx, common.GetTestLog(), tx, notifier, *cluster.ID, *cluster.Status, "status", newStatus)
+				return updateErr
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(db.First(&cluster, "id = ?", cluster.ID).Error).ShouldNot(HaveOccurred())
+			Expect(*cluster.Status).ShouldNot(Equal(newStatus))
+		})
+
 		It("db_failure", func() {
 			common.CloseDB(db)
 			_, err = UpdateCluster(ctx, common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl), *cluster.ID, *cluster.Status, "status", newStatus)
@@ -49,6 +49,15 @@ var finalizingStages = []models.FinalizingStage{
 	models.FinalizingStageDone,
 }
 
+// FinalizingStageEventProperty is the property of the cluster_finalizing_stage_updated events that
+// holds the stage
+const FinalizingStageEventProperty = "finalizing_stage"
+
+// FinalizingStages returns the finalizing stages in the order in which the controller goes through them
+func FinalizingStages() []models.FinalizingStage {
+	return append([]models.FinalizingStage{}, finalizingStages...)
+}
+
 var nonFailingFinalizingStages = []models.FinalizingStage{
 	models.FinalizingStageApplyingOlmManifests,
 	models.FinalizingStageWaitingForOlmOperatorsCsvInitialization,
@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/restapi"
+	installerops "github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+var resetInstallScheduleFields = []interface{}{"install_schedule_not_before", nil, "install_schedule_not_after", nil}
+
+var resetInstallScheduleUpdates = map[string]interface{}{"install_schedule_not_before": nil, "install_schedule_not_after": nil}
+
+// ScheduledInstaller starts the installation of a cluster. The cluster monitor uses it to start the
+// installation of clusters that are ready inside the window of their install schedule.
+type ScheduledInstaller interface {
+	InstallClusterInternal(ctx context.Context, params installerops.V2InstallClusterParams) (*common.Cluster, error)
+}
+
+// SetScheduledInstaller sets the installer used by the cluster monitor to start scheduled installations.
+// The inventory depends on the cluster manager, so it can only be set once both are created.
+func (m *Manager) SetScheduledInstaller(scheduledInstaller ScheduledInstaller) {
+	m.scheduledInstaller = scheduledInstaller
+}
+
+func (m *Manager) handleInstallSchedule(ctx context.Context, log logrus.FieldLogger, c *common.Cluster, now time.Time) {
+	schedule := c.InstallSchedule
+	if schedule == nil || schedule.NotBefore == nil {
+		return
+	}
+
+	// The installation of clusters managed through the kube-api is started by their controller
+	if c.KubeKeyNamespace != "" {
+		return
+	}
+
+	preInstallationStatuses := []string{models.ClusterStatusPendingForInput, models.ClusterStatusInsufficient, models.ClusterStatusReady}
+	status := swag.StringValue(c.Status)
+	if !funk.ContainsString(preInstallationStatuses, status) {
+		return
+	}
+
+	if schedule.NotAfter != nil && now.After(time.Time(*schedule.NotAfter)) {
+		log.Infof("cluster %s was not ready for installation before the end of its install schedule at %s",
+			c.ID, schedule.NotAfter.String())
+		eventgen.SendScheduledInstallationWindowMissedEvent(ctx, m.eventsHandler, *c.ID, schedule.NotAfter.String())
+		m.removeInstallSchedule(log, c)
+		return
+	}
+
+	if status != models.ClusterStatusReady || now.Before(time.Time(*schedule.NotBefore)) {
+		return
+	}
+
+	if m.scheduledInstaller == nil {
+		log.Warnf("cannot start the scheduled installation of cluster %s, no installer is set", c.ID)
+		return
+	}
+
+	// The monitor may see the cluster ready again before the previous attempt has moved it on
+	if _, inProgress := m.scheduledInstalls.LoadOrStore(*c.ID, true); inProgress {
+		return
+	}
+
+	log.Infof("starting the scheduled installation of cluster %s", c.ID)
+	eventgen.SendScheduledInstallationStartedEvent(ctx, m.eventsHandler, *c.ID, schedule.NotBefore.String())
+	go m.startScheduledInstallation(c)
+}
+
+// startScheduledInstallation installs the cluster on behalf of the service, so that the monitor isn't
+// blocked while the installation is prepared
+func (m *Manager) startScheduledInstallation(c *common.Cluster) {
+	defer m.scheduledInstalls.Delete(*c.ID)
+
+	requestID := requestid.NewID()
+	ctx := context.WithValue(requestid.ToContext(context.Background(), requestID), restapi.AuthKey, ocm.AdminPayload())
+	log := requestid.RequestIDLogger(m.log, requestID)
+
+	if _, err := m.scheduledInstaller.InstallClusterInternal(ctx, installerops.V2InstallClusterParams{ClusterID: *c.ID}); err != nil {
+		log.WithError(err).Errorf("failed to start the scheduled installation of cluster %s", c.ID)
+		eventgen.SendScheduledInstallationFailedEvent(ctx, m.eventsHandler, *c.ID, err.Error())
+		m.removeInstallSchedule(log, c)
+	}
+}
+
+func (m *Manager) removeInstallSchedule(log logrus.FieldLogger, c *common.Cluster) {
+	// The status is not part of the query since the installation attempt may have already changed it
+	if err := m.db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).Updates(resetInstallScheduleUpdates).Error; err != nil {
+		log.WithError(err).Errorf("failed to remove the install schedule of cluster %s", c.ID)
+	}
+}
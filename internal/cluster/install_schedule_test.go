@@ -0,0 +1,181 @@
+package cluster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	commontesting "github.com/openshift/assisted-service/internal/common/testing"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	installerops "github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type fakeScheduledInstaller struct {
+	lock       sync.Mutex
+	clusterIDs []strfmt.UUID
+	usernames  []string
+	err        error
+	release    chan struct{}
+}
+
+func (f *fakeScheduledInstaller) InstallClusterInternal(ctx context.Context, params installerops.V2InstallClusterParams) (*common.Cluster, error) {
+	if f.release != nil {
+		<-f.release
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.clusterIDs = append(f.clusterIDs, params.ClusterID)
+	f.usernames = append(f.usernames, ocm.UserNameFromContext(ctx))
+	return nil, f.err
+}
+
+func (f *fakeScheduledInstaller) installed() []strfmt.UUID {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]strfmt.UUID{}, f.clusterIDs...)
+}
+
+var _ = Describe("install schedule", func() {
+	var (
+		ctx                = context.Background()
+		db                 *gorm.DB
+		dbName             string
+		ctrl               *gomock.Controller
+		clusterApi         *Manager
+		mockEvents         *eventsapi.MockHandler
+		scheduledInstaller *fakeScheduledInstaller
+		clusterID          strfmt.UUID
+		now                time.Time
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog(), db, commontesting.GetDummyNotificationStream(ctrl),
+			mockEvents, nil, nil, nil, nil, &leader.DummyElector{}, nil, nil, nil, nil, nil, nil, false, nil)
+		scheduledInstaller = &fakeScheduledInstaller{}
+		clusterApi.SetScheduledInstaller(scheduledInstaller)
+		clusterID = strfmt.UUID(uuid.New().String())
+		now = time.Now()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createCluster := func(status string, notBefore, notAfter *strfmt.DateTime) *common.Cluster {
+		c := &common.Cluster{Cluster: models.Cluster{
+			ID:              &clusterID,
+			Status:          swag.String(status),
+			InstallSchedule: &models.InstallSchedule{NotBefore: notBefore, NotAfter: notAfter},
+		}}
+		Expect(db.Create(c).Error).ShouldNot(HaveOccurred())
+		return c
+	}
+
+	dateTime := func(t time.Time) *strfmt.DateTime {
+		dt := strfmt.DateTime(t)
+		return &dt
+	}
+
+	expectEvent := func(name string) {
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(name),
+			eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+	}
+
+	scheduleRemoved := func() bool {
+		var c common.Cluster
+		Expect(db.Take(&c, "id = ?", clusterID.String()).Error).ShouldNot(HaveOccurred())
+		return c.InstallSchedule == nil || c.InstallSchedule.NotBefore == nil
+	}
+
+	It("starts the installation of a ready cluster inside the window", func() {
+		c := createCluster(models.ClusterStatusReady, dateTime(now.Add(-time.Minute)), dateTime(now.Add(time.Hour)))
+		expectEvent(eventgen.ScheduledInstallationStartedEventName)
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		Eventually(scheduledInstaller.installed).Should(Equal([]strfmt.UUID{clusterID}))
+		Expect(scheduledInstaller.usernames).To(Equal([]string{ocm.AdminUsername}))
+	})
+
+	It("starts the installation of a ready cluster when the window has no end", func() {
+		c := createCluster(models.ClusterStatusReady, dateTime(now.Add(-time.Minute)), nil)
+		expectEvent(eventgen.ScheduledInstallationStartedEventName)
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		Eventually(scheduledInstaller.installed).Should(Equal([]strfmt.UUID{clusterID}))
+	})
+
+	It("does not start the installation again while it is being started", func() {
+		scheduledInstaller.release = make(chan struct{})
+		c := createCluster(models.ClusterStatusReady, dateTime(now.Add(-time.Minute)), nil)
+		expectEvent(eventgen.ScheduledInstallationStartedEventName)
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		close(scheduledInstaller.release)
+		Eventually(scheduledInstaller.installed).Should(Equal([]strfmt.UUID{clusterID}))
+		Consistently(scheduledInstaller.installed, "100ms").Should(HaveLen(1))
+	})
+
+	It("does not start the installation of a cluster managed through the kube-api", func() {
+		c := createCluster(models.ClusterStatusReady, dateTime(now.Add(-time.Minute)), nil)
+		c.KubeKeyNamespace = "test-namespace"
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		Consistently(scheduledInstaller.installed, "100ms").Should(BeEmpty())
+		Expect(scheduleRemoved()).To(BeFalse())
+	})
+
+	It("does not start the installation before the window", func() {
+		c := createCluster(models.ClusterStatusReady, dateTime(now.Add(time.Minute)), dateTime(now.Add(time.Hour)))
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		Expect(scheduledInstaller.installed()).To(BeEmpty())
+		Expect(scheduleRemoved()).To(BeFalse())
+	})
+
+	It("does not start the installation of a cluster that is not ready", func() {
+		c := createCluster(models.ClusterStatusInsufficient, dateTime(now.Add(-time.Minute)), dateTime(now.Add(time.Hour)))
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		Expect(scheduledInstaller.installed()).To(BeEmpty())
+		Expect(scheduleRemoved()).To(BeFalse())
+	})
+
+	It("ignores clusters that are already installing", func() {
+		c := createCluster(models.ClusterStatusInstalling, dateTime(now.Add(-time.Hour)), dateTime(now.Add(-time.Minute)))
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		Expect(scheduledInstaller.installed()).To(BeEmpty())
+		Expect(scheduleRemoved()).To(BeFalse())
+	})
+
+	It("removes the schedule when the window is missed", func() {
+		c := createCluster(models.ClusterStatusInsufficient, dateTime(now.Add(-time.Hour)), dateTime(now.Add(-time.Minute)))
+		expectEvent(eventgen.ScheduledInstallationWindowMissedEventName)
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		Expect(scheduledInstaller.installed()).To(BeEmpty())
+		Expect(scheduleRemoved()).To(BeTrue())
+	})
+
+	It("removes the schedule when the installation fails to start", func() {
+		scheduledInstaller.err = errors.New("not ready")
+		c := createCluster(models.ClusterStatusReady, dateTime(now.Add(-time.Minute)), nil)
+		expectEvent(eventgen.ScheduledInstallationStartedEventName)
+		expectEvent(eventgen.ScheduledInstallationFailedEventName)
+		clusterApi.handleInstallSchedule(ctx, common.GetTestLog(), c, now)
+		Eventually(scheduledInstaller.installed).Should(Equal([]strfmt.UUID{clusterID}))
+		Eventually(scheduleRemoved).Should(BeTrue())
+	})
+})
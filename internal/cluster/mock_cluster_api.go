@@ -83,17 +83,17 @@ func (mr *MockRegistrationAPIMockRecorder) RegisterAddHostsOCPCluster(c, db inte
 }
 
 // RegisterCluster mocks base method.
-func (m *MockRegistrationAPI) RegisterCluster(ctx context.Context, c *common.Cluster) error {
+func (m *MockRegistrationAPI) RegisterCluster(ctx context.Context, c *common.Cluster, beforeCreate func(tx *gorm.DB) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterCluster", ctx, c)
+	ret := m.ctrl.Call(m, "RegisterCluster", ctx, c, beforeCreate)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RegisterCluster indicates an expected call of RegisterCluster.
-func (mr *MockRegistrationAPIMockRecorder) RegisterCluster(ctx, c interface{}) *gomock.Call {
+func (mr *MockRegistrationAPIMockRecorder) RegisterCluster(ctx, c, beforeCreate interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCluster", reflect.TypeOf((*MockRegistrationAPI)(nil).RegisterCluster), ctx, c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCluster", reflect.TypeOf((*MockRegistrationAPI)(nil).RegisterCluster), ctx, c, beforeCreate)
 }
 
 // MockInstallationAPI is a mock of InstallationAPI interface.
@@ -561,17 +561,17 @@ func (mr *MockAPIMockRecorder) RegisterAddHostsOCPCluster(c, db interface{}) *go
 }
 
 // RegisterCluster mocks base method.
-func (m *MockAPI) RegisterCluster(ctx context.Context, c *common.Cluster) error {
+func (m *MockAPI) RegisterCluster(ctx context.Context, c *common.Cluster, beforeCreate func(tx *gorm.DB) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterCluster", ctx, c)
+	ret := m.ctrl.Call(m, "RegisterCluster", ctx, c, beforeCreate)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RegisterCluster indicates an expected call of RegisterCluster.
-func (mr *MockAPIMockRecorder) RegisterCluster(ctx, c interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) RegisterCluster(ctx, c, beforeCreate interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCluster", reflect.TypeOf((*MockAPI)(nil).RegisterCluster), ctx, c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCluster", reflect.TypeOf((*MockAPI)(nil).RegisterCluster), ctx, c, beforeCreate)
 }
 
 // ResetCluster mocks base method.
@@ -25,15 +25,15 @@ type registrar struct {
 	db  *gorm.DB
 }
 
-func (r *registrar) RegisterCluster(ctx context.Context, cluster *common.Cluster) error {
-	return r.registerCluster(cluster, models.ClusterStatusInsufficient, StatusInfoInsufficient, time.Now())
+func (r *registrar) RegisterCluster(ctx context.Context, cluster *common.Cluster, beforeCreate func(tx *gorm.DB) error) error {
+	return r.registerCluster(cluster, models.ClusterStatusInsufficient, StatusInfoInsufficient, time.Now(), beforeCreate)
 }
 
 func (r *registrar) RegisterAddHostsCluster(ctx context.Context, cluster *common.Cluster) error {
-	return r.registerCluster(cluster, models.ClusterStatusAddingHosts, statusInfoAddingHosts, time.Now())
+	return r.registerCluster(cluster, models.ClusterStatusAddingHosts, statusInfoAddingHosts, time.Now(), nil)
 }
 
-func (r *registrar) registerCluster(cluster *common.Cluster, status, statusInfo string, registerTime time.Time) error {
+func (r *registrar) registerCluster(cluster *common.Cluster, status, statusInfo string, registerTime time.Time, beforeCreate func(tx *gorm.DB) error) error {
 	cluster.Status = swag.String(status)
 	cluster.StatusInfo = swag.String(statusInfo)
 	cluster.StatusUpdatedAt = strfmt.DateTime(registerTime)
@@ -56,6 +56,11 @@ func (r *registrar) registerCluster(cluster *common.Cluster, status, statusInfo
 				}
 			}
 		}
+		if beforeCreate != nil {
+			if err = beforeCreate(tx); err != nil {
+				return err
+			}
+		}
 		if err = tx.Create(cluster).Error; err != nil {
 			r.log.Errorf("Error registering cluster %s", cluster.Name)
 			return err
@@ -93,6 +98,14 @@ func (r *registrar) DeregisterCluster(ctx context.Context, cluster *common.Clust
 			return errors.Errorf("failed to delete cluster records %s", cluster.ID)
 		}
 
+		if err = common.DeleteEventSubscriptions(tx, *cluster.ID); err != nil {
+			return errors.Errorf("failed to delete the event subscriptions of cluster %s", cluster.ID)
+		}
+
+		if err = common.DeleteRoleBindings(tx, *cluster.ID); err != nil {
+			return errors.Errorf("failed to delete the role bindings of cluster %s", cluster.ID)
+		}
+
 		if err = tx.Delete(cluster).Error; err != nil {
 			return errors.Errorf("failed to delete cluster %s", cluster.ID)
 		}
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
@@ -38,7 +39,7 @@ var _ = Describe("registrar", func() {
 				Status: swag.String(models.ClusterStatusInsufficient),
 			}}
 
-			updateErr = registerManager.RegisterCluster(ctx, &cluster)
+			updateErr = registerManager.RegisterCluster(ctx, &cluster, nil)
 			Expect(updateErr).Should(BeNil())
 			Expect(swag.StringValue(cluster.Status)).Should(Equal(models.ClusterStatusInsufficient))
 			cluster = getClusterFromDB(*cluster.ID, db)
@@ -46,7 +47,7 @@ var _ = Describe("registrar", func() {
 		})
 
 		It("register a registered cluster", func() {
-			updateErr = registerManager.RegisterCluster(ctx, &cluster)
+			updateErr = registerManager.RegisterCluster(ctx, &cluster, nil)
 			Expect(updateErr).Should(HaveOccurred())
 
 			cluster = getClusterFromDB(*cluster.ID, db)
@@ -55,7 +56,7 @@ var _ = Describe("registrar", func() {
 
 		It("register a (soft) deleted cluster", func() {
 			Expect(db.Unscoped().Delete(&cluster).Error).ShouldNot(HaveOccurred())
-			updateErr = registerManager.RegisterCluster(ctx, &cluster)
+			updateErr = registerManager.RegisterCluster(ctx, &cluster, nil)
 			Expect(updateErr).ShouldNot(HaveOccurred())
 
 			cluster = getClusterFromDB(*cluster.ID, db)
@@ -66,12 +67,22 @@ var _ = Describe("registrar", func() {
 			Expect(db.First(&common.Cluster{}, "id = ?", cluster.ID).RowsAffected).Should(Equal(int64(0)))
 			Expect(db.Unscoped().First(&common.Cluster{}, "id = ?", cluster.ID).RowsAffected).Should(Equal(int64(1)))
 
-			updateErr = registerManager.RegisterCluster(ctx, &cluster)
+			updateErr = registerManager.RegisterCluster(ctx, &cluster, nil)
 			Expect(updateErr).ShouldNot(HaveOccurred())
 
 			cluster = getClusterFromDB(*cluster.ID, db)
 			Expect(swag.StringValue(cluster.Status)).Should(Equal(models.ClusterStatusInsufficient))
 		})
+
+		It("does not register the cluster when beforeCreate fails", func() {
+			otherID := strfmt.UUID(uuid.New().String())
+			other := common.Cluster{Cluster: models.Cluster{ID: &otherID}}
+			updateErr = registerManager.RegisterCluster(ctx, &other, func(tx *gorm.DB) error {
+				return errors.New("quota exceeded")
+			})
+			Expect(updateErr).To(MatchError("quota exceeded"))
+			Expect(db.Unscoped().First(&common.Cluster{}, "id = ?", otherID).RowsAffected).Should(Equal(int64(0)))
+		})
 	})
 
 	Context("deregister", func() {
@@ -107,6 +118,37 @@ var _ = Describe("registrar", func() {
 			Expect(db.First(&models.MachineNetwork{}, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
 		})
 
+		It("unregister a cluster removes its event subscriptions", func() {
+			subscriptionID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.EventSubscription{EventSubscription: models.EventSubscription{
+				ID:        &subscriptionID,
+				ClusterID: cluster.ID,
+				URL:       swag.String("https://example.com/hook"),
+			}}).Error).ShouldNot(HaveOccurred())
+			Expect(db.Create(&common.EventSubscriptionDelivery{EventSubscriptionDelivery: models.EventSubscriptionDelivery{
+				SubscriptionID: subscriptionID,
+				Status:         models.EventSubscriptionDeliveryStatusPending,
+			}}).Error).ShouldNot(HaveOccurred())
+
+			Expect(registerManager.DeregisterCluster(ctx, &cluster)).Should(Succeed())
+			Expect(db.First(&common.EventSubscription{}, "id = ?", subscriptionID).Error).Should(HaveOccurred())
+			Expect(db.First(&common.EventSubscriptionDelivery{}, "subscription_id = ?", subscriptionID).Error).Should(HaveOccurred())
+		})
+
+		It("unregister a cluster removes its role bindings", func() {
+			roleBindingID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.RoleBinding{RoleBinding: models.RoleBinding{
+				ID:          &roleBindingID,
+				ClusterID:   cluster.ID,
+				Role:        swag.String(models.RoleBindingRoleViewer),
+				SubjectKind: swag.String(models.RoleBindingSubjectKindUser),
+				Subject:     swag.String("technician"),
+			}}).Error).ShouldNot(HaveOccurred())
+
+			Expect(registerManager.DeregisterCluster(ctx, &cluster)).Should(Succeed())
+			Expect(db.First(&common.RoleBinding{}, "id = ?", roleBindingID).Error).Should(HaveOccurred())
+		})
+
 		It("unregister a cluster in installing state", func() {
 			// cluster state to installing
 			cluster.Status = swag.String("installing")
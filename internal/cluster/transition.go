@@ -204,6 +204,7 @@ func (th *transitionHandler) PostPrepareForInstallation(sw stateswitch.StateSwit
 	}
 	extra := append(append(make([]interface{}, 0), "install_started_at", strfmt.DateTime(time.Now())), resetLogsField...)
 	extra = append(extra, resetInstallationPreparationStatusFields...)
+	extra = append(extra, resetInstallScheduleFields...)
 	err = th.updateTransitionCluster(params.ctx, logutil.FromContext(params.ctx, th.log), th.db, sCluster,
 		statusInfoPreparingForInstallation, extra...)
 	if err != nil {
@@ -716,8 +717,17 @@ func FinalizingStage(sCluster *stateCluster) interface{} {
 	return sCluster.cluster.Progress.FinalizingStage
 }
 
+// clusterFinalizingStageTimeout returns the timeout of the current finalizing stage of the cluster,
+// which may be overridden by the user
+func (th *transitionHandler) clusterFinalizingStageTimeout(sCluster *stateCluster) time.Duration {
+	if timeout, ok := common.GetInstallTimeouts(sCluster.cluster).FinalizingStageTimeout(sCluster.cluster.Progress.FinalizingStage); ok {
+		return timeout
+	}
+	return finalizingStageTimeout(sCluster.cluster.Progress.FinalizingStage, sCluster.cluster.MonitoredOperators, th.isSoftTimeoutsEnabled(sCluster.cluster), th.log)
+}
+
 func (th *transitionHandler) finalizingStageTimeoutMinutes(sCluster *stateCluster) int64 {
-	return int64(finalizingStageTimeout(sCluster.cluster.Progress.FinalizingStage, sCluster.cluster.MonitoredOperators, th.isSoftTimeoutsEnabled(sCluster.cluster), th.log).Minutes())
+	return int64(th.clusterFinalizingStageTimeout(sCluster).Minutes())
 }
 
 func (th *transitionHandler) FinalizingStageTimeoutMinutes(sCluster *stateCluster) interface{} {
@@ -760,7 +770,7 @@ func (th *transitionHandler) IsFinalizingStageTimedOut(sw stateswitch.StateSwitc
 	if sCluster.cluster.Progress == nil || sCluster.cluster.Progress.FinalizingStage == "" {
 		return false, nil
 	}
-	timeout := finalizingStageTimeout(sCluster.cluster.Progress.FinalizingStage, sCluster.cluster.MonitoredOperators, th.isSoftTimeoutsEnabled(sCluster.cluster), th.log)
+	timeout := th.clusterFinalizingStageTimeout(sCluster)
 	return time.Since(time.Time(sCluster.cluster.Progress.FinalizingStageStartedAt)) > timeout, nil
 }
 
@@ -5760,6 +5760,39 @@ var _ = Describe("finalizing timeouts", func() {
 			})
 		}
 	})
+	Context("install timeouts overridden", func() {
+		const overriddenTimeout = 3 * time.Hour
+		stage := models.FinalizingStageWaitingForClusterOperators
+
+		BeforeEach(func() {
+			clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db, commontesting.GetDummyNotificationStream(ctrl),
+				mockEvents, nil, mockHostAPI, mockMetric, nil, nil, operatorsManager, nil, mockS3Api, nil, nil, nil, false, nil)
+		})
+		createClusterWithOverride := func(stageTimestamp time.Time) *common.Cluster {
+			cls := createCluster(models.ClusterStatusFinalizing, stage, time.Now(), stageTimestamp)
+			cls.InstallTimeouts = fmt.Sprintf(`{"finalizing_stages": {"%s": "%s"}}`, stage, overriddenTimeout)
+			Expect(db.Model(cls).Update("install_timeouts", cls.InstallTimeouts).Error).ToNot(HaveOccurred())
+			return cls
+		}
+		It("should move to error when the overridden timeout expired", func() {
+			Expect(finalizingStageTimeout(stage, nil, false, logrus.New())).To(BeNumerically(">", overriddenTimeout))
+			cls := createClusterWithOverride(time.Now().Add(-(overriddenTimeout + time.Second)))
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), gomock.Any()).Times(2)
+			mockMetric.EXPECT().ClusterInstallationFinished(gomock.Any(), models.ClusterStatusError, models.ClusterStatusFinalizing,
+				cls.OpenshiftVersion, *cls.ID, cls.EmailDomain, gomock.Any()).Times(1)
+			clusterAfterUpdate, err := clusterApi.RefreshStatus(ctx, cls, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(swag.StringValue(clusterAfterUpdate.Status)).To(Equal(models.ClusterStatusError))
+			Expect(swag.StringValue(clusterAfterUpdate.StatusInfo)).To(Equal(fmt.Sprintf(statusInfoFinalizingStageTimeout, stage, int64(overriddenTimeout.Minutes()))))
+		})
+		It("should stay in finalizing while the overridden timeout didn't expire", func() {
+			cls := createClusterWithOverride(time.Now().Add(-(overriddenTimeout - time.Minute)))
+			mockS3Api.EXPECT().DoesObjectExist(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+			clusterAfterUpdate, err := clusterApi.RefreshStatus(ctx, cls, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(swag.StringValue(clusterAfterUpdate.Status)).To(Equal(models.ClusterStatusFinalizing))
+		})
+	})
 })
 
 func getClusterFromDB(clusterId strfmt.UUID, db *gorm.DB) common.Cluster {
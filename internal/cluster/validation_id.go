@@ -52,6 +52,7 @@ const (
 	AreFenceAgentsRemediationRequirementsSatisfied = ValidationID(models.ClusterValidationIDFenceAgentsRemediationRequirementsSatisfied)
 	AreNodeMaintenanceRequirementsSatisfied        = ValidationID(models.ClusterValidationIDNodeMaintenanceRequirementsSatisfied)
 	AreKubeDeschedulerRequirementsSatisfied        = ValidationID(models.ClusterValidationIDKubeDeschedulerRequirementsSatisfied)
+	ArePluginOperatorsRequirementsSatisfied        = ValidationID(models.ClusterValidationIDPluginOperatorsRequirementsSatisfied)
 )
 
 func (v ValidationID) Category() (string, error) {
@@ -86,7 +87,8 @@ func (v ValidationID) Category() (string, error) {
 		AreSelfNodeRemediationRequirementsSatisfied,
 		AreFenceAgentsRemediationRequirementsSatisfied,
 		AreNodeMaintenanceRequirementsSatisfied,
-		AreKubeDeschedulerRequirementsSatisfied:
+		AreKubeDeschedulerRequirementsSatisfied,
+		ArePluginOperatorsRequirementsSatisfied:
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected cluster validation id %s", string(v)))
@@ -0,0 +1,11 @@
+package common
+
+import (
+	"github.com/openshift/assisted-service/models"
+)
+
+// AuditRecord records a change made to a cluster, an infra-env or a host, either through the API or by
+// the controllers
+type AuditRecord struct {
+	models.AuditRecord
+}
@@ -50,7 +50,8 @@ const (
 	S390xCPUArchitecture   = "s390x"
 	MultiCPUArchitecture   = "multi"
 
-	ExternalPlatformNameOci = "oci"
+	ExternalPlatformNameOci     = "oci"
+	ExternalPlatformNameProxmox = "proxmox"
 
 	MaxMasterHostsNeededForInstallationInHaModeOfOCP418OrNewer       = 5
 	MinMasterHostsNeededForInstallationInHaMode                      = 3
@@ -670,6 +671,10 @@ func IsOciExternalIntegrationEnabled(platform *models.Platform) bool {
 	return IsExternalIntegrationEnabled(platform, ExternalPlatformNameOci)
 }
 
+func IsProxmoxExternalIntegrationEnabled(platform *models.Platform) bool {
+	return IsExternalIntegrationEnabled(platform, ExternalPlatformNameProxmox)
+}
+
 func IsMultiNodeNonePlatformCluster(cluster *Cluster) bool {
 	return !IsSingleNodeCluster(cluster) && swag.BoolValue(cluster.UserManagedNetworking)
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -355,10 +356,14 @@ func openTestDB(dbName string) (*gorm.DB, error) {
 	)
 
 	open := func() (*gorm.DB, error) {
-		return gorm.Open(postgres.Open(dsn), &gorm.Config{
+		db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 			DisableForeignKeyConstraintWhenMigrating: true,
 			Logger:                                   newLogger,
 		})
+		if err != nil {
+			return nil, err
+		}
+		return db, db.Use(transaction.AfterCommitPlugin{})
 	}
 
 	for attempts := 0; attempts < 30; attempts++ {
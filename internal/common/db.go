@@ -43,6 +43,10 @@ type Cluster struct {
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
 	PullSecret string `json:"pull_secret" gorm:"type:TEXT"`
 
+	// The clouds.yaml with the credentials of the OpenStack cloud, it is given in the OpenStack platform
+	// settings and only used to generate the manifests of the cluster
+	OpenstackCloudsYaml string `json:"-" gorm:"type:TEXT"`
+
 	// The compute hash value of the http-proxy, https-proxy and no-proxy attributes, used internally to indicate
 	// if the proxy settings were changed while downloading ISO
 	ProxyHash string `json:"proxy_hash"`
@@ -163,6 +167,10 @@ type Host struct {
 	// Timestamp to trigger monitor. Monitor will be triggered if timestamp is recent
 	TriggerMonitorTimestamp time.Time
 
+	// Timestamp of the last change of the configuration of the host or of its cluster, the agent asks for its
+	// next steps more often after a change
+	ConfigUpdatedAt time.Time
+
 	// A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.
 	IgnitionEndpointToken string `json:"ignition_endpoint_token" gorm:"type:TEXT"`
 
@@ -312,6 +320,11 @@ func AutoMigrate(db *gorm.DB) error {
 		&models.MachineNetwork{},
 		&models.APIVip{},
 		&models.IngressVip{},
+		&NotificationOutboxEntry{},
+		&EventSubscription{},
+		&EventSubscriptionDelivery{},
+		&RoleBinding{},
+		&AuditRecord{},
 	)
 }
 
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+// EventSubscription is a webhook registered to receive the events of a cluster or an infra-env
+type EventSubscription struct {
+	models.EventSubscription
+
+	// Secret used to sign the deliveries, encrypted with the subscription secret key. It is never
+	// returned by the API.
+	Secret string `json:"-"`
+}
+
+// EventSubscriptionDelivery is an event waiting to be, or already, POSTed to the URL of an event
+// subscription. Deliveries are written in the same transaction as the event they carry.
+type EventSubscriptionDelivery struct {
+	models.EventSubscriptionDelivery
+
+	// The JSON encoded event that is POSTed to the subscription URL
+	Payload string `json:"-" gorm:"type:text"`
+
+	NextAttemptAt time.Time `json:"-"`
+}
+
+// DeleteEventSubscriptions removes the event subscriptions of the cluster or infra-env with the
+// given ID, together with their deliveries
+func DeleteEventSubscriptions(db *gorm.DB, id strfmt.UUID) error {
+	subscriptions := db.Model(&EventSubscription{}).Select("id").Where("cluster_id = ? OR infra_env_id = ?", id, id)
+	if err := db.Where("subscription_id IN (?)", subscriptions).Delete(&EventSubscriptionDelivery{}).Error; err != nil {
+		return err
+	}
+	return db.Where("cluster_id = ? OR infra_env_id = ?", id, id).Delete(&EventSubscription{}).Error
+}
@@ -6774,3 +6774,308 @@ func (e *RebootsForNodeEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event scheduled_installation_started
+//
+type ScheduledInstallationStartedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    NotBefore string
+}
+
+var ScheduledInstallationStartedEventName string = "scheduled_installation_started"
+
+func NewScheduledInstallationStartedEvent(
+    clusterId strfmt.UUID,
+    notBefore string,
+) *ScheduledInstallationStartedEvent {
+    return &ScheduledInstallationStartedEvent{
+        eventName: ScheduledInstallationStartedEventName,
+        ClusterId: clusterId,
+        NotBefore: notBefore,
+    }
+}
+
+func SendScheduledInstallationStartedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    notBefore string,) {
+    ev := NewScheduledInstallationStartedEvent(
+        clusterId,
+        notBefore,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendScheduledInstallationStartedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    notBefore string,
+    eventTime time.Time) {
+    ev := NewScheduledInstallationStartedEvent(
+        clusterId,
+        notBefore,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ScheduledInstallationStartedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ScheduledInstallationStartedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ScheduledInstallationStartedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ScheduledInstallationStartedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{not_before}", fmt.Sprint(e.NotBefore),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ScheduledInstallationStartedEvent) FormatMessage() string {
+    s := "Cluster {cluster_id}: starting the installation scheduled to start not before {not_before}"
+    return e.format(&s)
+}
+
+//
+// Event scheduled_installation_failed
+//
+type ScheduledInstallationFailedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Error string
+}
+
+var ScheduledInstallationFailedEventName string = "scheduled_installation_failed"
+
+func NewScheduledInstallationFailedEvent(
+    clusterId strfmt.UUID,
+    error string,
+) *ScheduledInstallationFailedEvent {
+    return &ScheduledInstallationFailedEvent{
+        eventName: ScheduledInstallationFailedEventName,
+        ClusterId: clusterId,
+        Error: error,
+    }
+}
+
+func SendScheduledInstallationFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,) {
+    ev := NewScheduledInstallationFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendScheduledInstallationFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,
+    eventTime time.Time) {
+    ev := NewScheduledInstallationFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ScheduledInstallationFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ScheduledInstallationFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *ScheduledInstallationFailedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ScheduledInstallationFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ScheduledInstallationFailedEvent) FormatMessage() string {
+    s := "Cluster {cluster_id}: failed to start the scheduled installation, the schedule was removed. Error: {error}"
+    return e.format(&s)
+}
+
+//
+// Event scheduled_installation_window_missed
+//
+type ScheduledInstallationWindowMissedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    NotAfter string
+}
+
+var ScheduledInstallationWindowMissedEventName string = "scheduled_installation_window_missed"
+
+func NewScheduledInstallationWindowMissedEvent(
+    clusterId strfmt.UUID,
+    notAfter string,
+) *ScheduledInstallationWindowMissedEvent {
+    return &ScheduledInstallationWindowMissedEvent{
+        eventName: ScheduledInstallationWindowMissedEventName,
+        ClusterId: clusterId,
+        NotAfter: notAfter,
+    }
+}
+
+func SendScheduledInstallationWindowMissedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    notAfter string,) {
+    ev := NewScheduledInstallationWindowMissedEvent(
+        clusterId,
+        notAfter,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendScheduledInstallationWindowMissedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    notAfter string,
+    eventTime time.Time) {
+    ev := NewScheduledInstallationWindowMissedEvent(
+        clusterId,
+        notAfter,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ScheduledInstallationWindowMissedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ScheduledInstallationWindowMissedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *ScheduledInstallationWindowMissedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ScheduledInstallationWindowMissedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{not_after}", fmt.Sprint(e.NotAfter),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ScheduledInstallationWindowMissedEvent) FormatMessage() string {
+    s := "Cluster {cluster_id}: the cluster was not ready for installation before the end of the scheduled window at {not_after}, the schedule was removed"
+    return e.format(&s)
+}
+
+// EventNames lists the names of all the events
+var EventNames = []string{
+    CancelInstallStartFailedEventName,
+    CancelInstallCommitFailedEventName,
+    HostRegistrationSettingPropertiesFailedEventName,
+    HostMediaDisconnectedEventName,
+    ClusterRegistrationSucceededEventName,
+    ClusterDeregisterFailedEventName,
+    ClusterDeregisteredEventName,
+    ClusterValidationFailedEventName,
+    ClusterValidationFixedEventName,
+    AfterInactivityClusterDeregisteredEventName,
+    ClusterInstallationCompletedEventName,
+    ClusterInstallationFailedEventName,
+    ClusterInstallationCanceledEventName,
+    CancelInstallationFailedEventName,
+    ClusterStatusUpdatedEventName,
+    ClusterFinalizingStageUpdatedEventName,
+    ClusterInstallationResetEventName,
+    ResetInstallationFailedEventName,
+    ApiIngressVipUpdatedEventName,
+    ApiIngressVipTimedOutEventName,
+    PrepareInstallationFailedEventName,
+    ClusterPrepareInstallationStartedEventName,
+    InstallationPreparingTimedOutEventName,
+    ClusterDegradedOLMOperatorsFailedEventName,
+    ExpiredImageDeletedEventName,
+    ClusterOperatorReportEventName,
+    ClusterOperatorStatusEventName,
+    FinalizingStageTimedOutEventName,
+    HostDeregisteredEventName,
+    HostInstallerArgsAppliedEventName,
+    HostBootstrapSetEventName,
+    HostStatusUpdatedEventName,
+    HostStageTimedOutEventName,
+    HostRoleUpdatedEventName,
+    ImageStatusUpdatedEventName,
+    HostInstallationCancelledEventName,
+    HostInstallationStartedEventName,
+    HostCancelInstallationFailedEventName,
+    HostInstallationResetEventName,
+    HostInstallationResetFailedEventName,
+    UserRequiredCompleteInstallationResetEventName,
+    HostSetStatusFailedEventName,
+    HostValidationFailedEventName,
+    HostValidationFixedEventName,
+    QuickDiskFormatPerformedEventName,
+    QuickDiskFormatSkippedEventName,
+    InfraEnvRegistrationFailedEventName,
+    InfraEnvRegisteredEventName,
+    InfraEnvDeregisterFailedEventName,
+    InfraEnvDeregisteredEventName,
+    GenerateImageFetchFailedEventName,
+    ExistingImageReusedEventName,
+    InstallConfigAppliedEventName,
+    ProxySettingsChangedEventName,
+    DiskSpeedSlowerThanSupportedEventName,
+    HostDiscoveryIgnitionConfigAppliedEventName,
+    HostResetFetchFailedEventName,
+    HostBootLogsUploadedEventName,
+    HostLogsUploadedEventName,
+    ClusterLogsUploadedEventName,
+    HostApprovedUpdatedEventName,
+    HostRegistrationSucceededEventName,
+    HostBindSucceededEventName,
+    HostUnbindSucceededEventName,
+    GenerateImageFormatFailedEventName,
+    GenerateMinimalIsoFailedEventName,
+    UploadImageFailedEventName,
+    IgnitionConfigImageGeneratedEventName,
+    HostInstallProgressUpdatedEventName,
+    HostRegistrationFailedEventName,
+    HostBindFailedEventName,
+    HostUnbindFailedEventName,
+    InactiveClustersDeregisteredEventName,
+    ClustersPermanentlyDeletedEventName,
+    ImageInfoUpdatedEventName,
+    UpgradeAgentStartedEventName,
+    UpgradeAgentFinishedEventName,
+    UpgradeAgentFailedEventName,
+    ValidationsIgnoredEventName,
+    RebootsForNodeEventName,
+    ScheduledInstallationStartedEventName,
+    ScheduledInstallationFailedEventName,
+    ScheduledInstallationWindowMissedEventName,
+}
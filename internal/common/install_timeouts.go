@@ -0,0 +1,105 @@
+package common
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// MaxInstallTimeout is the longest timeout that can be set for a single installation stage
+const MaxInstallTimeout = 7 * 24 * time.Hour
+
+// InstallTimeouts contains the per-cluster overrides of the installation timeouts, as stored in the
+// install_timeouts field of the cluster. The timeouts use the format of time.ParseDuration.
+type InstallTimeouts struct {
+	HostStages       map[models.HostStage]string       `json:"host_stages,omitempty"`
+	FinalizingStages map[models.FinalizingStage]string `json:"finalizing_stages,omitempty"`
+}
+
+// ParseInstallTimeouts parses and validates the install_timeouts field of a cluster. An empty value
+// means that there are no overrides.
+func ParseInstallTimeouts(value string) (*InstallTimeouts, error) {
+	timeouts := &InstallTimeouts{}
+	if value == "" {
+		return timeouts, nil
+	}
+	if err := json.Unmarshal([]byte(value), timeouts); err != nil {
+		return nil, errors.Wrap(err, "install timeouts must be a JSON object with host_stages and finalizing_stages")
+	}
+	for stage, timeout := range timeouts.HostStages {
+		if err := stage.Validate(strfmt.Default); err != nil {
+			return nil, errors.Errorf("unknown host stage '%s'", stage)
+		}
+		if stage == models.HostStageDone || stage == models.HostStageFailed {
+			return nil, errors.Errorf("host stage '%s' has no timeout", stage)
+		}
+		if err := validateInstallTimeout(timeout); err != nil {
+			return nil, errors.Wrapf(err, "invalid timeout of host stage '%s'", stage)
+		}
+	}
+	for stage, timeout := range timeouts.FinalizingStages {
+		if err := stage.Validate(strfmt.Default); err != nil {
+			return nil, errors.Errorf("unknown finalizing stage '%s'", stage)
+		}
+		if err := validateInstallTimeout(timeout); err != nil {
+			return nil, errors.Wrapf(err, "invalid timeout of finalizing stage '%s'", stage)
+		}
+	}
+	return timeouts, nil
+}
+
+func validateInstallTimeout(value string) error {
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 || timeout > MaxInstallTimeout {
+		return errors.Errorf("%s is not between 0 and %s", value, MaxInstallTimeout)
+	}
+	return nil
+}
+
+// String returns the JSON representation of the overrides, or an empty string if there are none
+func (t *InstallTimeouts) String() string {
+	if len(t.HostStages) == 0 && len(t.FinalizingStages) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(t)
+	return string(b)
+}
+
+// HostStageTimeout returns the timeout of the host stage if it is overridden
+func (t *InstallTimeouts) HostStageTimeout(stage models.HostStage) (time.Duration, bool) {
+	value, ok := t.HostStages[stage]
+	if !ok {
+		return 0, false
+	}
+	timeout, err := time.ParseDuration(value)
+	return timeout, err == nil
+}
+
+// FinalizingStageTimeout returns the timeout of the finalizing stage if it is overridden
+func (t *InstallTimeouts) FinalizingStageTimeout(stage models.FinalizingStage) (time.Duration, bool) {
+	value, ok := t.FinalizingStages[stage]
+	if !ok {
+		return 0, false
+	}
+	timeout, err := time.ParseDuration(value)
+	return timeout, err == nil
+}
+
+// GetInstallTimeouts returns the timeout overrides of the cluster. The field is validated when it is
+// updated, so invalid values are ignored rather than failing the monitor.
+func GetInstallTimeouts(cluster *Cluster) *InstallTimeouts {
+	if cluster == nil {
+		return &InstallTimeouts{}
+	}
+	timeouts, err := ParseInstallTimeouts(cluster.InstallTimeouts)
+	if err != nil {
+		return &InstallTimeouts{}
+	}
+	return timeouts
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("ParseInstallTimeouts", func() {
+	It("empty value has no overrides", func() {
+		timeouts, err := ParseInstallTimeouts("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeouts.String()).To(BeEmpty())
+		_, ok := timeouts.HostStageTimeout(models.HostStageRebooting)
+		Expect(ok).To(BeFalse())
+		_, ok = timeouts.FinalizingStageTimeout(models.FinalizingStageWaitingForClusterOperators)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("valid overrides", func() {
+		timeouts, err := ParseInstallTimeouts(`{"host_stages": {"Rebooting": "2h"}, "finalizing_stages": {"Waiting for cluster operators": "12h"}}`)
+		Expect(err).ToNot(HaveOccurred())
+		timeout, ok := timeouts.HostStageTimeout(models.HostStageRebooting)
+		Expect(ok).To(BeTrue())
+		Expect(timeout).To(Equal(2 * time.Hour))
+		_, ok = timeouts.HostStageTimeout(models.HostStageInstalling)
+		Expect(ok).To(BeFalse())
+		timeout, ok = timeouts.FinalizingStageTimeout(models.FinalizingStageWaitingForClusterOperators)
+		Expect(ok).To(BeTrue())
+		Expect(timeout).To(Equal(12 * time.Hour))
+		Expect(timeouts.String()).To(MatchJSON(`{"host_stages": {"Rebooting": "2h"}, "finalizing_stages": {"Waiting for cluster operators": "12h"}}`))
+	})
+
+	DescribeTable("invalid overrides",
+		func(value, expectedError string) {
+			_, err := ParseInstallTimeouts(value)
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+		Entry("not JSON", `Rebooting=2h`, "must be a JSON object"),
+		Entry("unknown host stage", `{"host_stages": {"Sleeping": "2h"}}`, "unknown host stage 'Sleeping'"),
+		Entry("final host stage", `{"host_stages": {"Done": "2h"}}`, "host stage 'Done' has no timeout"),
+		Entry("unknown finalizing stage", `{"finalizing_stages": {"Waiting": "2h"}}`, "unknown finalizing stage 'Waiting'"),
+		Entry("invalid duration", `{"host_stages": {"Rebooting": "2 hours"}}`, "invalid timeout of host stage 'Rebooting'"),
+		Entry("negative duration", `{"finalizing_stages": {"Waiting for cluster operators": "-1h"}}`, "is not between 0 and"),
+		Entry("too long duration", `{"finalizing_stages": {"Waiting for cluster operators": "200h"}}`, "is not between 0 and"),
+	)
+
+	It("ignores invalid values of the cluster", func() {
+		cluster := &Cluster{Cluster: models.Cluster{InstallTimeouts: `{"host_stages": {"Sleeping": "2h"}}`}}
+		Expect(GetInstallTimeouts(cluster).String()).To(BeEmpty())
+		Expect(GetInstallTimeouts(nil).String()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"time"
+)
+
+const (
+	NotificationOutboxStatusPending    = "pending"
+	NotificationOutboxStatusDeadLetter = "dead_letter"
+)
+
+// NotificationOutboxEntry is a notification stream envelope waiting to be relayed to the
+// configured stream writer. Entries are written in the same transaction as the resource
+// change they describe and are removed once delivered.
+type NotificationOutboxEntry struct {
+	ID int64 `gorm:"primaryKey;autoIncrement"`
+
+	// Ordering key of the envelope, the cluster ID when the notification belongs to a cluster.
+	// Entries sharing a key are delivered in ID order. The notifier locks the key until its
+	// transaction ends, so that their ID order is the order their transactions committed in.
+	Key string `gorm:"index:idx_notification_outbox_status_key_id,priority:2"`
+
+	// Notification type, e.g. ClusterState or Event
+	Name string
+
+	// The JSON encoded envelope
+	Envelope string `gorm:"type:text"`
+
+	Status string `gorm:"index:idx_notification_outbox_status_key_id,priority:1"`
+
+	Attempts int
+
+	NextAttemptAt time.Time
+
+	LastError string `gorm:"type:text"`
+
+	CreatedAt time.Time
+
+	UpdatedAt time.Time
+}
+
+func (NotificationOutboxEntry) TableName() string {
+	return "notification_outbox"
+}
@@ -0,0 +1,17 @@
+package common
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+// RoleBinding grants a role on a cluster or on an infra-env to a user or to the members of a group
+type RoleBinding struct {
+	models.RoleBinding
+}
+
+// DeleteRoleBindings removes the role bindings of the cluster or infra-env with the given ID
+func DeleteRoleBindings(db *gorm.DB, id strfmt.UUID) error {
+	return db.Where("cluster_id = ? OR infra_env_id = ?", id, id).Delete(&RoleBinding{}).Error
+}
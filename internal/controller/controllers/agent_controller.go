@@ -77,6 +77,8 @@ const (
 	AgentCurrentStageAnnotation          = "agent." + aiv1beta1.Group + "/current-stage"
 	AgentRoleAnnotation                  = "agent." + aiv1beta1.Group + "/role"
 	AgentValidationsInfoAnnotation       = "agent." + aiv1beta1.Group + "/validations-info"
+	AgentDiagnosticsAnnotation           = "agent." + aiv1beta1.Group + "/diagnostics"
+	AgentRunDiagnosticsAnnotation        = "agent." + aiv1beta1.Group + "/run-diagnostics"
 	AgentLabelHostManufacturer           = InventoryLabelPrefix + "host-manufacturer"
 	AgentLabelHostProductName            = InventoryLabelPrefix + "host-productname"
 	AgentLabelHostIsVirtual              = InventoryLabelPrefix + "host-isvirtual"
@@ -180,8 +182,11 @@ func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (
 		log.WithError(err).Warnf("failed to set infraEnv name label on agent %s/%s", agent.Namespace, agent.Name)
 	}
 
+	// Queue the diagnostics requested by the user, the results are reported in the diagnostics annotation
+	diagnosticsQueued := r.queueRequestedDiagnostics(ctx, log, agent, h)
+
 	// Add/Update Agent annotations
-	if updateAnnotations(log, agent, &h.Host) {
+	if updateAnnotations(log, agent, &h.Host) || diagnosticsQueued {
 		if err = r.updateAndReplaceAgent(ctx, agent); err != nil {
 			log.WithError(err).Warnf("failed to set annotations on agent %s/%s", agent.Namespace, agent.Name)
 		}
@@ -271,12 +276,42 @@ func updateAnnotations(log logrus.FieldLogger, agent *v1beta1.Agent, h *models.H
 	updated = setAgentAnnotation(log, agent, AgentRoleAnnotation, string(h.Role)) || updated
 	updated = setAgentAnnotation(log, agent, AgentInventoryAnnotation, h.Inventory) || updated
 	updated = setAgentAnnotation(log, agent, AgentValidationsInfoAnnotation, h.ValidationsInfo) || updated
+	updated = setAgentAnnotation(log, agent, AgentDiagnosticsAnnotation, h.Diagnostics) || updated
 	if h.Progress != nil {
 		updated = setAgentAnnotation(log, agent, AgentCurrentStageAnnotation, string(h.Progress.CurrentStage)) || updated
 	}
 	return updated
 }
 
+// queueRequestedDiagnostics queues the diagnostics listed in the run-diagnostics annotation of the agent and removes
+// the annotation, so that each request runs once. It returns true if the annotation was removed.
+func (r *AgentReconciler) queueRequestedDiagnostics(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, h *common.Host) bool {
+	value, ok := agent.GetAnnotations()[AgentRunDiagnosticsAnnotation]
+	if !ok {
+		return false
+	}
+	delete(agent.Annotations, AgentRunDiagnosticsAnnotation)
+
+	var requests []*models.HostDiagnosticCreateParams
+	if err := json.Unmarshal([]byte(value), &requests); err != nil {
+		log.WithError(err).Errorf("failed to parse annotation %s of agent %s/%s", AgentRunDiagnosticsAnnotation, agent.Namespace, agent.Name)
+		return true
+	}
+	for _, request := range requests {
+		diagnostic, err := r.Installer.V2CreateHostDiagnosticInternal(ctx, installer.V2CreateHostDiagnosticParams{
+			HostID:                 *h.ID,
+			InfraEnvID:             h.InfraEnvID,
+			DiagnosticCreateParams: request,
+		})
+		if err != nil {
+			log.WithError(err).Errorf("failed to queue diagnostic for agent %s/%s", agent.Namespace, agent.Name)
+			continue
+		}
+		log.Infof("Queued %s diagnostic %s for agent %s/%s", *diagnostic.Type, diagnostic.ID.String(), agent.Namespace, agent.Name)
+	}
+	return true
+}
+
 // shouldCleanUnboundSpokeNode returns true if the agent has deprovision info set and the host is not in the process of unbinding
 func shouldCleanUnboundSpokeNode(agent *aiv1beta1.Agent, host *common.Host) bool {
 	if host.Status == nil {
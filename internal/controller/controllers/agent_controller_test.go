@@ -2246,6 +2246,89 @@ var _ = Describe("agent reconcile", func() {
 		// Ensure 'state' annotation is updated
 		Expect(updatedAgent.ObjectMeta.Annotations[AgentStateAnnotation]).To(Equal(models.HostStatusInstalled))
 	})
+
+	It("queues the diagnostics of the 'run-diagnostics' annotation", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		infraEnvId := strfmt.UUID(uuid.New().String())
+		infraEnvName := "infraEnvName"
+		diagnostics := `[{"id":"` + uuid.New().String() + `","type":"disk-performance","status":"pending"}]`
+		commonHost := &common.Host{
+			Host: models.Host{
+				ID:          &hostID,
+				InfraEnvID:  infraEnvId,
+				Status:      swag.String(models.HostStatusKnown),
+				Diagnostics: diagnostics,
+			},
+		}
+		mockInstallerInternal.EXPECT().GetHostByKubeKey(gomock.Any()).Return(commonHost, nil).AnyTimes()
+		allowGetInfraEnvInternal(mockInstallerInternal, infraEnvId, infraEnvName)
+
+		diskPerformance := models.HostDiagnosticTypeDiskPerformance
+		domainResolution := models.HostDiagnosticTypeDomainResolution
+		diagnosticID := strfmt.UUID(uuid.New().String())
+		mockInstallerInternal.EXPECT().V2CreateHostDiagnosticInternal(gomock.Any(), installer.V2CreateHostDiagnosticParams{
+			HostID:                 hostID,
+			InfraEnvID:             infraEnvId,
+			DiagnosticCreateParams: &models.HostDiagnosticCreateParams{Type: &diskPerformance},
+		}).Return(&models.HostDiagnostic{ID: &diagnosticID, Type: &diskPerformance}, nil).Times(1)
+		mockInstallerInternal.EXPECT().V2CreateHostDiagnosticInternal(gomock.Any(), installer.V2CreateHostDiagnosticParams{
+			HostID:                 hostID,
+			InfraEnvID:             infraEnvId,
+			DiagnosticCreateParams: &models.HostDiagnosticCreateParams{Type: &domainResolution},
+		}).Return(nil, common.NewApiError(http.StatusBadRequest, errors.New("At least one domain is required"))).Times(1)
+
+		agent := newAgent(hostID.String(), testNamespace, v1beta1.AgentSpec{})
+		agent.ObjectMeta.Annotations = map[string]string{
+			AgentRunDiagnosticsAnnotation: `[{"type":"disk-performance"},{"type":"domain-resolution"}]`,
+		}
+		Expect(c.Create(ctx, agent)).To(Succeed())
+
+		result, err := hr.Reconcile(ctx, newHostRequest(agent))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		updatedAgent := &v1beta1.Agent{}
+		key := types.NamespacedName{
+			Namespace: testNamespace,
+			Name:      hostID.String(),
+		}
+		Expect(c.Get(ctx, key, updatedAgent)).To(Succeed())
+		Expect(updatedAgent.ObjectMeta.Annotations).ToNot(HaveKey(AgentRunDiagnosticsAnnotation))
+		Expect(updatedAgent.ObjectMeta.Annotations[AgentDiagnosticsAnnotation]).To(Equal(diagnostics))
+	})
+
+	It("removes an invalid 'run-diagnostics' annotation", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		infraEnvId := strfmt.UUID(uuid.New().String())
+		infraEnvName := "infraEnvName"
+		commonHost := &common.Host{
+			Host: models.Host{
+				ID:         &hostID,
+				InfraEnvID: infraEnvId,
+				Status:     swag.String(models.HostStatusKnown),
+			},
+		}
+		mockInstallerInternal.EXPECT().GetHostByKubeKey(gomock.Any()).Return(commonHost, nil).AnyTimes()
+		allowGetInfraEnvInternal(mockInstallerInternal, infraEnvId, infraEnvName)
+
+		agent := newAgent(hostID.String(), testNamespace, v1beta1.AgentSpec{})
+		agent.ObjectMeta.Annotations = map[string]string{
+			AgentRunDiagnosticsAnnotation: "disk-performance",
+		}
+		Expect(c.Create(ctx, agent)).To(Succeed())
+
+		result, err := hr.Reconcile(ctx, newHostRequest(agent))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		updatedAgent := &v1beta1.Agent{}
+		key := types.NamespacedName{
+			Namespace: testNamespace,
+			Name:      hostID.String(),
+		}
+		Expect(c.Get(ctx, key, updatedAgent)).To(Succeed())
+		Expect(updatedAgent.ObjectMeta.Annotations).ToNot(HaveKey(AgentRunDiagnosticsAnnotation))
+	})
 })
 
 type notFoundError struct{}
@@ -1056,9 +1056,91 @@ func (r *ClusterDeploymentsReconciler) updateNetworkParams(clusterDeployment *hi
 		params.LoadBalancer = &models.LoadBalancer{Type: mapLoadBalancerTypes[clusterInstall.Spec.LoadBalancer.Type]}
 	}
 
+	if clusterInstall.Spec.InstallTimeouts != nil {
+		installTimeouts := installTimeoutsFromSpec(clusterInstall.Spec.InstallTimeouts)
+		if installTimeouts != cluster.InstallTimeouts {
+			params.InstallTimeouts = swag.String(installTimeouts)
+			update = true
+		}
+	}
+
+	if clusterInstall.Spec.OLMOperators != nil {
+		olmOperators := olmOperatorsFromSpec(clusterInstall.Spec.OLMOperators)
+		if !olmOperatorsMatch(olmOperators, cluster.MonitoredOperators) {
+			params.OlmOperators = olmOperators
+			update = true
+		}
+	}
+
 	return swag.Bool(update), nil
 }
 
+// olmOperatorsFromSpec converts the OLM operators of the AgentClusterInstall to the parameters used to create or
+// update the cluster. The operators and their install options are validated by the service.
+func olmOperatorsFromSpec(specOperators []hiveext.OLMOperator) []*models.OperatorCreateParams {
+	olmOperators := make([]*models.OperatorCreateParams, 0, len(specOperators))
+	for _, operator := range specOperators {
+		olmOperators = append(olmOperators, &models.OperatorCreateParams{
+			Name:                operator.Name,
+			Properties:          operator.Properties,
+			Channel:             operator.Channel,
+			StartingCsv:         operator.StartingCSV,
+			CatalogSource:       operator.CatalogSource,
+			CatalogSourceImage:  operator.CatalogSourceImage,
+			InstallPlanApproval: models.OperatorInstallPlanApproval(operator.InstallPlanApproval),
+		})
+	}
+	return olmOperators
+}
+
+// olmOperatorsMatch checks if the OLM operators explicitly requested for the cluster, ignoring the ones that were
+// added as dependencies, are the given ones with the same properties and install options.
+func olmOperatorsMatch(olmOperators []*models.OperatorCreateParams, monitoredOperators []*models.MonitoredOperator) bool {
+	requested := map[string]models.OperatorCreateParams{}
+	for _, operator := range monitoredOperators {
+		if operator.OperatorType != models.OperatorTypeOlm || operator.DependencyOnly {
+			continue
+		}
+		requested[operator.Name] = models.OperatorCreateParams{
+			Name:                operator.Name,
+			Properties:          operator.Properties,
+			Channel:             operator.Channel,
+			StartingCsv:         operator.StartingCsv,
+			CatalogSource:       operator.CatalogSource,
+			CatalogSourceImage:  operator.CatalogSourceImage,
+			InstallPlanApproval: operator.InstallPlanApproval,
+		}
+	}
+	if len(requested) != len(olmOperators) {
+		return false
+	}
+	for _, operator := range olmOperators {
+		if current, ok := requested[operator.Name]; !ok || current != *operator {
+			return false
+		}
+	}
+	return true
+}
+
+// installTimeoutsFromSpec converts the install timeouts of the AgentClusterInstall to the format of
+// the install_timeouts field of the cluster. The stages are validated by the update of the cluster.
+func installTimeoutsFromSpec(specTimeouts *hiveext.InstallTimeouts) string {
+	installTimeouts := &common.InstallTimeouts{}
+	if len(specTimeouts.HostStages) > 0 {
+		installTimeouts.HostStages = make(map[models.HostStage]string, len(specTimeouts.HostStages))
+		for stage, timeout := range specTimeouts.HostStages {
+			installTimeouts.HostStages[models.HostStage(stage)] = timeout.Duration.String()
+		}
+	}
+	if len(specTimeouts.FinalizingStages) > 0 {
+		installTimeouts.FinalizingStages = make(map[models.FinalizingStage]string, len(specTimeouts.FinalizingStages))
+		for stage, timeout := range specTimeouts.FinalizingStages {
+			installTimeouts.FinalizingStages[models.FinalizingStage(stage)] = timeout.Duration.String()
+		}
+	}
+	return installTimeouts.String()
+}
+
 func shouldUpdateLoadBalancer(
 	clusterInstall *hiveext.AgentClusterInstall,
 	cluster *common.Cluster,
@@ -1496,7 +1578,7 @@ func (r *ClusterDeploymentsReconciler) createNewCluster(
 	}
 
 	clusterParams := CreateClusterParams(clusterDeployment, clusterInstall, pullSecret, *releaseImage.Version,
-		*releaseImage.CPUArchitecture, ignitionEndpoint, nil)
+		*releaseImage.CPUArchitecture, ignitionEndpoint, olmOperatorsFromSpec(clusterInstall.Spec.OLMOperators))
 
 	c, err := r.Installer.RegisterClusterInternal(ctx, &key, mirrorRegistryConfiguration, installer.V2RegisterClusterParams{
 		NewClusterParams: clusterParams,
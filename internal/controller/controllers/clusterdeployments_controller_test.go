@@ -4026,6 +4026,110 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterRequirementsMetCondition).Message).To(Equal(hiveext.ClusterNotReadyMsg))
 			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterRequirementsMetCondition).Status).To(Equal(corev1.ConditionFalse))
 		})
+
+		It("update install timeouts", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:               &sId,
+					Name:             "different-cluster-name",
+					OpenshiftVersion: "4.8",
+					Status:           swag.String(models.ClusterStatusPendingForInput),
+					InstallTimeouts:  `{"host_stages":{"Rebooting":"1h0m0s"}}`,
+				},
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			mockVersions.EXPECT().GetReleaseImageByURL(gomock.Any(), gomock.Any(), gomock.Any()).Return(releaseImage, nil)
+
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:         &sId,
+					Status:     swag.String(models.ClusterStatusInsufficient),
+					StatusInfo: swag.String(models.ClusterStatusInsufficient),
+				},
+			}
+
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) {
+					Expect(swag.StringValue(param.ClusterUpdateParams.InstallTimeouts)).To(MatchJSON(
+						`{"host_stages":{"Rebooting":"2h0m0s"},"finalizing_stages":{"Waiting for cluster operators":"12h0m0s"}}`))
+				}).Return(updateReply, nil)
+
+			aci.Spec.InstallTimeouts = &hiveext.InstallTimeouts{
+				HostStages:       map[string]metav1.Duration{string(models.HostStageRebooting): {Duration: 2 * time.Hour}},
+				FinalizingStages: map[string]metav1.Duration{string(models.FinalizingStageWaitingForClusterOperators): {Duration: 12 * time.Hour}},
+			}
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			aci = getTestClusterInstall()
+			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterSpecSyncedCondition).Reason).To(Equal(hiveext.ClusterSyncedOkReason))
+		})
+
+		It("update OLM operators install options", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:               &sId,
+					Name:             "different-cluster-name",
+					OpenshiftVersion: "4.8",
+					Status:           swag.String(models.ClusterStatusPendingForInput),
+					MonitoredOperators: []*models.MonitoredOperator{
+						{
+							Name:         "lso",
+							OperatorType: models.OperatorTypeOlm,
+							Channel:      "stable",
+						},
+					},
+				},
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			mockVersions.EXPECT().GetReleaseImageByURL(gomock.Any(), gomock.Any(), gomock.Any()).Return(releaseImage, nil)
+
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:         &sId,
+					Status:     swag.String(models.ClusterStatusInsufficient),
+					StatusInfo: swag.String(models.ClusterStatusInsufficient),
+				},
+			}
+
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) {
+					Expect(param.ClusterUpdateParams.OlmOperators).To(HaveLen(1))
+					operator := param.ClusterUpdateParams.OlmOperators[0]
+					Expect(operator.Name).To(Equal("lso"))
+					Expect(operator.Channel).To(Equal("stable-4.8"))
+					Expect(operator.CatalogSource).To(Equal("my-catalog"))
+					Expect(operator.CatalogSourceImage).To(Equal("quay.io/example/catalog:latest"))
+					Expect(operator.InstallPlanApproval).To(Equal(models.OperatorInstallPlanApprovalAutomatic))
+				}).Return(updateReply, nil)
+
+			aci.Spec.OLMOperators = []hiveext.OLMOperator{
+				{
+					Name:                "lso",
+					Channel:             "stable-4.8",
+					CatalogSource:       "my-catalog",
+					CatalogSourceImage:  "quay.io/example/catalog:latest",
+					InstallPlanApproval: "Automatic",
+				},
+			}
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			aci = getTestClusterInstall()
+			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterSpecSyncedCondition).Reason).To(Equal(hiveext.ClusterSyncedOkReason))
+		})
 	})
 
 	Context("cluster update not needed", func() {
@@ -8,6 +8,7 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -56,6 +57,26 @@ func (c *controllerEventsWrapper) V2GetEvents(ctx context.Context, params *commo
 	)
 }
 
+func (c *controllerEventsWrapper) RegisterSubscription(ctx context.Context, params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error) {
+	return c.events.RegisterSubscription(ctx, params)
+}
+
+func (c *controllerEventsWrapper) ListSubscriptions(ctx context.Context, clusterID *strfmt.UUID, infraEnvID *strfmt.UUID) ([]*common.EventSubscription, error) {
+	return c.events.ListSubscriptions(ctx, clusterID, infraEnvID)
+}
+
+func (c *controllerEventsWrapper) GetSubscription(ctx context.Context, subscriptionID strfmt.UUID) (*common.EventSubscription, error) {
+	return c.events.GetSubscription(ctx, subscriptionID)
+}
+
+func (c *controllerEventsWrapper) DeregisterSubscription(ctx context.Context, subscriptionID strfmt.UUID) error {
+	return c.events.DeregisterSubscription(ctx, subscriptionID)
+}
+
+func (c *controllerEventsWrapper) ListSubscriptionDeliveries(ctx context.Context, subscriptionID strfmt.UUID, status *string, limit *int64) ([]*common.EventSubscriptionDelivery, error) {
+	return c.events.ListSubscriptionDeliveries(ctx, subscriptionID, status, limit)
+}
+
 func (c *controllerEventsWrapper) SendClusterEvent(ctx context.Context, event eventsapi.ClusterEvent) {
 	c.events.SendClusterEvent(ctx, event)
 
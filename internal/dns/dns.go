@@ -2,13 +2,16 @@ package dns
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
 
 	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/validations"
 	"github.com/pkg/errors"
@@ -49,7 +52,69 @@ type DNSProviderFactory interface {
 }
 
 type defaultDNSProviderFactory struct {
-	log logrus.FieldLogger
+	log       logrus.FieldLogger
+	providers map[string]*ProviderConfig
+}
+
+// ProviderConfig holds the connection settings of a base domain whose records are managed by a DNS
+// provider other than Route53
+type ProviderConfig struct {
+	RFC2136  *RFC2136Config  `json:"rfc2136,omitempty"`
+	PowerDNS *PowerDNSConfig `json:"powerdns,omitempty"`
+}
+
+// ParseProvidersConfig parses the JSON encoded connection settings of the DNS providers, keyed by
+// base domain, checks that every base domain of the rfc2136 and powerdns providers has them and
+// reads their secrets from the referenced files.
+func ParseProvidersConfig(providersConfig string, baseDNSDomains map[string]string) (map[string]*ProviderConfig, error) {
+	providers := make(map[string]*ProviderConfig)
+	if providersConfig != "" {
+		if err := json.Unmarshal([]byte(providersConfig), &providers); err != nil {
+			return nil, errors.Wrap(err, "failed to parse DNS providers configuration")
+		}
+	}
+	for baseDNSDomainName, val := range baseDNSDomains {
+		s := strings.SplitN(val, "/", 2)
+		if len(s) != 2 {
+			continue
+		}
+		config := providers[baseDNSDomainName]
+		var err error
+		switch s[1] {
+		case models.ManagedDomainProviderRfc2136:
+			if config == nil || config.RFC2136 == nil {
+				err = errors.New("rfc2136 configuration is missing")
+			} else {
+				err = config.RFC2136.load()
+			}
+		case models.ManagedDomainProviderPowerdns:
+			if config == nil || config.PowerDNS == nil {
+				err = errors.New("powerdns configuration is missing")
+			} else {
+				err = config.PowerDNS.load()
+			}
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid DNS provider configuration for base domain %s", baseDNSDomainName)
+		}
+	}
+	return providers, nil
+}
+
+// readSecretFile returns the content of the file, without surrounding white space
+func readSecretFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read secret file %s", path)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func NewDNSProviderFactory(providers map[string]*ProviderConfig, log logrus.FieldLogger) DNSProviderFactory {
+	return &defaultDNSProviderFactory{
+		log:       log,
+		providers: providers,
+	}
 }
 
 type handler struct {
@@ -59,7 +124,7 @@ type handler struct {
 }
 
 func NewDNSHandler(baseDNSDomains map[string]string, log logrus.FieldLogger) DNSApi {
-	return NewDNSHandlerWithProviders(baseDNSDomains, log, &defaultDNSProviderFactory{log})
+	return NewDNSHandlerWithProviders(baseDNSDomains, log, &defaultDNSProviderFactory{log: log})
 }
 
 func NewDNSHandlerWithProviders(baseDNSDomains map[string]string, log logrus.FieldLogger, providers DNSProviderFactory) DNSApi {
@@ -263,29 +328,44 @@ func (h *handler) checkDNSRecordsExists(names []string, domain *DNSDomain, recor
 }
 
 func (f *defaultDNSProviderFactory) GetProviderByRecordType(domain *DNSDomain, recordType string) dnsproviders.Provider {
+	recordSet := dnsproviders.RecordSet{
+		RecordSetType: recordType,
+		TTL:           60,
+	}
 	switch domain.Provider {
-	case "route53":
+	case models.ManagedDomainProviderRoute53:
 		return dnsproviders.Route53{
-			RecordSet: dnsproviders.RecordSet{
-				RecordSetType: recordType,
-				TTL:           60,
-			},
+			RecordSet:    recordSet,
 			HostedZoneID: domain.ID,
 			SharedCreds:  true,
 		}
+	case models.ManagedDomainProviderRfc2136:
+		if config := f.providers[domain.Name]; config != nil && config.RFC2136 != nil {
+			return RFC2136{
+				RecordSet: recordSet,
+				Zone:      domain.ID,
+				Config:    config.RFC2136,
+			}
+		}
+	case models.ManagedDomainProviderPowerdns:
+		if config := f.providers[domain.Name]; config != nil && config.PowerDNS != nil {
+			return PowerDNS{
+				RecordSet: recordSet,
+				ZoneID:    domain.ID,
+				Config:    config.PowerDNS,
+			}
+		}
 	}
 	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
 	return nil
 }
 
 func (f *defaultDNSProviderFactory) GetProvider(domain *DNSDomain) dnsproviders.Provider {
-	switch domain.Provider {
-	case "route53":
+	if domain.Provider == models.ManagedDomainProviderRoute53 {
 		return dnsproviders.Route53{
 			HostedZoneID: domain.ID,
 			SharedCreds:  true,
 		}
 	}
-	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
-	return nil
+	return f.GetProviderByRecordType(domain, "")
 }
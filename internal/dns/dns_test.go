@@ -3,6 +3,8 @@ package dns
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
@@ -297,7 +299,7 @@ var _ = Describe("Default DNS provider tests", func() {
 		domain = &DNSDomain{
 			Provider: "route53",
 		}
-		providers = &defaultDNSProviderFactory{logrus.New()}
+		providers = &defaultDNSProviderFactory{log: logrus.New()}
 	})
 
 	It("default provider is used when no provider factory specified", func() {
@@ -322,6 +324,88 @@ var _ = Describe("Default DNS provider tests", func() {
 		Expect(ok).To(BeTrue())
 		Expect(r53.RecordSet.RecordSetType).To(Equal("A"))
 	})
+	It("rfc2136 provider of a configured base domain", func() {
+		config := &RFC2136Config{Server: "192.0.2.53"}
+		providers = NewDNSProviderFactory(map[string]*ProviderConfig{"example.com": {RFC2136: config}}, logrus.New())
+		p := providers.GetProviderByRecordType(&DNSDomain{Name: "example.com", ID: "example.com", Provider: "rfc2136"}, "A")
+		rfc2136, ok := p.(RFC2136)
+		Expect(ok).To(BeTrue())
+		Expect(rfc2136.Zone).To(Equal("example.com"))
+		Expect(rfc2136.Config).To(Equal(config))
+		Expect(rfc2136.RecordSet.RecordSetType).To(Equal("A"))
+	})
+	It("powerdns provider of a configured base domain", func() {
+		config := &PowerDNSConfig{URL: "http://pdns.example.com:8081", APIKey: "key"}
+		providers = NewDNSProviderFactory(map[string]*ProviderConfig{"example.com": {PowerDNS: config}}, logrus.New())
+		p := providers.GetProvider(&DNSDomain{Name: "example.com", ID: "example.com.", Provider: "powerdns"})
+		powerDNS, ok := p.(PowerDNS)
+		Expect(ok).To(BeTrue())
+		Expect(powerDNS.ZoneID).To(Equal("example.com."))
+		Expect(powerDNS.Config).To(Equal(config))
+	})
+	It("return nil when the base domain is not configured", func() {
+		p := providers.GetProviderByRecordType(&DNSDomain{Name: "example.com", Provider: "rfc2136"}, "A")
+		Expect(p).To(BeNil())
+	})
+})
+
+var _ = Describe("DNS providers configuration", func() {
+	var secretsDir string
+
+	BeforeEach(func() {
+		var err error
+		secretsDir, err = os.MkdirTemp("", "dns-secrets")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(secretsDir, "tsig-secret"), []byte("c2VjcmV0\n"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(secretsDir, "api-key"), []byte("key\n"), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(secretsDir)
+	})
+
+	It("accepts an empty configuration without rfc2136 and powerdns domains", func() {
+		providers, err := ParseProvidersConfig("", map[string]string{"example.com": "abc/route53"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(providers).To(BeEmpty())
+	})
+	It("parses the configuration of every base domain", func() {
+		providers, err := ParseProvidersConfig(fmt.Sprintf(`{
+			"bind.example.com": {"rfc2136": {"server": "192.0.2.53:5353", "tsig_key_name": "assisted", "tsig_secret_file": "%s"}},
+			"pdns.example.com": {"powerdns": {"url": "http://pdns.example.com:8081", "api_key_file": "%s"}}
+		}`, filepath.Join(secretsDir, "tsig-secret"), filepath.Join(secretsDir, "api-key")), map[string]string{
+			"bind.example.com": "bind.example.com/rfc2136",
+			"pdns.example.com": "pdns.example.com./powerdns",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(providers["bind.example.com"].RFC2136.Server).To(Equal("192.0.2.53:5353"))
+		Expect(providers["bind.example.com"].RFC2136.TSIGSecret).To(Equal("c2VjcmV0"))
+		Expect(providers["pdns.example.com"].PowerDNS.APIKey).To(Equal("key"))
+	})
+	It("ignores secrets set directly in the configuration", func() {
+		_, err := ParseProvidersConfig(`{"pdns.example.com": {"powerdns": {"url": "http://pdns.example.com:8081", "api_key": "key"}}}`,
+			map[string]string{"pdns.example.com": "pdns.example.com./powerdns"})
+		Expect(err).To(MatchError(ContainSubstring("API key file is required")))
+	})
+	It("fails when a secret file does not exist", func() {
+		_, err := ParseProvidersConfig(fmt.Sprintf(`{"bind.example.com": {"rfc2136": {"server": "192.0.2.53", "tsig_key_name": "assisted", "tsig_secret_file": "%s"}}}`,
+			filepath.Join(secretsDir, "missing")), map[string]string{"bind.example.com": "bind.example.com/rfc2136"})
+		Expect(err).To(MatchError(ContainSubstring("failed to read secret file")))
+	})
+	It("fails when a base domain is missing its configuration", func() {
+		_, err := ParseProvidersConfig("", map[string]string{"bind.example.com": "bind.example.com/rfc2136"})
+		Expect(err).To(MatchError(ContainSubstring("bind.example.com")))
+	})
+	It("fails on an unsupported TSIG algorithm", func() {
+		_, err := ParseProvidersConfig(`{"bind.example.com": {"rfc2136": {"server": "192.0.2.53", "tsig_key_name": "assisted", "tsig_algorithm": "hmac-md5", "tsig_secret_file": "/dev/null"}}}`,
+			map[string]string{"bind.example.com": "bind.example.com/rfc2136"})
+		Expect(err).To(MatchError(ContainSubstring("unsupported TSIG algorithm")))
+	})
+	It("fails on an invalid powerdns URL", func() {
+		_, err := ParseProvidersConfig(`{"pdns.example.com": {"powerdns": {"url": "pdns:8081", "api_key_file": "/dev/null"}}}`,
+			map[string]string{"pdns.example.com": "pdns.example.com./powerdns"})
+		Expect(err).To(HaveOccurred())
+	})
 })
 
 var _ = Describe("Base DNS domain validation", func() {
@@ -0,0 +1,216 @@
+package dns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/pkg/errors"
+)
+
+const (
+	powerDNSDefaultServerID = "localhost"
+	powerDNSTimeout         = 10 * time.Second
+	powerDNSAPIKeyHeader    = "X-API-Key"
+)
+
+var powerDNSClient = &http.Client{Timeout: powerDNSTimeout}
+
+// PowerDNSConfig holds the connection settings of the HTTP API of a PowerDNS authoritative server
+type PowerDNSConfig struct {
+	// Base URL of the API, e.g. http://pdns.example.com:8081
+	URL string `json:"url"`
+
+	// File, usually mounted from a secret, that holds the API key
+	APIKeyFile string `json:"api_key_file"`
+
+	// Defaults to localhost, the only server ID that PowerDNS uses
+	ServerID string `json:"server_id,omitempty"`
+
+	// The API key, read from APIKeyFile
+	APIKey string `json:"-"`
+}
+
+// load reads the API key from its file and validates the configuration
+func (c *PowerDNSConfig) load() error {
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid URL %q", c.URL)
+	}
+	if c.APIKeyFile == "" {
+		return errors.New("API key file is required")
+	}
+	if c.APIKey, err = readSecretFile(c.APIKeyFile); err != nil {
+		return err
+	}
+	if c.APIKey == "" {
+		return errors.New("API key is required")
+	}
+	return nil
+}
+
+// PowerDNS manages the records of a zone through the PowerDNS HTTP API,
+// see https://doc.powerdns.com/authoritative/http-api/zone.html
+type PowerDNS struct {
+	RecordSet dnsproviders.RecordSet
+	ZoneID    string
+	Config    *PowerDNSConfig
+}
+
+type powerDNSRecord struct {
+	Content  string `json:"content"`
+	Disabled bool   `json:"disabled"`
+}
+
+type powerDNSRRSet struct {
+	Name       string           `json:"name"`
+	Type       string           `json:"type"`
+	TTL        int64            `json:"ttl,omitempty"`
+	ChangeType string           `json:"changetype,omitempty"`
+	Records    []powerDNSRecord `json:"records"`
+}
+
+type powerDNSZone struct {
+	Name   string          `json:"name"`
+	RRSets []powerDNSRRSet `json:"rrsets"`
+}
+
+// CreateRecordSet creates the record set, or replaces its records if it already exists
+func (p PowerDNS) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return p.UpdateRecordSet(recordSetName, recordSetValue)
+}
+
+func (p PowerDNS) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return p.patch(powerDNSRRSet{
+		Name:       canonicalName(recordSetName),
+		Type:       p.RecordSet.RecordSetType,
+		TTL:        p.RecordSet.TTL,
+		ChangeType: "REPLACE",
+		Records:    []powerDNSRecord{{Content: recordSetValue}},
+	})
+}
+
+// DeleteRecordSet deletes the record set. The clusters own their record sets, so the value is not
+// checked.
+func (p PowerDNS) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return p.patch(powerDNSRRSet{
+		Name:       canonicalName(recordSetName),
+		Type:       p.RecordSet.RecordSetType,
+		ChangeType: "DELETE",
+		Records:    []powerDNSRecord{},
+	})
+}
+
+// GetRecordSet returns the comma separated values of the record set, or an empty string when it
+// does not exist
+func (p PowerDNS) GetRecordSet(recordSetName string) (string, error) {
+	name := canonicalName(recordSetName)
+	query := url.Values{}
+	query.Set("rrsets", "true")
+	query.Set("rrset_name", name)
+	query.Set("rrset_type", p.RecordSet.RecordSetType)
+	zone, err := p.getZone(query)
+	if err != nil {
+		return "", err
+	}
+	var values []string
+	for _, rrset := range zone.RRSets {
+		if !strings.EqualFold(rrset.Name, name) || rrset.Type != p.RecordSet.RecordSetType {
+			continue
+		}
+		for _, record := range rrset.Records {
+			values = append(values, record.Content)
+		}
+	}
+	return strings.Join(values, ","), nil
+}
+
+func (p PowerDNS) GetDomainName() (string, error) {
+	query := url.Values{}
+	query.Set("rrsets", "false")
+	zone, err := p.getZone(query)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(zone.Name, "."), nil
+}
+
+func (p PowerDNS) zoneURL() string {
+	serverID := p.Config.ServerID
+	if serverID == "" {
+		serverID = powerDNSDefaultServerID
+	}
+	return fmt.Sprintf("%s/api/v1/servers/%s/zones/%s", strings.TrimSuffix(p.Config.URL, "/"),
+		url.PathEscape(serverID), url.PathEscape(canonicalName(p.ZoneID)))
+}
+
+func (p PowerDNS) patch(rrset powerDNSRRSet) (string, error) {
+	body, err := json.Marshal(powerDNSZone{RRSets: []powerDNSRRSet{rrset}})
+	if err != nil {
+		return "", err
+	}
+	resp, err := p.do(http.MethodPatch, p.zoneURL(), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if err = powerDNSError(resp); err != nil {
+		return "", errors.Wrapf(err, "failed to %s record set %s of type %s", strings.ToLower(rrset.ChangeType), rrset.Name, rrset.Type)
+	}
+	return resp.Status, nil
+}
+
+func (p PowerDNS) getZone(query url.Values) (*powerDNSZone, error) {
+	resp, err := p.do(http.MethodGet, p.zoneURL()+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err = powerDNSError(resp); err != nil {
+		return nil, errors.Wrapf(err, "failed to get zone %s", p.ZoneID)
+	}
+	var zone powerDNSZone
+	if err = json.NewDecoder(resp.Body).Decode(&zone); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode zone %s", p.ZoneID)
+	}
+	return &zone, nil
+}
+
+func (p PowerDNS) do(method, reqURL string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(powerDNSAPIKeyHeader, p.Config.APIKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return powerDNSClient.Do(req)
+}
+
+func powerDNSError(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	var apiError struct {
+		Error string `json:"error"`
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if json.Unmarshal(body, &apiError) == nil && apiError.Error != "" {
+		return errors.Errorf("PowerDNS responded with status %d: %s", resp.StatusCode, apiError.Error)
+	}
+	return errors.Errorf("PowerDNS responded with status %d", resp.StatusCode)
+}
+
+func canonicalName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
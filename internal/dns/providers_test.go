@@ -0,0 +1,454 @@
+package dns
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// fakeDNSServer is an authoritative server for a single zone that accepts dynamic updates over TCP.
+// When a key is set, only requests with a valid TSIG record are accepted and the responses are
+// signed with responseSecret, unless unsignedResponses is set.
+type fakeDNSServer struct {
+	listener          net.Listener
+	zone              string
+	keyName           string
+	secret            []byte
+	responseSecret    []byte
+	unsignedResponses bool
+
+	lock    sync.Mutex
+	records map[string][]net.IP
+}
+
+func newFakeDNSServer(zone, keyName string, secret []byte) *fakeDNSServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	s := &fakeDNSServer{
+		listener:       listener,
+		zone:           zone,
+		keyName:        keyName,
+		secret:         secret,
+		responseSecret: secret,
+		records:        make(map[string][]net.IP),
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeDNSServer) key(name dnsmessage.Name, recordType dnsmessage.Type) string {
+	return strings.ToLower(name.String()) + "/" + recordType.String()
+}
+
+func (s *fakeDNSServer) handle(conn net.Conn) {
+	defer conn.Close()
+	length := make([]byte, 2)
+	if _, err := io.ReadFull(conn, length); err != nil {
+		return
+	}
+	msg := make([]byte, binary.BigEndian.Uint16(length))
+	if _, err := io.ReadFull(conn, msg); err != nil {
+		return
+	}
+	resp := s.respond(msg)
+	_, _ = conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(resp))), resp...))
+}
+
+func (s *fakeDNSServer) respond(msg []byte) []byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var p dnsmessage.Parser
+	header, err := p.Start(msg)
+	Expect(err).ToNot(HaveOccurred())
+	question, err := p.Question()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(p.SkipAllQuestions()).To(Succeed())
+	Expect(p.SkipAllAnswers()).To(Succeed())
+
+	respHeader := dnsmessage.Header{ID: header.ID, Response: true, OpCode: header.OpCode, Authoritative: true}
+	var answers []net.IP
+	requestMAC, verified := s.verify(msg)
+	switch {
+	case !verified:
+		respHeader.RCode = dnsmessage.RCode(9) // NOTAUTH
+	case header.OpCode == rfc2136OpCodeUpdate:
+		for {
+			h, err := p.AuthorityHeader()
+			if err == dnsmessage.ErrSectionDone {
+				break
+			}
+			Expect(err).ToNot(HaveOccurred())
+			r, err := p.UnknownResource()
+			Expect(err).ToNot(HaveOccurred())
+			key := s.key(h.Name, h.Type)
+			switch h.Class {
+			case dnsmessage.ClassINET:
+				s.records[key] = append(s.records[key], net.IP(r.Data))
+			case dnsmessage.ClassANY:
+				delete(s.records, key)
+			case rfc2136ClassNone:
+				var kept []net.IP
+				for _, ip := range s.records[key] {
+					if !ip.Equal(net.IP(r.Data)) {
+						kept = append(kept, ip)
+					}
+				}
+				s.records[key] = kept
+			}
+		}
+	case question.Type == dnsmessage.TypeSOA:
+		if !strings.EqualFold(question.Name.String(), s.zone) {
+			respHeader.RCode = dnsmessage.RCodeRefused
+		}
+	default:
+		answers = s.records[s.key(question.Name, question.Type)]
+		if len(answers) == 0 {
+			respHeader.RCode = dnsmessage.RCodeNameError
+		}
+	}
+
+	b := dnsmessage.NewBuilder(nil, respHeader)
+	Expect(b.StartQuestions()).To(Succeed())
+	Expect(b.Question(question)).To(Succeed())
+	Expect(b.StartAnswers()).To(Succeed())
+	rh := dnsmessage.ResourceHeader{Name: question.Name, Class: dnsmessage.ClassINET, TTL: 60}
+	if question.Type == dnsmessage.TypeSOA && respHeader.RCode == dnsmessage.RCodeSuccess {
+		Expect(b.SOAResource(rh, dnsmessage.SOAResource{
+			NS:   dnsmessage.MustNewName("ns." + s.zone),
+			MBox: dnsmessage.MustNewName("hostmaster." + s.zone),
+		})).To(Succeed())
+	}
+	for _, ip := range answers {
+		if question.Type == dnsmessage.TypeA {
+			a := dnsmessage.AResource{}
+			copy(a.A[:], ip.To4())
+			Expect(b.AResource(rh, a)).To(Succeed())
+		} else {
+			aaaa := dnsmessage.AAAAResource{}
+			copy(aaaa.AAAA[:], ip.To16())
+			Expect(b.AAAAResource(rh, aaaa)).To(Succeed())
+		}
+	}
+	resp, err := b.Finish()
+	Expect(err).ToNot(HaveOccurred())
+	if s.keyName == "" || s.unsignedResponses {
+		return resp
+	}
+	if !verified {
+		return s.sign(resp, header.ID, nil, 16) // BADSIG
+	}
+	return s.sign(resp, header.ID, requestMAC, 0)
+}
+
+// sign appends an HMAC-SHA256 TSIG record to the response. Responses to requests that could not be
+// verified carry an empty MAC.
+func (s *fakeDNSServer) sign(resp []byte, id uint16, requestMAC []byte, tsigError uint16) []byte {
+	keyName, _ := canonicalWireName(s.keyName)
+	algorithmName, _ := canonicalWireName("hmac-sha256")
+	timers := binary.BigEndian.AppendUint16(nil, 0)
+	timers = binary.BigEndian.AppendUint32(timers, uint32(time.Now().Unix()))
+	timers = binary.BigEndian.AppendUint16(timers, 300)
+	var digest []byte
+	if tsigError == 0 {
+		mac := hmac.New(sha256.New, s.responseSecret)
+		mac.Write(binary.BigEndian.AppendUint16(nil, uint16(len(requestMAC))))
+		mac.Write(requestMAC)
+		mac.Write(resp)
+		mac.Write(keyName)
+		mac.Write([]byte{0, 255, 0, 0, 0, 0})
+		mac.Write(algorithmName)
+		mac.Write(timers)
+		mac.Write([]byte{0, 0, 0, 0})
+		digest = mac.Sum(nil)
+	}
+
+	rdata := append(append([]byte{}, algorithmName...), timers...)
+	rdata = binary.BigEndian.AppendUint16(rdata, uint16(len(digest)))
+	rdata = append(rdata, digest...)
+	rdata = binary.BigEndian.AppendUint16(rdata, id)
+	rdata = binary.BigEndian.AppendUint16(rdata, tsigError)
+	rdata = binary.BigEndian.AppendUint16(rdata, 0)
+
+	signed := append(append([]byte{}, resp...), keyName...)
+	signed = append(signed, 0, 250, 0, 255, 0, 0, 0, 0)
+	signed = binary.BigEndian.AppendUint16(signed, uint16(len(rdata)))
+	signed = append(signed, rdata...)
+	binary.BigEndian.PutUint16(signed[10:], binary.BigEndian.Uint16(signed[10:])+1)
+	return signed
+}
+
+// verify checks the HMAC-SHA256 TSIG record at the end of the message and returns its MAC
+func (s *fakeDNSServer) verify(msg []byte) ([]byte, bool) {
+	if s.keyName == "" {
+		return nil, true
+	}
+	var p dnsmessage.Parser
+	if _, err := p.Start(msg); err != nil {
+		return nil, false
+	}
+	if p.SkipAllQuestions() != nil || p.SkipAllAnswers() != nil || p.SkipAllAuthorities() != nil {
+		return nil, false
+	}
+	additionals, err := p.AllAdditionals()
+	if err != nil || len(additionals) == 0 {
+		return nil, false
+	}
+	tsig := additionals[len(additionals)-1]
+	if tsig.Header.Type != tsigType || !strings.EqualFold(tsig.Header.Name.String(), s.keyName) {
+		return nil, false
+	}
+	rdata := tsig.Body.(*dnsmessage.UnknownResource).Data
+	keyName, _ := canonicalWireName(s.keyName)
+	unsigned := append([]byte{}, msg[:len(msg)-len(keyName)-10-len(rdata)]...)
+	binary.BigEndian.PutUint16(unsigned[10:], binary.BigEndian.Uint16(unsigned[10:])-1)
+
+	algorithmName, _ := canonicalWireName("hmac-sha256")
+	if len(rdata) < len(algorithmName)+10 || string(rdata[:len(algorithmName)]) != string(algorithmName) {
+		return nil, false
+	}
+	timers := rdata[len(algorithmName) : len(algorithmName)+8]
+	macSize := int(binary.BigEndian.Uint16(rdata[len(algorithmName)+8:]))
+	digest := rdata[len(algorithmName)+10 : len(algorithmName)+10+macSize]
+
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(unsigned)
+	mac.Write(keyName)
+	mac.Write([]byte{0, 255, 0, 0, 0, 0})
+	mac.Write(algorithmName)
+	mac.Write(timers)
+	mac.Write([]byte{0, 0, 0, 0})
+	return digest, hmac.Equal(digest, mac.Sum(nil))
+}
+
+var _ = Describe("RFC 2136 DNS provider", func() {
+	const (
+		keyName = "assisted-service."
+		zone    = "example.com."
+	)
+	var (
+		secret = []byte("0123456789abcdef0123456789abcdef")
+		server *fakeDNSServer
+		config *RFC2136Config
+	)
+
+	provider := func(recordType string) RFC2136 {
+		return RFC2136{
+			RecordSet: dnsproviders.RecordSet{RecordSetType: recordType, TTL: 60},
+			Zone:      "example.com",
+			Config:    config,
+		}
+	}
+
+	BeforeEach(func() {
+		server = newFakeDNSServer(zone, keyName, secret)
+		config = &RFC2136Config{
+			Server:      server.listener.Addr().String(),
+			TSIGKeyName: keyName,
+			TSIGSecret:  base64.StdEncoding.EncodeToString(secret),
+		}
+	})
+
+	AfterEach(func() {
+		server.listener.Close()
+	})
+
+	It("creates, reads and deletes A records", func() {
+		p := provider("A")
+		Expect(p.GetRecordSet("api.test.example.com")).To(BeEmpty())
+		_, err := p.CreateRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.GetRecordSet("api.test.example.com")).To(Equal("192.0.2.10"))
+		Expect(provider("AAAA").GetRecordSet("api.test.example.com")).To(BeEmpty())
+
+		_, err = p.DeleteRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.GetRecordSet("api.test.example.com")).To(BeEmpty())
+	})
+
+	It("creates wildcard AAAA records", func() {
+		p := provider("AAAA")
+		_, err := p.CreateRecordSet("*.apps.test.example.com", "2001:db8::10")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.GetRecordSet("*.apps.test.example.com")).To(Equal("2001:db8::10"))
+	})
+
+	It("replaces the values on update", func() {
+		p := provider("A")
+		_, err := p.CreateRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = p.UpdateRecordSet("api.test.example.com", "192.0.2.11")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.GetRecordSet("api.test.example.com")).To(Equal("192.0.2.11"))
+	})
+
+	It("returns the zone served by the server", func() {
+		Expect(provider("").GetDomainName()).To(Equal("example.com"))
+		p := provider("")
+		p.Zone = "other.com"
+		_, err := p.GetDomainName()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the server rejects the signature", func() {
+		config.TSIGSecret = base64.StdEncoding.EncodeToString([]byte("wrong"))
+		_, err := provider("A").CreateRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).To(MatchError(ContainSubstring("rejected the TSIG signature of the request: BADSIG")))
+	})
+
+	It("fails when the response is not signed", func() {
+		server.unsignedResponses = true
+		_, err := provider("A").CreateRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).To(MatchError(ContainSubstring("the response is not signed")))
+		_, err = provider("A").GetRecordSet("api.test.example.com")
+		Expect(err).To(MatchError(ContainSubstring("the response is not signed")))
+	})
+
+	It("fails when the response is signed with another secret", func() {
+		server.responseSecret = []byte("wrong")
+		_, err := provider("A").CreateRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).To(MatchError(ContainSubstring("the TSIG signature of the response is invalid")))
+	})
+
+	It("fails when the response was signed outside of the allowed time window", func() {
+		key, err := provider("A").tsigKey()
+		Expect(err).ToNot(HaveOccurred())
+		msg := dnsmessage.Message{Header: dnsmessage.Header{ID: 1}}
+		req, err := msg.Pack()
+		Expect(err).ToNot(HaveOccurred())
+		_, requestMAC, err := key.sign(1, req, time.Now())
+		Expect(err).ToNot(HaveOccurred())
+		msg.Header.Response = true
+		resp, err := msg.Pack()
+		Expect(err).ToNot(HaveOccurred())
+		resp = server.sign(resp, 1, requestMAC, 0)
+		Expect(key.verify(resp, requestMAC, time.Now())).To(Succeed())
+		Expect(key.verify(resp, requestMAC, time.Now().Add(10*time.Minute))).To(MatchError(ContainSubstring("validity period")))
+	})
+
+	It("sends unsigned requests when no key is configured", func() {
+		server.keyName = ""
+		config.TSIGKeyName = ""
+		_, err := provider("A").CreateRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("fails on a value that does not match the record type", func() {
+		_, err := provider("AAAA").CreateRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("PowerDNS provider", func() {
+	var (
+		server *httptest.Server
+		lock   sync.Mutex
+		rrsets map[string]powerDNSRRSet
+		config *PowerDNSConfig
+	)
+
+	provider := func(recordType string) PowerDNS {
+		return PowerDNS{
+			RecordSet: dnsproviders.RecordSet{RecordSetType: recordType, TTL: 60},
+			ZoneID:    "example.com.",
+			Config:    config,
+		}
+	}
+
+	BeforeEach(func() {
+		rrsets = make(map[string]powerDNSRRSet)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+			if r.Header.Get(powerDNSAPIKeyHeader) != "key" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Path != "/api/v1/servers/localhost/zones/example.com." {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error": "Could not find domain"}`))
+				return
+			}
+			switch r.Method {
+			case http.MethodPatch:
+				var zone powerDNSZone
+				Expect(json.NewDecoder(r.Body).Decode(&zone)).To(Succeed())
+				for _, rrset := range zone.RRSets {
+					key := rrset.Name + "/" + rrset.Type
+					if rrset.ChangeType == "DELETE" {
+						delete(rrsets, key)
+					} else {
+						rrsets[key] = rrset
+					}
+				}
+				w.WriteHeader(http.StatusNoContent)
+			case http.MethodGet:
+				zone := powerDNSZone{Name: "example.com."}
+				if r.URL.Query().Get("rrsets") == "true" {
+					for _, rrset := range rrsets {
+						zone.RRSets = append(zone.RRSets, rrset)
+					}
+				}
+				Expect(json.NewEncoder(w).Encode(&zone)).To(Succeed())
+			}
+		}))
+		config = &PowerDNSConfig{URL: server.URL, APIKey: "key"}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("creates, reads and deletes records", func() {
+		p := provider("A")
+		Expect(p.GetRecordSet("api.test.example.com")).To(BeEmpty())
+		_, err := p.CreateRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rrsets["api.test.example.com./A"].TTL).To(BeEquivalentTo(60))
+		Expect(p.GetRecordSet("api.test.example.com")).To(Equal("192.0.2.10"))
+		Expect(provider("AAAA").GetRecordSet("api.test.example.com")).To(BeEmpty())
+
+		_, err = p.DeleteRecordSet("api.test.example.com", "192.0.2.10")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.GetRecordSet("api.test.example.com")).To(BeEmpty())
+	})
+
+	It("returns the zone name", func() {
+		Expect(provider("").GetDomainName()).To(Equal("example.com"))
+	})
+
+	It("reports the API errors", func() {
+		p := provider("A")
+		p.ZoneID = "other.com"
+		_, err := p.CreateRecordSet("api.test.other.com", "192.0.2.10")
+		Expect(err).To(MatchError(ContainSubstring("Could not find domain")))
+	})
+
+	It("fails with a wrong API key", func() {
+		config.APIKey = "wrong"
+		_, err := provider("").GetDomainName()
+		Expect(err).To(MatchError(ContainSubstring("401")))
+	})
+})
@@ -0,0 +1,604 @@
+package dns
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1" // #nosec
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/pkg/errors"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	rfc2136DefaultPort      = "53"
+	rfc2136Timeout          = 10 * time.Second
+	rfc2136OpCodeUpdate     = dnsmessage.OpCode(5)
+	rfc2136ClassNone        = dnsmessage.Class(254)
+	tsigType                = dnsmessage.Type(250)
+	tsigFudge               = 300
+	tsigDefaultAlgorithm    = "hmac-sha256"
+	maxDNSMessageSize       = 65535
+	dnsMessageHeaderLen     = 12
+	dnsMessageHeaderArCount = 10
+	dnsResourceHeaderLen    = 10
+	dnsQuestionFieldsLen    = 4
+)
+
+var tsigErrors = map[uint16]string{
+	16: "BADSIG",
+	17: "BADKEY",
+	18: "BADTIME",
+	22: "BADTRUNC",
+}
+
+var tsigAlgorithms = map[string]func() hash.Hash{
+	"hmac-sha1":   sha1.New,
+	"hmac-sha256": sha256.New,
+	"hmac-sha512": sha512.New,
+}
+
+// RFC2136Config holds the connection settings of a DNS server, e.g. BIND, that accepts RFC 2136
+// dynamic updates
+type RFC2136Config struct {
+	// Address of the DNS server, port 53 is used when not specified
+	Server string `json:"server"`
+
+	// Name and algorithm of the TSIG key that signs the requests and the responses, and the file,
+	// usually mounted from a secret, that holds its base64 encoded secret. The requests are not
+	// signed when no key name is specified.
+	TSIGKeyName    string `json:"tsig_key_name,omitempty"`
+	TSIGAlgorithm  string `json:"tsig_algorithm,omitempty"`
+	TSIGSecretFile string `json:"tsig_secret_file,omitempty"`
+
+	// The base64 encoded secret, read from TSIGSecretFile
+	TSIGSecret string `json:"-"`
+}
+
+// load reads the TSIG secret from its file and validates the configuration
+func (c *RFC2136Config) load() error {
+	if c.Server == "" {
+		return errors.New("server is required")
+	}
+	if c.TSIGKeyName == "" {
+		return nil
+	}
+	if _, ok := tsigAlgorithms[c.tsigAlgorithm()]; !ok {
+		return errors.Errorf("unsupported TSIG algorithm %s", c.TSIGAlgorithm)
+	}
+	if c.TSIGSecretFile == "" {
+		return errors.New("TSIG secret file is required")
+	}
+	secret, err := readSecretFile(c.TSIGSecretFile)
+	if err != nil {
+		return err
+	}
+	if _, err = base64.StdEncoding.DecodeString(secret); err != nil || secret == "" {
+		return errors.New("TSIG secret must be a non empty base64 encoded string")
+	}
+	c.TSIGSecret = secret
+	return nil
+}
+
+func (c *RFC2136Config) tsigAlgorithm() string {
+	if c.TSIGAlgorithm == "" {
+		return tsigDefaultAlgorithm
+	}
+	return strings.TrimSuffix(strings.ToLower(c.TSIGAlgorithm), ".")
+}
+
+// RFC2136 manages the records of a zone with RFC 2136 dynamic updates, signed with TSIG (RFC 8945)
+// when a key is configured, in which case the responses must be signed with the same key. Records
+// are read with regular queries to the same server.
+type RFC2136 struct {
+	RecordSet dnsproviders.RecordSet
+	Zone      string
+	Config    *RFC2136Config
+}
+
+type rfc2136Change struct {
+	class dnsmessage.Class
+	ttl   uint32
+	data  []byte
+}
+
+func (r RFC2136) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	data, err := r.recordData(recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	return r.update(recordSetName, rfc2136Change{class: dnsmessage.ClassINET, ttl: uint32(r.RecordSet.TTL), data: data})
+}
+
+func (r RFC2136) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	data, err := r.recordData(recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	return r.update(recordSetName,
+		rfc2136Change{class: dnsmessage.ClassANY},
+		rfc2136Change{class: dnsmessage.ClassINET, ttl: uint32(r.RecordSet.TTL), data: data})
+}
+
+func (r RFC2136) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	data, err := r.recordData(recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	return r.update(recordSetName, rfc2136Change{class: rfc2136ClassNone, data: data})
+}
+
+// GetRecordSet returns the comma separated values of the record set, or an empty string when it
+// does not exist
+func (r RFC2136) GetRecordSet(recordSetName string) (string, error) {
+	recordType, err := r.recordType()
+	if err != nil {
+		return "", err
+	}
+	name, err := fqdn(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	parser, header, err := r.query(dnsmessage.Question{Name: name, Type: recordType, Class: dnsmessage.ClassINET})
+	if err != nil {
+		return "", err
+	}
+	if header.RCode == dnsmessage.RCodeNameError {
+		return "", nil
+	}
+	if header.RCode != dnsmessage.RCodeSuccess {
+		return "", errors.Errorf("query of %s failed: %s", recordSetName, header.RCode)
+	}
+	var values []string
+	for {
+		h, err := parser.AnswerHeader()
+		if err == dnsmessage.ErrSectionDone {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if h.Type != recordType || !strings.EqualFold(h.Name.String(), name.String()) {
+			if err = parser.SkipAnswer(); err != nil {
+				return "", err
+			}
+			continue
+		}
+		if recordType == dnsmessage.TypeA {
+			a, err := parser.AResource()
+			if err != nil {
+				return "", err
+			}
+			values = append(values, net.IP(a.A[:]).String())
+		} else {
+			aaaa, err := parser.AAAAResource()
+			if err != nil {
+				return "", err
+			}
+			values = append(values, net.IP(aaaa.AAAA[:]).String())
+		}
+	}
+	return strings.Join(values, ","), nil
+}
+
+// GetDomainName returns the name of the zone after checking that the server is authoritative for it
+func (r RFC2136) GetDomainName() (string, error) {
+	zone, err := fqdn(r.Zone)
+	if err != nil {
+		return "", err
+	}
+	parser, header, err := r.query(dnsmessage.Question{Name: zone, Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET})
+	if err != nil {
+		return "", err
+	}
+	if header.RCode != dnsmessage.RCodeSuccess {
+		return "", errors.Errorf("query of zone %s failed: %s", r.Zone, header.RCode)
+	}
+	answers, err := parser.AllAnswers()
+	if err != nil {
+		return "", err
+	}
+	for _, answer := range answers {
+		if answer.Header.Type == dnsmessage.TypeSOA && strings.EqualFold(answer.Header.Name.String(), zone.String()) {
+			return strings.TrimSuffix(zone.String(), "."), nil
+		}
+	}
+	return "", errors.Errorf("zone %s is not served by %s", r.Zone, r.Config.Server)
+}
+
+func (r RFC2136) recordType() (dnsmessage.Type, error) {
+	switch r.RecordSet.RecordSetType {
+	case "A":
+		return dnsmessage.TypeA, nil
+	case "AAAA":
+		return dnsmessage.TypeAAAA, nil
+	}
+	return 0, errors.Errorf("unsupported record type %q", r.RecordSet.RecordSetType)
+}
+
+func (r RFC2136) recordData(value string) ([]byte, error) {
+	recordType, err := r.recordType()
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(value)
+	if ip != nil && recordType == dnsmessage.TypeA {
+		ip = ip.To4()
+	}
+	if ip == nil || (recordType == dnsmessage.TypeAAAA && ip.To4() != nil) {
+		return nil, errors.Errorf("%q is not a valid value for a record of type %s", value, r.RecordSet.RecordSetType)
+	}
+	return ip, nil
+}
+
+func (r RFC2136) update(recordSetName string, changes ...rfc2136Change) (string, error) {
+	recordType, err := r.recordType()
+	if err != nil {
+		return "", err
+	}
+	name, err := fqdn(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	zone, err := fqdn(r.Zone)
+	if err != nil {
+		return "", err
+	}
+	id := uint16(rand.Intn(1 << 16)) // #nosec
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: id, OpCode: rfc2136OpCodeUpdate})
+	if err = b.StartQuestions(); err != nil {
+		return "", err
+	}
+	// In an update message the question section holds the zone
+	if err = b.Question(dnsmessage.Question{Name: zone, Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET}); err != nil {
+		return "", err
+	}
+	if err = b.StartAuthorities(); err != nil {
+		return "", err
+	}
+	for _, change := range changes {
+		err = b.UnknownResource(dnsmessage.ResourceHeader{Name: name, Class: change.class, TTL: change.ttl},
+			dnsmessage.UnknownResource{Type: recordType, Data: change.data})
+		if err != nil {
+			return "", err
+		}
+	}
+	msg, err := b.Finish()
+	if err != nil {
+		return "", err
+	}
+	header, _, err := r.exchange(id, msg)
+	if err != nil {
+		return "", err
+	}
+	if header.RCode != dnsmessage.RCodeSuccess {
+		return "", errors.Errorf("update of %s in zone %s was rejected: %s", recordSetName, r.Zone, header.RCode)
+	}
+	return header.RCode.String(), nil
+}
+
+func (r RFC2136) query(question dnsmessage.Question) (*dnsmessage.Parser, dnsmessage.Header, error) {
+	id := uint16(rand.Intn(1 << 16)) // #nosec
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: id})
+	if err := b.StartQuestions(); err != nil {
+		return nil, dnsmessage.Header{}, err
+	}
+	if err := b.Question(question); err != nil {
+		return nil, dnsmessage.Header{}, err
+	}
+	msg, err := b.Finish()
+	if err != nil {
+		return nil, dnsmessage.Header{}, err
+	}
+	header, parser, err := r.exchange(id, msg)
+	if err != nil {
+		return nil, dnsmessage.Header{}, err
+	}
+	if err = parser.SkipAllQuestions(); err != nil {
+		return nil, dnsmessage.Header{}, err
+	}
+	return parser, header, nil
+}
+
+// exchange signs the message, sends it to the server over TCP and verifies the signature of the
+// response
+func (r RFC2136) exchange(id uint16, msg []byte) (dnsmessage.Header, *dnsmessage.Parser, error) {
+	key, err := r.tsigKey()
+	if err != nil {
+		return dnsmessage.Header{}, nil, err
+	}
+	msg, requestMAC, err := key.sign(id, msg, time.Now())
+	if err != nil {
+		return dnsmessage.Header{}, nil, err
+	}
+	server := r.Config.Server
+	if _, _, err = net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, rfc2136DefaultPort)
+	}
+	conn, err := net.DialTimeout("tcp", server, rfc2136Timeout)
+	if err != nil {
+		return dnsmessage.Header{}, nil, err
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(rfc2136Timeout)); err != nil {
+		return dnsmessage.Header{}, nil, err
+	}
+
+	// Messages sent over TCP are prefixed with their length
+	if _, err = conn.Write(binary.BigEndian.AppendUint16(nil, uint16(len(msg)))); err != nil {
+		return dnsmessage.Header{}, nil, err
+	}
+	if _, err = conn.Write(msg); err != nil {
+		return dnsmessage.Header{}, nil, err
+	}
+	length := make([]byte, 2)
+	if _, err = io.ReadFull(conn, length); err != nil {
+		return dnsmessage.Header{}, nil, err
+	}
+	resp := make([]byte, binary.BigEndian.Uint16(length))
+	if _, err = io.ReadFull(conn, resp); err != nil {
+		return dnsmessage.Header{}, nil, err
+	}
+
+	var parser dnsmessage.Parser
+	header, err := parser.Start(resp)
+	if err != nil {
+		return dnsmessage.Header{}, nil, err
+	}
+	if header.ID != id {
+		return dnsmessage.Header{}, nil, errors.Errorf("unexpected response ID %d from %s", header.ID, server)
+	}
+	if err = key.verify(resp, requestMAC, time.Now()); err != nil {
+		return dnsmessage.Header{}, nil, errors.Wrapf(err, "invalid response from %s", server)
+	}
+	return header, &parser, nil
+}
+
+// tsigKey is the TSIG key that signs the requests and the responses, see
+// https://www.rfc-editor.org/rfc/rfc8945
+type tsigKey struct {
+	newHash       func() hash.Hash
+	secret        []byte
+	keyName       []byte
+	algorithmName []byte
+}
+
+// tsigKey returns the configured key, or nil when the messages are not signed
+func (r RFC2136) tsigKey() (*tsigKey, error) {
+	if r.Config.TSIGKeyName == "" {
+		return nil, nil
+	}
+	algorithm := r.Config.tsigAlgorithm()
+	newHash, ok := tsigAlgorithms[algorithm]
+	if !ok {
+		return nil, errors.Errorf("unsupported TSIG algorithm %s", r.Config.TSIGAlgorithm)
+	}
+	secret, err := base64.StdEncoding.DecodeString(r.Config.TSIGSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode TSIG secret")
+	}
+	keyName, err := canonicalWireName(r.Config.TSIGKeyName)
+	if err != nil {
+		return nil, err
+	}
+	algorithmName, err := canonicalWireName(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return &tsigKey{newHash: newHash, secret: secret, keyName: keyName, algorithmName: algorithmName}, nil
+}
+
+// digest returns the MAC of the message and of the TSIG variables. The MAC of a response also
+// covers the MAC of its request, passed as prefix.
+func (k *tsigKey) digest(prefix, msg, timers []byte, tsigError uint16, otherData []byte) []byte {
+	mac := hmac.New(k.newHash, k.secret)
+	mac.Write(prefix)
+	mac.Write(msg)
+	mac.Write(k.keyName)
+	mac.Write(binary.BigEndian.AppendUint16(nil, uint16(dnsmessage.ClassANY)))
+	mac.Write(binary.BigEndian.AppendUint32(nil, 0)) // TTL
+	mac.Write(k.algorithmName)
+	mac.Write(timers)
+	mac.Write(binary.BigEndian.AppendUint16(nil, tsigError))
+	mac.Write(binary.BigEndian.AppendUint16(nil, uint16(len(otherData))))
+	mac.Write(otherData)
+	return mac.Sum(nil)
+}
+
+// sign appends a TSIG record to the message and returns the signed message and its MAC. The
+// message is returned as is when there is no key.
+func (k *tsigKey) sign(id uint16, msg []byte, now time.Time) ([]byte, []byte, error) {
+	if k == nil {
+		return msg, nil, nil
+	}
+	timeSigned := uint64(now.Unix())
+	timers := []byte{
+		byte(timeSigned >> 40), byte(timeSigned >> 32), byte(timeSigned >> 24),
+		byte(timeSigned >> 16), byte(timeSigned >> 8), byte(timeSigned),
+	}
+	timers = binary.BigEndian.AppendUint16(timers, tsigFudge)
+	digest := k.digest(nil, msg, timers, 0, nil)
+
+	rdata := append(append([]byte{}, k.algorithmName...), timers...)
+	rdata = binary.BigEndian.AppendUint16(rdata, uint16(len(digest)))
+	rdata = append(rdata, digest...)
+	rdata = binary.BigEndian.AppendUint16(rdata, id)
+	rdata = binary.BigEndian.AppendUint32(rdata, 0) // error and other data length
+
+	signed := append(append([]byte{}, msg...), k.keyName...)
+	signed = binary.BigEndian.AppendUint16(signed, uint16(tsigType))
+	signed = binary.BigEndian.AppendUint16(signed, uint16(dnsmessage.ClassANY))
+	signed = binary.BigEndian.AppendUint32(signed, 0)
+	signed = binary.BigEndian.AppendUint16(signed, uint16(len(rdata)))
+	signed = append(signed, rdata...)
+	if len(signed) > maxDNSMessageSize {
+		return nil, nil, errors.New("signed DNS message is too long")
+	}
+	arCount := binary.BigEndian.Uint16(signed[dnsMessageHeaderArCount:])
+	binary.BigEndian.PutUint16(signed[dnsMessageHeaderArCount:], arCount+1)
+	return signed, digest, nil
+}
+
+// verify checks the TSIG record that ends the response to the request with the given MAC, as
+// described in https://www.rfc-editor.org/rfc/rfc8945#section-5.3. Nothing is checked when
+// there is no key.
+func (k *tsigKey) verify(resp, requestMAC []byte, now time.Time) error {
+	if k == nil {
+		return nil
+	}
+	offset, err := lastAdditionalOffset(resp)
+	if err != nil {
+		return err
+	}
+	nameEnd, err := skipWireName(resp, offset)
+	if err != nil {
+		return err
+	}
+	if nameEnd+dnsResourceHeaderLen > len(resp) ||
+		binary.BigEndian.Uint16(resp[nameEnd:]) != uint16(tsigType) ||
+		nameEnd+dnsResourceHeaderLen+int(binary.BigEndian.Uint16(resp[nameEnd+8:])) != len(resp) {
+		return errors.New("the response is not signed")
+	}
+	if !bytes.Equal(bytes.ToLower(resp[offset:nameEnd]), k.keyName) {
+		return errors.New("the response is signed with another key")
+	}
+
+	rdata := resp[nameEnd+dnsResourceHeaderLen:]
+	algorithmEnd, err := skipWireName(rdata, 0)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(bytes.ToLower(rdata[:algorithmEnd]), k.algorithmName) {
+		return errors.New("the response is signed with another algorithm")
+	}
+	fields := rdata[algorithmEnd:]
+	if len(fields) < 10 {
+		return errors.New("malformed TSIG record")
+	}
+	timers := fields[:8]
+	macSize := int(binary.BigEndian.Uint16(fields[8:]))
+	if len(fields) < 10+macSize+6 {
+		return errors.New("malformed TSIG record")
+	}
+	mac := fields[10 : 10+macSize]
+	originalID := fields[10+macSize : 12+macSize]
+	tsigError := binary.BigEndian.Uint16(fields[12+macSize:])
+	otherLen := int(binary.BigEndian.Uint16(fields[14+macSize:]))
+	if len(fields) != 16+macSize+otherLen {
+		return errors.New("malformed TSIG record")
+	}
+	otherData := fields[16+macSize:]
+	if tsigError != 0 {
+		name, ok := tsigErrors[tsigError]
+		if !ok {
+			name = fmt.Sprintf("error %d", tsigError)
+		}
+		return errors.Errorf("the server rejected the TSIG signature of the request: %s", name)
+	}
+
+	// The MAC covers the response as it was before the TSIG record was added
+	unsigned := append([]byte{}, resp[:offset]...)
+	copy(unsigned, originalID)
+	binary.BigEndian.PutUint16(unsigned[dnsMessageHeaderArCount:], binary.BigEndian.Uint16(unsigned[dnsMessageHeaderArCount:])-1)
+	prefix := binary.BigEndian.AppendUint16(nil, uint16(len(requestMAC)))
+	prefix = append(prefix, requestMAC...)
+	if !hmac.Equal(mac, k.digest(prefix, unsigned, timers, tsigError, otherData)) {
+		return errors.New("the TSIG signature of the response is invalid")
+	}
+
+	timeSigned := int64(timers[0])<<40 | int64(timers[1])<<32 | int64(timers[2])<<24 |
+		int64(timers[3])<<16 | int64(timers[4])<<8 | int64(timers[5])
+	fudge := int64(binary.BigEndian.Uint16(timers[6:]))
+	if delta := now.Unix() - timeSigned; delta > fudge || delta < -fudge {
+		return errors.New("the TSIG signature of the response is outside of its validity period")
+	}
+	return nil
+}
+
+// lastAdditionalOffset returns the offset of the last record of the additional section
+func lastAdditionalOffset(msg []byte) (int, error) {
+	if len(msg) < dnsMessageHeaderLen {
+		return 0, errors.New("malformed DNS message")
+	}
+	qdCount := int(binary.BigEndian.Uint16(msg[4:]))
+	rrCount := int(binary.BigEndian.Uint16(msg[6:])) + int(binary.BigEndian.Uint16(msg[8:])) +
+		int(binary.BigEndian.Uint16(msg[dnsMessageHeaderArCount:]))
+	if binary.BigEndian.Uint16(msg[dnsMessageHeaderArCount:]) == 0 {
+		return 0, errors.New("the response is not signed")
+	}
+	offset := dnsMessageHeaderLen
+	var err error
+	for i := 0; i < qdCount; i++ {
+		if offset, err = skipWireName(msg, offset); err != nil {
+			return 0, err
+		}
+		offset += dnsQuestionFieldsLen
+	}
+	for i := 0; i < rrCount-1; i++ {
+		if offset, err = skipWireName(msg, offset); err != nil {
+			return 0, err
+		}
+		if offset+dnsResourceHeaderLen > len(msg) {
+			return 0, errors.New("malformed DNS message")
+		}
+		offset += dnsResourceHeaderLen + int(binary.BigEndian.Uint16(msg[offset+8:]))
+	}
+	if offset >= len(msg) {
+		return 0, errors.New("malformed DNS message")
+	}
+	return offset, nil
+}
+
+// skipWireName returns the offset that follows the, possibly compressed, name at the offset
+func skipWireName(msg []byte, offset int) (int, error) {
+	for offset < len(msg) {
+		length := int(msg[offset])
+		switch {
+		case length == 0:
+			return offset + 1, nil
+		case length&0xC0 == 0xC0:
+			if offset+2 > len(msg) {
+				return 0, errors.New("malformed DNS name")
+			}
+			return offset + 2, nil
+		case length&0xC0 != 0:
+			return 0, errors.New("malformed DNS name")
+		}
+		offset += 1 + length
+	}
+	return 0, errors.New("malformed DNS name")
+}
+
+func fqdn(name string) (dnsmessage.Name, error) {
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return dnsmessage.NewName(name)
+}
+
+// canonicalWireName returns the uncompressed, lower case, wire format of the name
+func canonicalWireName(name string) ([]byte, error) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	var ret []byte
+	if name != "" {
+		for _, label := range strings.Split(name, ".") {
+			if label == "" || len(label) > dnsDomainLabelLen {
+				return nil, errors.Errorf("invalid DNS name %q", name)
+			}
+			ret = append(ret, byte(len(label)))
+			ret = append(ret, label...)
+		}
+	}
+	return append(ret, 0), nil
+}
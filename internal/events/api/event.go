@@ -38,6 +38,11 @@ type Sender interface {
 type Handler interface {
 	Sender
 	V2GetEvents(ctx context.Context, params *common.V2GetEventsParams) (*common.V2GetEventsResponse, error)
+	RegisterSubscription(ctx context.Context, params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error)
+	ListSubscriptions(ctx context.Context, clusterID *strfmt.UUID, infraEnvID *strfmt.UUID) ([]*common.EventSubscription, error)
+	GetSubscription(ctx context.Context, subscriptionID strfmt.UUID) (*common.EventSubscription, error)
+	DeregisterSubscription(ctx context.Context, subscriptionID strfmt.UUID) error
+	ListSubscriptionDeliveries(ctx context.Context, subscriptionID strfmt.UUID, status *string, limit *int64) ([]*common.EventSubscriptionDelivery, error)
 }
 
 var DefaultEventCategories = []string{
@@ -12,6 +12,7 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	models "github.com/openshift/assisted-service/models"
 )
 
 // MockSender is a mock of Sender interface.
@@ -178,6 +179,65 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// DeregisterSubscription mocks base method.
+func (m *MockHandler) DeregisterSubscription(ctx context.Context, subscriptionID strfmt.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterSubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterSubscription indicates an expected call of DeregisterSubscription.
+func (mr *MockHandlerMockRecorder) DeregisterSubscription(ctx, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterSubscription", reflect.TypeOf((*MockHandler)(nil).DeregisterSubscription), ctx, subscriptionID)
+}
+
+// GetSubscription mocks base method.
+func (m *MockHandler) GetSubscription(ctx context.Context, subscriptionID strfmt.UUID) (*common.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].(*common.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockHandlerMockRecorder) GetSubscription(ctx, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockHandler)(nil).GetSubscription), ctx, subscriptionID)
+}
+
+// ListSubscriptionDeliveries mocks base method.
+func (m *MockHandler) ListSubscriptionDeliveries(ctx context.Context, subscriptionID strfmt.UUID, status *string, limit *int64) ([]*common.EventSubscriptionDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptionDeliveries", ctx, subscriptionID, status, limit)
+	ret0, _ := ret[0].([]*common.EventSubscriptionDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptionDeliveries indicates an expected call of ListSubscriptionDeliveries.
+func (mr *MockHandlerMockRecorder) ListSubscriptionDeliveries(ctx, subscriptionID, status, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptionDeliveries", reflect.TypeOf((*MockHandler)(nil).ListSubscriptionDeliveries), ctx, subscriptionID, status, limit)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockHandler) ListSubscriptions(ctx context.Context, clusterID, infraEnvID *strfmt.UUID) ([]*common.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, clusterID, infraEnvID)
+	ret0, _ := ret[0].([]*common.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockHandlerMockRecorder) ListSubscriptions(ctx, clusterID, infraEnvID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockHandler)(nil).ListSubscriptions), ctx, clusterID, infraEnvID)
+}
+
 // NotifyInternalEvent mocks base method.
 func (m *MockHandler) NotifyInternalEvent(ctx context.Context, clusterID, hostID, infraEnvID *strfmt.UUID, msg string) {
 	m.ctrl.T.Helper()
@@ -190,6 +250,21 @@ func (mr *MockHandlerMockRecorder) NotifyInternalEvent(ctx, clusterID, hostID, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyInternalEvent", reflect.TypeOf((*MockHandler)(nil).NotifyInternalEvent), ctx, clusterID, hostID, infraEnvID, msg)
 }
 
+// RegisterSubscription mocks base method.
+func (m *MockHandler) RegisterSubscription(ctx context.Context, params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSubscription", ctx, params)
+	ret0, _ := ret[0].(*common.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSubscription indicates an expected call of RegisterSubscription.
+func (mr *MockHandlerMockRecorder) RegisterSubscription(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSubscription", reflect.TypeOf((*MockHandler)(nil).RegisterSubscription), ctx, params)
+}
+
 // SendClusterEvent mocks base method.
 func (m *MockHandler) SendClusterEvent(ctx context.Context, event ClusterEvent) {
 	m.ctrl.T.Helper()
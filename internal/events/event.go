@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
@@ -31,18 +32,26 @@ var DefaultEventCategories = []string{
 var defaultEventLimit int64 = 5000
 
 type Events struct {
-	db     *gorm.DB
-	log    logrus.FieldLogger
-	authz  auth.Authorizer
-	stream stream.Notifier
+	db                   *gorm.DB
+	log                  logrus.FieldLogger
+	authz                auth.Authorizer
+	stream               stream.Notifier
+	subscriptionSecurity *SubscriptionSecurity
 }
 
+// New returns an events handler that only accepts public event subscription URLs and no signed subscriptions
 func New(db *gorm.DB, authz auth.Authorizer, stream stream.Notifier, log logrus.FieldLogger) eventsapi.Handler {
+	return NewWithSubscriptionSecurity(db, authz, stream, &SubscriptionSecurity{resolver: net.DefaultResolver}, log)
+}
+
+func NewWithSubscriptionSecurity(db *gorm.DB, authz auth.Authorizer, stream stream.Notifier,
+	subscriptionSecurity *SubscriptionSecurity, log logrus.FieldLogger) eventsapi.Handler {
 	return &Events{
-		db:     db,
-		log:    log,
-		authz:  authz,
-		stream: stream,
+		db:                   db,
+		log:                  log,
+		authz:                authz,
+		stream:               stream,
+		subscriptionSecurity: subscriptionSecurity,
 	}
 }
 
@@ -95,17 +104,18 @@ func (e *Events) v2SaveEvent(ctx context.Context, clusterID *strfmt.UUID, hostID
 		}
 
 		// Create the new event:
-		return tx.Create(&event).Error
+		if err := tx.Create(&event).Error; err != nil {
+			return err
+		}
+		if err := enqueueSubscriptionDeliveries(tx, &event); err != nil {
+			return err
+		}
+		return stream.NotifyWithDB(ctx, e.stream, tx, &event)
 	})
 	if err != nil {
 		log.WithError(err).Errorf("failed to add event. Rolling back transaction on event=%s resources: %s",
 			message, strings.Join(errMsg, " "))
 	}
-
-	err = e.stream.Notify(ctx, &event)
-	if err != nil {
-		log.WithError(err).Warning("failed to notify event")
-	}
 }
 
 // exceedsLimit checks if there are already events that are too close to the given one. It returns
@@ -466,7 +476,7 @@ func (e Events) queryEvents(ctx context.Context, params *common.V2GetEventsParam
 	}
 
 	if e.authz != nil && !e.authz.IsAdmin(ctx) {
-		tx = e.authz.OwnedBy(ctx, cleanQuery.Table("(?) as s", tx))
+		tx = e.accessibleEvents(ctx, cleanQuery.Table("(?) as s", tx), params)
 	}
 
 	err = tx.Offset(int(*params.Offset)).Limit(int(*params.Limit)).Find(&events).Error
@@ -477,6 +487,28 @@ func (e Events) queryEvents(ctx context.Context, params *common.V2GetEventsParam
 	return events, eventSeverityCount, &eventCount, nil
 }
 
+// accessibleEvents limits the query to the events the user may read. The events of a cluster or an
+// infra-env are readable by the users that may read it, including through their role bindings,
+// other events only by the owners of their cluster or infra-env.
+func (e Events) accessibleEvents(ctx context.Context, tx *gorm.DB, params *common.V2GetEventsParams) *gorm.DB {
+	if params.ClusterID != nil {
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: params.ClusterID}}
+		if canRead, err := e.authz.HasAccessTo(ctx, cluster, auth.ReadAction); canRead {
+			return tx.Where("cluster_id = ?", params.ClusterID.String())
+		} else if err != nil {
+			e.log.WithError(err).Warnf("failed to check access to cluster %s", params.ClusterID)
+		}
+	} else if params.InfraEnvID != nil {
+		infraEnv := &common.InfraEnv{InfraEnv: models.InfraEnv{ID: params.InfraEnvID}}
+		if canRead, err := e.authz.HasAccessTo(ctx, infraEnv, auth.ReadAction); canRead {
+			return tx.Where("infra_env_id = ?", params.InfraEnvID.String())
+		} else if err != nil {
+			e.log.WithError(err).Warnf("failed to check access to infra-env %s", params.InfraEnvID)
+		}
+	}
+	return e.authz.OwnedBy(ctx, tx)
+}
+
 func (e Events) V2GetEvents(ctx context.Context, params *common.V2GetEventsParams) (*common.V2GetEventsResponse, error) {
 	//initialize the selectedCategories either from the filter, if exists, or from the default values
 	if len(params.Categories) == 0 {
@@ -581,6 +581,46 @@ var _ = Describe("Events library", func() {
 				Expect(len(evs)).To(Equal(2))
 			})
 		})
+
+		Context("with a role binding", func() {
+			BeforeEach(func() {
+				payload := &ocm.AuthPayload{}
+				payload.Role = ocm.UserRole
+				payload.Username = "technician"
+				payload.Organization = "org9"
+				ctx = context.WithValue(context.TODO(), restapi.AuthKey, payload)
+
+				id := strfmt.UUID(uuid.New().String())
+				Expect(db.Create(&common.RoleBinding{RoleBinding: models.RoleBinding{
+					ID:          &id,
+					ClusterID:   &cluster1,
+					Role:        swag.String(models.RoleBindingRoleViewer),
+					SubjectKind: swag.String(models.RoleBindingSubjectKindUser),
+					Subject:     swag.String("technician"),
+				}}).Error).ShouldNot(HaveOccurred())
+			})
+
+			It("gets the events of the cluster", func() {
+				response, err := theEvents.V2GetEvents(ctx, common.GetDefaultV2GetEventsParams(&cluster1, nil, nil))
+				Expect(err).ShouldNot(HaveOccurred())
+				evs := response.GetEvents()
+				Expect(len(evs)).To(Equal(2))
+				Expect(hasEvent(evs, "cluster1-org1")).To(BeTrue())
+				Expect(hasEvent(evs, "bound-host-on-cluster1-infra1-org1")).To(BeTrue())
+			})
+
+			It("does not get the events of other clusters", func() {
+				response, err := theEvents.V2GetEvents(ctx, common.GetDefaultV2GetEventsParams(&cluster2, nil, nil))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetEvents()).To(BeEmpty())
+			})
+
+			It("does not get the events of hosts by their id alone", func() {
+				response, err := theEvents.V2GetEvents(ctx, common.GetDefaultV2GetEventsParams(nil, []strfmt.UUID{host}, nil))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetEvents()).To(BeEmpty())
+			})
+		})
 	})
 
 	Context("Limits", func() {
@@ -141,3 +141,50 @@ func (a *Api) V2ListEvents(ctx context.Context, params events.V2ListEventsParams
 		WithEventCount(*eventCount).
 		WithPayload(ret)
 }
+
+func (a *Api) V2RegisterEventSubscription(ctx context.Context, params events.V2RegisterEventSubscriptionParams) middleware.Responder {
+	subscription, err := a.handler.RegisterSubscription(ctx, params.NewEventSubscriptionParams)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return events.NewV2RegisterEventSubscriptionCreated().WithPayload(&subscription.EventSubscription)
+}
+
+func (a *Api) V2ListEventSubscriptions(ctx context.Context, params events.V2ListEventSubscriptionsParams) middleware.Responder {
+	subscriptions, err := a.handler.ListSubscriptions(ctx, params.ClusterID, params.InfraEnvID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	ret := make(models.EventSubscriptionList, len(subscriptions))
+	for i, subscription := range subscriptions {
+		ret[i] = &subscription.EventSubscription
+	}
+	return events.NewV2ListEventSubscriptionsOK().WithPayload(ret)
+}
+
+func (a *Api) V2GetEventSubscription(ctx context.Context, params events.V2GetEventSubscriptionParams) middleware.Responder {
+	subscription, err := a.handler.GetSubscription(ctx, params.SubscriptionID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return events.NewV2GetEventSubscriptionOK().WithPayload(&subscription.EventSubscription)
+}
+
+func (a *Api) V2DeregisterEventSubscription(ctx context.Context, params events.V2DeregisterEventSubscriptionParams) middleware.Responder {
+	if err := a.handler.DeregisterSubscription(ctx, params.SubscriptionID); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return events.NewV2DeregisterEventSubscriptionNoContent()
+}
+
+func (a *Api) V2ListEventSubscriptionDeliveries(ctx context.Context, params events.V2ListEventSubscriptionDeliveriesParams) middleware.Responder {
+	deliveries, err := a.handler.ListSubscriptionDeliveries(ctx, params.SubscriptionID, params.Status, params.Limit)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	ret := make(models.EventSubscriptionDeliveryList, len(deliveries))
+	for i, delivery := range deliveries {
+		ret[i] = &delivery.EventSubscriptionDelivery
+	}
+	return events.NewV2ListEventSubscriptionDeliveriesOK().WithPayload(ret)
+}
@@ -0,0 +1,222 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SubscriptionDispatcherConfig struct {
+	Interval          time.Duration `envconfig:"EVENT_SUBSCRIPTION_DISPATCH_INTERVAL" default:"2s"`
+	BatchSize         int           `envconfig:"EVENT_SUBSCRIPTION_BATCH_SIZE" default:"200"`
+	Workers           int           `envconfig:"EVENT_SUBSCRIPTION_WORKERS" default:"10"`
+	Timeout           time.Duration `envconfig:"EVENT_SUBSCRIPTION_TIMEOUT" default:"5s"`
+	MaxAttempts       int64         `envconfig:"EVENT_SUBSCRIPTION_MAX_ATTEMPTS" default:"8"`
+	InitialBackoff    time.Duration `envconfig:"EVENT_SUBSCRIPTION_INITIAL_BACKOFF" default:"5s"`
+	MaxBackoff        time.Duration `envconfig:"EVENT_SUBSCRIPTION_MAX_BACKOFF" default:"10m"`
+	DeliveryRetention time.Duration `envconfig:"EVENT_SUBSCRIPTION_DELIVERY_RETENTION" default:"168h"`
+}
+
+// SubscriptionDispatcher POSTs the pending event subscription deliveries to the subscription URLs.
+// Only the leader dispatches. The deliveries of a subscription are sent in the order of their
+// events: when one of them fails, the ones behind it wait until it is delivered or failed.
+// Subscriptions are dispatched concurrently by a bounded pool of workers, so that a slow
+// subscriber only holds back its own deliveries.
+type SubscriptionDispatcher struct {
+	db            *gorm.DB
+	client        *http.Client
+	security      *SubscriptionSecurity
+	leaderElector leader.Leader
+	config        SubscriptionDispatcherConfig
+	log           logrus.FieldLogger
+}
+
+func NewSubscriptionDispatcher(db *gorm.DB, leaderElector leader.Leader, config SubscriptionDispatcherConfig,
+	security *SubscriptionSecurity, log logrus.FieldLogger) *SubscriptionDispatcher {
+	return &SubscriptionDispatcher{
+		db:            db,
+		client:        security.httpClient(config.Timeout),
+		security:      security,
+		leaderElector: leaderElector,
+		config:        config,
+		log:           log,
+	}
+}
+
+func (d *SubscriptionDispatcher) Dispatch() {
+	if !d.leaderElector.IsLeader() {
+		d.log.Debugf("Not a leader, exiting event subscription dispatcher")
+		return
+	}
+	var (
+		requestID  = requestid.NewID()
+		ctx        = requestid.ToContext(context.Background(), requestID)
+		log        = requestid.RequestIDLogger(d.log, requestID)
+		now        = time.Now()
+		deliveries []*common.EventSubscriptionDelivery
+	)
+
+	err := d.db.Where("status = ?", models.EventSubscriptionDeliveryStatusPending).
+		Order("id").Limit(d.config.BatchSize).Find(&deliveries).Error
+	if err != nil {
+		log.WithError(err).Error("failed to read the pending event subscription deliveries")
+		return
+	}
+
+	var subscriptionIDs []strfmt.UUID
+	subscriptionDeliveries := make(map[strfmt.UUID][]*common.EventSubscriptionDelivery)
+	for _, delivery := range deliveries {
+		if _, ok := subscriptionDeliveries[delivery.SubscriptionID]; !ok {
+			subscriptionIDs = append(subscriptionIDs, delivery.SubscriptionID)
+		}
+		subscriptionDeliveries[delivery.SubscriptionID] = append(subscriptionDeliveries[delivery.SubscriptionID], delivery)
+	}
+
+	workers := d.config.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	queue := make(chan strfmt.UUID)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for subscriptionID := range queue {
+				d.dispatchSubscription(ctx, log, subscriptionID, subscriptionDeliveries[subscriptionID], now)
+			}
+		}()
+	}
+	for _, subscriptionID := range subscriptionIDs {
+		if !d.leaderElector.IsLeader() {
+			log.Debugf("Not a leader, exiting event subscription dispatcher")
+			break
+		}
+		queue <- subscriptionID
+	}
+	close(queue)
+	wg.Wait()
+	d.prune(log)
+}
+
+// dispatchSubscription sends the deliveries of a single subscription in order, until one of them
+// fails or waits for its backoff
+func (d *SubscriptionDispatcher) dispatchSubscription(ctx context.Context, log logrus.FieldLogger, subscriptionID strfmt.UUID,
+	deliveries []*common.EventSubscriptionDelivery, now time.Time) {
+	subscription := &common.EventSubscription{}
+	if err := d.db.Take(subscription, "id = ?", subscriptionID.String()).Error; err != nil {
+		// The subscription was removed after the batch was read, its deliveries are gone too
+		log.WithError(err).Debugf("failed to get event subscription %s", subscriptionID)
+		return
+	}
+	for _, delivery := range deliveries {
+		if !d.leaderElector.IsLeader() || delivery.NextAttemptAt.After(now) || !d.deliver(ctx, log, subscription, delivery) {
+			return
+		}
+	}
+}
+
+// deliver sends a single delivery and returns whether the deliveries behind it may be sent
+func (d *SubscriptionDispatcher) deliver(ctx context.Context, log logrus.FieldLogger, subscription *common.EventSubscription,
+	delivery *common.EventSubscriptionDelivery) bool {
+	responseCode, retryable, err := d.post(ctx, subscription, delivery)
+	delivery.Attempts++
+	updates := map[string]interface{}{
+		"attempts":      delivery.Attempts,
+		"response_code": responseCode,
+	}
+	unblocked := true
+	if err == nil {
+		updates["status"] = models.EventSubscriptionDeliveryStatusDelivered
+		updates["delivered_at"] = strfmt.DateTime(time.Now())
+		updates["last_error"] = ""
+	} else {
+		updates["last_error"] = err.Error()
+		logger := log.WithError(err).WithFields(logrus.Fields{
+			"subscription_id": delivery.SubscriptionID,
+			"delivery_id":     delivery.ID,
+			"event_name":      delivery.EventName,
+			"attempts":        delivery.Attempts,
+		})
+		if !retryable || delivery.Attempts >= d.config.MaxAttempts {
+			logger.Warn("event subscription delivery failed, giving up")
+			updates["status"] = models.EventSubscriptionDeliveryStatusFailed
+		} else {
+			logger.Debug("event subscription delivery failed, will retry")
+			updates["next_attempt_at"] = time.Now().Add(d.backoff(delivery.Attempts))
+			unblocked = false
+		}
+	}
+	if dbErr := d.db.Model(delivery).Updates(updates).Error; dbErr != nil {
+		log.WithError(dbErr).Errorf("failed to update event subscription delivery %d", delivery.ID)
+		return false
+	}
+	return unblocked
+}
+
+// post returns the response code, if any, and whether a failed delivery should be retried
+func (d *SubscriptionDispatcher) post(ctx context.Context, subscription *common.EventSubscription,
+	delivery *common.EventSubscriptionDelivery) (int64, bool, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(stream.WebhookEventHeader, delivery.EventName)
+	if subscription.Secret != "" {
+		secret, err := d.security.openSecret(subscription.Secret, subscription.ID.String())
+		if err != nil {
+			return 0, false, err
+		}
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(stream.WebhookTimestampHeader, timestamp)
+		req.Header.Set(stream.WebhookSignatureHeader, "sha256="+stream.SignWebhookPayload(secret, timestamp, body))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, !errors.Is(err, errDisallowedDestination), err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return int64(resp.StatusCode), false, nil
+	}
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+	return int64(resp.StatusCode), retryable, fmt.Errorf("%s responded with status %d", *subscription.URL, resp.StatusCode)
+}
+
+func (d *SubscriptionDispatcher) backoff(attempts int64) time.Duration {
+	backoff := d.config.InitialBackoff
+	for i := int64(1); i < attempts && backoff < d.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > d.config.MaxBackoff {
+		backoff = d.config.MaxBackoff
+	}
+	return backoff
+}
+
+// prune removes the delivered and failed deliveries that are older than the retention period
+func (d *SubscriptionDispatcher) prune(log logrus.FieldLogger) {
+	err := d.db.Where("status <> ? AND created_at < ?", models.EventSubscriptionDeliveryStatusPending,
+		time.Now().Add(-d.config.DeliveryRetention)).Delete(&common.EventSubscriptionDelivery{}).Error
+	if err != nil {
+		log.WithError(err).Warn("failed to prune event subscription deliveries")
+	}
+}
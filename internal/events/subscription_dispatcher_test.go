@@ -0,0 +1,244 @@
+package events
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Subscription dispatcher", func() {
+	var (
+		ctrl       *gomock.Controller
+		security   *SubscriptionSecurity
+		db         *gorm.DB
+		dbName     string
+		mockLeader *leader.MockLeader
+		dispatcher *SubscriptionDispatcher
+		server     *httptest.Server
+		lock       sync.Mutex
+		requests   []*http.Request
+		bodies     []string
+		statusCode int
+	)
+
+	addSubscription := func(secret string) *common.EventSubscription {
+		id := strfmt.UUID(uuid.New().String())
+		clusterID := strfmt.UUID(uuid.New().String())
+		url := server.URL
+		subscription := &common.EventSubscription{
+			EventSubscription: models.EventSubscription{
+				ID:        &id,
+				ClusterID: &clusterID,
+				URL:       &url,
+				Signed:    secret != "",
+				Filter:    &models.EventSubscriptionFilter{},
+			},
+		}
+		if secret != "" {
+			var err error
+			subscription.Secret, err = security.sealSecret(secret, id.String())
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(db.Create(subscription).Error).ToNot(HaveOccurred())
+		return subscription
+	}
+
+	addDelivery := func(subscription *common.EventSubscription, name string) *common.EventSubscriptionDelivery {
+		delivery := &common.EventSubscriptionDelivery{
+			EventSubscriptionDelivery: models.EventSubscriptionDelivery{
+				SubscriptionID: *subscription.ID,
+				EventName:      name,
+				Status:         models.EventSubscriptionDeliveryStatusPending,
+			},
+			Payload:       `{"name":"` + name + `"}`,
+			NextAttemptAt: time.Now(),
+		}
+		Expect(db.Create(delivery).Error).ToNot(HaveOccurred())
+		return delivery
+	}
+
+	reload := func(delivery *common.EventSubscriptionDelivery) *common.EventSubscriptionDelivery {
+		var ret common.EventSubscriptionDelivery
+		Expect(db.Take(&ret, "id = ?", delivery.ID).Error).ToNot(HaveOccurred())
+		return &ret
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockLeader = leader.NewMockLeader(ctrl)
+		mockLeader.EXPECT().IsLeader().Return(true).AnyTimes()
+		requests = nil
+		bodies = nil
+		statusCode = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			lock.Lock()
+			defer lock.Unlock()
+			requests = append(requests, r)
+			bodies = append(bodies, string(body))
+			w.WriteHeader(statusCode)
+		}))
+		// The test server listens on the loopback interface
+		security = newTestSubscriptionSecurity("127.0.0.0/8")
+		dispatcher = NewSubscriptionDispatcher(db, mockLeader, SubscriptionDispatcherConfig{
+			BatchSize:         100,
+			Timeout:           time.Second,
+			MaxAttempts:       3,
+			InitialBackoff:    time.Minute,
+			MaxBackoff:        time.Hour,
+			DeliveryRetention: time.Hour,
+		}, security, logrus.New())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("posts signed deliveries in order and marks them delivered", func() {
+		subscription := addSubscription("secret")
+		first := addDelivery(subscription, "first")
+		second := addDelivery(subscription, "second")
+
+		dispatcher.Dispatch()
+
+		Expect(bodies).To(Equal([]string{`{"name":"first"}`, `{"name":"second"}`}))
+		Expect(requests[0].Header.Get(stream.WebhookEventHeader)).To(Equal("first"))
+		timestamp := requests[0].Header.Get(stream.WebhookTimestampHeader)
+		Expect(requests[0].Header.Get(stream.WebhookSignatureHeader)).To(Equal(
+			"sha256=" + stream.SignWebhookPayload("secret", timestamp, []byte(bodies[0]))))
+		for _, delivery := range []*common.EventSubscriptionDelivery{first, second} {
+			stored := reload(delivery)
+			Expect(stored.Status).To(Equal(models.EventSubscriptionDeliveryStatusDelivered))
+			Expect(stored.ResponseCode).To(BeEquivalentTo(http.StatusOK))
+			Expect(stored.Attempts).To(BeEquivalentTo(1))
+		}
+	})
+
+	It("does not let a slow subscriber hold back the other subscriptions", func() {
+		release := make(chan struct{})
+		slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer slowServer.Close()
+		defer close(release)
+		dispatcher.config.Workers = 2
+
+		slow := addSubscription("")
+		slowURL := slowServer.URL
+		Expect(db.Model(slow).Update("url", slowURL).Error).ToNot(HaveOccurred())
+		addDelivery(slow, "slow")
+		fast := addDelivery(addSubscription(""), "fast")
+
+		dispatched := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(dispatched)
+			dispatcher.Dispatch()
+		}()
+		Eventually(func() string {
+			return reload(fast).Status
+		}).Should(Equal(models.EventSubscriptionDeliveryStatusDelivered))
+		Consistently(dispatched, 100*time.Millisecond).ShouldNot(BeClosed())
+		release <- struct{}{}
+		Eventually(dispatched).Should(BeClosed())
+	})
+
+	It("does not sign deliveries of subscriptions without a secret", func() {
+		addDelivery(addSubscription(""), "event")
+		dispatcher.Dispatch()
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Header.Get(stream.WebhookSignatureHeader)).To(BeEmpty())
+	})
+
+	It("fails deliveries to addresses that are not allowed without sending them", func() {
+		security.allowedNetworks = nil
+		dispatcher.client = security.httpClient(time.Second)
+		delivery := addDelivery(addSubscription(""), "event")
+		dispatcher.Dispatch()
+		Expect(requests).To(BeEmpty())
+		stored := reload(delivery)
+		Expect(stored.Status).To(Equal(models.EventSubscriptionDeliveryStatusFailed))
+		Expect(stored.LastError).To(ContainSubstring("not allowed"))
+	})
+
+	It("retries server errors later and holds back the following deliveries", func() {
+		statusCode = http.StatusServiceUnavailable
+		subscription := addSubscription("")
+		first := addDelivery(subscription, "first")
+		second := addDelivery(subscription, "second")
+
+		dispatcher.Dispatch()
+
+		Expect(requests).To(HaveLen(1))
+		stored := reload(first)
+		Expect(stored.Status).To(Equal(models.EventSubscriptionDeliveryStatusPending))
+		Expect(stored.Attempts).To(BeEquivalentTo(1))
+		Expect(stored.ResponseCode).To(BeEquivalentTo(http.StatusServiceUnavailable))
+		Expect(stored.LastError).To(ContainSubstring("503"))
+		Expect(stored.NextAttemptAt).To(BeTemporally("~", time.Now().Add(time.Minute), 5*time.Second))
+		Expect(reload(second).Attempts).To(BeEquivalentTo(0))
+
+		dispatcher.Dispatch()
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("fails client errors immediately", func() {
+		statusCode = http.StatusNotFound
+		delivery := addDelivery(addSubscription(""), "event")
+		dispatcher.Dispatch()
+		Expect(reload(delivery).Status).To(Equal(models.EventSubscriptionDeliveryStatusFailed))
+	})
+
+	It("fails deliveries that exhausted their attempts", func() {
+		statusCode = http.StatusInternalServerError
+		delivery := addDelivery(addSubscription(""), "event")
+		Expect(db.Model(delivery).Update("attempts", 2).Error).ToNot(HaveOccurred())
+		dispatcher.Dispatch()
+		stored := reload(delivery)
+		Expect(stored.Status).To(Equal(models.EventSubscriptionDeliveryStatusFailed))
+		Expect(stored.Attempts).To(BeEquivalentTo(3))
+	})
+
+	It("prunes old deliveries that are no longer pending", func() {
+		subscription := addSubscription("")
+		old := addDelivery(subscription, "old")
+		Expect(db.Model(old).Updates(map[string]interface{}{
+			"status":     models.EventSubscriptionDeliveryStatusDelivered,
+			"created_at": time.Now().Add(-2 * time.Hour),
+		}).Error).ToNot(HaveOccurred())
+
+		dispatcher.Dispatch()
+
+		var count int64
+		Expect(db.Model(&common.EventSubscriptionDelivery{}).Where("id = ?", old.ID).Count(&count).Error).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+
+	It("does nothing when not the leader", func() {
+		notLeader := leader.NewMockLeader(ctrl)
+		notLeader.EXPECT().IsLeader().Return(false).Times(1)
+		dispatcher.leaderElector = notLeader
+		delivery := addDelivery(addSubscription(""), "event")
+		dispatcher.Dispatch()
+		Expect(requests).To(BeEmpty())
+		Expect(reload(delivery).Status).To(Equal(models.EventSubscriptionDeliveryStatusPending))
+	})
+})
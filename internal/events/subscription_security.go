@@ -0,0 +1,154 @@
+package events
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/pkg/errors"
+)
+
+type SubscriptionSecurityConfig struct {
+	// Networks, in CIDR notation, that subscription URLs may point to although they are loopback,
+	// private or link-local addresses
+	AllowedNetworks []string `envconfig:"EVENT_SUBSCRIPTION_ALLOWED_NETWORKS" default:""`
+	// File holding the base64 encoded 256 bit key that encrypts the signing secrets of the
+	// subscriptions, usually mounted from a secret. Signed subscriptions can't be registered without it.
+	SecretKeyFile string `envconfig:"EVENT_SUBSCRIPTION_SECRET_KEY_FILE" default:""`
+}
+
+var errDisallowedDestination = errors.New("address is not allowed for event subscriptions")
+
+// Addresses outside of the special purpose ranges known to the net package that are not routable
+// on the internet
+var nonPublicNetworks = mustParseCIDRs("0.0.0.0/8", "100.64.0.0/10", "192.0.0.0/24", "198.18.0.0/15")
+
+type ipResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// SubscriptionSecurity keeps event subscriptions from being used to reach internal services, and
+// seals their signing secrets before they are stored
+type SubscriptionSecurity struct {
+	allowedNetworks []*net.IPNet
+	secrets         *gencrypto.SecretCipher
+	resolver        ipResolver
+}
+
+func NewSubscriptionSecurity(config SubscriptionSecurityConfig) (*SubscriptionSecurity, error) {
+	security := &SubscriptionSecurity{resolver: net.DefaultResolver}
+	for _, cidr := range config.AllowedNetworks {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid network %q in EVENT_SUBSCRIPTION_ALLOWED_NETWORKS", cidr)
+		}
+		security.allowedNetworks = append(security.allowedNetworks, network)
+	}
+	if config.SecretKeyFile != "" {
+		secrets, err := gencrypto.NewSecretCipherFromFile(config.SecretKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		security.secrets = secrets
+	}
+	return security, nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedIP returns whether deliveries may be sent to the address
+func (s *SubscriptionSecurity) allowedIP(ip net.IP) bool {
+	if containsIP(s.allowedNetworks, ip) {
+		return true
+	}
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified() &&
+		!containsIP(nonPublicNetworks, ip)
+}
+
+// checkURL resolves the host of the subscription URL and fails when any of its addresses may not
+// be delivered to. Deliveries check the address they connect to again, as DNS may change.
+func (s *SubscriptionSecurity) checkURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := u.Hostname()
+	var addresses []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		addresses = []net.IP{ip}
+	} else {
+		resolved, err := s.resolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve event subscription host %s", host)
+		}
+		for _, address := range resolved {
+			addresses = append(addresses, address.IP)
+		}
+	}
+	for _, ip := range addresses {
+		if !s.allowedIP(ip) {
+			return errors.Errorf("event subscription host %s resolves to %s, which is not a public address", host, ip)
+		}
+	}
+	return nil
+}
+
+// httpClient returns a client that refuses to connect to the addresses deliveries may not be sent to.
+// It doesn't use the proxy of the service, the address it connects to couldn't be checked otherwise.
+func (s *SubscriptionSecurity) httpClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !s.allowedIP(ip) {
+				return errors.Wrapf(errDisallowedDestination, "connecting to %s", host)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func (s *SubscriptionSecurity) sealSecret(secret, subscriptionID string) (string, error) {
+	if s.secrets == nil {
+		return "", errors.New("signed event subscriptions require EVENT_SUBSCRIPTION_SECRET_KEY_FILE to be configured")
+	}
+	return s.secrets.Encrypt(secret, subscriptionID)
+}
+
+func (s *SubscriptionSecurity) openSecret(sealedSecret, subscriptionID string) (string, error) {
+	if s.secrets == nil {
+		return "", errors.New("EVENT_SUBSCRIPTION_SECRET_KEY_FILE is not configured")
+	}
+	return s.secrets.Decrypt(sealedSecret, subscriptionID)
+}
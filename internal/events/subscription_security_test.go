@@ -0,0 +1,130 @@
+package events
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/pkg/errors"
+)
+
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	addresses, ok := r[host]
+	if !ok {
+		return nil, errors.Errorf("no such host %s", host)
+	}
+	ret := make([]net.IPAddr, 0, len(addresses))
+	for _, address := range addresses {
+		ret = append(ret, net.IPAddr{IP: net.ParseIP(address)})
+	}
+	return ret, nil
+}
+
+func newTestSubscriptionSecurity(allowedNetworks ...string) *SubscriptionSecurity {
+	secrets, err := gencrypto.NewSecretCipher([]byte(strings.Repeat("k", 32)))
+	Expect(err).ToNot(HaveOccurred())
+	security := &SubscriptionSecurity{
+		secrets: secrets,
+		resolver: fakeResolver{
+			"example.com":          {"93.184.216.34"},
+			"internal.example.com": {"10.0.0.1"},
+			"mixed.example.com":    {"93.184.216.34", "127.0.0.1"},
+		},
+	}
+	for _, cidr := range allowedNetworks {
+		_, network, err := net.ParseCIDR(cidr)
+		Expect(err).ToNot(HaveOccurred())
+		security.allowedNetworks = append(security.allowedNetworks, network)
+	}
+	return security
+}
+
+var _ = Describe("Subscription security", func() {
+	ctx := context.Background()
+
+	DescribeTable("allowed addresses",
+		func(address string, allowed bool) {
+			Expect(newTestSubscriptionSecurity().allowedIP(net.ParseIP(address))).To(Equal(allowed))
+		},
+		Entry("public IPv4", "93.184.216.34", true),
+		Entry("public IPv6", "2606:2800:220:1:248:1893:25c8:1946", true),
+		Entry("loopback", "127.0.0.1", false),
+		Entry("IPv6 loopback", "::1", false),
+		Entry("private", "192.168.1.1", false),
+		Entry("IPv6 unique local", "fd00::1", false),
+		Entry("link-local metadata service", "169.254.169.254", false),
+		Entry("IPv4 mapped link-local", "::ffff:169.254.169.254", false),
+		Entry("shared address space", "100.100.100.200", false),
+		Entry("unspecified", "0.0.0.0", false),
+	)
+
+	It("allows the configured networks", func() {
+		security := newTestSubscriptionSecurity("10.0.0.0/8")
+		Expect(security.allowedIP(net.ParseIP("10.1.2.3"))).To(BeTrue())
+		Expect(security.allowedIP(net.ParseIP("192.168.1.1"))).To(BeFalse())
+	})
+
+	DescribeTable("checking URLs",
+		func(url string, valid bool) {
+			err := newTestSubscriptionSecurity().checkURL(ctx, url)
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("public host", "https://example.com/hook", true),
+		Entry("host with a private address", "https://internal.example.com/hook", false),
+		Entry("host with a public and a loopback address", "https://mixed.example.com/hook", false),
+		Entry("unresolvable host", "https://unknown.example.com/hook", false),
+		Entry("loopback address", "http://127.0.0.1:8080/hook", false),
+		Entry("metadata service", "http://169.254.169.254/latest/meta-data", false),
+		Entry("IPv6 loopback", "http://[::1]/hook", false),
+	)
+
+	It("refuses to deliver to addresses that are not allowed", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		_, err := newTestSubscriptionSecurity().httpClient(time.Second).Get(server.URL)
+		Expect(errors.Is(err, errDisallowedDestination)).To(BeTrue())
+
+		resp, err := newTestSubscriptionSecurity("127.0.0.0/8").httpClient(time.Second).Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("seals secrets to their subscription", func() {
+		security := newTestSubscriptionSecurity()
+		sealed, err := security.sealSecret("secret", "subscription")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sealed).ToNot(ContainSubstring("secret"))
+		Expect(security.openSecret(sealed, "subscription")).To(Equal("secret"))
+		_, err = security.openSecret(sealed, "other")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("requires a secret key for signed subscriptions", func() {
+		security, err := NewSubscriptionSecurity(SubscriptionSecurityConfig{})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = security.sealSecret("secret", "subscription")
+		Expect(err).To(MatchError(ContainSubstring("EVENT_SUBSCRIPTION_SECRET_KEY_FILE")))
+	})
+
+	It("rejects invalid allowed networks", func() {
+		_, err := NewSubscriptionSecurity(SubscriptionSecurityConfig{AllowedNetworks: []string{"10.0.0.0"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
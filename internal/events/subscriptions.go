@@ -0,0 +1,304 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/openshift/assisted-service/internal/common"
+	commonevents "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+var defaultSubscriptionDeliveriesLimit int64 = 100
+
+var (
+	validSubscriptionSeverities = []string{
+		models.EventSeverityInfo,
+		models.EventSeverityWarning,
+		models.EventSeverityError,
+		models.EventSeverityCritical,
+	}
+	validSubscriptionCategories = []string{
+		models.EventCategoryUser,
+		models.EventCategoryMetrics,
+	}
+)
+
+func (e *Events) RegisterSubscription(ctx context.Context, params *models.EventSubscriptionCreateParams) (*common.EventSubscription, error) {
+	log := logutil.FromContext(ctx, e.log)
+	if err := validateSubscriptionParams(params); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err := e.checkSubscriptionAccess(ctx, params.ClusterID, params.InfraEnvID, auth.UpdateAction); err != nil {
+		return nil, err
+	}
+	if err := e.subscriptionSecurity.checkURL(ctx, params.URL); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	var secret string
+	if params.Secret != "" {
+		var err error
+		if secret, err = e.subscriptionSecurity.sealSecret(params.Secret, id.String()); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+	filter := params.Filter
+	if filter == nil {
+		filter = &models.EventSubscriptionFilter{}
+	}
+	subscription := &common.EventSubscription{
+		EventSubscription: models.EventSubscription{
+			ID:         &id,
+			ClusterID:  params.ClusterID,
+			InfraEnvID: params.InfraEnvID,
+			URL:        &params.URL,
+			Signed:     params.Secret != "",
+			Filter:     filter,
+			UserName:   ocm.UserNameFromContext(ctx),
+		},
+		Secret: secret,
+	}
+	if err := e.db.Create(subscription).Error; err != nil {
+		log.WithError(err).Error("failed to create event subscription")
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Registered event subscription %s for %s", id, subscriptionTarget(params.ClusterID, params.InfraEnvID))
+	return subscription, nil
+}
+
+func (e *Events) ListSubscriptions(ctx context.Context, clusterID *strfmt.UUID, infraEnvID *strfmt.UUID) ([]*common.EventSubscription, error) {
+	if (clusterID == nil) == (infraEnvID == nil) {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("exactly one of cluster_id and infra_env_id must be set"))
+	}
+	if err := e.checkSubscriptionAccess(ctx, clusterID, infraEnvID, auth.ReadAction); err != nil {
+		return nil, err
+	}
+	query := e.db.Order("created_at")
+	if clusterID != nil {
+		query = query.Where("cluster_id = ?", clusterID.String())
+	} else {
+		query = query.Where("infra_env_id = ?", infraEnvID.String())
+	}
+	subscriptions := make([]*common.EventSubscription, 0)
+	if err := query.Find(&subscriptions).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return subscriptions, nil
+}
+
+func (e *Events) GetSubscription(ctx context.Context, subscriptionID strfmt.UUID) (*common.EventSubscription, error) {
+	return e.getSubscription(ctx, subscriptionID, auth.ReadAction)
+}
+
+func (e *Events) DeregisterSubscription(ctx context.Context, subscriptionID strfmt.UUID) error {
+	log := logutil.FromContext(ctx, e.log)
+	if _, err := e.getSubscription(ctx, subscriptionID, auth.UpdateAction); err != nil {
+		return err
+	}
+	err := e.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", subscriptionID.String()).Delete(&common.EventSubscriptionDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", subscriptionID.String()).Delete(&common.EventSubscription{}).Error
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to delete event subscription %s", subscriptionID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Deregistered event subscription %s", subscriptionID)
+	return nil
+}
+
+func (e *Events) ListSubscriptionDeliveries(ctx context.Context, subscriptionID strfmt.UUID, status *string, limit *int64) ([]*common.EventSubscriptionDelivery, error) {
+	if _, err := e.getSubscription(ctx, subscriptionID, auth.ReadAction); err != nil {
+		return nil, err
+	}
+	if limit == nil || *limit <= 0 {
+		limit = &defaultSubscriptionDeliveriesLimit
+	}
+	query := e.db.Where("subscription_id = ?", subscriptionID.String())
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	deliveries := make([]*common.EventSubscriptionDelivery, 0)
+	if err := query.Order("id DESC").Limit(int(*limit)).Find(&deliveries).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return deliveries, nil
+}
+
+func (e *Events) getSubscription(ctx context.Context, subscriptionID strfmt.UUID, action auth.Action) (*common.EventSubscription, error) {
+	var subscription common.EventSubscription
+	if err := e.db.Take(&subscription, "id = ?", subscriptionID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("event subscription %s not found", subscriptionID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err := e.checkSubscriptionAccess(ctx, subscription.ClusterID, subscription.InfraEnvID, action); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// checkSubscriptionAccess checks that the user may perform the action on the subscriptions of the
+// cluster or infra-env. Users that cannot read the resource are told that it does not exist.
+func (e *Events) checkSubscriptionAccess(ctx context.Context, clusterID *strfmt.UUID, infraEnvID *strfmt.UUID, action auth.Action) error {
+	var (
+		obj interface{}
+		err error
+	)
+	if clusterID != nil {
+		obj, err = common.GetClusterFromDB(e.db, *clusterID, common.SkipEagerLoading)
+	} else {
+		obj, err = common.GetInfraEnvFromDB(e.db, *infraEnvID)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, errors.Errorf("%s not found", subscriptionTarget(clusterID, infraEnvID)))
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	allowed, err := e.authz.HasAccessTo(ctx, obj, action)
+	if allowed {
+		return nil
+	}
+	if action != auth.ReadAction {
+		if canRead, _ := e.authz.HasAccessTo(ctx, obj, auth.ReadAction); canRead {
+			return common.NewApiError(http.StatusForbidden,
+				errors.Errorf("unauthorized to manage the event subscriptions of %s", subscriptionTarget(clusterID, infraEnvID)))
+		}
+	}
+	if err != nil {
+		e.log.WithError(err).Errorf("failed to check access to %s", subscriptionTarget(clusterID, infraEnvID))
+	}
+	return common.NewApiError(http.StatusNotFound, errors.Errorf("%s not found", subscriptionTarget(clusterID, infraEnvID)))
+}
+
+func subscriptionTarget(clusterID *strfmt.UUID, infraEnvID *strfmt.UUID) string {
+	if clusterID != nil {
+		return fmt.Sprintf("cluster %s", clusterID)
+	}
+	return fmt.Sprintf("infra-env %s", infraEnvID)
+}
+
+func validateSubscriptionParams(params *models.EventSubscriptionCreateParams) error {
+	if (params.ClusterID == nil) == (params.InfraEnvID == nil) {
+		return errors.New("exactly one of cluster_id and infra_env_id must be set")
+	}
+	u, err := url.Parse(params.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid event subscription URL %q, an absolute http or https URL is required", params.URL)
+	}
+	if params.Filter == nil {
+		return nil
+	}
+	for _, severity := range params.Filter.Severities {
+		if !funk.ContainsString(validSubscriptionSeverities, severity) {
+			return errors.Errorf("invalid severity %q in event subscription filter, must be one of %s",
+				severity, strings.Join(validSubscriptionSeverities, ", "))
+		}
+	}
+	for _, category := range params.Filter.Categories {
+		if !funk.ContainsString(validSubscriptionCategories, category) {
+			return errors.Errorf("invalid category %q in event subscription filter, must be one of %s",
+				category, strings.Join(validSubscriptionCategories, ", "))
+		}
+	}
+	for _, name := range params.Filter.Names {
+		if !funk.ContainsString(commonevents.EventNames, name) {
+			return errors.Errorf("unknown event name %q in event subscription filter", name)
+		}
+	}
+	return nil
+}
+
+// subscriptionMatches returns whether the event passes the filter of the subscription
+func subscriptionMatches(filter *models.EventSubscriptionFilter, event *common.Event) bool {
+	if filter == nil {
+		filter = &models.EventSubscriptionFilter{}
+	}
+	categories := []string(filter.Categories)
+	if len(categories) == 0 {
+		categories = DefaultEventCategories
+	}
+	if !funk.ContainsString(categories, event.Category) {
+		return false
+	}
+	if len(filter.Severities) > 0 && (event.Severity == nil || !funk.ContainsString(filter.Severities, *event.Severity)) {
+		return false
+	}
+	if len(filter.Names) > 0 && !funk.ContainsString(filter.Names, event.Name) {
+		return false
+	}
+	if filter.Message != "" && (event.Message == nil ||
+		!strings.Contains(strings.ToLower(*event.Message), strings.ToLower(filter.Message))) {
+		return false
+	}
+	return true
+}
+
+// enqueueSubscriptionDeliveries stores a pending delivery of the event for every subscription of
+// its cluster or infra-env that it matches. It runs in the transaction that creates the event so
+// that an event is never stored without its deliveries.
+func enqueueSubscriptionDeliveries(tx *gorm.DB, event *common.Event) error {
+	if event.ClusterID == nil && event.InfraEnvID == nil {
+		return nil
+	}
+	query := tx.Where("FALSE")
+	if event.ClusterID != nil {
+		query = query.Or("cluster_id = ?", event.ClusterID.String())
+	}
+	if event.InfraEnvID != nil {
+		query = query.Or("infra_env_id = ?", event.InfraEnvID.String())
+	}
+	var subscriptions []*common.EventSubscription
+	if err := tx.Where(query).Find(&subscriptions).Error; err != nil {
+		return err
+	}
+	var payload []byte
+	deliveries := make([]*common.EventSubscriptionDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscriptionMatches(subscription.Filter, event) {
+			continue
+		}
+		if payload == nil {
+			var err error
+			if payload, err = json.Marshal(event.Payload()); err != nil {
+				return err
+			}
+		}
+		delivery := &common.EventSubscriptionDelivery{
+			EventSubscriptionDelivery: models.EventSubscriptionDelivery{
+				SubscriptionID: *subscription.ID,
+				EventName:      event.Name,
+				Status:         models.EventSubscriptionDeliveryStatusPending,
+			},
+			Payload:       string(payload),
+			NextAttemptAt: time.Now(),
+		}
+		if event.EventTime != nil {
+			delivery.EventTime = *event.EventTime
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return tx.Create(&deliveries).Error
+}
@@ -0,0 +1,315 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	commonevents "github.com/openshift/assisted-service/internal/common/events"
+	commontesting "github.com/openshift/assisted-service/internal/common/testing"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Event subscriptions", func() {
+	var (
+		ctrl       *gomock.Controller
+		db         *gorm.DB
+		dbName     string
+		theEvents  eventsapi.Handler
+		ctx        context.Context
+		clusterID  strfmt.UUID
+		infraEnvID strfmt.UUID
+	)
+
+	userContext := func(role ocm.RoleType, username string, orgID string) context.Context {
+		payload := &ocm.AuthPayload{Role: role, Username: username, Organization: orgID}
+		return context.WithValue(context.Background(), restapi.AuthKey, payload)
+	}
+
+	expectApiError := func(err error, code int32) {
+		Expect(err).To(HaveOccurred())
+		apiErr, ok := err.(*common.ApiErrorResponse)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.StatusCode()).To(Equal(code))
+	}
+
+	register := func(params *models.EventSubscriptionCreateParams) *common.EventSubscription {
+		subscription, err := theEvents.RegisterSubscription(ctx, params)
+		Expect(err).ToNot(HaveOccurred())
+		return subscription
+	}
+
+	grant := func(role string, username string) {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.RoleBinding{RoleBinding: models.RoleBinding{
+			ID:          &id,
+			ClusterID:   &clusterID,
+			Role:        swag.String(role),
+			SubjectKind: swag.String(models.RoleBindingSubjectKindUser),
+			Subject:     swag.String(username),
+		}}).Error).ToNot(HaveOccurred())
+	}
+
+	deliveries := func(subscriptionID strfmt.UUID) []*common.EventSubscriptionDelivery {
+		var ret []*common.EventSubscriptionDelivery
+		Expect(db.Where("subscription_id = ?", subscriptionID.String()).Order("id").Find(&ret).Error).ToNot(HaveOccurred())
+		return ret
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		theEvents = New(db, nil, commontesting.GetDummyNotificationStream(ctrl), logrus.WithField("pkg", "events"))
+		cfg := &auth.Config{AuthType: auth.TypeRHSSO}
+		theEvents.(*Events).authz = auth.NewAuthzHandler(cfg, nil, logrus.New(), db)
+		theEvents.(*Events).subscriptionSecurity = newTestSubscriptionSecurity()
+		ctx = userContext(ocm.UserRole, "user1", "org1")
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Kind: swag.String(models.ClusterKindCluster), UserName: "user1", OrgID: "org1"}}).Error).ToNot(HaveOccurred())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, UserName: "user1", OrgID: "org1"}}).Error).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("register", func() {
+		It("registers a signed subscription to a cluster", func() {
+			subscription := register(&models.EventSubscriptionCreateParams{
+				ClusterID: &clusterID,
+				URL:       "https://example.com/hook",
+				Secret:    "secret",
+			})
+			Expect(subscription.ID).ToNot(BeNil())
+			Expect(subscription.Signed).To(BeTrue())
+			Expect(subscription.UserName).To(Equal("user1"))
+
+			stored, err := theEvents.GetSubscription(ctx, *subscription.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored.Secret).ToNot(ContainSubstring("secret"))
+			Expect(theEvents.(*Events).subscriptionSecurity.openSecret(stored.Secret, subscription.ID.String())).To(Equal("secret"))
+			Expect(*stored.ClusterID).To(Equal(clusterID))
+			Expect(*stored.URL).To(Equal("https://example.com/hook"))
+
+			b, err := json.Marshal(stored.EventSubscription)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(b)).ToNot(ContainSubstring("secret\""))
+		})
+
+		It("registers an unsigned subscription to an infra-env", func() {
+			subscription := register(&models.EventSubscriptionCreateParams{
+				InfraEnvID: &infraEnvID,
+				URL:        "http://example.com/hook",
+			})
+			Expect(subscription.Signed).To(BeFalse())
+			Expect(*subscription.InfraEnvID).To(Equal(infraEnvID))
+		})
+
+		DescribeTable("rejects invalid parameters",
+			func(mutate func(*models.EventSubscriptionCreateParams)) {
+				params := &models.EventSubscriptionCreateParams{ClusterID: &clusterID, URL: "https://example.com/hook"}
+				mutate(params)
+				_, err := theEvents.RegisterSubscription(ctx, params)
+				expectApiError(err, http.StatusBadRequest)
+			},
+			Entry("both cluster and infra-env", func(p *models.EventSubscriptionCreateParams) { p.InfraEnvID = &infraEnvID }),
+			Entry("neither cluster nor infra-env", func(p *models.EventSubscriptionCreateParams) { p.ClusterID = nil }),
+			Entry("relative URL", func(p *models.EventSubscriptionCreateParams) { p.URL = "/hook" }),
+			Entry("unsupported scheme", func(p *models.EventSubscriptionCreateParams) { p.URL = "ftp://example.com/hook" }),
+			Entry("unknown severity", func(p *models.EventSubscriptionCreateParams) {
+				p.Filter = &models.EventSubscriptionFilter{Severities: pq.StringArray{"fatal"}}
+			}),
+			Entry("unknown category", func(p *models.EventSubscriptionCreateParams) {
+				p.Filter = &models.EventSubscriptionFilter{Categories: pq.StringArray{"other"}}
+			}),
+			Entry("unknown event name", func(p *models.EventSubscriptionCreateParams) {
+				p.Filter = &models.EventSubscriptionFilter{Names: pq.StringArray{commonevents.ClusterRegistrationSucceededEventName, "no_such_event"}}
+			}),
+			Entry("private address", func(p *models.EventSubscriptionCreateParams) { p.URL = "https://internal.example.com/hook" }),
+			Entry("link-local address", func(p *models.EventSubscriptionCreateParams) { p.URL = "http://169.254.169.254/latest" }),
+		)
+
+		It("accepts known event names in the filter", func() {
+			subscription := register(&models.EventSubscriptionCreateParams{
+				ClusterID: &clusterID,
+				URL:       "https://example.com/hook",
+				Filter:    &models.EventSubscriptionFilter{Names: pq.StringArray{commonevents.ClusterRegistrationSucceededEventName}},
+			})
+			Expect([]string(subscription.Filter.Names)).To(Equal([]string{commonevents.ClusterRegistrationSucceededEventName}))
+		})
+
+		It("rejects signed subscriptions when no secret key is configured", func() {
+			theEvents.(*Events).subscriptionSecurity.secrets = nil
+			_, err := theEvents.RegisterSubscription(ctx, &models.EventSubscriptionCreateParams{
+				ClusterID: &clusterID,
+				URL:       "https://example.com/hook",
+				Secret:    "secret",
+			})
+			expectApiError(err, http.StatusBadRequest)
+		})
+
+		It("fails for a missing cluster", func() {
+			id := strfmt.UUID(uuid.New().String())
+			_, err := theEvents.RegisterSubscription(ctx, &models.EventSubscriptionCreateParams{ClusterID: &id, URL: "https://example.com/hook"})
+			expectApiError(err, http.StatusNotFound)
+		})
+
+		It("hides the cluster of another organization", func() {
+			ctx = userContext(ocm.UserRole, "user2", "org2")
+			_, err := theEvents.RegisterSubscription(ctx, &models.EventSubscriptionCreateParams{ClusterID: &clusterID, URL: "https://example.com/hook"})
+			expectApiError(err, http.StatusNotFound)
+		})
+
+		It("allows the editors of the cluster", func() {
+			grant(models.RoleBindingRoleEditor, "user2")
+			ctx = userContext(ocm.UserRole, "user2", "org2")
+			register(&models.EventSubscriptionCreateParams{ClusterID: &clusterID, URL: "https://example.com/hook"})
+		})
+
+		It("forbids the viewers of the cluster", func() {
+			grant(models.RoleBindingRoleViewer, "user2")
+			ctx = userContext(ocm.UserRole, "user2", "org2")
+			_, err := theEvents.RegisterSubscription(ctx, &models.EventSubscriptionCreateParams{ClusterID: &clusterID, URL: "https://example.com/hook"})
+			expectApiError(err, http.StatusForbidden)
+		})
+
+		It("forbids read-only admins", func() {
+			ctx = userContext(ocm.ReadOnlyAdminRole, "admin", "org2")
+			_, err := theEvents.RegisterSubscription(ctx, &models.EventSubscriptionCreateParams{ClusterID: &clusterID, URL: "https://example.com/hook"})
+			expectApiError(err, http.StatusForbidden)
+		})
+	})
+
+	Context("list, get and deregister", func() {
+		var subscription *common.EventSubscription
+
+		BeforeEach(func() {
+			subscription = register(&models.EventSubscriptionCreateParams{ClusterID: &clusterID, URL: "https://example.com/hook"})
+			register(&models.EventSubscriptionCreateParams{InfraEnvID: &infraEnvID, URL: "https://example.com/hook"})
+		})
+
+		It("lists the subscriptions of a cluster", func() {
+			subscriptions, err := theEvents.ListSubscriptions(ctx, &clusterID, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subscriptions).To(HaveLen(1))
+			Expect(*subscriptions[0].ID).To(Equal(*subscription.ID))
+		})
+
+		It("requires exactly one of cluster and infra-env when listing", func() {
+			_, err := theEvents.ListSubscriptions(ctx, nil, nil)
+			expectApiError(err, http.StatusBadRequest)
+		})
+
+		It("lists the subscriptions to the viewers of the cluster", func() {
+			grant(models.RoleBindingRoleViewer, "user2")
+			ctx = userContext(ocm.UserRole, "user2", "org2")
+			subscriptions, err := theEvents.ListSubscriptions(ctx, &clusterID, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subscriptions).To(HaveLen(1))
+		})
+
+		It("does not show subscriptions to another organization", func() {
+			ctx = userContext(ocm.UserRole, "user2", "org2")
+			_, err := theEvents.GetSubscription(ctx, *subscription.ID)
+			expectApiError(err, http.StatusNotFound)
+		})
+
+		It("deregisters a subscription and its deliveries", func() {
+			theEvents.V2AddEvent(ctx, &clusterID, nil, nil, "name", models.EventSeverityInfo, "message", time.Now())
+			Expect(deliveries(*subscription.ID)).To(HaveLen(1))
+
+			Expect(theEvents.DeregisterSubscription(ctx, *subscription.ID)).To(Succeed())
+			_, err := theEvents.GetSubscription(ctx, *subscription.ID)
+			expectApiError(err, http.StatusNotFound)
+			Expect(deliveries(*subscription.ID)).To(BeEmpty())
+		})
+
+		It("lists the deliveries of a subscription, newest first", func() {
+			for _, name := range []string{"first", "second", "third"} {
+				theEvents.V2AddEvent(ctx, &clusterID, nil, nil, name, models.EventSeverityInfo, "message", time.Now())
+			}
+			ret, err := theEvents.ListSubscriptionDeliveries(ctx, *subscription.ID, nil, swag.Int64(2))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(HaveLen(2))
+			Expect(ret[0].EventName).To(Equal("third"))
+			Expect(ret[1].EventName).To(Equal("second"))
+
+			ret, err = theEvents.ListSubscriptionDeliveries(ctx, *subscription.ID, swag.String(models.EventSubscriptionDeliveryStatusDelivered), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(BeEmpty())
+		})
+	})
+
+	Context("enqueue", func() {
+		It("stores a pending delivery of the event payload", func() {
+			subscription := register(&models.EventSubscriptionCreateParams{ClusterID: &clusterID, URL: "https://example.com/hook"})
+			theEvents.V2AddEvent(ctx, &clusterID, nil, nil, "name", models.EventSeverityInfo, "message", time.Now())
+
+			ret := deliveries(*subscription.ID)
+			Expect(ret).To(HaveLen(1))
+			Expect(ret[0].Status).To(Equal(models.EventSubscriptionDeliveryStatusPending))
+			Expect(ret[0].EventName).To(Equal("name"))
+			var event models.Event
+			Expect(json.Unmarshal([]byte(ret[0].Payload), &event)).To(Succeed())
+			Expect(*event.ClusterID).To(Equal(clusterID))
+			Expect(*event.Message).To(Equal("message"))
+		})
+
+		It("only enqueues events of the subscribed infra-env", func() {
+			subscription := register(&models.EventSubscriptionCreateParams{InfraEnvID: &infraEnvID, URL: "https://example.com/hook"})
+			theEvents.V2AddEvent(ctx, &clusterID, nil, nil, "cluster", models.EventSeverityInfo, "message", time.Now())
+			theEvents.V2AddEvent(ctx, nil, nil, &infraEnvID, "infra-env", models.EventSeverityInfo, "message", time.Now())
+
+			ret := deliveries(*subscription.ID)
+			Expect(ret).To(HaveLen(1))
+			Expect(ret[0].EventName).To(Equal("infra-env"))
+		})
+	})
+})
+
+var _ = Describe("Event subscription filter", func() {
+	DescribeTable("matching",
+		func(filter *models.EventSubscriptionFilter, category string, severity string, name string, message string, expected bool) {
+			event := &common.Event{Event: models.Event{
+				Category: category,
+				Severity: swag.String(severity),
+				Name:     name,
+				Message:  swag.String(message),
+			}}
+			Expect(subscriptionMatches(filter, event)).To(Equal(expected))
+		},
+		Entry("no filter matches user events", nil, models.EventCategoryUser, models.EventSeverityInfo, "a", "m", true),
+		Entry("no filter skips metrics events", nil, models.EventCategoryMetrics, models.EventSeverityInfo, "a", "m", false),
+		Entry("category", &models.EventSubscriptionFilter{Categories: pq.StringArray{models.EventCategoryMetrics}},
+			models.EventCategoryMetrics, models.EventSeverityInfo, "a", "m", true),
+		Entry("severity matches", &models.EventSubscriptionFilter{Severities: pq.StringArray{models.EventSeverityError}},
+			models.EventCategoryUser, models.EventSeverityError, "a", "m", true),
+		Entry("severity does not match", &models.EventSubscriptionFilter{Severities: pq.StringArray{models.EventSeverityError}},
+			models.EventCategoryUser, models.EventSeverityInfo, "a", "m", false),
+		Entry("name does not match", &models.EventSubscriptionFilter{Names: pq.StringArray{"b"}},
+			models.EventCategoryUser, models.EventSeverityInfo, "a", "m", false),
+		Entry("message matches regardless of case", &models.EventSubscriptionFilter{Message: "INSTALL"},
+			models.EventCategoryUser, models.EventSeverityInfo, "a", "cluster installed", true),
+		Entry("message does not match", &models.EventSubscriptionFilter{Message: "failed"},
+			models.EventCategoryUser, models.EventSeverityInfo, "a", "cluster installed", false),
+	)
+})
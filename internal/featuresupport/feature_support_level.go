@@ -53,14 +53,17 @@ var featuresList = map[models.FeatureSupportLevelID]SupportLevelFeature{
 	models.FeatureSupportLevelIDFENCEAGENTSREMEDIATION: (&FenceAgentsRemediationFeature{}).New(),
 	models.FeatureSupportLevelIDNODEMAINTENANCE:        (&NodeMaintenanceFeature{}).New(),
 	models.FeatureSupportLevelIDKUBEDESCHEDULER:        (&KubeDeschedulerFeature{}).New(),
+	models.FeatureSupportLevelIDOPERATORVERSIONPINNING: (&OperatorVersionPinningFeature{}).New(),
 
 	// Platform features
-	models.FeatureSupportLevelIDNUTANIXINTEGRATION:  (&NutanixIntegrationFeature{}).New(),
-	models.FeatureSupportLevelIDVSPHEREINTEGRATION:  (&VsphereIntegrationFeature{}).New(),
-	models.FeatureSupportLevelIDEXTERNALPLATFORMOCI: (&OciIntegrationFeature{}).New(),
-	models.FeatureSupportLevelIDBAREMETALPLATFORM:   (&BaremetalPlatformFeature{}).New(),
-	models.FeatureSupportLevelIDNONEPLATFORM:        (&NonePlatformFeature{}).New(),
-	models.FeatureSupportLevelIDEXTERNALPLATFORM:    (&ExternalPlatformFeature{}).New(),
+	models.FeatureSupportLevelIDNUTANIXINTEGRATION:      (&NutanixIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDVSPHEREINTEGRATION:      (&VsphereIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDOPENSTACKINTEGRATION:    (&OpenstackIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDEXTERNALPLATFORMOCI:     (&OciIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX: (&ProxmoxIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDBAREMETALPLATFORM:       (&BaremetalPlatformFeature{}).New(),
+	models.FeatureSupportLevelIDNONEPLATFORM:            (&NonePlatformFeature{}).New(),
+	models.FeatureSupportLevelIDEXTERNALPLATFORM:        (&ExternalPlatformFeature{}).New(),
 }
 
 func GetFeatureByID(featureID models.FeatureSupportLevelID) SupportLevelFeature {
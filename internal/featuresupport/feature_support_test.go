@@ -21,11 +21,16 @@ func getPlatformFilters() []SupportLevelFilters {
 		{PlatformType: models.PlatformTypeNutanix.Pointer()},
 		{PlatformType: models.PlatformTypeBaremetal.Pointer()},
 		{PlatformType: models.PlatformTypeNone.Pointer()},
+		{PlatformType: models.PlatformTypeOpenstack.Pointer()},
 		{PlatformType: models.PlatformTypeExternal.Pointer()},
 		{
 			PlatformType:         models.PlatformTypeExternal.Pointer(),
 			ExternalPlatformName: swag.String(common.ExternalPlatformNameOci),
 		},
+		{
+			PlatformType:         models.PlatformTypeExternal.Pointer(),
+			ExternalPlatformName: swag.String(common.ExternalPlatformNameProxmox),
+		},
 	}
 }
 
@@ -517,6 +522,70 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 		)
 	})
 
+	Context("Test Proxmox platform support", func() {
+		DescribeTable(
+			"Validation pass",
+			func(openshiftVersion string, cpuArchitecture string, expectedSupportLevel models.SupportLevel) {
+				filters := SupportLevelFilters{
+					OpenshiftVersion: openshiftVersion,
+					CPUArchitecture:  swag.String(cpuArchitecture),
+				}
+				supportLevel := GetSupportLevel(models.FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX, filters)
+				Expect(supportLevel).To(Equal(expectedSupportLevel))
+			},
+			Entry("Proxmox unavailable with Openshift 4.13", "4.13", models.ClusterCPUArchitectureX8664, models.SupportLevelUnavailable),
+			Entry("Proxmox dev-preview with Openshift 4.14", "4.14", models.ClusterCPUArchitectureX8664, models.SupportLevelDevPreview),
+			Entry("Proxmox dev-preview with Openshift 4.15 on arm64", "4.15", models.ClusterCPUArchitectureArm64, models.SupportLevelDevPreview),
+			Entry("Proxmox unavailable on s390x", "4.15", models.ClusterCPUArchitectureS390x, models.SupportLevelUnavailable),
+		)
+
+		It("is incompatible with cluster managed networking", func() {
+			cluster := common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion:      "4.15",
+				CPUArchitecture:       models.ClusterCPUArchitectureX8664,
+				UserManagedNetworking: swag.Bool(false),
+				Platform: &models.Platform{
+					Type:     models.PlatformTypeExternal.Pointer(),
+					External: &models.PlatformExternal{PlatformName: swag.String(common.ExternalPlatformNameProxmox)},
+				},
+			}}
+			Expect(featuresList[models.FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX].getFeatureActiveLevel(&cluster, nil, nil, nil)).To(Equal(activeLevelActive))
+			Expect(featuresList[models.FeatureSupportLevelIDEXTERNALPLATFORMOCI].getFeatureActiveLevel(&cluster, nil, nil, nil)).To(Equal(activeLevelNotActive))
+			Expect(ValidateIncompatibleFeatures(logrus.New(), models.ClusterCPUArchitectureX8664, &cluster, nil, nil)).ToNot(BeNil())
+		})
+	})
+
+	Context("Test OpenStack platform support", func() {
+		DescribeTable(
+			"Validation pass",
+			func(openshiftVersion string, cpuArchitecture string, expectedSupportLevel models.SupportLevel) {
+				filters := SupportLevelFilters{
+					OpenshiftVersion: openshiftVersion,
+					CPUArchitecture:  swag.String(cpuArchitecture),
+				}
+				supportLevel := GetSupportLevel(models.FeatureSupportLevelIDOPENSTACKINTEGRATION, filters)
+				Expect(supportLevel).To(Equal(expectedSupportLevel))
+			},
+			Entry("OpenStack unavailable with Openshift 4.13", "4.13", models.ClusterCPUArchitectureX8664, models.SupportLevelUnavailable),
+			Entry("OpenStack tech-preview with Openshift 4.14", "4.14", models.ClusterCPUArchitectureX8664, models.SupportLevelTechPreview),
+			Entry("OpenStack tech-preview with Openshift 4.15 on arm64", "4.15", models.ClusterCPUArchitectureArm64, models.SupportLevelTechPreview),
+			Entry("OpenStack unavailable on ppc64le", "4.15", models.ClusterCPUArchitecturePpc64le, models.SupportLevelUnavailable),
+		)
+
+		It("is incompatible with single node OpenShift", func() {
+			cluster := common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion:      "4.15",
+				CPUArchitecture:       models.ClusterCPUArchitectureX8664,
+				ControlPlaneCount:     1,
+				HighAvailabilityMode:  swag.String(models.ClusterHighAvailabilityModeNone),
+				UserManagedNetworking: swag.Bool(true),
+				Platform:              &models.Platform{Type: models.PlatformTypeOpenstack.Pointer()},
+			}}
+			Expect(featuresList[models.FeatureSupportLevelIDOPENSTACKINTEGRATION].getFeatureActiveLevel(&cluster, nil, nil, nil)).To(Equal(activeLevelActive))
+			Expect(ValidateIncompatibleFeatures(logrus.New(), models.ClusterCPUArchitectureX8664, &cluster, nil, nil)).ToNot(BeNil())
+		})
+	})
+
 	Context("GetSupportList", func() {
 
 		for _, filters := range getPlatformFilters() {
@@ -524,19 +593,19 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 			When("GetFeatureSupportList 4.12 with Platform", func() {
 				It(string(*filters.PlatformType)+" "+swag.StringValue(filters.ExternalPlatformName), func() {
 					list := GetFeatureSupportList("dummy", nil, filters.PlatformType, filters.ExternalPlatformName)
-					Expect(len(list)).To(Equal(38))
+					Expect(len(list)).To(Equal(39))
 				})
 			})
 		}
 
 		It("GetFeatureSupportList 4.12", func() {
 			list := GetFeatureSupportList("4.12", nil, nil, nil)
-			Expect(len(list)).To(Equal(43))
+			Expect(len(list)).To(Equal(46))
 		})
 
 		It("GetFeatureSupportList 4.13", func() {
 			list := GetFeatureSupportList("4.13", nil, nil, nil)
-			Expect(len(list)).To(Equal(43))
+			Expect(len(list)).To(Equal(46))
 		})
 
 		It("GetCpuArchitectureSupportList 4.12", func() {
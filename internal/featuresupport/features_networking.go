@@ -38,6 +38,7 @@ func (feature *VipAutoAllocFeature) getIncompatibleFeatures(string) *[]models.Fe
 	return &[]models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDSNO,
 		models.FeatureSupportLevelIDEXTERNALPLATFORMOCI,
+		models.FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX,
 		models.FeatureSupportLevelIDNONEPLATFORM,
 		models.FeatureSupportLevelIDEXTERNALPLATFORM,
 		models.FeatureSupportLevelIDUSERMANAGEDLOADBALANCER,
@@ -130,6 +131,7 @@ func (feature *ClusterManagedNetworkingFeature) getIncompatibleFeatures(string)
 		models.FeatureSupportLevelIDSNO,
 		models.FeatureSupportLevelIDUSERMANAGEDNETWORKING,
 		models.FeatureSupportLevelIDEXTERNALPLATFORMOCI,
+		models.FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX,
 		models.FeatureSupportLevelIDNONEPLATFORM,
 		models.FeatureSupportLevelIDEXTERNALPLATFORM,
 	}
@@ -334,6 +336,7 @@ func (feature *PlatformManagedNetworkingFeature) getIncompatibleFeatures(string)
 	return &[]models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDBAREMETALPLATFORM,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 	}
 }
@@ -500,6 +503,7 @@ func (feature *UserManagedLoadBalancerFeature) getIncompatibleFeatures(string) *
 	return &[]models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDEXTERNALPLATFORM,
 		models.FeatureSupportLevelIDEXTERNALPLATFORMOCI,
+		models.FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX,
 		models.FeatureSupportLevelIDNONEPLATFORM,
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDUSERMANAGEDNETWORKING,
@@ -176,7 +176,7 @@ func (feature *CnvFeature) getSupportLevel(filters SupportLevelFilters) models.S
 		return models.SupportLevelUnavailable
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeOpenstack) {
 		return models.SupportLevelUnavailable
 	}
 
@@ -191,6 +191,7 @@ func (feature *CnvFeature) getIncompatibleFeatures(string) *[]models.FeatureSupp
 	return &[]models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 	}
 }
 
@@ -322,7 +323,7 @@ func (feature *MtvFeature) getSupportLevel(filters SupportLevelFilters) models.S
 		return models.SupportLevelUnavailable
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeOpenstack) {
 		return models.SupportLevelUnavailable
 	}
 
@@ -346,6 +347,7 @@ func (feature *MtvFeature) getIncompatibleFeatures(string) *[]models.FeatureSupp
 	return &[]models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 	}
 }
 
@@ -670,7 +672,7 @@ func (feature *OscFeature) getSupportLevel(filters SupportLevelFilters) models.S
 		return models.SupportLevelUnavailable
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeOpenstack) {
 		return models.SupportLevelUnavailable
 	}
 
@@ -694,6 +696,7 @@ func (feature *OscFeature) getIncompatibleFeatures(string) *[]models.FeatureSupp
 	return &[]models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 	}
 }
 
@@ -748,6 +751,7 @@ func (feature *NmstateFeature) getIncompatibleFeatures(string) *[]models.Feature
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
 		models.FeatureSupportLevelIDEXTERNALPLATFORM,
 		models.FeatureSupportLevelIDEXTERNALPLATFORMOCI,
+		models.FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX,
 	}
 }
 
@@ -1017,3 +1021,74 @@ func (f *KubeDeschedulerFeature) getFeatureActiveLevel(cluster *common.Cluster,
 	}
 	return activeLevelNotActive
 }
+
+// OperatorVersionPinningFeature describes the support for selecting the channel, the starting cluster service
+// version, the catalog source and the install plan approval mode of the OLM operators.
+type OperatorVersionPinningFeature struct{}
+
+func (f *OperatorVersionPinningFeature) New() SupportLevelFeature {
+	return &OperatorVersionPinningFeature{}
+}
+
+func (f *OperatorVersionPinningFeature) getId() models.FeatureSupportLevelID {
+	return models.FeatureSupportLevelIDOPERATORVERSIONPINNING
+}
+
+func (f *OperatorVersionPinningFeature) GetName() string {
+	return "Operator version pinning"
+}
+
+func (f *OperatorVersionPinningFeature) getSupportLevel(filters SupportLevelFilters) models.SupportLevel {
+	if !isFeatureCompatibleWithArchitecture(f, filters.OpenshiftVersion, swag.StringValue(filters.CPUArchitecture)) {
+		return models.SupportLevelUnavailable
+	}
+
+	return models.SupportLevelTechPreview
+}
+
+// getIncompatibleArchitectures returns the multi architecture release image, the catalog source images selected by
+// the users aren't guaranteed to provide the operators for all the architectures of the hosts.
+func (f *OperatorVersionPinningFeature) getIncompatibleArchitectures(_ *string) *[]models.ArchitectureSupportLevelID {
+	return &[]models.ArchitectureSupportLevelID{
+		models.ArchitectureSupportLevelIDMULTIARCHRELEASEIMAGE,
+	}
+}
+
+func (f *OperatorVersionPinningFeature) getIncompatibleFeatures(string) *[]models.FeatureSupportLevelID {
+	return &[]models.FeatureSupportLevelID{}
+}
+
+func (f *OperatorVersionPinningFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *models.InfraEnv, clusterUpdateParams *models.V2ClusterUpdateParams, _ *models.InfraEnvUpdateParams) featureActiveLevel {
+	if len(getPinnedOperators(cluster, clusterUpdateParams)) > 0 {
+		return activeLevelActive
+	}
+	return activeLevelNotActive
+}
+
+// getPinnedOperators returns the OLM operators, either of the update parameters or of the cluster when the update
+// parameters don't change them, that select the channel, version, catalog source or install plan approval mode.
+func getPinnedOperators(cluster *common.Cluster, updateParams *models.V2ClusterUpdateParams) []*models.OperatorCreateParams {
+	var operators []*models.OperatorCreateParams
+	if updateParams != nil && updateParams.OlmOperators != nil {
+		operators = updateParams.OlmOperators
+	} else if cluster != nil {
+		for _, operator := range cluster.MonitoredOperators {
+			operators = append(operators, &models.OperatorCreateParams{
+				Name:                operator.Name,
+				Channel:             operator.Channel,
+				StartingCsv:         operator.StartingCsv,
+				CatalogSource:       operator.CatalogSource,
+				CatalogSourceImage:  operator.CatalogSourceImage,
+				InstallPlanApproval: operator.InstallPlanApproval,
+			})
+		}
+	}
+	var pinned []*models.OperatorCreateParams
+	for _, operator := range operators {
+		if operator.Channel != "" || operator.StartingCsv != "" || operator.CatalogSource != "" ||
+			operator.CatalogSourceImage != "" || operator.InstallPlanApproval != "" {
+			pinned = append(pinned, operator)
+		}
+	}
+	return pinned
+}
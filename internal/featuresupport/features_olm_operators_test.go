@@ -338,4 +338,47 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 			Entry("on Nutanix", "4.12", common.X86CPUArchitecture, models.PlatformTypeExternal, models.SupportLevelUnavailable),
 		)
 	})
+
+	Context("Test operator version pinning feature", func() {
+		var feature *OperatorVersionPinningFeature
+
+		BeforeEach(func() {
+			feature = &OperatorVersionPinningFeature{}
+		})
+
+		It("is not active when the operators use the defaults", func() {
+			cluster := &common.Cluster{Cluster: models.Cluster{
+				MonitoredOperators: []*models.MonitoredOperator{{Name: "lvm"}},
+			}}
+			Expect(feature.getFeatureActiveLevel(cluster, nil, nil, nil)).To(Equal(activeLevelNotActive))
+		})
+
+		It("is active when an operator of the cluster is pinned", func() {
+			cluster := &common.Cluster{Cluster: models.Cluster{
+				MonitoredOperators: []*models.MonitoredOperator{{Name: "lvm", Channel: "stable-4.16"}},
+			}}
+			Expect(feature.getFeatureActiveLevel(cluster, nil, nil, nil)).To(Equal(activeLevelActive))
+		})
+
+		It("uses the operators of the update parameters", func() {
+			cluster := &common.Cluster{Cluster: models.Cluster{
+				MonitoredOperators: []*models.MonitoredOperator{{Name: "lvm", Channel: "stable-4.16"}},
+			}}
+			updateParams := &models.V2ClusterUpdateParams{
+				OlmOperators: []*models.OperatorCreateParams{{Name: "lvm"}},
+			}
+			Expect(feature.getFeatureActiveLevel(cluster, nil, updateParams, nil)).To(Equal(activeLevelNotActive))
+			updateParams.OlmOperators[0].CatalogSource = "mirrored-operators"
+			Expect(feature.getFeatureActiveLevel(cluster, nil, updateParams, nil)).To(Equal(activeLevelActive))
+		})
+
+		It("is tech preview", func() {
+			filters := SupportLevelFilters{OpenshiftVersion: "4.16", CPUArchitecture: swag.String(models.ClusterCPUArchitectureArm64)}
+			Expect(feature.getSupportLevel(filters)).To(Equal(models.SupportLevelTechPreview))
+		})
+
+		It("is not available with the multi architecture release image", func() {
+			Expect(IsFeatureAvailable(models.FeatureSupportLevelIDOPERATORVERSIONPINNING, "4.16", swag.String(models.ClusterCPUArchitectureMulti))).To(BeFalse())
+		})
+	})
 })
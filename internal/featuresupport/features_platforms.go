@@ -262,6 +262,64 @@ func (feature *VsphereIntegrationFeature) getIncompatibleArchitectures(_ *string
 	}
 }
 
+// OpenstackIntegrationFeature
+type OpenstackIntegrationFeature struct{}
+
+func (feature *OpenstackIntegrationFeature) New() SupportLevelFeature {
+	return &OpenstackIntegrationFeature{}
+}
+
+func (feature *OpenstackIntegrationFeature) getId() models.FeatureSupportLevelID {
+	return models.FeatureSupportLevelIDOPENSTACKINTEGRATION
+}
+
+func (feature *OpenstackIntegrationFeature) GetName() string {
+	return "OpenStack Platform Integration"
+}
+
+func (feature *OpenstackIntegrationFeature) getSupportLevel(filters SupportLevelFilters) models.SupportLevel {
+	if isPlatformSet(filters) {
+		return ""
+	}
+
+	if !isFeatureCompatibleWithArchitecture(feature, filters.OpenshiftVersion, swag.StringValue(filters.CPUArchitecture)) {
+		return models.SupportLevelUnavailable
+	}
+
+	if isSupported, err := common.BaseVersionGreaterOrEqual("4.14", filters.OpenshiftVersion); isSupported || err != nil {
+		return models.SupportLevelTechPreview
+	}
+
+	return models.SupportLevelUnavailable
+}
+
+func (feature *OpenstackIntegrationFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *models.InfraEnv, clusterUpdateParams *models.V2ClusterUpdateParams, _ *models.InfraEnvUpdateParams) featureActiveLevel {
+	if isPlatformActive(cluster, clusterUpdateParams, models.PlatformTypeOpenstack) {
+		return activeLevelActive
+	}
+
+	return activeLevelNotActive
+}
+
+func (feature *OpenstackIntegrationFeature) getIncompatibleFeatures(string) *[]models.FeatureSupportLevelID {
+	return &[]models.FeatureSupportLevelID{
+		models.FeatureSupportLevelIDSNO,
+		models.FeatureSupportLevelIDTNA,
+		models.FeatureSupportLevelIDPLATFORMMANAGEDNETWORKING,
+		models.FeatureSupportLevelIDCNV,
+		models.FeatureSupportLevelIDMTV,
+		models.FeatureSupportLevelIDNONSTANDARDHACONTROLPLANE,
+		models.FeatureSupportLevelIDOSC,
+	}
+}
+
+func (feature *OpenstackIntegrationFeature) getIncompatibleArchitectures(_ *string) *[]models.ArchitectureSupportLevelID {
+	return &[]models.ArchitectureSupportLevelID{
+		models.ArchitectureSupportLevelIDS390XARCHITECTURE,
+		models.ArchitectureSupportLevelIDPPC64LEARCHITECTURE,
+	}
+}
+
 // OciIntegrationFeature
 type OciIntegrationFeature struct{}
 
@@ -323,6 +381,63 @@ func (feature *OciIntegrationFeature) getFeatureActiveLevel(cluster *common.Clus
 	return activeLevelNotActive
 }
 
+// ProxmoxIntegrationFeature
+type ProxmoxIntegrationFeature struct{}
+
+func (feature *ProxmoxIntegrationFeature) New() SupportLevelFeature {
+	return &ProxmoxIntegrationFeature{}
+}
+
+func (feature *ProxmoxIntegrationFeature) getId() models.FeatureSupportLevelID {
+	return models.FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX
+}
+
+func (feature *ProxmoxIntegrationFeature) GetName() string {
+	return "Proxmox VE external platform"
+}
+
+func (feature *ProxmoxIntegrationFeature) getSupportLevel(filters SupportLevelFilters) models.SupportLevel {
+	if isPlatformSet(filters) {
+		return ""
+	}
+
+	if !isFeatureCompatibleWithArchitecture(feature, filters.OpenshiftVersion, swag.StringValue(filters.CPUArchitecture)) {
+		return models.SupportLevelUnavailable
+	}
+
+	if isSupported, err := common.BaseVersionGreaterOrEqual("4.14", filters.OpenshiftVersion); isSupported || err != nil {
+		return models.SupportLevelDevPreview
+	}
+
+	return models.SupportLevelUnavailable
+}
+
+func (feature *ProxmoxIntegrationFeature) getIncompatibleFeatures(string) *[]models.FeatureSupportLevelID {
+	return &[]models.FeatureSupportLevelID{
+		models.FeatureSupportLevelIDTNA,
+		models.FeatureSupportLevelIDCLUSTERMANAGEDNETWORKING,
+		models.FeatureSupportLevelIDVIPAUTOALLOC,
+		models.FeatureSupportLevelIDNONSTANDARDHACONTROLPLANE,
+		models.FeatureSupportLevelIDUSERMANAGEDLOADBALANCER,
+		models.FeatureSupportLevelIDNMSTATE,
+	}
+}
+
+func (feature *ProxmoxIntegrationFeature) getIncompatibleArchitectures(_ *string) *[]models.ArchitectureSupportLevelID {
+	return &[]models.ArchitectureSupportLevelID{
+		models.ArchitectureSupportLevelIDS390XARCHITECTURE,
+		models.ArchitectureSupportLevelIDPPC64LEARCHITECTURE,
+	}
+}
+
+func (feature *ProxmoxIntegrationFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *models.InfraEnv, clusterUpdateParams *models.V2ClusterUpdateParams, _ *models.InfraEnvUpdateParams) featureActiveLevel {
+	if isPlatformActive(cluster, clusterUpdateParams, models.PlatformTypeExternal) && isExternalIntegrationActive(cluster, clusterUpdateParams, common.ExternalPlatformNameProxmox) {
+		return activeLevelActive
+	}
+
+	return activeLevelNotActive
+}
+
 // ExternalPlatformFeature
 type ExternalPlatformFeature struct{}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/infraenv"
 	"github.com/openshift/assisted-service/pkg/leader"
@@ -20,6 +21,7 @@ type Config struct {
 	InfraenvDeleteInactiveAfter time.Duration `envconfig:"INFRAENV_DELETED_INACTIVE_AFTER" default:"480h"` // 20d
 	MaxGCClustersPerInterval    int           `envconfig:"MAX_GC_CLUSTERS_PER_INTERVAL" default:"100"`
 	MaxGCInfraEnvsPerInterval   int           `envconfig:"MAX_GC_INFRAENVS_PER_INTERVAL" default:"100"`
+	AuditRecordsRetention       time.Duration `envconfig:"AUDIT_RECORDS_RETENTION" default:"2160h"` // 90d
 }
 
 func NewGarbageCollectors(
@@ -106,3 +108,18 @@ func (g garbageCollector) DeleteOrphans() {
 		g.log.WithError(err).Errorf("Failed to delete orphan hosts")
 	}
 }
+
+func (g garbageCollector) DeleteExpiredAuditRecords() {
+	if !g.leaderElector.IsLeader() {
+		return
+	}
+	olderThan := time.Now().Add(-g.Config.AuditRecordsRetention)
+	reply := g.db.Where("created_at < ?", olderThan).Delete(&common.AuditRecord{})
+	if reply.Error != nil {
+		g.log.WithError(reply.Error).Errorf("Failed to delete audit records created before %s", olderThan)
+		return
+	}
+	if reply.RowsAffected > 0 {
+		g.log.Infof("Deleted %d audit records created before %s", reply.RowsAffected, olderThan)
+	}
+}
@@ -0,0 +1,89 @@
+package gencrypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	secretKeySize    = 32
+	secretKeyIDLen   = 4
+	secretFormatV1   = "v1"
+	secretFieldCount = 3
+)
+
+// SecretCipher encrypts short secrets, such as webhook signing secrets, so that they are not stored
+// in the database in plaintext. Secrets are sealed with AES-256-GCM and bound to associated data,
+// usually the ID of the record holding them, so that a sealed secret can't be copied to another record.
+type SecretCipher struct {
+	aead  cipher.AEAD
+	keyID string
+}
+
+// NewSecretCipherFromFile reads the base64 encoded 256 bit key from the file, usually mounted from a secret
+func NewSecretCipherFromFile(keyFile string) (*SecretCipher, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read secret key file %s", keyFile)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != secretKeySize {
+		return nil, errors.Errorf("secret key file %s must hold a base64 encoded %d bytes key", keyFile, secretKeySize)
+	}
+	return NewSecretCipher(key)
+}
+
+func NewSecretCipher(key []byte) (*SecretCipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	keyHash := sha256.Sum256(key)
+	return &SecretCipher{
+		aead:  aead,
+		keyID: hex.EncodeToString(keyHash[:secretKeyIDLen]),
+	}, nil
+}
+
+// Encrypt returns the sealed secret in the "v1:<key id>:<base64 nonce and ciphertext>" form
+func (c *SecretCipher) Encrypt(secret, associatedData string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(secret), []byte(associatedData))
+	return fmt.Sprintf("%s:%s:%s", secretFormatV1, c.keyID, base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+func (c *SecretCipher) Decrypt(sealedSecret, associatedData string) (string, error) {
+	fields := strings.SplitN(sealedSecret, ":", secretFieldCount)
+	if len(fields) != secretFieldCount || fields[0] != secretFormatV1 {
+		return "", errors.New("unsupported sealed secret format")
+	}
+	if fields[1] != c.keyID {
+		return "", errors.Errorf("secret was sealed with key %s, the configured key is %s", fields[1], c.keyID)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(fields[2])
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return "", errors.New("malformed sealed secret")
+	}
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	secret, err := c.aead.Open(nil, nonce, ciphertext, []byte(associatedData))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt secret")
+	}
+	return string(secret), nil
+}
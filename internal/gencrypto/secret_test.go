@@ -0,0 +1,72 @@
+package gencrypto
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretCipher", func() {
+	var (
+		key    = []byte(strings.Repeat("k", secretKeySize))
+		cipher *SecretCipher
+	)
+
+	BeforeEach(func() {
+		var err error
+		cipher, err = NewSecretCipher(key)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("decrypts what it encrypted", func() {
+		sealed, err := cipher.Encrypt("secret", "record")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sealed).ToNot(ContainSubstring("secret"))
+		Expect(sealed).To(HavePrefix("v1:"))
+		secret, err := cipher.Decrypt(sealed, "record")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret).To(Equal("secret"))
+	})
+
+	It("does not decrypt a secret bound to another record", func() {
+		sealed, err := cipher.Encrypt("secret", "record")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cipher.Decrypt(sealed, "other")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reports secrets sealed with another key", func() {
+		other, err := NewSecretCipher([]byte(strings.Repeat("o", secretKeySize)))
+		Expect(err).ToNot(HaveOccurred())
+		sealed, err := other.Encrypt("secret", "record")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cipher.Decrypt(sealed, "record")
+		Expect(err).To(MatchError(ContainSubstring("was sealed with key")))
+	})
+
+	It("rejects plaintext secrets", func() {
+		_, err := cipher.Decrypt("secret", "record")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reads the key from a file", func() {
+		dir, err := os.MkdirTemp("", "secret-key")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		keyFile := filepath.Join(dir, "key")
+		Expect(os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600)).To(Succeed())
+		fromFile, err := NewSecretCipherFromFile(keyFile)
+		Expect(err).ToNot(HaveOccurred())
+		sealed, err := cipher.Encrypt("secret", "record")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fromFile.Decrypt(sealed, "record")).To(Equal("secret"))
+
+		Expect(os.WriteFile(keyFile, []byte("c2hvcnQ="), 0600)).To(Succeed())
+		_, err = NewSecretCipherFromFile(keyFile)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,324 @@
+package fingerprint
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/thoas/go-funk"
+)
+
+type signal string
+
+const (
+	signalManufacturer signal = "manufacturer"
+	signalProductName  signal = "product name"
+	signalSerialNumber signal = "serial number"
+	signalNICVendor    signal = "NIC vendor"
+	signalDiskModel    signal = "disk model"
+)
+
+// The SMBIOS values are set by the hypervisor or the cloud and are the most reliable signals, the NICs and
+// disks only tell which emulated or para-virtualized devices the host uses.
+var signalWeights = map[signal]int{
+	signalManufacturer: 3,
+	signalProductName:  3,
+	signalSerialNumber: 2,
+	signalNICVendor:    1,
+	signalDiskModel:    1,
+}
+
+const (
+	highConfidenceScore   = 4
+	mediumConfidenceScore = 3
+)
+
+// rule matches a lower case value of the inventory with a pattern, either as a prefix or as a substring
+type rule struct {
+	environment string
+	signal      signal
+	pattern     string
+	prefix      bool
+}
+
+var rules = []rule{
+	{environment: models.HostFingerprintEnvironmentVsphere, signal: signalManufacturer, pattern: "vmware"},
+	{environment: models.HostFingerprintEnvironmentVsphere, signal: signalProductName, pattern: "vmware"},
+	{environment: models.HostFingerprintEnvironmentVsphere, signal: signalSerialNumber, pattern: "vmware-", prefix: true},
+	{environment: models.HostFingerprintEnvironmentVsphere, signal: signalNICVendor, pattern: "0x15ad"},
+	{environment: models.HostFingerprintEnvironmentVsphere, signal: signalDiskModel, pattern: "vmware"},
+
+	{environment: models.HostFingerprintEnvironmentNutanix, signal: signalManufacturer, pattern: "nutanix"},
+	{environment: models.HostFingerprintEnvironmentNutanix, signal: signalProductName, pattern: "ahv", prefix: true},
+	{environment: models.HostFingerprintEnvironmentNutanix, signal: signalDiskModel, pattern: "nutanix"},
+
+	{environment: models.HostFingerprintEnvironmentOpenstack, signal: signalManufacturer, pattern: "openstack"},
+	{environment: models.HostFingerprintEnvironmentOpenstack, signal: signalProductName, pattern: "openstack"},
+
+	{environment: models.HostFingerprintEnvironmentOci, signal: signalManufacturer, pattern: "oraclecloud.com"},
+	{environment: models.HostFingerprintEnvironmentOci, signal: signalProductName, pattern: "oraclecloud.com"},
+
+	{environment: models.HostFingerprintEnvironmentProxmox, signal: signalManufacturer, pattern: "proxmox"},
+	{environment: models.HostFingerprintEnvironmentProxmox, signal: signalProductName, pattern: "proxmox"},
+
+	{environment: models.HostFingerprintEnvironmentKvm, signal: signalManufacturer, pattern: "qemu"},
+	{environment: models.HostFingerprintEnvironmentKvm, signal: signalProductName, pattern: "kvm"},
+	{environment: models.HostFingerprintEnvironmentKvm, signal: signalProductName, pattern: "standard pc (", prefix: true},
+	{environment: models.HostFingerprintEnvironmentKvm, signal: signalNICVendor, pattern: "0x1af4"},
+	{environment: models.HostFingerprintEnvironmentKvm, signal: signalDiskModel, pattern: "qemu"},
+
+	{environment: models.HostFingerprintEnvironmentHyperv, signal: signalManufacturer, pattern: "microsoft corporation"},
+	{environment: models.HostFingerprintEnvironmentHyperv, signal: signalProductName, pattern: "virtual machine"},
+	{environment: models.HostFingerprintEnvironmentHyperv, signal: signalDiskModel, pattern: "msft"},
+
+	{environment: models.HostFingerprintEnvironmentXen, signal: signalManufacturer, pattern: "xen"},
+	{environment: models.HostFingerprintEnvironmentXen, signal: signalProductName, pattern: "hvm domu"},
+	{environment: models.HostFingerprintEnvironmentXen, signal: signalNICVendor, pattern: "0x5853"},
+
+	{environment: models.HostFingerprintEnvironmentVirtualbox, signal: signalManufacturer, pattern: "innotek"},
+	{environment: models.HostFingerprintEnvironmentVirtualbox, signal: signalProductName, pattern: "virtualbox"},
+	{environment: models.HostFingerprintEnvironmentVirtualbox, signal: signalDiskModel, pattern: "vbox"},
+
+	{environment: models.HostFingerprintEnvironmentAws, signal: signalManufacturer, pattern: "amazon ec2"},
+	{environment: models.HostFingerprintEnvironmentAws, signal: signalNICVendor, pattern: "0x1d0f"},
+	{environment: models.HostFingerprintEnvironmentAws, signal: signalDiskModel, pattern: "amazon elastic block store"},
+
+	{environment: models.HostFingerprintEnvironmentGcp, signal: signalManufacturer, pattern: "google"},
+	{environment: models.HostFingerprintEnvironmentGcp, signal: signalProductName, pattern: "google compute engine"},
+	{environment: models.HostFingerprintEnvironmentGcp, signal: signalSerialNumber, pattern: "googlecloud-", prefix: true},
+	{environment: models.HostFingerprintEnvironmentGcp, signal: signalNICVendor, pattern: "0x1ae0"},
+	{environment: models.HostFingerprintEnvironmentGcp, signal: signalDiskModel, pattern: "persistentdisk"},
+}
+
+// kvmBasedEnvironments run KVM guests, so the generic KVM signals (e.g. virtio NICs) corroborate them
+var kvmBasedEnvironments = []string{
+	models.HostFingerprintEnvironmentNutanix,
+	models.HostFingerprintEnvironmentOpenstack,
+	models.HostFingerprintEnvironmentOci,
+	models.HostFingerprintEnvironmentProxmox,
+}
+
+func (r rule) matches(value string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return false
+	}
+	if r.prefix {
+		return strings.HasPrefix(value, r.pattern)
+	}
+	return strings.Contains(value, r.pattern)
+}
+
+type match struct {
+	signal signal
+	value  string
+}
+
+func (m match) String() string {
+	return fmt.Sprintf("%s: %s", m.signal, m.value)
+}
+
+// Classify detects the hypervisor or the cloud that a host runs on from its inventory. Every inventory value
+// matching a rule adds the weight of its signal to the score of the rule environment, the environment with the
+// highest score wins. The confidence is lowered when the values of the inventory point to other environments too.
+func Classify(inventory *models.Inventory) *models.HostFingerprint {
+	if inventory == nil {
+		return &models.HostFingerprint{
+			Environment: models.HostFingerprintEnvironmentUnknown,
+			Confidence:  models.HostFingerprintConfidenceLow,
+		}
+	}
+
+	matches := make(map[string][]match)
+	for _, value := range inventoryValues(inventory) {
+		for _, r := range rules {
+			if r.signal == value.signal && r.matches(value.value) && !hasMatch(matches[r.environment], value) {
+				matches[r.environment] = append(matches[r.environment], value)
+			}
+		}
+	}
+
+	scores := make(map[string]int)
+	for environment, environmentMatches := range matches {
+		for _, m := range environmentMatches {
+			scores[environment] += signalWeights[m.signal]
+		}
+	}
+	for _, environment := range kvmBasedEnvironments {
+		if scores[environment] > 0 {
+			scores[environment] += scores[models.HostFingerprintEnvironmentKvm]
+		}
+	}
+
+	environment := ""
+	for _, r := range rules {
+		if score := scores[r.environment]; score > scores[environment] {
+			environment = r.environment
+		}
+	}
+	if environment == "" {
+		return classifyWithoutMatches(inventory)
+	}
+
+	signals := make([]string, 0)
+	for _, m := range matches[environment] {
+		signals = append(signals, m.String())
+	}
+	if funk.ContainsString(kvmBasedEnvironments, environment) {
+		for _, m := range matches[models.HostFingerprintEnvironmentKvm] {
+			signals = append(signals, m.String())
+		}
+	}
+
+	confidence := confidenceOf(scores[environment])
+	if hasConflictingMatches(environment, scores) {
+		confidence = lowerConfidence(confidence)
+	}
+
+	return &models.HostFingerprint{
+		Environment: environment,
+		Confidence:  confidence,
+		Signals:     signals,
+	}
+}
+
+func inventoryValues(inventory *models.Inventory) []match {
+	var values []match
+	if inventory.SystemVendor != nil {
+		values = append(values,
+			match{signal: signalManufacturer, value: inventory.SystemVendor.Manufacturer},
+			match{signal: signalProductName, value: inventory.SystemVendor.ProductName},
+			match{signal: signalSerialNumber, value: inventory.SystemVendor.SerialNumber})
+	}
+	for _, intf := range inventory.Interfaces {
+		if intf != nil {
+			values = append(values, match{signal: signalNICVendor, value: intf.Vendor})
+		}
+	}
+	for _, disk := range inventory.Disks {
+		if disk != nil {
+			values = append(values,
+				match{signal: signalDiskModel, value: disk.Vendor},
+				match{signal: signalDiskModel, value: disk.Model})
+		}
+	}
+	return values
+}
+
+func hasMatch(matches []match, value match) bool {
+	for _, m := range matches {
+		if m.signal == value.signal {
+			return true
+		}
+	}
+	return false
+}
+
+// classifyWithoutMatches is used when no inventory value points to a known hypervisor or cloud
+func classifyWithoutMatches(inventory *models.Inventory) *models.HostFingerprint {
+	if inventory.SystemVendor == nil {
+		return &models.HostFingerprint{
+			Environment: models.HostFingerprintEnvironmentUnknown,
+			Confidence:  models.HostFingerprintConfidenceLow,
+		}
+	}
+	if inventory.SystemVendor.Virtual {
+		return &models.HostFingerprint{
+			Environment: models.HostFingerprintEnvironmentUnknown,
+			Confidence:  models.HostFingerprintConfidenceMedium,
+			Signals:     []string{"virtual: true"},
+		}
+	}
+	return &models.HostFingerprint{
+		Environment: models.HostFingerprintEnvironmentBaremetal,
+		Confidence:  models.HostFingerprintConfidenceMedium,
+		Signals:     []string{"virtual: false"},
+	}
+}
+
+func confidenceOf(score int) string {
+	switch {
+	case score >= highConfidenceScore:
+		return models.HostFingerprintConfidenceHigh
+	case score >= mediumConfidenceScore:
+		return models.HostFingerprintConfidenceMedium
+	default:
+		return models.HostFingerprintConfidenceLow
+	}
+}
+
+func lowerConfidence(confidence string) string {
+	if confidence == models.HostFingerprintConfidenceHigh {
+		return models.HostFingerprintConfidenceMedium
+	}
+	return models.HostFingerprintConfidenceLow
+}
+
+func hasConflictingMatches(environment string, scores map[string]int) bool {
+	for other, score := range scores {
+		if other == environment || score == 0 {
+			continue
+		}
+		// The generic KVM signals corroborate the KVM based environments
+		if other == models.HostFingerprintEnvironmentKvm && funk.ContainsString(kvmBasedEnvironments, environment) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// FromHost classifies the host by its inventory, it returns nil if the host has not sent its inventory yet
+func FromHost(host *models.Host) (*models.HostFingerprint, error) {
+	// during the discovery there is a short time that host didn't return its inventory to the service
+	if host.Inventory == "" {
+		return nil, nil
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling host to inventory, error %w", err)
+	}
+	return Classify(inventory), nil
+}
+
+// IsHostInEnvironment checks if the host runs on one of the environments, fingerprints with a low
+// confidence are not trusted
+func IsHostInEnvironment(host *models.Host, environments ...string) (bool, error) {
+	fingerprint, err := FromHost(host)
+	if err != nil || fingerprint == nil {
+		return false, err
+	}
+	return fingerprint.Confidence != models.HostFingerprintConfidenceLow &&
+		funk.ContainsString(environments, fingerprint.Environment), nil
+}
+
+// Marshal serializes the fingerprint of the inventory to be stored in the hardware_fingerprint field of the host
+func Marshal(inventory *models.Inventory) (string, error) {
+	data, err := json.Marshal(Classify(inventory))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ForHosts returns the environment that all the hosts run on, or an empty string if the hosts run on different
+// environments or one of them could not be classified
+func ForHosts(hosts []*models.Host) (string, error) {
+	environment := ""
+	for _, host := range hosts {
+		fingerprint, err := FromHost(host)
+		if err != nil {
+			return "", err
+		}
+		if fingerprint == nil || fingerprint.Environment == models.HostFingerprintEnvironmentUnknown {
+			return "", nil
+		}
+		if environment != "" && environment != fingerprint.Environment {
+			return "", nil
+		}
+		environment = fingerprint.Environment
+	}
+	return environment, nil
+}
@@ -0,0 +1,161 @@
+package fingerprint
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+func inventoryStr(inventory *models.Inventory) string {
+	data, err := json.Marshal(inventory)
+	Expect(err).ToNot(HaveOccurred())
+	return string(data)
+}
+
+var _ = Describe("Classify", func() {
+	DescribeTable("environment and confidence",
+		func(inventory *models.Inventory, environment string, confidence string) {
+			fingerprint := Classify(inventory)
+			Expect(fingerprint.Environment).To(Equal(environment))
+			Expect(fingerprint.Confidence).To(Equal(confidence))
+		},
+		Entry("no inventory", nil, models.HostFingerprintEnvironmentUnknown, models.HostFingerprintConfidenceLow),
+		Entry("no system vendor", &models.Inventory{}, models.HostFingerprintEnvironmentUnknown, models.HostFingerprintConfidenceLow),
+		Entry("bare metal",
+			&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "Dell Inc.", ProductName: "PowerEdge R640"}},
+			models.HostFingerprintEnvironmentBaremetal, models.HostFingerprintConfidenceMedium),
+		Entry("unknown virtual machine",
+			&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "Acme", Virtual: true}},
+			models.HostFingerprintEnvironmentUnknown, models.HostFingerprintConfidenceMedium),
+		Entry("vSphere",
+			&models.Inventory{
+				SystemVendor: &models.SystemVendor{Manufacturer: "VMware, Inc.", ProductName: "VMware7,1", SerialNumber: "VMware-42 1c 8a", Virtual: true},
+				Interfaces:   []*models.Interface{{Name: "ens192", Vendor: "0x15ad"}},
+			},
+			models.HostFingerprintEnvironmentVsphere, models.HostFingerprintConfidenceHigh),
+		Entry("vSphere with the manufacturer only",
+			&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "VMware, Inc."}},
+			models.HostFingerprintEnvironmentVsphere, models.HostFingerprintConfidenceMedium),
+		Entry("vSphere with an overridden manufacturer",
+			&models.Inventory{
+				SystemVendor: &models.SystemVendor{Manufacturer: "Custom", SerialNumber: "VMware-42 1c 8a", Virtual: true},
+				Interfaces:   []*models.Interface{{Name: "ens192", Vendor: "0x15ad"}},
+				Disks:        []*models.Disk{{Name: "sda", Vendor: "VMware", Model: "Virtual disk"}},
+			},
+			models.HostFingerprintEnvironmentVsphere, models.HostFingerprintConfidenceHigh),
+		Entry("Nutanix",
+			&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "Nutanix", ProductName: "AHV", Virtual: true}},
+			models.HostFingerprintEnvironmentNutanix, models.HostFingerprintConfidenceHigh),
+		Entry("OpenStack with virtio devices",
+			&models.Inventory{
+				SystemVendor: &models.SystemVendor{Manufacturer: "OpenStack Foundation", ProductName: "OpenStack Nova", Virtual: true},
+				Interfaces:   []*models.Interface{{Name: "eth0", Vendor: "0x1af4"}},
+			},
+			models.HostFingerprintEnvironmentOpenstack, models.HostFingerprintConfidenceHigh),
+		Entry("OpenStack with the product name only",
+			&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "Red Hat", ProductName: "OpenStack Compute"}},
+			models.HostFingerprintEnvironmentOpenstack, models.HostFingerprintConfidenceMedium),
+		Entry("OCI",
+			&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "OracleCloud.com", ProductName: "Standard PC (i440FX + PIIX, 1996)"}},
+			models.HostFingerprintEnvironmentOci, models.HostFingerprintConfidenceHigh),
+		Entry("Proxmox VE",
+			&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "Proxmox Server Solutions GmbH", Virtual: true}},
+			models.HostFingerprintEnvironmentProxmox, models.HostFingerprintConfidenceMedium),
+		Entry("QEMU guest",
+			&models.Inventory{
+				SystemVendor: &models.SystemVendor{Manufacturer: "QEMU", ProductName: "Standard PC (Q35 + ICH9, 2009)", Virtual: true},
+				Disks:        []*models.Disk{{Name: "sda", Model: "QEMU HARDDISK"}},
+			},
+			models.HostFingerprintEnvironmentKvm, models.HostFingerprintConfidenceHigh),
+		Entry("KVM guest with virtio devices only",
+			&models.Inventory{
+				SystemVendor: &models.SystemVendor{Manufacturer: "Acme", Virtual: true},
+				Interfaces:   []*models.Interface{{Name: "eth0", Vendor: "0x1af4"}, {Name: "eth1", Vendor: "0x1af4"}},
+			},
+			models.HostFingerprintEnvironmentKvm, models.HostFingerprintConfidenceLow),
+		Entry("Hyper-V",
+			&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "Microsoft Corporation", ProductName: "Virtual Machine", Virtual: true}},
+			models.HostFingerprintEnvironmentHyperv, models.HostFingerprintConfidenceHigh),
+		Entry("AWS",
+			&models.Inventory{
+				SystemVendor: &models.SystemVendor{Manufacturer: "Amazon EC2", ProductName: "m5.xlarge", Virtual: true},
+				Interfaces:   []*models.Interface{{Name: "ens5", Vendor: "0x1d0f"}},
+			},
+			models.HostFingerprintEnvironmentAws, models.HostFingerprintConfidenceHigh),
+		Entry("vSphere labels with virtio devices",
+			&models.Inventory{
+				SystemVendor: &models.SystemVendor{Manufacturer: "VMware, Inc.", ProductName: "VMware7,1", Virtual: true},
+				Interfaces:   []*models.Interface{{Name: "eth0", Vendor: "0x1af4"}},
+			},
+			models.HostFingerprintEnvironmentVsphere, models.HostFingerprintConfidenceMedium),
+	)
+
+	It("lists the matching signals", func() {
+		fingerprint := Classify(&models.Inventory{
+			SystemVendor: &models.SystemVendor{Manufacturer: "OpenStack Foundation", ProductName: "OpenStack Nova", Virtual: true},
+			Interfaces:   []*models.Interface{{Name: "eth0", Vendor: "0x1af4"}},
+		})
+		Expect(fingerprint.Signals).To(ConsistOf(
+			"manufacturer: OpenStack Foundation",
+			"product name: OpenStack Nova",
+			"NIC vendor: 0x1af4",
+		))
+	})
+})
+
+var _ = Describe("IsHostInEnvironment", func() {
+	It("host without inventory", func() {
+		supported, err := IsHostInEnvironment(&models.Host{}, models.HostFingerprintEnvironmentVsphere)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(supported).To(BeFalse())
+	})
+
+	It("host with an invalid inventory", func() {
+		supported, err := IsHostInEnvironment(&models.Host{Inventory: "invalid-inventory"}, models.HostFingerprintEnvironmentVsphere)
+		Expect(err).To(HaveOccurred())
+		Expect(supported).To(BeFalse())
+	})
+
+	It("host in one of the environments", func() {
+		host := &models.Host{Inventory: inventoryStr(&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "QEMU"}})}
+		supported, err := IsHostInEnvironment(host, models.HostFingerprintEnvironmentProxmox, models.HostFingerprintEnvironmentKvm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(supported).To(BeTrue())
+	})
+
+	It("low confidence fingerprints are not trusted", func() {
+		host := &models.Host{Inventory: inventoryStr(&models.Inventory{
+			SystemVendor: &models.SystemVendor{Manufacturer: "Acme", Virtual: true},
+			Interfaces:   []*models.Interface{{Name: "ens192", Vendor: "0x15ad"}},
+		})}
+		supported, err := IsHostInEnvironment(host, models.HostFingerprintEnvironmentVsphere)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(supported).To(BeFalse())
+	})
+})
+
+var _ = Describe("ForHosts", func() {
+	vsphereHost := &models.Host{Inventory: inventoryStr(&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "VMware, Inc."}})}
+	nutanixHost := &models.Host{Inventory: inventoryStr(&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: "Nutanix"}})}
+
+	It("all the hosts run on the same environment", func() {
+		environment, err := ForHosts([]*models.Host{vsphereHost, vsphereHost})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(environment).To(Equal(models.HostFingerprintEnvironmentVsphere))
+	})
+
+	It("the hosts run on different environments", func() {
+		environment, err := ForHosts([]*models.Host{vsphereHost, nutanixHost})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(environment).To(BeEmpty())
+	})
+
+	It("a host has no inventory", func() {
+		environment, err := ForHosts([]*models.Host{vsphereHost, {}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(environment).To(BeEmpty())
+	})
+})
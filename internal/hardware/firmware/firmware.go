@@ -0,0 +1,72 @@
+package firmware
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	// VirtualizationDisabledReason tells how to enable the virtualization extensions of the CPU.
+	VirtualizationDisabledReason = "The virtualization extensions of the CPU (Intel VT-x or AMD-V) are disabled in the firmware of the host. " +
+		"Enable them in the BIOS/UEFI settings of the host and reboot it"
+
+	// IommuDisabledReason tells how to enable the IOMMU, which is needed to assign devices to virtual machines.
+	IommuDisabledReason = "The IOMMU (Intel VT-d or AMD-Vi) is disabled on the host, so its devices can't be assigned to virtual machines. " +
+		"Enable it in the BIOS/UEFI settings of the host and reboot it"
+
+	// sriovDisabledReasonTemplate tells how to enable SR-IOV for the given network interfaces.
+	sriovDisabledReasonTemplate = "SR-IOV is disabled in the firmware for the network interfaces %s. " +
+		"Enable SR-IOV in the BIOS/UEFI settings of the host and in the settings of these interfaces, and reboot the host"
+)
+
+// Parse parses the firmware settings that the host reported. It returns nil if the host didn't report them yet.
+func Parse(firmware string) (*models.FirmwareInspectionResponse, error) {
+	if firmware == "" {
+		return nil, nil
+	}
+	var report models.FirmwareInspectionResponse
+	if err := json.Unmarshal([]byte(firmware), &report); err != nil {
+		return nil, fmt.Errorf("failed to parse the firmware report: %w", err)
+	}
+	return &report, nil
+}
+
+// IsVirtualizationDisabled returns true if the host reported that the virtualization extensions of its CPU are disabled
+// in its firmware. Some firmwares disable them without hiding the vmx or svm CPU flags, so the flags aren't enough.
+func IsVirtualizationDisabled(report *models.FirmwareInspectionResponse) bool {
+	return report != nil && report.VirtualizationEnabled != nil && !*report.VirtualizationEnabled
+}
+
+// IsIommuDisabled returns true if the host reported that its IOMMU is disabled.
+func IsIommuDisabled(report *models.FirmwareInspectionResponse) bool {
+	return report != nil && report.IommuEnabled != nil && !*report.IommuEnabled
+}
+
+// SriovDisabledInterfaces returns the given network interfaces that the report of the host lists with 0 SR-IOV virtual
+// functions, which is how their SR-IOV capability shows when it's disabled in the firmware. The interfaces that the
+// report doesn't list weren't inspected, so they aren't returned. It returns nil if the host didn't report its firmware
+// settings.
+func SriovDisabledInterfaces(report *models.FirmwareInspectionResponse, names []string) []string {
+	if report == nil {
+		return nil
+	}
+	totalVfs := make(map[string]int64, len(report.SriovInterfaces))
+	for _, iface := range report.SriovInterfaces {
+		totalVfs[iface.Name] = iface.TotalVfs
+	}
+	var disabled []string
+	for _, name := range names {
+		if vfs, ok := totalVfs[name]; ok && vfs == 0 {
+			disabled = append(disabled, name)
+		}
+	}
+	return disabled
+}
+
+// SriovDisabledReason tells how to enable SR-IOV for the given network interfaces.
+func SriovDisabledReason(names []string) string {
+	return fmt.Sprintf(sriovDisabledReasonTemplate, strings.Join(names, ", "))
+}
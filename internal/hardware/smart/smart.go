@@ -0,0 +1,149 @@
+package smart
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// reallocatedSectorCountAttribute is the ID of the ATA SMART attribute that counts the reallocated sectors.
+const reallocatedSectorCountAttribute = 5
+
+// Thresholds are the limits above which a disk is considered unhealthy. A negative limit disables its check.
+type Thresholds struct {
+	CheckOverallHealth    bool  `envconfig:"DISK_HEALTH_CHECK_OVERALL_STATUS" default:"true"`
+	MaxReallocatedSectors int64 `envconfig:"DISK_HEALTH_MAX_REALLOCATED_SECTORS" default:"50"`
+	MaxMediaErrors        int64 `envconfig:"DISK_HEALTH_MAX_MEDIA_ERRORS" default:"0"`
+	MaxPercentageUsed     int64 `envconfig:"DISK_HEALTH_MAX_PERCENTAGE_USED" default:"95"`
+}
+
+// Health is the health information of a disk, as far as its SMART data reports it. Nil fields weren't reported.
+type Health struct {
+	Passed             *bool
+	ReallocatedSectors *int64
+	MediaErrors        *int64
+	PercentageUsed     *int64
+}
+
+// report is the subset of the output of 'smartctl --json' that the agent reports for ATA, NVMe and SCSI disks.
+type report struct {
+	SmartStatus *struct {
+		Passed *bool `json:"passed"`
+	} `json:"smart_status"`
+	AtaSmartAttributes *struct {
+		Table []struct {
+			ID  int64 `json:"id"`
+			Raw struct {
+				Value int64 `json:"value"`
+			} `json:"raw"`
+		} `json:"table"`
+	} `json:"ata_smart_attributes"`
+	NvmeSmartHealthInformationLog *struct {
+		MediaErrors    *int64 `json:"media_errors"`
+		PercentageUsed *int64 `json:"percentage_used"`
+	} `json:"nvme_smart_health_information_log"`
+	ScsiGrownDefectList *int64 `json:"scsi_grown_defect_list"`
+	// The error counter log also counts the errors that the disk corrected, only the uncorrected ones are media errors
+	ScsiErrorCounterLog *struct {
+		Read  *scsiErrorCounters `json:"read"`
+		Write *scsiErrorCounters `json:"write"`
+	} `json:"scsi_error_counter_log"`
+	ScsiPercentageUsedEnduranceIndicator *int64 `json:"scsi_percentage_used_endurance_indicator"`
+	EnduranceUsed                        *struct {
+		CurrentPercent *int64 `json:"current_percent"`
+	} `json:"endurance_used"`
+}
+
+type scsiErrorCounters struct {
+	TotalUncorrectedErrors *int64 `json:"total_uncorrected_errors"`
+}
+
+// Parse parses the SMART data that the agent reports for a disk. It returns nil if the disk has no SMART data.
+func Parse(smart string) (*Health, error) {
+	if smart == "" {
+		return nil, nil
+	}
+	var r report
+	if err := json.Unmarshal([]byte(smart), &r); err != nil {
+		return nil, fmt.Errorf("failed to parse the SMART data: %w", err)
+	}
+	health := &Health{}
+	if r.SmartStatus != nil {
+		health.Passed = r.SmartStatus.Passed
+	}
+	if r.AtaSmartAttributes != nil {
+		for _, attribute := range r.AtaSmartAttributes.Table {
+			if attribute.ID == reallocatedSectorCountAttribute {
+				value := attribute.Raw.Value
+				health.ReallocatedSectors = &value
+			}
+		}
+	}
+	if health.ReallocatedSectors == nil {
+		health.ReallocatedSectors = r.ScsiGrownDefectList
+	}
+	if r.NvmeSmartHealthInformationLog != nil {
+		health.MediaErrors = r.NvmeSmartHealthInformationLog.MediaErrors
+		health.PercentageUsed = r.NvmeSmartHealthInformationLog.PercentageUsed
+	}
+	if health.MediaErrors == nil && r.ScsiErrorCounterLog != nil {
+		health.MediaErrors = sumUncorrectedErrors(r.ScsiErrorCounterLog.Read, r.ScsiErrorCounterLog.Write)
+	}
+	if r.ScsiPercentageUsedEnduranceIndicator != nil {
+		health.PercentageUsed = r.ScsiPercentageUsedEnduranceIndicator
+	}
+	if health.PercentageUsed == nil && r.EnduranceUsed != nil {
+		health.PercentageUsed = r.EnduranceUsed.CurrentPercent
+	}
+	return health, nil
+}
+
+// sumUncorrectedErrors returns the sum of the uncorrected errors of the counters, or nil if none of them reports them.
+func sumUncorrectedErrors(counters ...*scsiErrorCounters) *int64 {
+	var sum *int64
+	for _, c := range counters {
+		if c == nil || c.TotalUncorrectedErrors == nil {
+			continue
+		}
+		if sum == nil {
+			sum = new(int64)
+		}
+		*sum += *c.TotalUncorrectedErrors
+	}
+	return sum
+}
+
+// Problems returns the reasons why the disk is considered unhealthy, or an empty slice if it is healthy.
+func (t Thresholds) Problems(health *Health) []string {
+	var problems []string
+	if health == nil {
+		return problems
+	}
+	if t.CheckOverallHealth && health.Passed != nil && !*health.Passed {
+		problems = append(problems, "the SMART overall-health self-assessment test failed")
+	}
+	if t.MaxReallocatedSectors >= 0 && health.ReallocatedSectors != nil && *health.ReallocatedSectors > t.MaxReallocatedSectors {
+		problems = append(problems, fmt.Sprintf("%d sectors were reallocated, the maximum is %d",
+			*health.ReallocatedSectors, t.MaxReallocatedSectors))
+	}
+	if t.MaxMediaErrors >= 0 && health.MediaErrors != nil && *health.MediaErrors > t.MaxMediaErrors {
+		problems = append(problems, fmt.Sprintf("%d media errors were reported, the maximum is %d",
+			*health.MediaErrors, t.MaxMediaErrors))
+	}
+	if t.MaxPercentageUsed >= 0 && health.PercentageUsed != nil && *health.PercentageUsed > t.MaxPercentageUsed {
+		problems = append(problems, fmt.Sprintf("%d%% of its rated endurance was used, the maximum is %d%%",
+			*health.PercentageUsed, t.MaxPercentageUsed))
+	}
+	return problems
+}
+
+// DiskProblems returns the reasons why the disk is considered unhealthy according to its SMART data. A disk without
+// SMART data, or with SMART data that can't be parsed, is considered healthy.
+func (t Thresholds) DiskProblems(disk *models.Disk) []string {
+	health, err := Parse(disk.Smart)
+	if err != nil {
+		return nil
+	}
+	return t.Problems(health)
+}
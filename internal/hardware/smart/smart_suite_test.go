@@ -0,0 +1,13 @@
+package smart
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSmart(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "smart tests")
+}
@@ -0,0 +1,138 @@
+package smart
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Parse", func() {
+	It("returns nil without SMART data", func() {
+		health, err := Parse("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health).To(BeNil())
+	})
+
+	It("fails on broken SMART data", func() {
+		_, err := Parse("{")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("parses ATA disks", func() {
+		health, err := Parse(`{"smart_status":{"passed":true},"ata_smart_attributes":{"table":[` +
+			`{"id":1,"name":"Raw_Read_Error_Rate","raw":{"value":7}},{"id":5,"name":"Reallocated_Sector_Ct","raw":{"value":12}}]},` +
+			`"endurance_used":{"current_percent":4}}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health).To(Equal(&Health{
+			Passed:             ptr.To(true),
+			ReallocatedSectors: ptr.To(int64(12)),
+			PercentageUsed:     ptr.To(int64(4)),
+		}))
+	})
+
+	It("parses NVMe disks", func() {
+		health, err := Parse(`{"smart_status":{"passed":false},` +
+			`"nvme_smart_health_information_log":{"critical_warning":4,"media_errors":2,"percentage_used":101}}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health).To(Equal(&Health{
+			Passed:         ptr.To(false),
+			MediaErrors:    ptr.To(int64(2)),
+			PercentageUsed: ptr.To(int64(101)),
+		}))
+	})
+
+	It("parses SCSI disks", func() {
+		health, err := Parse(`{"smart_status":{"passed":true},"scsi_grown_defect_list":30,` +
+			`"scsi_error_counter_log":{"read":{"total_uncorrected_errors":1},"write":{"total_uncorrected_errors":2}},` +
+			`"scsi_percentage_used_endurance_indicator":5}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health).To(Equal(&Health{
+			Passed:             ptr.To(true),
+			ReallocatedSectors: ptr.To(int64(30)),
+			MediaErrors:        ptr.To(int64(3)),
+			PercentageUsed:     ptr.To(int64(5)),
+		}))
+	})
+})
+
+var _ = Describe("SCSI error counters", func() {
+	defaults := Thresholds{CheckOverallHealth: true, MaxReallocatedSectors: 50, MaxMediaErrors: 0, MaxPercentageUsed: 95}
+
+	It("doesn't count the corrected errors as media errors", func() {
+		disk := &models.Disk{Smart: `{"smart_status":{"passed":true},"scsi_error_counter_log":{` +
+			`"read":{"errors_corrected_by_eccfast":1,"total_errors_corrected":1,"correction_algorithm_invocations":1,"gigabytes_processed":"12.345","total_uncorrected_errors":0},` +
+			`"write":{"errors_corrected_by_eccdelayed":2,"total_errors_corrected":2,"total_uncorrected_errors":0},` +
+			`"verify":{"total_errors_corrected":3,"total_uncorrected_errors":0}}}`}
+		health, err := Parse(disk.Smart)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.MediaErrors).To(Equal(ptr.To(int64(0))))
+		Expect(defaults.DiskProblems(disk)).To(BeEmpty())
+	})
+
+	It("counts the uncorrected errors as media errors", func() {
+		disk := &models.Disk{Smart: `{"scsi_error_counter_log":{"read":{"total_errors_corrected":5,"total_uncorrected_errors":1}}}`}
+		Expect(defaults.DiskProblems(disk)).To(Equal([]string{"1 media errors were reported, the maximum is 0"}))
+	})
+
+	It("doesn't report media errors without uncorrected error counters", func() {
+		health, err := Parse(`{"scsi_error_counter_log":{"read":{"total_errors_corrected":5}}}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.MediaErrors).To(BeNil())
+	})
+
+	It("doesn't overwrite the NVMe media errors", func() {
+		health, err := Parse(`{"nvme_smart_health_information_log":{"media_errors":0},` +
+			`"scsi_error_counter_log":{"read":{"total_uncorrected_errors":7}}}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.MediaErrors).To(Equal(ptr.To(int64(0))))
+	})
+})
+
+var _ = Describe("Problems", func() {
+	thresholds := Thresholds{
+		CheckOverallHealth:    true,
+		MaxReallocatedSectors: 50,
+		MaxMediaErrors:        0,
+		MaxPercentageUsed:     95,
+	}
+
+	It("accepts healthy disks", func() {
+		Expect(thresholds.Problems(&Health{
+			Passed:             ptr.To(true),
+			ReallocatedSectors: ptr.To(int64(50)),
+			MediaErrors:        ptr.To(int64(0)),
+			PercentageUsed:     ptr.To(int64(95)),
+		})).To(BeEmpty())
+		Expect(thresholds.Problems(&Health{})).To(BeEmpty())
+		Expect(thresholds.Problems(nil)).To(BeEmpty())
+	})
+
+	It("reports each problem", func() {
+		Expect(thresholds.Problems(&Health{
+			Passed:             ptr.To(false),
+			ReallocatedSectors: ptr.To(int64(51)),
+			MediaErrors:        ptr.To(int64(1)),
+			PercentageUsed:     ptr.To(int64(96)),
+		})).To(Equal([]string{
+			"the SMART overall-health self-assessment test failed",
+			"51 sectors were reallocated, the maximum is 50",
+			"1 media errors were reported, the maximum is 0",
+			"96% of its rated endurance was used, the maximum is 95%",
+		}))
+	})
+
+	It("skips disabled checks", func() {
+		disabled := Thresholds{MaxReallocatedSectors: -1, MaxMediaErrors: -1, MaxPercentageUsed: -1}
+		Expect(disabled.Problems(&Health{
+			Passed:             ptr.To(false),
+			ReallocatedSectors: ptr.To(int64(51)),
+			MediaErrors:        ptr.To(int64(1)),
+			PercentageUsed:     ptr.To(int64(96)),
+		})).To(BeEmpty())
+	})
+
+	It("considers disks with broken SMART data healthy", func() {
+		Expect(thresholds.DiskProblems(&models.Disk{Smart: "{"})).To(BeEmpty())
+	})
+})
@@ -16,6 +16,7 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/feature"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -38,6 +39,7 @@ const (
 	iscsiHostIPNotAvailable                 = "Host IP address is not available"
 	iscsiNetworkInterfaceNotFound           = "Cannot find the network interface behind the default route"
 	iscsiHostIPParseErrorTemplate           = "Cannot parse iSCSI host IP %s: %w"
+	unhealthyDiskTemplate                   = "Disk is unhealthy: %s"
 )
 
 //go:generate mockgen -source=validator.go -package=hardware -destination=mock_validator.go
@@ -67,6 +69,7 @@ func NewValidator(log logrus.FieldLogger, cfg ValidatorCfg, operatorsAPI operato
 		compileDiskReasonTemplate(iscsiHostIPNotAvailable),
 		compileDiskReasonTemplate(iscsiNetworkInterfaceNotFound),
 		compileDiskReasonTemplate(iscsiHostIPParseErrorTemplate, ".*", ".*"),
+		compileDiskReasonTemplate(unhealthyDiskTemplate, ".*"),
 	}
 
 	return &validator{
@@ -87,6 +90,8 @@ type ValidatorCfg struct {
 	MaxHostDisconnectionTime      time.Duration                `envconfig:"HOST_MAX_DISCONNECTION_TIME" default:"3m"`
 	AgentDockerImage              string                       `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer-agent:latest"`
 	EdgeWorkerProductNames        string                       `envconfig:"EDGE_WORKERS_PRODUCT_NAMES" default:"BlueField SoC"`
+	// DiskHealth is loaded once by the service and shared with the storage operators
+	DiskHealth smart.Thresholds `ignored:"true"`
 }
 
 type validator struct {
@@ -160,6 +165,10 @@ func (v *validator) DiskIsEligible(ctx context.Context, disk *models.Disk, infra
 			fmt.Sprintf(wrongDriveTypeTemplate, disk.DriveType, strings.Join(v.getValidDeviceStorageTypes(hostArchitecture, clusterVersion), ", ")))
 	}
 
+	if problems := v.DiskHealth.DiskProblems(disk); len(problems) > 0 {
+		notEligibleReasons = append(notEligibleReasons, fmt.Sprintf(unhealthyDiskTemplate, strings.Join(problems, ", ")))
+	}
+
 	if disk.DriveType == models.DriveTypeMultipath {
 		fcDisks := hostutil.GetDisksOfHolderByType(inventory.Disks, disk, models.DriveTypeFC)
 		iSCSIDisks := hostutil.GetDisksOfHolderByType(inventory.Disks, disk, models.DriveTypeISCSI)
@@ -445,6 +454,13 @@ func totalizeRequirements(ocpRequirements models.ClusterHostRequirementsDetails,
 				total.PacketLossPercentage = ptr.To(math.Min(*total.PacketLossPercentage, *details.PacketLossPercentage))
 			}
 		}
+		if details.NetworkBandwidthMbps != nil && *details.NetworkBandwidthMbps > 0 {
+			if total.NetworkBandwidthMbps == nil {
+				total.NetworkBandwidthMbps = details.NetworkBandwidthMbps
+			} else {
+				total.NetworkBandwidthMbps = ptr.To(math.Max(*total.NetworkBandwidthMbps, *details.NetworkBandwidthMbps))
+			}
+		}
 	}
 	return total
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
@@ -181,6 +182,40 @@ var _ = Describe("Disk eligibility", func() {
 		Expect(notEligibleReasons).To(BeEmpty())
 	})
 
+	It("Check if an unhealthy disk is eligible", func() {
+		cfg := ValidatorCfg{
+			VersionedRequirements: versionRequirements,
+			DiskHealth: smart.Thresholds{
+				CheckOverallHealth:    true,
+				MaxReallocatedSectors: 50,
+				MaxMediaErrors:        0,
+				MaxPercentageUsed:     95,
+			},
+		}
+		hwvalidator = NewValidator(logrus.New(), cfg, operatorsMock, mockProviderRegistry)
+		operatorsMock.EXPECT().GetRequirementsBreakdownForHostInCluster(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*models.OperatorHostRequirements{}, nil).AnyTimes()
+
+		By("Check a healthy disk is eligible")
+		testDisk.Smart = `{"smart_status":{"passed":true},"nvme_smart_health_information_log":{"media_errors":0,"percentage_used":10}}`
+		notEligibleReasons, err := hwvalidator.DiskIsEligible(ctx, &testDisk, infraEnv, &cluster, &host, inventory)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notEligibleReasons).To(BeEmpty())
+
+		By("Check a worn out disk is not eligible")
+		testDisk.Smart = `{"smart_status":{"passed":false},"nvme_smart_health_information_log":{"media_errors":3,"percentage_used":99}}`
+		notEligibleReasons, err = hwvalidator.DiskIsEligible(ctx, &testDisk, infraEnv, &cluster, &host, inventory)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notEligibleReasons).To(ConsistOf("Disk is unhealthy: the SMART overall-health self-assessment test failed, " +
+			"3 media errors were reported, the maximum is 0, 99% of its rated endurance was used, the maximum is 95%"))
+
+		By("Check the health reasons are replaced when the disk is checked again")
+		testDisk.InstallationEligibility.NotEligibleReasons = notEligibleReasons
+		testDisk.Smart = `{"smart_status":{"passed":true},"ata_smart_attributes":{"table":[{"id":5,"raw":{"value":120}}]}}`
+		notEligibleReasons, err = hwvalidator.DiskIsEligible(ctx, &testDisk, infraEnv, &cluster, &host, inventory)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notEligibleReasons).To(ConsistOf("Disk is unhealthy: 120 sectors were reallocated, the maximum is 50"))
+	})
+
 	It("Check if iSCSI is eligible", func() {
 		testDisk.DriveType = models.DriveTypeISCSI
 		testDisk.Name = "iscsi0"
@@ -1108,6 +1143,7 @@ var _ = Describe("Cluster host requirements", func() {
 			DiskSizeGb:                       10,
 			NetworkLatencyThresholdMs:        ptr.To(float64(100)),
 			PacketLossPercentage:             ptr.To(float64(0)),
+			NetworkBandwidthMbps:             ptr.To(float64(1000)),
 		}
 		details2 = models.ClusterHostRequirementsDetails{
 			InstallationDiskSpeedThresholdMs: 5,
@@ -1116,6 +1152,7 @@ var _ = Describe("Cluster host requirements", func() {
 			DiskSizeGb:                       5,
 			NetworkLatencyThresholdMs:        ptr.To(float64(1000)),
 			PacketLossPercentage:             ptr.To(float64(10)),
+			NetworkBandwidthMbps:             ptr.To(float64(10000)),
 		}
 
 		operatorRequirements = []*models.OperatorHostRequirements{
@@ -1159,6 +1196,7 @@ var _ = Describe("Cluster host requirements", func() {
 		Expect(result.Total.InstallationDiskSpeedThresholdMs).To(BeEquivalentTo(defaultMasterDiskSpeedThreshold))
 		Expect(result.Total.NetworkLatencyThresholdMs).To(Equal(details1.NetworkLatencyThresholdMs))
 		Expect(result.Total.PacketLossPercentage).To(Equal(details1.PacketLossPercentage))
+		Expect(result.Total.NetworkBandwidthMbps).To(Equal(details2.NetworkBandwidthMbps))
 	})
 
 	It("should contain correct default requirements for sno master host", func() {
@@ -88,6 +88,9 @@ func validateDetails(details *models.ClusterHostRequirementsDetails, version str
 	if details.InstallationDiskSpeedThresholdMs < 0 {
 		return fmt.Errorf("CPU cores requirement must not be negative for version %v and %v role", version, role)
 	}
+	if details.NetworkBandwidthMbps != nil && *details.NetworkBandwidthMbps < 0 {
+		return fmt.Errorf("network bandwidth requirement must not be negative for version %v and %v role", version, role)
+	}
 	return nil
 }
 
@@ -110,5 +113,6 @@ func copyClusterHostRequirementsDetails(details *models.ClusterHostRequirementsD
 		RAMMib:                           details.RAMMib,
 		NetworkLatencyThresholdMs:        details.NetworkLatencyThresholdMs,
 		PacketLossPercentage:             details.PacketLossPercentage,
+		NetworkBandwidthMbps:             details.NetworkBandwidthMbps,
 	}
 }
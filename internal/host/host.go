@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"net"
 	"net/http"
 	reflect "reflect"
 	"strconv"
@@ -14,10 +15,12 @@ import (
 	"github.com/filanov/stateswitch"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/hardware/fingerprint"
 	"github.com/openshift/assisted-service/internal/host/hostcommands"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
@@ -29,6 +32,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/leader"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -80,6 +84,8 @@ type API interface {
 	UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateApiVipConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateTangConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
+	UpdateNetworkThroughputReport(ctx context.Context, h *models.Host, throughputReport string) error
+	UpdateFirmwareReport(ctx context.Context, h *models.Host, firmwareReport string) error
 	HostMonitoring()
 	CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	IsRequireUserActionReset(h *models.Host) bool
@@ -120,6 +126,8 @@ type API interface {
 	ResetHostValidation(ctx context.Context, hostID, infraEnvID strfmt.UUID, validationID string, db *gorm.DB) error
 	GetHostByKubeKey(key types.NamespacedName) (*common.Host, error)
 	UpdateDomainNameResolution(ctx context.Context, h *models.Host, domainResolutionResponse models.DomainResolutionResponse, db *gorm.DB) error
+	AddDiagnostic(ctx context.Context, h *models.Host, params *models.HostDiagnosticCreateParams, db *gorm.DB) (*models.HostDiagnostic, error)
+	UpdateDiagnosticResult(ctx context.Context, h *models.Host, stepReply *models.StepReply, db *gorm.DB) (bool, error)
 	BindHost(ctx context.Context, h *models.Host, clusterID strfmt.UUID, db *gorm.DB) error
 	UnbindHost(ctx context.Context, h *models.Host, db *gorm.DB, reclaim bool) error
 	GetKnownHostApprovedCounts(clusterID strfmt.UUID) (registered, approved int, err error)
@@ -409,6 +417,11 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 
 	disksToBeFormatted := strings.Join(common.GetDisksIdentifiersToBeFormatted(inventory), ",")
 
+	hardwareFingerprint, err := fingerprint.Marshal(inventory)
+	if err != nil {
+		return err
+	}
+
 	// If there is substantial change in the inventory that might cause the state machine to move to a new status
 	// or one of the validations to change, then the updated_at field has to be modified.  Otherwise, we just
 	// perform update with touching the updated_at field
@@ -417,6 +430,7 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 		"installation_disk_path": installationDiskPath,
 		"installation_disk_id":   installationDiskID,
 		"disks_to_be_formatted":  disksToBeFormatted,
+		"hardware_fingerprint":   hardwareFingerprint,
 	}
 	return m.updateHostAndNotify(ctx, db, h, updates).Error
 }
@@ -497,6 +511,7 @@ func (m *Manager) refreshStatusInternal(ctx context.Context, h *models.Host, c *
 		eventHandler:      m.eventsHandler,
 		conditions:        conditions,
 		validationResults: newValidationRes,
+		cluster:           vc.cluster,
 	})
 	if err != nil {
 		return common.NewApiError(http.StatusConflict, err)
@@ -548,7 +563,7 @@ func (m *Manager) UnbindHost(ctx context.Context, h *models.Host, db *gorm.DB, r
 	})
 }
 
-func (m *Manager) GetNextSteps(ctx context.Context, host *models.Host) (models.Steps, error) {
+func (m *Manager) GetNextSteps(ctx context.Context, host *common.Host) (models.Steps, error) {
 	return m.instructionApi.GetNextSteps(ctx, host)
 }
 
@@ -572,9 +587,8 @@ func (m *Manager) updateHostAndNotify(ctx context.Context, db *gorm.DB, h *model
 		}).Warn("Updated host that could not be retrieved from database")
 		return response
 	}
-	err = m.stream.Notify(ctx, host)
-	if err != nil {
-		m.log.WithError(err).Warning("failed to notify host update event")
+	if err = stream.NotifyWithDB(ctx, m.stream, db, host); err != nil {
+		_ = response.AddError(errors.Wrapf(err, "failed to notify host %s update", h.ID.String()))
 	}
 	return response
 }
@@ -723,12 +737,60 @@ func (m *Manager) SetUploadLogsAt(ctx context.Context, h *models.Host, db *gorm.
 }
 
 func (m *Manager) UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error {
-	if h.Connectivity != connectivityReport {
-		updates := map[string]interface{}{"connectivity": connectivityReport}
+	err := m.updateConnectivity(ctx, h, func(previousReport string) (string, error) {
+		return hostutil.KeepThroughputReports(previousReport, connectivityReport)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to set connectivity to host %s", h.ID.String())
+	}
+	return nil
+}
 
+// UpdateNetworkThroughputReport stores the throughput measured by the host in its connectivity report.
+func (m *Manager) UpdateNetworkThroughputReport(ctx context.Context, h *models.Host, throughputReport string) error {
+	var response models.NetworkThroughputResponse
+	if err := json.Unmarshal([]byte(throughputReport), &response); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal the network throughput of host %s", h.ID.String())
+	}
+	err := m.updateConnectivity(ctx, h, func(previousReport string) (string, error) {
+		return hostutil.SetThroughputReports(previousReport, response.RemoteHosts)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to set the network throughput of host %s", h.ID.String())
+	}
+	return nil
+}
+
+// updateConnectivity replaces the connectivity report of the host with the one built from the report stored in the
+// database. The row of the host is locked meanwhile, so that the connectivity and the throughput replies of the host
+// that are handled at the same time, possibly by different replicas, don't overwrite each other.
+func (m *Manager) updateConnectivity(ctx context.Context, h *models.Host, update func(previousReport string) (string, error)) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		current, err := common.GetHostFromDB(transaction.AddForUpdateQueryOption(tx), h.InfraEnvID.String(), h.ID.String())
+		if err != nil {
+			return err
+		}
+		connectivityReport, err := update(current.Connectivity)
+		if err != nil {
+			return err
+		}
 		// Only if the connectivity between the hosts changed change the updated_at field
+		if current.Connectivity != connectivityReport {
+			if err = m.updateHost(ctx, tx, h, map[string]interface{}{"connectivity": connectivityReport}).Error; err != nil {
+				return err
+			}
+		}
+		h.Connectivity = connectivityReport
+		return nil
+	})
+}
+
+// UpdateFirmwareReport stores the firmware settings reported by the host.
+func (m *Manager) UpdateFirmwareReport(ctx context.Context, h *models.Host, firmwareReport string) error {
+	if h.Firmware != firmwareReport {
+		updates := map[string]interface{}{"firmware": firmwareReport}
 		if err := m.updateHost(ctx, m.db, h, updates).Error; err != nil {
-			return errors.Wrapf(err, "failed to set connectivity to host %s", h.ID.String())
+			return errors.Wrapf(err, "failed to set the firmware report of host %s", h.ID.String())
 		}
 	}
 	return nil
@@ -896,6 +958,135 @@ func (m *Manager) UpdateDomainNameResolution(ctx context.Context, h *models.Host
 	return nil
 }
 
+func validateDiagnosticParams(params *models.HostDiagnosticCreateParams) error {
+	if params.Type == nil {
+		return errors.New("The type of the diagnostic is required")
+	}
+	switch *params.Type {
+	case models.HostDiagnosticTypeDiskPerformance:
+	case models.HostDiagnosticTypeContainerImageAvailability:
+		if params.Image == "" {
+			return errors.Errorf("The image is required for the %s diagnostic", *params.Type)
+		}
+	case models.HostDiagnosticTypeDomainResolution:
+		if len(params.Domains) == 0 {
+			return errors.Errorf("At least one domain is required for the %s diagnostic", *params.Type)
+		}
+		for _, domain := range params.Domains {
+			if strings.TrimSpace(domain) == "" {
+				return errors.Errorf("Empty domain names aren't allowed for the %s diagnostic", *params.Type)
+			}
+		}
+	case models.HostDiagnosticTypeConnectivity:
+		if net.ParseIP(params.IPAddress) == nil {
+			return errors.Errorf("A valid IP address is required for the %s diagnostic, got <%s>", *params.Type, params.IPAddress)
+		}
+	default:
+		return errors.Errorf("Unsupported diagnostic type <%s>", *params.Type)
+	}
+	return nil
+}
+
+// AddDiagnostic queues a diagnostic that the host runs with the next steps it requests. The oldest finished
+// diagnostics are discarded so that the host keeps at most hostutil.MaxHostDiagnostics of them.
+func (m *Manager) AddDiagnostic(ctx context.Context, h *models.Host, params *models.HostDiagnosticCreateParams, db *gorm.DB) (*models.HostDiagnostic, error) {
+	log := logutil.FromContext(ctx, m.log)
+	if db == nil {
+		db = m.db
+	}
+	if !funk.ContainsString(hostutil.DiagnosticsAllowedStatuses, swag.StringValue(h.Status)) {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Can't run diagnostics on host %s in status <%s>", h.ID.String(), swag.StringValue(h.Status)))
+	}
+	if err := validateDiagnosticParams(params); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	now := strfmt.DateTime(time.Now())
+	diagnostic := &models.HostDiagnostic{
+		ID:        &id,
+		Type:      params.Type,
+		DiskPath:  params.DiskPath,
+		Image:     params.Image,
+		Domains:   params.Domains,
+		IPAddress: params.IPAddress,
+		Status:    swag.String(models.HostDiagnosticStatusPending),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	_, err := hostutil.UpdateDiagnostics(db, h, func(diagnostics models.HostDiagnosticList) (models.HostDiagnosticList, error) {
+		diagnostics = append(diagnostics, diagnostic)
+		for i := 0; len(diagnostics) > hostutil.MaxHostDiagnostics && i < len(diagnostics); {
+			status := swag.StringValue(diagnostics[i].Status)
+			if status == models.HostDiagnosticStatusSucceeded || status == models.HostDiagnosticStatusFailed {
+				diagnostics = append(diagnostics[:i], diagnostics[i+1:]...)
+				continue
+			}
+			i++
+		}
+		if len(diagnostics) > hostutil.MaxHostDiagnostics {
+			return nil, common.NewApiError(http.StatusConflict,
+				errors.Errorf("Host %s already has %d diagnostics in progress", h.ID.String(), hostutil.MaxHostDiagnostics))
+		}
+		return diagnostics, nil
+	})
+	if err != nil {
+		var apiErr *common.ApiErrorResponse
+		if errors.As(err, &apiErr) {
+			return nil, err
+		}
+		log.WithError(err).Errorf("failed to add diagnostic to host %s", h.ID.String())
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Added %s diagnostic %s to host %s", *diagnostic.Type, diagnostic.ID.String(), h.ID.String())
+	return diagnostic, nil
+}
+
+// UpdateDiagnosticResult stores the reply of the host if it belongs to one of its diagnostics. It returns false when
+// the reply isn't the result of a diagnostic, so that it is processed as a regular step reply.
+func (m *Manager) UpdateDiagnosticResult(ctx context.Context, h *models.Host, stepReply *models.StepReply, db *gorm.DB) (bool, error) {
+	log := logutil.FromContext(ctx, m.log)
+	if db == nil {
+		db = m.db
+	}
+	diagnostics, err := hostutil.UnmarshalDiagnostics(h.Diagnostics)
+	if err != nil {
+		return false, err
+	}
+	if hostutil.FindDiagnosticByStepID(diagnostics, stepReply.StepID) == nil {
+		return false, nil
+	}
+
+	var diagnostic *models.HostDiagnostic
+	_, err = hostutil.UpdateDiagnostics(db, h, func(diagnostics models.HostDiagnosticList) (models.HostDiagnosticList, error) {
+		diagnostic = hostutil.FindDiagnosticByStepID(diagnostics, stepReply.StepID)
+		if diagnostic == nil {
+			return diagnostics, nil
+		}
+		diagnostic.UpdatedAt = strfmt.DateTime(time.Now())
+		diagnostic.Result = stepReply.Output
+		if stepReply.ExitCode == 0 {
+			diagnostic.Status = swag.String(models.HostDiagnosticStatusSucceeded)
+			diagnostic.StatusInfo = ""
+		} else {
+			diagnostic.Status = swag.String(models.HostDiagnosticStatusFailed)
+			diagnostic.StatusInfo = stepReply.Error
+			if diagnostic.StatusInfo == "" {
+				diagnostic.StatusInfo = fmt.Sprintf("The diagnostic failed with exit code %d", stepReply.ExitCode)
+			}
+		}
+		return diagnostics, nil
+	})
+	if err != nil {
+		return true, errors.Wrapf(err, "failed to update the result of step %s of host %s", stepReply.StepID, h.ID.String())
+	}
+	if diagnostic != nil {
+		log.Infof("Diagnostic %s of host %s finished with status %s", diagnostic.ID.String(), h.ID.String(), swag.StringValue(diagnostic.Status))
+	}
+	return true, nil
+}
+
 func (m *Manager) UpdateImageStatus(ctx context.Context, h *models.Host, newImageStatus *models.ContainerImageAvailability, db *gorm.DB) error {
 	hostImageStatuses, err := common.UnmarshalImageStatuses(h.ImagesStatus)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
@@ -1412,6 +1413,9 @@ var _ = Describe("UpdateInventory", func() {
 			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
 			Expect(h.InstallationDiskPath).To(Equal(diskPath))
 			Expect(h.InstallationDiskID).To(Equal(diskId))
+			var hardwareFingerprint models.HostFingerprint
+			Expect(json.Unmarshal([]byte(h.HardwareFingerprint), &hardwareFingerprint)).To(Succeed())
+			Expect(hardwareFingerprint.Environment).To(Equal(models.HostFingerprintEnvironmentUnknown))
 
 			// Now make sure it gets removed if the disk is no longer in the inventory
 			mockValidator.EXPECT().DiskIsEligible(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
@@ -2292,6 +2296,84 @@ var _ = Describe("UpdateNTP", func() {
 	}
 })
 
+var _ = Describe("Connectivity and throughput reports", func() {
+	var (
+		ctx                                         = context.Background()
+		hapi                                        API
+		db                                          *gorm.DB
+		ctrl                                        *gomock.Controller
+		hostId, remoteHostId, clusterId, infraEnvId strfmt.UUID
+		host                                        models.Host
+		dbName                                      string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		dummy := &leader.DummyElector{}
+		hapi = NewManager(common.GetTestLog(), db, testing.GetDummyNotificationStream(ctrl), eventsapi.NewMockHandler(ctrl), nil, nil, createValidatorCfg(), nil, defaultConfig, dummy, nil, nil, false, nil, nil, false)
+		hostId = strfmt.UUID(uuid.New().String())
+		remoteHostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+
+		host = hostutil.GenerateTestHost(hostId, infraEnvId, clusterId, models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	connectivityReport := func(remoteIP string) string {
+		report, err := hostutil.MarshalConnectivityReport(&models.ConnectivityReport{
+			RemoteHosts: []*models.ConnectivityRemoteHost{{
+				HostID:         remoteHostId,
+				L3Connectivity: []*models.L3Connectivity{{RemoteIPAddress: remoteIP, Successful: true}},
+			}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return report
+	}
+
+	throughputReply := func() string {
+		reply, err := json.Marshal(&models.NetworkThroughputResponse{
+			RemoteHosts: []*models.ConnectivityRemoteHost{{
+				HostID:           remoteHostId,
+				ThroughputReport: []*models.ThroughputReport{{RemoteIPAddress: "1.2.3.5", Successful: true, ThroughputMbps: 9000}},
+			}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return string(reply)
+	}
+
+	storedReport := func() *models.ConnectivityReport {
+		report, err := hostutil.UnmarshalConnectivityReport(hostutil.GetHostFromDB(hostId, infraEnvId, db).Connectivity)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.RemoteHosts).To(HaveLen(1))
+		return report
+	}
+
+	It("doesn't lose the throughput when the replies are handled with stale hosts", func() {
+		connectivityHost, throughputHost := host, host
+		Expect(hapi.UpdateConnectivityReport(ctx, &connectivityHost, connectivityReport("1.2.3.5"))).To(Succeed())
+
+		By("storing the throughput with a host read before the connectivity was stored")
+		Expect(hapi.UpdateNetworkThroughputReport(ctx, &throughputHost, throughputReply())).To(Succeed())
+		report := storedReport()
+		Expect(report.RemoteHosts[0].L3Connectivity).To(HaveLen(1))
+		Expect(report.RemoteHosts[0].ThroughputReport).To(HaveLen(1))
+
+		By("storing the connectivity with a host read before the throughput was stored")
+		Expect(hapi.UpdateConnectivityReport(ctx, &connectivityHost, connectivityReport("1.2.3.6"))).To(Succeed())
+		report = storedReport()
+		Expect(report.RemoteHosts[0].L3Connectivity[0].RemoteIPAddress).To(Equal("1.2.3.6"))
+		Expect(report.RemoteHosts[0].ThroughputReport).To(HaveLen(1))
+		Expect(report.RemoteHosts[0].ThroughputReport[0].ThroughputMbps).To(BeEquivalentTo(9000))
+	})
+})
+
 var _ = Describe("UpdateMachineConfigPoolName", func() {
 	var (
 		ctx                           = context.Background()
@@ -4683,6 +4765,167 @@ var _ = Describe("UpdateDomainNameResolution", func() {
 	})
 })
 
+var _ = Describe("Diagnostics", func() {
+	var (
+		ctx                 = context.Background()
+		ctrl                *gomock.Controller
+		manager             API
+		db                  *gorm.DB
+		dbName              string
+		host                models.Host
+		hostID, infraEnvID  strfmt.UUID
+		diskPerformance     = models.HostDiagnosticTypeDiskPerformance
+		imageAvailability   = models.HostDiagnosticTypeContainerImageAvailability
+		domainResolution    = models.HostDiagnosticTypeDomainResolution
+		connectivityToIP    = models.HostDiagnosticTypeConnectivity
+		unsupportedDiagType = models.HostDiagnosticType("unsupported")
+	)
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		db, dbName = common.PrepareTestDB()
+		manager = NewManager(common.GetTestLog(), db, testing.GetDummyNotificationStream(ctrl), nil, nil, nil, nil, nil, defaultConfig, nil, nil, nil, false, nil, nil, false)
+		hostID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHostByKind(hostID, infraEnvID, nil, models.HostStatusKnownUnbound, models.HostKindHost, models.HostRoleAutoAssign)
+		Expect(db.Create(&host).Error).ToNot(HaveOccurred())
+	})
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+	getDiagnostics := func() models.HostDiagnosticList {
+		h := hostutil.GetHostFromDB(hostID, infraEnvID, db)
+		diagnostics, err := hostutil.UnmarshalDiagnostics(h.Diagnostics)
+		Expect(err).ToNot(HaveOccurred())
+		return diagnostics
+	}
+	expectApiError := func(err error, statusCode int32) {
+		ExpectWithOffset(1, err).To(HaveOccurred())
+		apiErr, ok := err.(*common.ApiErrorResponse)
+		ExpectWithOffset(1, ok).To(BeTrue())
+		ExpectWithOffset(1, apiErr.StatusCode()).To(Equal(statusCode))
+	}
+
+	Context("AddDiagnostic", func() {
+		It("queues a pending diagnostic", func() {
+			diagnostic, err := manager.AddDiagnostic(ctx, &host, &models.HostDiagnosticCreateParams{
+				Type:  &imageAvailability,
+				Image: "quay.io/example/image:latest",
+			}, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diagnostic.ID).ToNot(BeNil())
+			Expect(*diagnostic.Status).To(Equal(models.HostDiagnosticStatusPending))
+			Expect(host.Diagnostics).ToNot(BeEmpty())
+
+			diagnostics := getDiagnostics()
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(*diagnostics[0].ID).To(Equal(*diagnostic.ID))
+			Expect(diagnostics[0].Image).To(Equal("quay.io/example/image:latest"))
+		})
+
+		DescribeTable("validates the parameters",
+			func(params *models.HostDiagnosticCreateParams, valid bool) {
+				_, err := manager.AddDiagnostic(ctx, &host, params, db)
+				if valid {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					expectApiError(err, http.StatusBadRequest)
+					Expect(getDiagnostics()).To(BeEmpty())
+				}
+			},
+			Entry("disk performance", &models.HostDiagnosticCreateParams{Type: &diskPerformance}, true),
+			Entry("image availability without image", &models.HostDiagnosticCreateParams{Type: &imageAvailability}, false),
+			Entry("domain resolution", &models.HostDiagnosticCreateParams{Type: &domainResolution, Domains: []string{"example.com"}}, true),
+			Entry("domain resolution without domains", &models.HostDiagnosticCreateParams{Type: &domainResolution}, false),
+			Entry("domain resolution with an empty domain", &models.HostDiagnosticCreateParams{Type: &domainResolution, Domains: []string{" "}}, false),
+			Entry("connectivity to IPv4", &models.HostDiagnosticCreateParams{Type: &connectivityToIP, IPAddress: "192.168.127.10"}, true),
+			Entry("connectivity to IPv6", &models.HostDiagnosticCreateParams{Type: &connectivityToIP, IPAddress: "1001:db8::10"}, true),
+			Entry("connectivity to an invalid IP", &models.HostDiagnosticCreateParams{Type: &connectivityToIP, IPAddress: "example.com"}, false),
+			Entry("unsupported type", &models.HostDiagnosticCreateParams{Type: &unsupportedDiagType}, false),
+			Entry("missing type", &models.HostDiagnosticCreateParams{}, false),
+		)
+
+		It("fails for installing hosts", func() {
+			Expect(db.Model(&host).Update("status", models.HostStatusInstalling).Error).ToNot(HaveOccurred())
+			host.Status = swag.String(models.HostStatusInstalling)
+			_, err := manager.AddDiagnostic(ctx, &host, &models.HostDiagnosticCreateParams{Type: &diskPerformance}, db)
+			expectApiError(err, http.StatusConflict)
+		})
+
+		It("discards the oldest finished diagnostics", func() {
+			for i := 0; i < hostutil.MaxHostDiagnostics; i++ {
+				_, err := manager.AddDiagnostic(ctx, &host, &models.HostDiagnosticCreateParams{Type: &diskPerformance}, db)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			_, err := manager.AddDiagnostic(ctx, &host, &models.HostDiagnosticCreateParams{Type: &diskPerformance}, db)
+			expectApiError(err, http.StatusConflict)
+
+			diagnostics := getDiagnostics()
+			diagnostics[1].Status = swag.String(models.HostDiagnosticStatusSucceeded)
+			diagnosticsStr, err := hostutil.MarshalDiagnostics(diagnostics)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Model(&host).Update("diagnostics", diagnosticsStr).Error).ToNot(HaveOccurred())
+
+			added, err := manager.AddDiagnostic(ctx, &host, &models.HostDiagnosticCreateParams{Type: &diskPerformance}, db)
+			Expect(err).ToNot(HaveOccurred())
+			newDiagnostics := getDiagnostics()
+			Expect(newDiagnostics).To(HaveLen(hostutil.MaxHostDiagnostics))
+			Expect(*newDiagnostics[0].ID).To(Equal(*diagnostics[0].ID))
+			Expect(*newDiagnostics[1].ID).To(Equal(*diagnostics[2].ID))
+			Expect(*newDiagnostics[hostutil.MaxHostDiagnostics-1].ID).To(Equal(*added.ID))
+		})
+	})
+
+	Context("UpdateDiagnosticResult", func() {
+		var diagnostic *models.HostDiagnostic
+
+		BeforeEach(func() {
+			var err error
+			diagnostic, err = manager.AddDiagnostic(ctx, &host, &models.HostDiagnosticCreateParams{Type: &diskPerformance}, db)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = hostutil.UpdateDiagnostics(db, &host, func(diagnostics models.HostDiagnosticList) (models.HostDiagnosticList, error) {
+				diagnostics[0].Status = swag.String(models.HostDiagnosticStatusRunning)
+				diagnostics[0].StepID = "diagnostic-step"
+				return diagnostics, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("ignores replies of other steps", func() {
+			handled, err := manager.UpdateDiagnosticResult(ctx, &host, &models.StepReply{StepID: "other-step"}, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handled).To(BeFalse())
+			Expect(*getDiagnostics()[0].Status).To(Equal(models.HostDiagnosticStatusRunning))
+		})
+
+		It("stores successful results", func() {
+			handled, err := manager.UpdateDiagnosticResult(ctx, &host, &models.StepReply{
+				StepID: "diagnostic-step",
+				Output: `{"path":"/dev/sda","io_sync_duration":10}`,
+			}, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handled).To(BeTrue())
+			diagnostics := getDiagnostics()
+			Expect(*diagnostics[0].ID).To(Equal(*diagnostic.ID))
+			Expect(*diagnostics[0].Status).To(Equal(models.HostDiagnosticStatusSucceeded))
+			Expect(diagnostics[0].Result).To(Equal(`{"path":"/dev/sda","io_sync_duration":10}`))
+		})
+
+		It("stores failed results", func() {
+			handled, err := manager.UpdateDiagnosticResult(ctx, &host, &models.StepReply{
+				StepID:   "diagnostic-step",
+				ExitCode: 1,
+				Error:    "fio failed",
+			}, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handled).To(BeTrue())
+			diagnostics := getDiagnostics()
+			Expect(*diagnostics[0].Status).To(Equal(models.HostDiagnosticStatusFailed))
+			Expect(diagnostics[0].StatusInfo).To(Equal("fio failed"))
+		})
+	})
+})
+
 var _ = Describe("HandleReclaimBootArtifactDownload", func() {
 	var (
 		ctx           = context.Background()
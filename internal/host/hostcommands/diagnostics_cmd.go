@@ -0,0 +1,168 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// diagnosticReplyTimeout is the time the host has to report the result of a diagnostic. It is longer than the timeouts
+// of the disk performance and image availability checks, so those report their own failures first.
+const diagnosticReplyTimeout = 30 * time.Minute
+
+// diagnosticsCmd generates the steps of the on-demand diagnostics that the users requested for the host. Each pending
+// diagnostic is sent once, and is marked as running until the host replies with the result.
+type diagnosticsCmd struct {
+	baseCmd
+	db                              *gorm.DB
+	hwValidator                     hardware.Validator
+	diskPerfCheckCmd                *diskPerfCheckCmd
+	imageAvailabilityTimeoutSeconds float64
+	isStepDisabled                  func(models.StepType) bool
+}
+
+func newDiagnosticsCmd(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, diskPerfCheckCmd *diskPerfCheckCmd,
+	imageAvailabilityTimeoutSeconds float64, isStepDisabled func(models.StepType) bool) *diagnosticsCmd {
+	return &diagnosticsCmd{
+		baseCmd:                         baseCmd{log: log},
+		db:                              db,
+		hwValidator:                     hwValidator,
+		diskPerfCheckCmd:                diskPerfCheckCmd,
+		imageAvailabilityTimeoutSeconds: imageAvailabilityTimeoutSeconds,
+		isStepDisabled:                  isStepDisabled,
+	}
+}
+
+func (c *diagnosticsCmd) GetSteps(_ context.Context, host *models.Host) ([]*models.Step, error) {
+	if !funk.ContainsString(hostutil.DiagnosticsAllowedStatuses, swag.StringValue(host.Status)) {
+		return nil, nil
+	}
+	diagnostics, err := hostutil.UnmarshalDiagnostics(host.Diagnostics)
+	if err != nil {
+		return nil, err
+	}
+	if !funk.Contains(diagnostics, c.needsUpdate) {
+		return nil, nil
+	}
+
+	var steps []*models.Step
+	_, err = hostutil.UpdateDiagnostics(c.db, host, func(diagnostics models.HostDiagnosticList) (models.HostDiagnosticList, error) {
+		steps = nil
+		now := strfmt.DateTime(time.Now())
+		for _, diagnostic := range diagnostics {
+			switch swag.StringValue(diagnostic.Status) {
+			case models.HostDiagnosticStatusPending:
+				diagnostic.UpdatedAt = now
+				step, err := c.getStep(host, diagnostic)
+				if err != nil {
+					diagnostic.Status = swag.String(models.HostDiagnosticStatusFailed)
+					diagnostic.StatusInfo = err.Error()
+					continue
+				}
+				step.StepID = createStepID(step.StepType)
+				diagnostic.Status = swag.String(models.HostDiagnosticStatusRunning)
+				diagnostic.StatusInfo = ""
+				diagnostic.StepID = step.StepID
+				steps = append(steps, step)
+			case models.HostDiagnosticStatusRunning:
+				if c.timedOut(diagnostic) {
+					diagnostic.UpdatedAt = now
+					diagnostic.Status = swag.String(models.HostDiagnosticStatusFailed)
+					diagnostic.StatusInfo = "The host didn't report the result of the diagnostic"
+				}
+			}
+		}
+		return diagnostics, nil
+	})
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to update the diagnostics of host %s", host.ID.String())
+		return nil, err
+	}
+	return steps, nil
+}
+
+func (c *diagnosticsCmd) needsUpdate(diagnostic *models.HostDiagnostic) bool {
+	status := swag.StringValue(diagnostic.Status)
+	return status == models.HostDiagnosticStatusPending || status == models.HostDiagnosticStatusRunning && c.timedOut(diagnostic)
+}
+
+func (c *diagnosticsCmd) timedOut(diagnostic *models.HostDiagnostic) bool {
+	return time.Since(time.Time(diagnostic.UpdatedAt)) > diagnosticReplyTimeout
+}
+
+func (c *diagnosticsCmd) getStep(host *models.Host, diagnostic *models.HostDiagnostic) (*models.Step, error) {
+	step, err := c.getStepForType(host, diagnostic)
+	if err != nil {
+		return nil, err
+	}
+	if c.isStepDisabled(step.StepType) {
+		return nil, errors.Errorf("Step %s is disabled in the service", step.StepType)
+	}
+	return step, nil
+}
+
+func (c *diagnosticsCmd) getStepForType(host *models.Host, diagnostic *models.HostDiagnostic) (*models.Step, error) {
+	var (
+		stepType models.StepType
+		request  interface{}
+	)
+	switch *diagnostic.Type {
+	case models.HostDiagnosticTypeDiskPerformance:
+		path := diagnostic.DiskPath
+		if path == "" {
+			bootDevice, err := hardware.GetBootDevice(c.hwValidator, host)
+			if err != nil {
+				return nil, err
+			}
+			path = bootDevice
+		}
+		diskArgs, err := c.diskPerfCheckCmd.GetArgs(path)
+		if err != nil {
+			return nil, err
+		}
+		return &models.Step{StepType: models.StepTypeInstallationDiskSpeedCheck, Args: diskArgs}, nil
+	case models.HostDiagnosticTypeContainerImageAvailability:
+		stepType = models.StepTypeContainerImageAvailability
+		request = models.ContainerImageAvailabilityRequest{
+			Images:  []string{diagnostic.Image},
+			Timeout: int64(c.imageAvailabilityTimeoutSeconds),
+		}
+	case models.HostDiagnosticTypeDomainResolution:
+		stepType = models.StepTypeDomainResolution
+		domains := make([]models.DomainResolutionRequestDomain, 0, len(diagnostic.Domains))
+		for _, domain := range diagnostic.Domains {
+			domains = append(domains, models.DomainResolutionRequestDomain{DomainName: swag.String(domain)})
+		}
+		request = models.DomainResolutionRequest{Domains: domains}
+	case models.HostDiagnosticTypeConnectivity:
+		// The connectivity check expects the hosts of the cluster, so the address is sent as the only address of
+		// a fake host identified by the diagnostic:
+		stepType = models.StepTypeConnectivityCheck
+		request = models.ConnectivityCheckParams{
+			{
+				HostID: *diagnostic.ID,
+				Nics: []*models.ConnectivityCheckNic{
+					{IPAddresses: []string{diagnostic.IPAddress}},
+				},
+			},
+		}
+	default:
+		return nil, errors.Errorf("unsupported diagnostic type %s", *diagnostic.Type)
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal the request of diagnostic %s", diagnostic.ID.String())
+	}
+	return &models.Step{StepType: stepType, Args: []string{string(data)}}, nil
+}
@@ -0,0 +1,213 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("diagnostics", func() {
+	ctx := context.Background()
+	var host models.Host
+	var db *gorm.DB
+	var dCmd *diagnosticsCmd
+	var id, clusterId, infraEnvId strfmt.UUID
+	var dbName string
+	var ctrl *gomock.Controller
+	var mockValidator *hardware.MockValidator
+	var disabledSteps map[models.StepType]bool
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockValidator = hardware.NewMockValidator(ctrl)
+		mockValidator.EXPECT().GetHostInstallationPath(gomock.Any()).Return("/dev/sda").AnyTimes()
+		disabledSteps = map[models.StepType]bool{}
+		diskPerfCheckCmd := NewDiskPerfCheckCmd(common.GetTestLog(), "quay.io/example/agent:latest", mockValidator, 600)
+		dCmd = newDiagnosticsCmd(common.GetTestLog(), db, mockValidator, diskPerfCheckCmd, 60,
+			func(stepType models.StepType) bool { return disabledSteps[stepType] })
+
+		id = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(id, infraEnvId, clusterId, models.HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	newDiagnostic := func(diagnosticType models.HostDiagnosticType, status string) *models.HostDiagnostic {
+		diagnosticID := strfmt.UUID(uuid.New().String())
+		return &models.HostDiagnostic{
+			ID:        &diagnosticID,
+			Type:      &diagnosticType,
+			Status:    swag.String(status),
+			CreatedAt: strfmt.DateTime(time.Now()),
+			UpdatedAt: strfmt.DateTime(time.Now()),
+		}
+	}
+
+	setDiagnostics := func(diagnostics ...*models.HostDiagnostic) {
+		diagnosticsStr, err := hostutil.MarshalDiagnostics(diagnostics)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Model(&host).Update("diagnostics", diagnosticsStr).Error).ToNot(HaveOccurred())
+		host.Diagnostics = diagnosticsStr
+	}
+
+	getDiagnostics := func() models.HostDiagnosticList {
+		var h models.Host
+		Expect(db.Take(&h, "id = ? and infra_env_id = ?", id.String(), infraEnvId.String()).Error).ToNot(HaveOccurred())
+		diagnostics, err := hostutil.UnmarshalDiagnostics(h.Diagnostics)
+		Expect(err).ToNot(HaveOccurred())
+		return diagnostics
+	}
+
+	It("returns no steps without diagnostics", func() {
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+
+	It("returns no steps when all the diagnostics finished", func() {
+		setDiagnostics(newDiagnostic(models.HostDiagnosticTypeDiskPerformance, models.HostDiagnosticStatusSucceeded),
+			newDiagnostic(models.HostDiagnosticTypeDiskPerformance, models.HostDiagnosticStatusFailed))
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+
+	It("doesn't run diagnostics while the host is installing", func() {
+		Expect(db.Model(&host).Update("status", models.HostStatusInstalling).Error).ToNot(HaveOccurred())
+		host.Status = swag.String(models.HostStatusInstalling)
+		setDiagnostics(newDiagnostic(models.HostDiagnosticTypeDiskPerformance, models.HostDiagnosticStatusPending))
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+		Expect(*getDiagnostics()[0].Status).To(Equal(models.HostDiagnosticStatusPending))
+	})
+
+	It("disk performance defaults to the installation disk", func() {
+		setDiagnostics(newDiagnostic(models.HostDiagnosticTypeDiskPerformance, models.HostDiagnosticStatusPending))
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeInstallationDiskSpeedCheck))
+		Expect(steps[0].Args[0]).To(ContainSubstring("/dev/sda"))
+
+		diagnostics := getDiagnostics()
+		Expect(*diagnostics[0].Status).To(Equal(models.HostDiagnosticStatusRunning))
+		Expect(diagnostics[0].StepID).To(Equal(steps[0].StepID))
+		Expect(host.Diagnostics).ToNot(BeEmpty())
+	})
+
+	It("disk performance of a chosen disk", func() {
+		diagnostic := newDiagnostic(models.HostDiagnosticTypeDiskPerformance, models.HostDiagnosticStatusPending)
+		diagnostic.DiskPath = "/dev/sdb"
+		setDiagnostics(diagnostic)
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].Args[0]).To(ContainSubstring("/dev/sdb"))
+	})
+
+	It("container image availability", func() {
+		diagnostic := newDiagnostic(models.HostDiagnosticTypeContainerImageAvailability, models.HostDiagnosticStatusPending)
+		diagnostic.Image = "quay.io/example/image:latest"
+		setDiagnostics(diagnostic)
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeContainerImageAvailability))
+		var request models.ContainerImageAvailabilityRequest
+		Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+		Expect(request.Images).To(ConsistOf("quay.io/example/image:latest"))
+		Expect(request.Timeout).To(BeEquivalentTo(60))
+	})
+
+	It("domain resolution", func() {
+		diagnostic := newDiagnostic(models.HostDiagnosticTypeDomainResolution, models.HostDiagnosticStatusPending)
+		diagnostic.Domains = []string{"example.com", "quay.io"}
+		setDiagnostics(diagnostic)
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeDomainResolution))
+		var request models.DomainResolutionRequest
+		Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+		Expect(request.Domains).To(HaveLen(2))
+		Expect(*request.Domains[0].DomainName).To(Equal("example.com"))
+		Expect(*request.Domains[1].DomainName).To(Equal("quay.io"))
+	})
+
+	It("connectivity", func() {
+		diagnostic := newDiagnostic(models.HostDiagnosticTypeConnectivity, models.HostDiagnosticStatusPending)
+		diagnostic.IPAddress = "192.168.127.10"
+		setDiagnostics(diagnostic)
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeConnectivityCheck))
+		var request models.ConnectivityCheckParams
+		Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+		Expect(request).To(HaveLen(1))
+		Expect(request[0].HostID).To(Equal(*diagnostic.ID))
+		Expect(request[0].Nics[0].IPAddresses).To(ConsistOf("192.168.127.10"))
+	})
+
+	It("fails diagnostics of disabled steps", func() {
+		disabledSteps[models.StepTypeDomainResolution] = true
+		diagnostic := newDiagnostic(models.HostDiagnosticTypeDomainResolution, models.HostDiagnosticStatusPending)
+		diagnostic.Domains = []string{"example.com"}
+		setDiagnostics(diagnostic)
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+		diagnostics := getDiagnostics()
+		Expect(*diagnostics[0].Status).To(Equal(models.HostDiagnosticStatusFailed))
+		Expect(diagnostics[0].StatusInfo).To(ContainSubstring("disabled"))
+	})
+
+	It("sends running diagnostics once", func() {
+		running := newDiagnostic(models.HostDiagnosticTypeDiskPerformance, models.HostDiagnosticStatusRunning)
+		running.StepID = "step-id"
+		pending := newDiagnostic(models.HostDiagnosticTypeDiskPerformance, models.HostDiagnosticStatusPending)
+		setDiagnostics(running, pending)
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		diagnostics := getDiagnostics()
+		Expect(diagnostics[0].StepID).To(Equal("step-id"))
+		Expect(diagnostics[1].StepID).To(Equal(steps[0].StepID))
+
+		steps, err = dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+
+	It("fails running diagnostics that the host didn't report", func() {
+		diagnostic := newDiagnostic(models.HostDiagnosticTypeDiskPerformance, models.HostDiagnosticStatusRunning)
+		diagnostic.UpdatedAt = strfmt.DateTime(time.Now().Add(-diagnosticReplyTimeout - time.Minute))
+		setDiagnostics(diagnostic)
+		steps, err := dCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+		diagnostics := getDiagnostics()
+		Expect(*diagnostics[0].Status).To(Equal(models.HostDiagnosticStatusFailed))
+		Expect(diagnostics[0].StatusInfo).To(ContainSubstring("didn't report"))
+	})
+})
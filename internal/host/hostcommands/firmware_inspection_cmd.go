@@ -0,0 +1,34 @@
+package hostcommands
+
+import (
+	"context"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+// firmwareInspectionCmd asks the agent to report the firmware settings of the host that the validations of the
+// operators depend on, like the state of the virtualization extensions, of the IOMMU and of SR-IOV. The settings
+// can only change when the host reboots, which registers it again and resets its report, so the step runs only
+// until the host reported them. Agents that don't support the step fail it, and the service then stores an empty
+// report, so the step isn't sent to them again and their firmware settings aren't validated.
+type firmwareInspectionCmd struct {
+	baseCmd
+}
+
+func newFirmwareInspectionCmd(log logrus.FieldLogger) *firmwareInspectionCmd {
+	return &firmwareInspectionCmd{
+		baseCmd: baseCmd{log: log},
+	}
+}
+
+func (c *firmwareInspectionCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	if host.Firmware != "" {
+		return nil, nil
+	}
+	step := &models.Step{
+		StepType: models.StepTypeFirmwareInspection,
+		Args:     []string{},
+	}
+	return []*models.Step{step}, nil
+}
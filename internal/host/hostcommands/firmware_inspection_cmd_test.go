@@ -0,0 +1,40 @@
+package hostcommands
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("firmware inspection", func() {
+	ctx := context.Background()
+	var host models.Host
+	var cmd *firmwareInspectionCmd
+
+	BeforeEach(func() {
+		cmd = newFirmwareInspectionCmd(common.GetTestLog())
+		clusterId := strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), clusterId,
+			models.HostStatusDiscovering)
+	})
+
+	It("inspects the firmware of a host without a report", func() {
+		steps, err := cmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeFirmwareInspection))
+	})
+
+	It("doesn't inspect the firmware again once it was reported", func() {
+		host.Firmware = `{"virtualization_enabled":true}`
+		steps, err := cmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+})
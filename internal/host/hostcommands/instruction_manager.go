@@ -26,7 +26,7 @@ import (
 
 //go:generate mockgen --build_flags=--mod=mod -package=hostcommands -destination=mock_instruction_api.go . InstructionApi
 type InstructionApi interface {
-	GetNextSteps(ctx context.Context, host *models.Host) (models.Steps, error)
+	GetNextSteps(ctx context.Context, host *common.Host) (models.Steps, error)
 }
 
 const (
@@ -51,6 +51,8 @@ type InstructionManager struct {
 	poolHostToSteps               stateToStepsMap
 	disabledStepsMap              map[models.StepType]bool
 	upgradeAgentCmd               CommandGetter
+	diagnosticsCmd                CommandGetter
+	nextStepScheduler             *nextStepScheduler
 	eventsHandler                 eventsapi.Sender
 }
 
@@ -72,6 +74,20 @@ type InstructionConfig struct {
 	ReleaseImageMirror       string
 	CheckClusterVersion      bool
 	HostFSMountDir           string
+
+	// The duration of the throughput test to each remote host, and the number of remote hosts tested in a step:
+	NetworkThroughputDuration       time.Duration `envconfig:"NETWORK_THROUGHPUT_DURATION" default:"5s"`
+	NetworkThroughputMaxRemoteHosts int           `envconfig:"NETWORK_THROUGHPUT_MAX_REMOTE_HOSTS" default:"3"`
+
+	// The interval after which the agents ask for their next steps is adapted to the activity of the hosts and to
+	// the load of the service, within the given bounds:
+	AdaptiveNextStepInterval bool          `envconfig:"ADAPTIVE_NEXT_STEP_INTERVAL" default:"false"`
+	NextStepMinInterval      time.Duration `envconfig:"NEXT_STEP_MIN_INTERVAL" default:"10s"`
+	NextStepMaxInterval      time.Duration `envconfig:"NEXT_STEP_MAX_INTERVAL" default:"2m"`
+	NextStepActiveWindow     time.Duration `envconfig:"NEXT_STEP_ACTIVE_WINDOW" default:"5m"`
+	NextStepIdleTime         time.Duration `envconfig:"NEXT_STEP_IDLE_TIME" default:"30m"`
+	NextStepTargetRate       float64       `envconfig:"NEXT_STEP_TARGET_RATE" default:"0"`
+	MaxHostDisconnectionTime time.Duration
 }
 
 func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, ocRelease oc.Release,
@@ -95,18 +111,20 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 	downloadBootArtifactsCmd := NewDownloadBootArtifactsCmd(log, instructionConfig.ImageServiceBaseURL, instructionConfig.AuthType, osImages, db, instructionConfig.ImageExpirationTime, instructionConfig.HostFSMountDir)
 	rebootForReclaimCmd := NewRebootForReclaimCmd(log, instructionConfig.HostFSMountDir)
 	verifyVipsCmd := newVerifyVipsCmd(log, db)
+	networkThroughputCmd := newNetworkThroughputCmd(log, db, hwValidator, instructionConfig.NetworkThroughputDuration, instructionConfig.NetworkThroughputMaxRemoteHosts)
+	firmwareInspectionCmd := newFirmwareInspectionCmd(log)
 
-	return &InstructionManager{
+	im := &InstructionManager{
 		log:              log,
 		db:               db,
 		config:           instructionConfig,
 		disabledStepsMap: generateDisabledStepsMap(log, instructionConfig.DisabledSteps),
 		installingClusterStateToSteps: stateToStepsMap{
-			models.HostStatusKnown:                    {[]CommandGetter{connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, inventoryCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
-			models.HostStatusInsufficient:             {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusKnown:                    {[]CommandGetter{connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, inventoryCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd, networkThroughputCmd, firmwareInspectionCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusInsufficient:             {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd, networkThroughputCmd, firmwareInspectionCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusDisconnected:             {[]CommandGetter{inventoryCmd}, defaultBackedOffInstructionInSec, models.StepsPostStepActionContinue},
-			models.HostStatusDiscovering:              {[]CommandGetter{inventoryCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
-			models.HostStatusPendingForInput:          {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusDiscovering:              {[]CommandGetter{inventoryCmd, firmwareInspectionCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusPendingForInput:          {[]CommandGetter{inventoryCmd, connectivityCmd, tangConnectivityCmd, freeAddressesCmd, dhcpAllocateCmd, ntpSynchronizerCmd, domainNameResolutionCmd, verifyVipsCmd, networkThroughputCmd, firmwareInspectionCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusInstalling:               {[]CommandGetter{installCmd, dhcpAllocateCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusInstallingInProgress:     {[]CommandGetter{dhcpAllocateCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue}, //TODO inventory step here is a temporary solution until format command is moved to a different state
 			models.HostStatusPreparingForInstallation: {[]CommandGetter{dhcpAllocateCmd, diskPerfCheckCmd, imageAvailabilityCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
@@ -130,19 +148,22 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 			models.HostStatusCancelled:            {[]CommandGetter{logsCmd, stopCmd}, defaultBackedOffInstructionInSec, models.StepsPostStepActionContinue},
 		},
 		poolHostToSteps: stateToStepsMap{
-			models.HostStatusDiscoveringUnbound:         {[]CommandGetter{inventoryCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusDiscoveringUnbound:         {[]CommandGetter{inventoryCmd, ntpSynchronizerCmd, firmwareInspectionCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusDisconnectedUnbound:        {[]CommandGetter{inventoryCmd}, defaultBackedOffInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusDisabledUnbound:            {[]CommandGetter{}, defaultBackedOffInstructionInSec, models.StepsPostStepActionContinue},
-			models.HostStatusInsufficientUnbound:        {[]CommandGetter{inventoryCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
-			models.HostStatusKnownUnbound:               {[]CommandGetter{inventoryCmd, ntpSynchronizerCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusInsufficientUnbound:        {[]CommandGetter{inventoryCmd, ntpSynchronizerCmd, firmwareInspectionCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
+			models.HostStatusKnownUnbound:               {[]CommandGetter{inventoryCmd, ntpSynchronizerCmd, firmwareInspectionCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusUnbinding:                  {[]CommandGetter{noopCmd}, 0, models.StepsPostStepActionExit},
 			models.HostStatusUnbindingPendingUserAction: {[]CommandGetter{noopCmd}, 0, models.StepsPostStepActionExit},
 			models.HostStatusReclaiming:                 {[]CommandGetter{downloadBootArtifactsCmd}, defaultNextInstructionInSec, models.StepsPostStepActionContinue},
 			models.HostStatusReclaimingRebooting:        {[]CommandGetter{rebootForReclaimCmd}, defaultBackedOffInstructionInSec, models.StepsPostStepActionExit},
 		},
-		upgradeAgentCmd: upgradeAgentCmd,
-		eventsHandler:   eventsHandler,
+		upgradeAgentCmd:   upgradeAgentCmd,
+		eventsHandler:     eventsHandler,
+		nextStepScheduler: newNextStepScheduler(log, instructionConfig),
 	}
+	im.diagnosticsCmd = newDiagnosticsCmd(log, db, hwValidator, diskPerfCheckCmd, instructionConfig.ImageAvailabilityTimeout.Seconds(), im.isStepDisabled)
+	return im
 }
 
 func (i *InstructionManager) isStepDisabled(stepType models.StepType) bool {
@@ -150,9 +171,10 @@ func (i *InstructionManager) isStepDisabled(stepType models.StepType) bool {
 	return ok
 }
 
-func (i *InstructionManager) GetNextSteps(ctx context.Context, host *models.Host) (models.Steps, error) {
+func (i *InstructionManager) GetNextSteps(ctx context.Context, dbHost *common.Host) (models.Steps, error) {
 
 	log := logutil.FromContext(ctx, i.log)
+	host := &dbHost.Host
 	InfraEnvID := host.InfraEnvID
 	hostID := host.ID
 	hostStatus := swag.StringValue(host.Status)
@@ -171,7 +193,7 @@ func (i *InstructionManager) GetNextSteps(ctx context.Context, host *models.Host
 	returnSteps.PostStepAction = swag.String(models.StepsPostStepActionContinue)
 	if cmdsMap, ok := stateToSteps[hostStatus]; ok {
 		//need to add the step id
-		returnSteps.NextInstructionSeconds = cmdsMap.NextStepInSec
+		returnSteps.NextInstructionSeconds = i.nextStepScheduler.interval(dbHost, cmdsMap.NextStepInSec)
 		returnSteps.PostStepAction = swag.String(cmdsMap.PostStepAction)
 		for _, cmd := range cmdsMap.Commands {
 			steps, err := cmd.GetSteps(ctx, host)
@@ -230,6 +252,14 @@ func (i *InstructionManager) GetNextSteps(ctx context.Context, host *models.Host
 			host.ClusterID,
 			i.config.AgentImage,
 		)
+	} else {
+		// The diagnostics requested by the users are added to the regular steps, so they run with the next poll
+		// of the agent:
+		steps, err := i.diagnosticsCmd.GetSteps(ctx, host)
+		if err != nil {
+			log.WithError(err).Warn("Failed to generate the steps of the host diagnostics")
+		}
+		returnSteps.Instructions = append(returnSteps.Instructions, steps...)
 	}
 
 	logSteps(returnSteps, InfraEnvID, hostID, log)
@@ -100,13 +100,31 @@ var _ = Describe("instruction_manager", func() {
 		})
 		Context("get_next_steps", func() {
 			It("invalid_host_state", func() {
-				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &host)
+				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &common.Host{Host: host})
 				Expect(stepsReply.Instructions).To(HaveLen(0))
 				Expect(stepsErr).Should(BeNil())
 			})
 			It("discovering", func() {
 				checkStep(models.HostStatusDiscovering, []models.StepType{
 					models.StepTypeInventory,
+					models.StepTypeFirmwareInspection,
+				})
+			})
+			It("discovering with a pending diagnostic", func() {
+				diagnosticID := strfmt.UUID(uuid.New().String())
+				diagnosticType := models.HostDiagnosticTypeDiskPerformance
+				diagnostics, err := hostutil.MarshalDiagnostics(models.HostDiagnosticList{{
+					ID:        &diagnosticID,
+					Type:      &diagnosticType,
+					DiskPath:  "/dev/sda",
+					Status:    swag.String(models.HostDiagnosticStatusPending),
+					UpdatedAt: strfmt.DateTime(time.Now()),
+				}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(db.Model(&host).Update("diagnostics", diagnostics).Error).ToNot(HaveOccurred())
+				checkStep(models.HostStatusDiscovering, []models.StepType{
+					models.StepTypeInventory, models.StepTypeInstallationDiskSpeedCheck,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("known", func() {
@@ -114,6 +132,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses,
 					models.StepTypeInventory, models.StepTypeNtpSynchronizer,
 					models.StepTypeDomainResolution,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("known with vip", func() {
@@ -122,6 +141,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses,
 					models.StepTypeInventory, models.StepTypeNtpSynchronizer,
 					models.StepTypeDomainResolution, models.StepTypeVerifyVips,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("disconnected", func() {
@@ -134,6 +154,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeInventory, models.StepTypeConnectivityCheck,
 					models.StepTypeFreeNetworkAddresses, models.StepTypeNtpSynchronizer,
 					models.StepTypeDomainResolution,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("insufficient with vip", func() {
@@ -142,6 +163,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeInventory, models.StepTypeConnectivityCheck,
 					models.StepTypeFreeNetworkAddresses, models.StepTypeNtpSynchronizer,
 					models.StepTypeDomainResolution, models.StepTypeVerifyVips,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("pending-for-input", func() {
@@ -149,6 +171,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeInventory, models.StepTypeConnectivityCheck,
 					models.StepTypeFreeNetworkAddresses, models.StepTypeNtpSynchronizer,
 					models.StepTypeDomainResolution,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("pending-for-input with vip", func() {
@@ -157,6 +180,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeInventory, models.StepTypeConnectivityCheck,
 					models.StepTypeFreeNetworkAddresses, models.StepTypeNtpSynchronizer,
 					models.StepTypeDomainResolution, models.StepTypeVerifyVips,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("error", func() {
@@ -212,13 +236,14 @@ var _ = Describe("instruction_manager", func() {
 		})
 		Context("get_next_steps", func() {
 			It("invalid_host_state", func() {
-				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &host)
+				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &common.Host{Host: host})
 				Expect(stepsReply.Instructions).To(HaveLen(0))
 				Expect(stepsErr).Should(BeNil())
 			})
 			It("discovering", func() {
 				checkStep(models.HostStatusDiscovering, []models.StepType{
 					models.StepTypeInventory,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("known", func() {
@@ -226,6 +251,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses,
 					models.StepTypeDhcpLeaseAllocate, models.StepTypeInventory,
 					models.StepTypeNtpSynchronizer, models.StepTypeDomainResolution,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("binding", func() {
@@ -241,6 +267,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeInventory, models.StepTypeConnectivityCheck,
 					models.StepTypeFreeNetworkAddresses, models.StepTypeDhcpLeaseAllocate,
 					models.StepTypeNtpSynchronizer, models.StepTypeDomainResolution,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("pending-for-input", func() {
@@ -248,6 +275,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeInventory, models.StepTypeConnectivityCheck,
 					models.StepTypeFreeNetworkAddresses, models.StepTypeDhcpLeaseAllocate,
 					models.StepTypeNtpSynchronizer, models.StepTypeDomainResolution,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 			It("error", func() {
@@ -290,6 +318,7 @@ var _ = Describe("instruction_manager", func() {
 		It("discovering-unbound", func() {
 			checkStep(models.HostStatusDiscoveringUnbound, []models.StepType{
 				models.StepTypeInventory, models.StepTypeNtpSynchronizer,
+				models.StepTypeFirmwareInspection,
 			})
 		})
 
@@ -302,12 +331,14 @@ var _ = Describe("instruction_manager", func() {
 		It("insufficient-unbound", func() {
 			checkStep(models.HostStatusInsufficientUnbound, []models.StepType{
 				models.StepTypeInventory, models.StepTypeNtpSynchronizer,
+				models.StepTypeFirmwareInspection,
 			})
 		})
 
 		It("known-unbound", func() {
 			checkStep(models.HostStatusKnownUnbound, []models.StepType{
 				models.StepTypeInventory, models.StepTypeNtpSynchronizer,
+				models.StepTypeFirmwareInspection,
 			})
 		})
 
@@ -404,6 +435,7 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeConnectivityCheck,
 					models.StepTypeDhcpLeaseAllocate,
 					models.StepTypeNtpSynchronizer,
+					models.StepTypeFirmwareInspection,
 				})
 			})
 		})
@@ -477,7 +509,7 @@ var _ = Describe("agent_upgrade", func() {
 					eventstest.WithNameMatcher(eventgen.UpgradeAgentStartedEventName),
 					eventstest.WithHostIdMatcher(host.ID.String()),
 					eventstest.WithInfraEnvIdMatcher(host.InfraEnvID.String())))
-				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &host)
+				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &common.Host{Host: host})
 				Expect(stepsErr).Should(BeNil())
 				Expect(stepsErr).ToNot(HaveOccurred())
 				Expect(stepsReply).ToNot(BeNil())
@@ -501,7 +533,7 @@ var _ = Describe("agent_upgrade", func() {
 			hostStatus := hostStatus
 			It(fmt.Sprintf("Don't creates upgrade agent step, hosts stauts: %s", hostStatus), func() {
 				Expect(db.Model(&host).Update("Status", hostStatus).Error).ShouldNot(HaveOccurred())
-				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &host)
+				stepsReply, stepsErr = instMng.GetNextSteps(ctx, &common.Host{Host: host})
 				Expect(stepsErr).Should(BeNil())
 				Expect(stepsErr).ToNot(HaveOccurred())
 				Expect(stepsReply).ToNot(BeNil())
@@ -559,7 +591,7 @@ func checkStepsByState(state string, host *models.Host, db *gorm.DB, mockEvents
 			mockVersions.EXPECT().GetMustGatherImages(gomock.Any(), gomock.Any(), gomock.Any()).Return(defaultMustGatherVersion, nil).Times(1)
 		}
 	}
-	stepsReply, stepsErr := instMng.GetNextSteps(ctx, &h.Host)
+	stepsReply, stepsErr := instMng.GetNextSteps(ctx, h)
 	ExpectWithOffset(1, stepsReply.Instructions).To(HaveLen(len(expectedStepTypes)))
 	if stateValues, ok := instMng.installingClusterStateToSteps[state]; ok {
 		Expect(stepsReply.NextInstructionSeconds).Should(Equal(stateValues.NextStepInSec))
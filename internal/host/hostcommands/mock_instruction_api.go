@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	common "github.com/openshift/assisted-service/internal/common"
 	models "github.com/openshift/assisted-service/models"
 )
 
@@ -36,7 +37,7 @@ func (m *MockInstructionApi) EXPECT() *MockInstructionApiMockRecorder {
 }
 
 // GetNextSteps mocks base method.
-func (m *MockInstructionApi) GetNextSteps(arg0 context.Context, arg1 *models.Host) (models.Steps, error) {
+func (m *MockInstructionApi) GetNextSteps(arg0 context.Context, arg1 *common.Host) (models.Steps, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetNextSteps", arg0, arg1)
 	ret0, _ := ret[0].(models.Steps)
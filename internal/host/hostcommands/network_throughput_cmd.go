@@ -0,0 +1,164 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// networkThroughputMargin is added to the expected duration of a throughput test before another host of the cluster
+// may start its own test.
+const networkThroughputMargin = 30 * time.Second
+
+// networkThroughputCmd measures the throughput from the host to the other hosts of its L3 majority group that have the
+// same role. It only runs when the role of the host requires a minimal bandwidth, and each pair is measured until it
+// succeeds once. Only one host of a cluster runs the test at a time, so the tests don't compete on the same links. The
+// hosts are admitted in memory, so this only holds for the hosts that poll the same replica of the service.
+type networkThroughputCmd struct {
+	baseCmd
+	db             *gorm.DB
+	hwValidator    hardware.Validator
+	duration       time.Duration
+	maxRemoteHosts int
+	running        common.ExpiringCache
+}
+
+type clusterThroughputTest struct {
+	mutex sync.Mutex
+	until time.Time
+}
+
+func newNetworkThroughputCmd(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, duration time.Duration,
+	maxRemoteHosts int) *networkThroughputCmd {
+	return &networkThroughputCmd{
+		baseCmd:        baseCmd{log: log},
+		db:             db,
+		hwValidator:    hwValidator,
+		duration:       duration,
+		maxRemoteHosts: maxRemoteHosts,
+		running:        common.NewExpiringCache(10*time.Minute, time.Minute),
+	}
+}
+
+func (c *networkThroughputCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	if c.duration <= 0 || c.maxRemoteHosts <= 0 || host.ClusterID == nil || host.Connectivity == "" ||
+		hostutil.IsDay2Host(host) || common.GetEffectiveRole(host) == models.HostRoleAutoAssign {
+		return nil, nil
+	}
+
+	var majorityGroups []string
+	if err := c.db.Model(&common.Cluster{}).Where("id = ?", host.ClusterID.String()).
+		Pluck("connectivity_majority_groups", &majorityGroups).Error; err != nil {
+		c.log.WithError(err).Errorf("failed to get the majority groups of cluster %s", host.ClusterID.String())
+		return nil, err
+	}
+	if len(majorityGroups) == 0 {
+		return nil, nil
+	}
+	group, family, err := network.GetL3MajorityGroup(majorityGroups[0], *host.ID)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the majority group of host %s", host.ID.String())
+		return nil, err
+	}
+	if len(group) < 2 {
+		return nil, nil
+	}
+
+	cluster, err := common.GetClusterFromDBWithHosts(c.db, *host.ClusterID)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get cluster %s", host.ClusterID.String())
+		return nil, err
+	}
+	remoteHosts, err := c.getRemoteHosts(host, cluster.Hosts, group, family)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the remote hosts of host %s", host.ID.String())
+		return nil, err
+	}
+	if len(remoteHosts) == 0 {
+		return nil, nil
+	}
+	requirements, err := c.hwValidator.GetClusterHostRequirements(ctx, cluster, host)
+	if err != nil {
+		c.log.WithError(err).Errorf("failed to get the requirements of host %s", host.ID.String())
+		return nil, err
+	}
+	if requirements.Total.NetworkBandwidthMbps == nil || !c.isAdmitted(host, len(remoteHosts)) {
+		return nil, nil
+	}
+
+	request := models.NetworkThroughputRequest{
+		DurationSeconds: swag.Int64(int64(c.duration.Seconds())),
+		RemoteHosts:     remoteHosts,
+	}
+	data, err := json.Marshal(&request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal the network throughput request of host %s", host.ID.String())
+	}
+	step := &models.Step{
+		StepType: models.StepTypeNetworkThroughput,
+		Args:     []string{string(data)},
+	}
+	return []*models.Step{step}, nil
+}
+
+// getRemoteHosts returns the hosts of the majority group with the same role as the host, that the host reached and
+// whose throughput wasn't measured successfully yet.
+func (c *networkThroughputCmd) getRemoteHosts(host *models.Host, hosts []*models.Host, group []strfmt.UUID,
+	family network.AddressFamily) ([]*models.NetworkThroughputRequestHost, error) {
+	report, err := hostutil.UnmarshalConnectivityReport(host.Connectivity)
+	if err != nil {
+		return nil, err
+	}
+	role := common.GetEffectiveRole(host)
+	var remoteHosts []*models.NetworkThroughputRequestHost
+	for _, remoteHost := range report.RemoteHosts {
+		if len(remoteHosts) == c.maxRemoteHosts {
+			break
+		}
+		if remoteHost.HostID == *host.ID || !funk.Contains(group, remoteHost.HostID) ||
+			funk.Contains(remoteHost.ThroughputReport, func(t *models.ThroughputReport) bool { return t.Successful }) {
+			continue
+		}
+		other := funk.Find(hosts, func(h *models.Host) bool { return *h.ID == remoteHost.HostID })
+		if other == nil || common.GetEffectiveRole(other.(*models.Host)) != role {
+			continue
+		}
+		address := network.GetL3RemoteAddress(remoteHost, family)
+		if address == "" {
+			continue
+		}
+		hostID := remoteHost.HostID
+		remoteHosts = append(remoteHosts, &models.NetworkThroughputRequestHost{
+			HostID:    &hostID,
+			IPAddress: swag.String(address),
+		})
+	}
+	return remoteHosts, nil
+}
+
+// isAdmitted returns true if no host of the cluster is running a throughput test, and reserves the cluster for the
+// duration of the test of the host. The reservations aren't shared with the other replicas of the service.
+func (c *networkThroughputCmd) isAdmitted(host *models.Host, numRemoteHosts int) bool {
+	valIntf, _ := c.running.GetOrInsert(host.ClusterID.String(), &clusterThroughputTest{})
+	test := valIntf.(*clusterThroughputTest)
+	test.mutex.Lock()
+	defer test.mutex.Unlock()
+	if time.Now().Before(test.until) {
+		return false
+	}
+	test.until = time.Now().Add(time.Duration(numRemoteHosts)*c.duration + networkThroughputMargin)
+	return true
+}
@@ -0,0 +1,164 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("network throughput", func() {
+	ctx := context.Background()
+	var db *gorm.DB
+	var dbName string
+	var ctrl *gomock.Controller
+	var mockValidator *hardware.MockValidator
+	var cmd *networkThroughputCmd
+	var host, other1, other2 *models.Host
+	var clusterId, infraEnvId strfmt.UUID
+	var requirements *models.ClusterHostRequirements
+
+	newHost := func(role models.HostRole) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		h := hostutil.GenerateTestHostByKind(id, infraEnvId, &clusterId, models.HostStatusKnown, models.HostKindHost, role)
+		return &h
+	}
+
+	connectivityReport := func(throughputs map[strfmt.UUID][]*models.ThroughputReport) string {
+		report := models.ConnectivityReport{}
+		for i, other := range []*models.Host{other1, other2} {
+			report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+				HostID: *other.ID,
+				L3Connectivity: []*models.L3Connectivity{
+					{RemoteIPAddress: []string{"1.2.3.5", "1.2.3.6"}[i], Successful: true},
+				},
+				ThroughputReport: throughputs[*other.ID],
+			})
+		}
+		b, err := json.Marshal(&report)
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	createCluster := func() {
+		majorityGroups, err := json.Marshal(&network.Connectivity{
+			MajorityGroups: map[string][]strfmt.UUID{
+				network.IPv4.String(): {*host.ID, *other1.ID, *other2.ID},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		cluster := hostutil.GenerateTestCluster(clusterId)
+		cluster.ConnectivityMajorityGroups = string(majorityGroups)
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		for _, h := range []*models.Host{host, other1, other2} {
+			Expect(db.Create(h).Error).ToNot(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockValidator = hardware.NewMockValidator(ctrl)
+		requirements = &models.ClusterHostRequirements{
+			Total: &models.ClusterHostRequirementsDetails{NetworkBandwidthMbps: swag.Float64(1000)},
+		}
+		mockValidator.EXPECT().GetClusterHostRequirements(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, *common.Cluster, *models.Host) (*models.ClusterHostRequirements, error) {
+				return requirements, nil
+			}).AnyTimes()
+		cmd = newNetworkThroughputCmd(common.GetTestLog(), db, mockValidator, 5*time.Second, 3)
+
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+		host = newHost(models.HostRoleMaster)
+		other1 = newHost(models.HostRoleMaster)
+		other2 = newHost(models.HostRoleMaster)
+		host.Connectivity = connectivityReport(nil)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("tests the throughput to the hosts with the same role", func() {
+		other2.Role = models.HostRoleWorker
+		createCluster()
+		steps, err := cmd.GetSteps(ctx, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeNetworkThroughput))
+
+		var request models.NetworkThroughputRequest
+		Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+		Expect(swag.Int64Value(request.DurationSeconds)).To(BeEquivalentTo(5))
+		Expect(request.RemoteHosts).To(HaveLen(1))
+		Expect(*request.RemoteHosts[0].HostID).To(Equal(*other1.ID))
+		Expect(swag.StringValue(request.RemoteHosts[0].IPAddress)).To(Equal("1.2.3.5"))
+	})
+
+	It("doesn't test the hosts that were measured successfully", func() {
+		host.Connectivity = connectivityReport(map[strfmt.UUID][]*models.ThroughputReport{
+			*other1.ID: {{RemoteIPAddress: "1.2.3.5", ThroughputMbps: 9400, Successful: true}},
+			*other2.ID: {{RemoteIPAddress: "1.2.3.6"}},
+		})
+		createCluster()
+		steps, err := cmd.GetSteps(ctx, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+
+		var request models.NetworkThroughputRequest
+		Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+		Expect(request.RemoteHosts).To(HaveLen(1))
+		Expect(*request.RemoteHosts[0].HostID).To(Equal(*other2.ID))
+	})
+
+	It("doesn't test when no bandwidth is required", func() {
+		requirements.Total.NetworkBandwidthMbps = nil
+		createCluster()
+		steps, err := cmd.GetSteps(ctx, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+
+	It("doesn't test without majority groups", func() {
+		cluster := hostutil.GenerateTestCluster(clusterId)
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		steps, err := cmd.GetSteps(ctx, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+
+	It("doesn't test auto-assign hosts", func() {
+		host.Role = models.HostRoleAutoAssign
+		host.SuggestedRole = models.HostRoleAutoAssign
+		createCluster()
+		steps, err := cmd.GetSteps(ctx, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+
+	It("runs a single test in a cluster at a time", func() {
+		createCluster()
+		steps, err := cmd.GetSteps(ctx, host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+
+		other1.Connectivity = host.Connectivity
+		steps, err = cmd.GetSteps(ctx, other1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeEmpty())
+	})
+})
@@ -0,0 +1,138 @@
+package hostcommands
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+// disconnectionMargin is the time left between the longest interval of a host and the time after which it is
+// considered disconnected, so that a late request doesn't disconnect it.
+const disconnectionMargin = time.Minute
+
+// nextStepRateWindow is the window in which the requests for the next steps are counted to measure the load.
+const nextStepRateWindow = time.Minute
+
+// adaptiveIntervalStatuses are the statuses, before the installation, in which the interval of the host is adapted
+// to its activity and to the load of the service. The hosts in the other statuses, such as the installing or
+// resetting hosts, keep the interval of their status.
+var adaptiveIntervalStatuses = []string{
+	models.HostStatusDiscovering, models.HostStatusKnown, models.HostStatusInsufficient,
+	models.HostStatusPendingForInput, models.HostStatusDisconnected,
+	models.HostStatusDiscoveringUnbound, models.HostStatusKnownUnbound, models.HostStatusInsufficientUnbound,
+	models.HostStatusDisconnectedUnbound,
+}
+
+// nextStepScheduler adapts the interval after which the agents ask for their next steps. The interval is shortened
+// after the status or the configuration of the host or of its cluster changed, so that the agent reacts quickly
+// while the host is being prepared, and stretched for the pool hosts that didn't change for a long time and when the
+// service is loaded.
+type nextStepScheduler struct {
+	config      InstructionConfig
+	minInterval time.Duration
+	maxInterval time.Duration
+	now         func() time.Time
+
+	mutex         sync.Mutex
+	windowStart   time.Time
+	currentCount  int64
+	previousCount int64
+}
+
+func newNextStepScheduler(log logrus.FieldLogger, config InstructionConfig) *nextStepScheduler {
+	s := &nextStepScheduler{
+		config:      config,
+		minInterval: config.NextStepMinInterval,
+		maxInterval: config.NextStepMaxInterval,
+		now:         time.Now,
+	}
+	if !config.AdaptiveNextStepInterval {
+		return s
+	}
+	if limit := config.MaxHostDisconnectionTime - disconnectionMargin; config.MaxHostDisconnectionTime > 0 && s.maxInterval > limit {
+		log.Warnf("NEXT_STEP_MAX_INTERVAL %s must be at least %s below HOST_MAX_DISCONNECTION_TIME %s, using %s",
+			s.maxInterval, disconnectionMargin, config.MaxHostDisconnectionTime, limit)
+		s.maxInterval = limit
+	}
+	if s.minInterval > s.maxInterval {
+		log.Warnf("NEXT_STEP_MIN_INTERVAL %s is above the longest interval %s, using %s", s.minInterval,
+			s.maxInterval, s.maxInterval)
+		s.minInterval = s.maxInterval
+	}
+	return s
+}
+
+// interval returns the number of seconds after which the host should ask for its next steps, given the default
+// interval of its status. It only uses the fields of the host, so it doesn't add queries to the requests.
+func (s *nextStepScheduler) interval(host *common.Host, defaultInterval int64) int64 {
+	if !s.config.AdaptiveNextStepInterval || defaultInterval <= 0 {
+		return defaultInterval
+	}
+	loadFactor := s.recordRequest()
+	if !funk.ContainsString(adaptiveIntervalStatuses, swag.StringValue(host.Status)) {
+		return defaultInterval
+	}
+
+	lastActivity := time.Time(host.StatusUpdatedAt)
+	if host.ConfigUpdatedAt.After(lastActivity) {
+		lastActivity = host.ConfigUpdatedAt
+	}
+	idleTime := s.now().Sub(lastActivity)
+	interval := time.Duration(defaultInterval) * time.Second
+	if idleTime < s.config.NextStepActiveWindow {
+		interval = s.minInterval
+	} else if hostutil.IsUnboundHost(&host.Host) && s.config.NextStepIdleTime > 0 {
+		// The interval of the pool hosts doubles for each idle period:
+		for periods := int64(idleTime / s.config.NextStepIdleTime); periods > 0 && interval < s.maxInterval; periods-- {
+			interval *= 2
+		}
+	}
+	interval = time.Duration(float64(interval) * loadFactor)
+	return int64(s.bound(interval) / time.Second)
+}
+
+func (s *nextStepScheduler) bound(interval time.Duration) time.Duration {
+	if s.maxInterval > 0 && interval > s.maxInterval {
+		interval = s.maxInterval
+	}
+	if interval < s.minInterval {
+		interval = s.minInterval
+	}
+	return interval
+}
+
+// recordRequest counts the request for the next steps and returns the factor by which the intervals are stretched
+// because of the load of the service. The rate of the requests is estimated from the counts of the current and the
+// previous windows, and is compared to the target rate of the service.
+func (s *nextStepScheduler) recordRequest() float64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	if elapsed := now.Sub(s.windowStart); elapsed >= 2*nextStepRateWindow {
+		s.windowStart = now
+		s.previousCount = 0
+		s.currentCount = 0
+	} else if elapsed >= nextStepRateWindow {
+		s.windowStart = s.windowStart.Add(nextStepRateWindow)
+		s.previousCount = s.currentCount
+		s.currentCount = 0
+	}
+	s.currentCount++
+
+	if s.config.NextStepTargetRate <= 0 {
+		return 1
+	}
+	previousWeight := 1 - float64(now.Sub(s.windowStart))/float64(nextStepRateWindow)
+	rate := (float64(s.previousCount)*previousWeight + float64(s.currentCount)) / nextStepRateWindow.Seconds()
+	if rate <= s.config.NextStepTargetRate {
+		return 1
+	}
+	return rate / s.config.NextStepTargetRate
+}
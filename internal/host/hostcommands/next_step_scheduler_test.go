@@ -0,0 +1,191 @@
+package hostcommands
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("next step scheduler", func() {
+	var (
+		scheduler                     *nextStepScheduler
+		config                        InstructionConfig
+		now                           time.Time
+		hostID, clusterID, infraEnvID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		config = InstructionConfig{
+			AdaptiveNextStepInterval: true,
+			NextStepMinInterval:      10 * time.Second,
+			NextStepMaxInterval:      10 * time.Minute,
+			NextStepActiveWindow:     5 * time.Minute,
+			NextStepIdleTime:         30 * time.Minute,
+		}
+		now = time.Now()
+		hostID = strfmt.UUID(uuid.New().String())
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+	})
+
+	newScheduler := func() *nextStepScheduler {
+		s := newNextStepScheduler(common.GetTestLog(), config)
+		s.now = func() time.Time { return now }
+		return s
+	}
+
+	createHost := func(status string, bound bool, idleTime time.Duration) *common.Host {
+		var host models.Host
+		if bound {
+			host = hostutil.GenerateTestHost(hostID, infraEnvID, clusterID, status)
+		} else {
+			host = hostutil.GenerateTestHostByKind(hostID, infraEnvID, nil, status, models.HostKindHost, models.HostRoleAutoAssign)
+		}
+		host.StatusUpdatedAt = strfmt.DateTime(now.Add(-idleTime))
+		return &common.Host{Host: host}
+	}
+
+	It("keeps the default interval when disabled", func() {
+		config.AdaptiveNextStepInterval = false
+		scheduler = newScheduler()
+		host := createHost(models.HostStatusKnownUnbound, false, 0)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(Equal(defaultNextInstructionInSec))
+	})
+
+	It("keeps the statuses without interval", func() {
+		scheduler = newScheduler()
+		host := createHost(models.HostStatusBinding, true, 0)
+		Expect(scheduler.interval(host, 0)).To(BeZero())
+	})
+
+	It("shortens the interval after the status of the host changed", func() {
+		scheduler = newScheduler()
+		host := createHost(models.HostStatusKnown, true, time.Minute)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(10))
+	})
+
+	It("shortens the interval after the configuration of the host or of its cluster changed", func() {
+		scheduler = newScheduler()
+		host := createHost(models.HostStatusKnownUnbound, false, time.Hour)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(240))
+
+		host.ConfigUpdatedAt = now.Add(-time.Minute)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(10))
+
+		By("stretches it again once the host is idle")
+		now = now.Add(10 * time.Minute)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(Equal(defaultNextInstructionInSec))
+	})
+
+	It("doesn't shorten the interval of installing hosts", func() {
+		scheduler = newScheduler()
+		host := createHost(models.HostStatusInstalling, true, time.Minute)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(Equal(defaultNextInstructionInSec))
+	})
+
+	It("is disabled by default", func() {
+		Expect(envconfig.Process("", &config)).To(Succeed())
+		Expect(config.AdaptiveNextStepInterval).To(BeFalse())
+		scheduler = newScheduler()
+		host := createHost(models.HostStatusKnown, true, time.Minute)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(Equal(defaultNextInstructionInSec))
+	})
+
+	It("keeps the default interval of idle hosts of clusters", func() {
+		scheduler = newScheduler()
+		host := createHost(models.HostStatusKnown, true, 2*time.Hour)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(Equal(defaultNextInstructionInSec))
+	})
+
+	It("stretches the interval of idle pool hosts", func() {
+		scheduler = newScheduler()
+		host := createHost(models.HostStatusKnownUnbound, false, 10*time.Minute)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(Equal(defaultNextInstructionInSec))
+
+		now = now.Add(25 * time.Minute)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(120))
+
+		now = now.Add(30 * time.Minute)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(240))
+
+		now = now.Add(10 * time.Hour)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(600))
+	})
+
+	It("keeps the interval below the disconnection time", func() {
+		config.MaxHostDisconnectionTime = 3 * time.Minute
+		scheduler = newScheduler()
+		Expect(scheduler.maxInterval).To(Equal(2 * time.Minute))
+		host := createHost(models.HostStatusKnownUnbound, false, 10*time.Hour)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(120))
+	})
+
+	It("keeps the default bounds consistent with the default disconnection time", func() {
+		config = InstructionConfig{}
+		Expect(envconfig.Process("", &config)).To(Succeed())
+		config.AdaptiveNextStepInterval = true
+		config.MaxHostDisconnectionTime = 3 * time.Minute
+		scheduler = newScheduler()
+		Expect(scheduler.maxInterval).To(Equal(config.NextStepMaxInterval))
+		Expect(scheduler.minInterval).To(Equal(config.NextStepMinInterval))
+	})
+
+	It("keeps the shortest interval below the longest one", func() {
+		config.NextStepMinInterval = 5 * time.Minute
+		config.MaxHostDisconnectionTime = 3 * time.Minute
+		scheduler = newScheduler()
+		Expect(scheduler.minInterval).To(Equal(2 * time.Minute))
+		host := createHost(models.HostStatusKnown, true, time.Minute)
+		Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(120))
+	})
+
+	Context("load", func() {
+		BeforeEach(func() {
+			config.NextStepTargetRate = 0.1
+		})
+
+		It("stretches the interval when the rate exceeds the target", func() {
+			scheduler = newScheduler()
+			host := createHost(models.HostStatusKnown, true, time.Hour)
+			for i := 0; i < 6; i++ {
+				Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(Equal(defaultNextInstructionInSec))
+			}
+			for i := 0; i < 5; i++ {
+				Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeNumerically(">", defaultNextInstructionInSec))
+			}
+			Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(120))
+
+			By("forgets the requests of old windows")
+			now = now.Add(2 * nextStepRateWindow)
+			Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(Equal(defaultNextInstructionInSec))
+		})
+
+		It("doesn't stretch the interval of installing hosts", func() {
+			scheduler = newScheduler()
+			host := createHost(models.HostStatusInstalling, true, time.Hour)
+			for i := 0; i < 20; i++ {
+				Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(Equal(defaultNextInstructionInSec))
+			}
+		})
+
+		It("stretches the interval of active hosts", func() {
+			scheduler = newScheduler()
+			host := createHost(models.HostStatusKnown, true, time.Minute)
+			for i := 0; i < 6; i++ {
+				Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeEquivalentTo(10))
+			}
+			Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeNumerically(">", 10))
+			for i := 0; i < 13; i++ {
+				scheduler.interval(host, defaultNextInstructionInSec)
+			}
+			Expect(scheduler.interval(host, defaultNextInstructionInSec)).To(BeNumerically("~", 35, 1))
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package hostutil
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MaxHostDiagnostics is the number of diagnostics kept for each host. The oldest finished diagnostics are discarded
+// when new ones are requested.
+const MaxHostDiagnostics = 20
+
+// DiagnosticsAllowedStatuses are the statuses of the hosts that accept on-demand diagnostics. In other statuses the
+// agent is installing the host, rebooting it or waiting to be bound or unbound, so it doesn't run them.
+var DiagnosticsAllowedStatuses = []string{
+	models.HostStatusDiscovering, models.HostStatusKnown, models.HostStatusInsufficient,
+	models.HostStatusPendingForInput, models.HostStatusDisconnected,
+	models.HostStatusDiscoveringUnbound, models.HostStatusKnownUnbound, models.HostStatusInsufficientUnbound,
+	models.HostStatusDisconnectedUnbound,
+}
+
+func UnmarshalDiagnostics(diagnosticsStr string) (models.HostDiagnosticList, error) {
+	diagnostics := models.HostDiagnosticList{}
+	if diagnosticsStr == "" {
+		return diagnostics, nil
+	}
+	if err := json.Unmarshal([]byte(diagnosticsStr), &diagnostics); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal host diagnostics")
+	}
+	return diagnostics, nil
+}
+
+func MarshalDiagnostics(diagnostics models.HostDiagnosticList) (string, error) {
+	if len(diagnostics) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(diagnostics)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal host diagnostics")
+	}
+	return string(data), nil
+}
+
+// FindDiagnosticByStepID returns the diagnostic that the host runs with the given step, or nil if the step doesn't
+// belong to a diagnostic.
+func FindDiagnosticByStepID(diagnostics models.HostDiagnosticList, stepID string) *models.HostDiagnostic {
+	if stepID == "" {
+		return nil
+	}
+	for _, diagnostic := range diagnostics {
+		if diagnostic.StepID == stepID {
+			return diagnostic
+		}
+	}
+	return nil
+}
+
+// UpdateDiagnostics locks the host and replaces its diagnostics with the ones returned by the update function, so
+// that the requests of the users and the replies of the agent don't overwrite each other. The diagnostics field of the
+// given host is updated as well.
+func UpdateDiagnostics(db *gorm.DB, h *models.Host, update func(models.HostDiagnosticList) (models.HostDiagnosticList, error)) (models.HostDiagnosticList, error) {
+	var result models.HostDiagnosticList
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var current models.Host
+		if err := transaction.AddForUpdateQueryOption(tx).Select("diagnostics").
+			Take(&current, "id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).Error; err != nil {
+			return err
+		}
+		diagnostics, err := UnmarshalDiagnostics(current.Diagnostics)
+		if err != nil {
+			return err
+		}
+		if diagnostics, err = update(diagnostics); err != nil {
+			return err
+		}
+		diagnosticsStr, err := MarshalDiagnostics(diagnostics)
+		if err != nil {
+			return err
+		}
+		if diagnosticsStr != current.Diagnostics {
+			if err = tx.Model(&models.Host{}).Where("id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).
+				Update("diagnostics", diagnosticsStr).Error; err != nil {
+				return errors.Wrapf(err, "failed to update the diagnostics of host %s", h.ID.String())
+			}
+		}
+		h.Diagnostics = diagnosticsStr
+		result = diagnostics
+		return nil
+	})
+	return result, err
+}
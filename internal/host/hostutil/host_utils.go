@@ -12,6 +12,7 @@ import (
 
 	"github.com/coreos/ignition/v2/config/v3_2"
 	ignition_types "github.com/coreos/ignition/v2/config/v3_2/types"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/openshift/assisted-service/internal/common"
@@ -274,6 +275,66 @@ func UnmarshalConnectivityReport(reportStr string) (*models.ConnectivityReport,
 	return &report, nil
 }
 
+// KeepThroughputReports returns the connectivity report with the throughput reports of the previous report. The
+// throughput is measured by a separate step, so the connectivity check doesn't report it.
+func KeepThroughputReports(previousReportStr, reportStr string) (string, error) {
+	if previousReportStr == "" || reportStr == "" {
+		return reportStr, nil
+	}
+	previousReport, err := UnmarshalConnectivityReport(previousReportStr)
+	if err != nil {
+		// A broken previous report has nothing to keep
+		return reportStr, nil
+	}
+	report, err := UnmarshalConnectivityReport(reportStr)
+	if err != nil {
+		return "", err
+	}
+	kept := false
+	for _, remoteHost := range report.RemoteHosts {
+		if len(remoteHost.ThroughputReport) > 0 {
+			continue
+		}
+		if previous := findRemoteHost(previousReport, remoteHost.HostID); previous != nil && len(previous.ThroughputReport) > 0 {
+			remoteHost.ThroughputReport = previous.ThroughputReport
+			kept = true
+		}
+	}
+	if !kept {
+		return reportStr, nil
+	}
+	return MarshalConnectivityReport(report)
+}
+
+// SetThroughputReports returns the connectivity report with the throughput reports of the given remote hosts.
+func SetThroughputReports(reportStr string, remoteHosts []*models.ConnectivityRemoteHost) (string, error) {
+	report := &models.ConnectivityReport{}
+	if reportStr != "" {
+		var err error
+		if report, err = UnmarshalConnectivityReport(reportStr); err != nil {
+			return "", err
+		}
+	}
+	for _, remoteHost := range remoteHosts {
+		current := findRemoteHost(report, remoteHost.HostID)
+		if current == nil {
+			current = &models.ConnectivityRemoteHost{HostID: remoteHost.HostID}
+			report.RemoteHosts = append(report.RemoteHosts, current)
+		}
+		current.ThroughputReport = remoteHost.ThroughputReport
+	}
+	return MarshalConnectivityReport(report)
+}
+
+func findRemoteHost(report *models.ConnectivityReport, hostID strfmt.UUID) *models.ConnectivityRemoteHost {
+	for _, remoteHost := range report.RemoteHosts {
+		if remoteHost.HostID == hostID {
+			return remoteHost
+		}
+	}
+	return nil
+}
+
 func GetHostCluster(log logrus.FieldLogger, db *gorm.DB, host *models.Host) (*common.Cluster, error) {
 	var cluster common.Cluster
 	err := db.First(&cluster, "id = ?", host.ClusterID).Error
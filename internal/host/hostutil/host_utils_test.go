@@ -424,6 +424,64 @@ var _ = Describe("GetHostInstallationDisk", func() {
 	})
 })
 
+var _ = Describe("Throughput reports", func() {
+	var remoteHostID strfmt.UUID
+
+	BeforeEach(func() {
+		remoteHostID = strfmt.UUID(uuid.New().String())
+	})
+
+	marshal := func(report *models.ConnectivityReport) string {
+		reportStr, err := MarshalConnectivityReport(report)
+		Expect(err).ToNot(HaveOccurred())
+		return reportStr
+	}
+
+	throughput := []*models.ThroughputReport{{RemoteIPAddress: "1.2.3.5", ThroughputMbps: 940, Successful: true}}
+
+	It("keeps the throughput of the previous report", func() {
+		previous := marshal(&models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{
+			{HostID: remoteHostID, ThroughputReport: throughput},
+		}})
+		current := marshal(&models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{
+			{HostID: remoteHostID, L3Connectivity: []*models.L3Connectivity{{RemoteIPAddress: "1.2.3.5", Successful: true}}},
+		}})
+		reportStr, err := KeepThroughputReports(previous, current)
+		Expect(err).ToNot(HaveOccurred())
+		report, err := UnmarshalConnectivityReport(reportStr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.RemoteHosts[0].L3Connectivity).To(HaveLen(1))
+		Expect(report.RemoteHosts[0].ThroughputReport).To(Equal(throughput))
+	})
+
+	It("doesn't keep the throughput of removed hosts", func() {
+		previous := marshal(&models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{
+			{HostID: remoteHostID, ThroughputReport: throughput},
+		}})
+		current := marshal(&models.ConnectivityReport{})
+		Expect(KeepThroughputReports(previous, current)).To(Equal(current))
+	})
+
+	It("sets the throughput of existing and new remote hosts", func() {
+		otherHostID := strfmt.UUID(uuid.New().String())
+		previous := marshal(&models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{
+			{HostID: remoteHostID, L3Connectivity: []*models.L3Connectivity{{RemoteIPAddress: "1.2.3.5", Successful: true}}},
+		}})
+		reportStr, err := SetThroughputReports(previous, []*models.ConnectivityRemoteHost{
+			{HostID: remoteHostID, ThroughputReport: throughput},
+			{HostID: otherHostID, ThroughputReport: throughput},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		report, err := UnmarshalConnectivityReport(reportStr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(report.RemoteHosts).To(HaveLen(2))
+		Expect(report.RemoteHosts[0].L3Connectivity).To(HaveLen(1))
+		Expect(report.RemoteHosts[0].ThroughputReport).To(Equal(throughput))
+		Expect(report.RemoteHosts[1].HostID).To(Equal(otherHostID))
+		Expect(report.RemoteHosts[1].ThroughputReport).To(Equal(throughput))
+	})
+})
+
 func TestHostUtil(t *testing.T) {
 	RegisterFailHandler(Fail)
 	common.InitializeDBTest()
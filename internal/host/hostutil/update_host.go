@@ -85,15 +85,14 @@ func UpdateHostStageTimeout(ctx context.Context, log logrus.FieldLogger, db *gor
 	return host, nil
 }
 
-func UpdateHostAndNotify(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, stream stream.Notifier, infraEnvId strfmt.UUID,
+func UpdateHostAndNotify(ctx context.Context, log logrus.FieldLogger, db *gorm.DB, notificationStream stream.Notifier, infraEnvId strfmt.UUID,
 	hostId strfmt.UUID, srcStatus string, extra ...interface{}) (*common.Host, error) {
 	host, err := UpdateHost(log, db, infraEnvId, hostId, srcStatus, extra...)
 	if err != nil {
 		return nil, err
 	}
-	err = stream.Notify(ctx, host)
-	if err != nil {
-		log.WithError(err).Warning("failed to notify host update event")
+	if err = stream.NotifyWithDB(ctx, notificationStream, db, host); err != nil {
+		return nil, errors.Wrapf(err, "failed to notify host %s update", hostId)
 	}
 	return host, nil
 
@@ -155,3 +154,15 @@ func hostExistsInDB(db *gorm.DB, hostId, infraEnvId strfmt.UUID, where map[strin
 	var host models.Host
 	return db.Select("id").Take(&host, where).Error == nil
 }
+
+// RecordHostConfigUpdate records that the configuration of the host was changed.
+func RecordHostConfigUpdate(db *gorm.DB, infraEnvID, hostID strfmt.UUID) error {
+	return db.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", hostID.String(), infraEnvID.String()).
+		Update("config_updated_at", time.Now()).Error
+}
+
+// RecordClusterConfigUpdate records that the configuration of the cluster, and so of all its hosts, was changed.
+func RecordClusterConfigUpdate(db *gorm.DB, clusterID strfmt.UUID) error {
+	return db.Model(&common.Host{}).Where("cluster_id = ?", clusterID.String()).
+		Update("config_updated_at", time.Now()).Error
+}
@@ -40,6 +40,21 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 	return m.recorder
 }
 
+// AddDiagnostic mocks base method.
+func (m *MockAPI) AddDiagnostic(arg0 context.Context, arg1 *models.Host, arg2 *models.HostDiagnosticCreateParams, arg3 *gorm.DB) (*models.HostDiagnostic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDiagnostic", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.HostDiagnostic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDiagnostic indicates an expected call of AddDiagnostic.
+func (mr *MockAPIMockRecorder) AddDiagnostic(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDiagnostic", reflect.TypeOf((*MockAPI)(nil).AddDiagnostic), arg0, arg1, arg2, arg3)
+}
+
 // AutoAssignRole mocks base method.
 func (m *MockAPI) AutoAssignRole(arg0 context.Context, arg1 *models.Host, arg2 *gorm.DB) (bool, error) {
 	m.ctrl.T.Helper()
@@ -159,7 +174,7 @@ func (mr *MockAPIMockRecorder) GetKnownHostApprovedCounts(arg0 interface{}) *gom
 }
 
 // GetNextSteps mocks base method.
-func (m *MockAPI) GetNextSteps(arg0 context.Context, arg1 *models.Host) (models.Steps, error) {
+func (m *MockAPI) GetNextSteps(arg0 context.Context, arg1 *common.Host) (models.Steps, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetNextSteps", arg0, arg1)
 	ret0, _ := ret[0].(models.Steps)
@@ -565,6 +580,21 @@ func (mr *MockAPIMockRecorder) UpdateConnectivityReport(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateConnectivityReport), arg0, arg1, arg2)
 }
 
+// UpdateDiagnosticResult mocks base method.
+func (m *MockAPI) UpdateDiagnosticResult(arg0 context.Context, arg1 *models.Host, arg2 *models.StepReply, arg3 *gorm.DB) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDiagnosticResult", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDiagnosticResult indicates an expected call of UpdateDiagnosticResult.
+func (mr *MockAPIMockRecorder) UpdateDiagnosticResult(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDiagnosticResult", reflect.TypeOf((*MockAPI)(nil).UpdateDiagnosticResult), arg0, arg1, arg2, arg3)
+}
+
 // UpdateDomainNameResolution mocks base method.
 func (m *MockAPI) UpdateDomainNameResolution(arg0 context.Context, arg1 *models.Host, arg2 models.DomainResolutionResponse, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -579,6 +609,20 @@ func (mr *MockAPIMockRecorder) UpdateDomainNameResolution(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainNameResolution", reflect.TypeOf((*MockAPI)(nil).UpdateDomainNameResolution), arg0, arg1, arg2, arg3)
 }
 
+// UpdateFirmwareReport mocks base method.
+func (m *MockAPI) UpdateFirmwareReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFirmwareReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFirmwareReport indicates an expected call of UpdateFirmwareReport.
+func (mr *MockAPIMockRecorder) UpdateFirmwareReport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirmwareReport", reflect.TypeOf((*MockAPI)(nil).UpdateFirmwareReport), arg0, arg1, arg2)
+}
+
 // UpdateHostname mocks base method.
 func (m *MockAPI) UpdateHostname(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -747,6 +791,20 @@ func (mr *MockAPIMockRecorder) UpdateNTP(arg0, arg1, arg2, arg3 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNTP", reflect.TypeOf((*MockAPI)(nil).UpdateNTP), arg0, arg1, arg2, arg3)
 }
 
+// UpdateNetworkThroughputReport mocks base method.
+func (m *MockAPI) UpdateNetworkThroughputReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkThroughputReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkThroughputReport indicates an expected call of UpdateNetworkThroughputReport.
+func (mr *MockAPIMockRecorder) UpdateNetworkThroughputReport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkThroughputReport", reflect.TypeOf((*MockAPI)(nil).UpdateNetworkThroughputReport), arg0, arg1, arg2)
+}
+
 // UpdateNodeLabels mocks base method.
 func (m *MockAPI) UpdateNodeLabels(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -252,6 +252,10 @@ func newValidations(v *validator) []validation {
 			id:        HasSufficientPacketLossRequirementForRole,
 			condition: v.hasSufficientPacketLossRequirementForRole,
 		},
+		{
+			id:        HasSufficientNetworkBandwidthRequirementForRole,
+			condition: v.hasSufficientNetworkBandwidthRequirementForRole,
+		},
 		{
 			id:        HasDefaultRoute,
 			condition: v.hasDefaultRoute,
@@ -310,6 +314,14 @@ func newValidations(v *validator) []validation {
 			id:        NoSkipMissingDisk,
 			condition: v.noSkipMissingDisk,
 		},
+		{
+			id:        NoUnhealthyDisks,
+			condition: v.noUnhealthyDisks,
+		},
+		{
+			id:        CompatibleSecureBootState,
+			condition: v.compatibleSecureBootState,
+		},
 		{
 			id:        NoIPCollisionsInNetwork,
 			condition: v.noIPCollisionsInNetwork,
@@ -948,6 +948,7 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(AreOscRequirementsSatisfied),
 		If(HasSufficientNetworkLatencyRequirementForRole),
 		If(HasSufficientPacketLossRequirementForRole),
+		If(HasSufficientNetworkBandwidthRequirementForRole),
 		If(HasDefaultRoute),
 		If(IsAPIDomainNameResolvedCorrectly),
 		If(IsAPIInternalDomainNameResolvedCorrectly),
@@ -960,6 +961,8 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(IsTimeSyncedBetweenHostAndService),
 		If(NoSkipInstallationDisk),
 		If(NoSkipMissingDisk),
+		If(NoUnhealthyDisks),
+		If(CompatibleSecureBootState),
 		If(NoIPCollisionsInNetwork),
 		If(NoIscsiNicBelongsToMachineCidr),
 		If(AreNodeFeatureDiscoveryRequirementsSatisfied),
@@ -72,7 +72,7 @@ var resetLogsField = []interface{}{"logs_info", "", "logs_started_at", strfmt.Da
 var resetProgressFields = []interface{}{"progress_current_stage", "", "progress_installation_percentage", 0,
 	"progress_progress_info", "", "progress_stage_started_at", strfmt.DateTime(time.Time{}), "progress_stage_updated_at", strfmt.DateTime(time.Time{})}
 
-var resetFields = append(resetProgressFields, "inventory", "", "bootstrap", false, "images_status", "")
+var resetFields = append(resetProgressFields, "inventory", "", "bootstrap", false, "images_status", "", "firmware", "")
 var restFieldsOnUnbind = append(append(resetProgressFields, resetLogsField...), "cluster_id", nil, "kind", swag.String(models.HostKindHost), "connectivity", "", "domain_name_resolutions", "",
 	"free_addresses", "", "images_status", "", "installation_disk_id", "", "installation_disk_path", "", "machine_config_pool_name", "",
 	"role", "auto-assign", "api_vip_connectivity", "", "suggested_role", "", "images_status", "",
@@ -559,6 +559,7 @@ type TransitionArgsRefreshHost struct {
 	conditions        map[string]bool
 	validationResults ValidationsStatus
 	db                *gorm.DB
+	cluster           *common.Cluster
 }
 
 func If(id stringer) stateswitch.Condition {
@@ -655,22 +656,43 @@ func (th *transitionHandler) HasStatusTimedOut(timeout time.Duration) stateswitc
 	}
 }
 
-func (th *transitionHandler) HasInstallationInProgressTimedOut(sw stateswitch.StateSwitch, _ stateswitch.TransitionArgs) (bool, error) {
+func (th *transitionHandler) HasInstallationInProgressTimedOut(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) (bool, error) {
 	sHost, ok := sw.(*stateHost)
 	if !ok {
 		return false, errors.New("HasInstallationInProgressTimedOut incompatible type of StateSwitch")
 	}
-	maxDuration := th.config.HostStageTimeout(sHost.host.Progress.CurrentStage)
+	params, ok := args.(*TransitionArgsRefreshHost)
+	if !ok {
+		return false, errors.New("HasInstallationInProgressTimedOut invalid argument")
+	}
+	maxDuration, overridden := common.GetInstallTimeouts(params.cluster).HostStageTimeout(sHost.host.Progress.CurrentStage)
+	if !overridden {
+		maxDuration = th.config.HostStageTimeout(sHost.host.Progress.CurrentStage)
+	}
 	if sHost.host.Progress.CurrentStage == models.HostStageRebooting {
 		if hostutil.IsSingleNode(th.log, th.db, sHost.host) {
-			// use extended reboot timeout for SNO
-			maxDuration = singleNodeRebootTimeout
+			if overridden {
+				// extend the reboot timeout of the cluster by as much as the SNO reboot timeout extends the default one
+				maxDuration += singleNodeRebootTimeout - hostStageTimeoutDefaults[models.HostStageRebooting]
+			} else {
+				// use extended reboot timeout for SNO
+				maxDuration = singleNodeRebootTimeout
+			}
 		}
 	}
 
 	return time.Since(time.Time(sHost.host.Progress.StageUpdatedAt)) > maxDuration, nil
 }
 
+// hostStageTimeout returns the timeout of the current stage of the host, which may be overridden
+// by its cluster
+func (th *transitionHandler) hostStageTimeout(sHost *stateHost, params *TransitionArgsRefreshHost) time.Duration {
+	if timeout, ok := common.GetInstallTimeouts(params.cluster).HostStageTimeout(sHost.host.Progress.CurrentStage); ok {
+		return timeout
+	}
+	return th.config.HostStageTimeout(sHost.host.Progress.CurrentStage)
+}
+
 func (th *transitionHandler) PostHostPreparationTimeout() stateswitch.PostTransition {
 	ret := func(sw stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
 		sHost, ok := sw.(*stateHost)
@@ -718,7 +740,7 @@ func (th *transitionHandler) replaceMacros(template string, sHost *stateHost, pa
 	log := logutil.FromContext(params.ctx, th.log)
 
 	template = strings.Replace(template, "$STAGE", string(sHost.host.Progress.CurrentStage), 1)
-	maxTime := th.config.HostStageTimeout(sHost.host.Progress.CurrentStage)
+	maxTime := th.hostStageTimeout(sHost, params)
 	template = strings.Replace(template, "$MAX_TIME", maxTime.String(), 1)
 	if strings.Contains(template, "$INSTALLATION_DISK") {
 		var installationDisk *models.Disk
@@ -787,7 +809,7 @@ func (th *transitionHandler) PostHostStageTimeout(reason string) stateswitch.Pos
 		)
 
 		statusInfo := th.replaceMacros(template, sHost, params)
-		maxDurationMinutes := int64(th.config.HostStageTimeout(sHost.host.Progress.CurrentStage).Minutes())
+		maxDurationMinutes := int64(th.hostStageTimeout(sHost, params).Minutes())
 		if swag.StringValue(sHost.host.StatusInfo) != statusInfo && (sHost.host.Progress == nil || !sHost.host.Progress.StageTimedOut) {
 			_, err = hostutil.UpdateHostStageTimeout(params.ctx, logutil.FromContext(params.ctx, th.log), params.db,
 				th.eventsHandler, th.stream, sHost.host.InfraEnvID, *sHost.host.ID,
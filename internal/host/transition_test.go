@@ -1449,6 +1449,36 @@ var _ = Describe("Refresh Host", func() {
 			refreshStatusExpectError(models.HostStatusError, statusInfoInstallationInProgressTimedOut)
 		})
 
+		It("host status should be unaffected if the install timeout of the stage is overridden", func() {
+			stage := models.HostStageInstalling
+			mockInstallationTimedOutHost(models.HostStatusInstalling, &stage)
+			registerCluster(clusterId, models.ClusterStatusInstalling)
+			Expect(db.Model(&cluster).Update("install_timeouts", `{"host_stages": {"Installing": "2h"}}`).Error).ShouldNot(HaveOccurred())
+			refreshStatusExpectNoError(models.HostStatusInstalling)
+		})
+
+		It("host status should be unaffected if the overridden reboot timeout is extended for SNO", func() {
+			stage := models.HostStageRebooting
+			mockInstallationTimedOutHost(models.HostStatusInstalling, &stage)
+			registerCluster(clusterId, models.ClusterStatusInstalling)
+			Expect(db.Model(&cluster).Updates(map[string]interface{}{
+				"high_availability_mode": models.ClusterHighAvailabilityModeNone,
+				"install_timeouts":       `{"host_stages": {"Rebooting": "1h"}}`,
+			}).Error).ShouldNot(HaveOccurred())
+			refreshStatusExpectNoError(models.HostStatusInstalling)
+		})
+
+		It("host status should be error if the extended overridden reboot timeout passed for SNO", func() {
+			stage := models.HostStageRebooting
+			mockInstallationTimedOutHost(models.HostStatusInstalling, &stage)
+			registerCluster(clusterId, models.ClusterStatusInstalling)
+			Expect(db.Model(&cluster).Updates(map[string]interface{}{
+				"high_availability_mode": models.ClusterHighAvailabilityModeNone,
+				"install_timeouts":       `{"host_stages": {"Rebooting": "30m"}}`,
+			}).Error).ShouldNot(HaveOccurred())
+			refreshStatusExpectError(models.HostStatusError, statusInfoInstallationInProgressTimedOut)
+		})
+
 		It("host status should remain disconnected if media is disconnected", func() {
 			mockMediaDisconnect(models.HostStatusDisconnected, nil)
 			registerCluster(clusterId, models.ClusterStatusInsufficient)
@@ -6636,6 +6666,7 @@ var allValidationIDs = []validationID{
 	SufficientOrUnknownInstallationDiskSpeed,
 	HasSufficientNetworkLatencyRequirementForRole,
 	HasSufficientPacketLossRequirementForRole,
+	HasSufficientNetworkBandwidthRequirementForRole,
 	HasDefaultRoute,
 	IsAPIDomainNameResolvedCorrectly,
 	IsAPIInternalDomainNameResolvedCorrectly,
@@ -6648,6 +6679,8 @@ var allValidationIDs = []validationID{
 	CompatibleAgent,
 	NoSkipInstallationDisk,
 	NoSkipMissingDisk,
+	NoUnhealthyDisks,
+	CompatibleSecureBootState,
 	NoIPCollisionsInNetwork,
 	IsReleaseDomainNameResolvedCorrectly,
 	NoIscsiNicBelongsToMachineCidr,
@@ -11,65 +11,69 @@ import (
 type validationID models.HostValidationID
 
 const (
-	IsMediaConnected                               = validationID(models.HostValidationIDMediaConnected)
-	IsConnected                                    = validationID(models.HostValidationIDConnected)
-	HasInventory                                   = validationID(models.HostValidationIDHasInventory)
-	IsMachineCidrDefined                           = validationID(models.HostValidationIDMachineCidrDefined)
-	BelongsToMachineCidr                           = validationID(models.HostValidationIDBelongsToMachineCidr)
-	HasMinCPUCores                                 = validationID(models.HostValidationIDHasMinCPUCores)
-	HasMinValidDisks                               = validationID(models.HostValidationIDHasMinValidDisks)
-	HasMinMemory                                   = validationID(models.HostValidationIDHasMinMemory)
-	HasCPUCoresForRole                             = validationID(models.HostValidationIDHasCPUCoresForRole)
-	HasMemoryForRole                               = validationID(models.HostValidationIDHasMemoryForRole)
-	IsHostnameUnique                               = validationID(models.HostValidationIDHostnameUnique)
-	IsHostnameValid                                = validationID(models.HostValidationIDHostnameValid)
-	IsIgnitionDownloadable                         = validationID(models.HostValidationIDIgnitionDownloadable)
-	BelongsToMajorityGroup                         = validationID(models.HostValidationIDBelongsToMajorityGroup)
-	IsPlatformNetworkSettingsValid                 = validationID(models.HostValidationIDValidPlatformNetworkSettings)
-	IsNTPSynced                                    = validationID(models.HostValidationIDNtpSynced)
-	IsTimeSyncedBetweenHostAndService              = validationID(models.HostValidationIDTimeSyncedBetweenHostAndService)
-	SucessfullOrUnknownContainerImagesAvailability = validationID(models.HostValidationIDContainerImagesAvailable)
-	AreLsoRequirementsSatisfied                    = validationID(models.HostValidationIDLsoRequirementsSatisfied)
-	AreOdfRequirementsSatisfied                    = validationID(models.HostValidationIDOdfRequirementsSatisfied)
-	AreCnvRequirementsSatisfied                    = validationID(models.HostValidationIDCnvRequirementsSatisfied)
-	AreLvmRequirementsSatisfied                    = validationID(models.HostValidationIDLvmRequirementsSatisfied)
-	AreMceRequirementsSatisfied                    = validationID(models.HostValidationIDMceRequirementsSatisfied)
-	AreMtvRequirementsSatisfied                    = validationID(models.HostValidationIDMtvRequirementsSatisfied)
-	AreOscRequirementsSatisfied                    = validationID(models.HostValidationIDOscRequirementsSatisfied)
-	SufficientOrUnknownInstallationDiskSpeed       = validationID(models.HostValidationIDSufficientInstallationDiskSpeed)
-	HasSufficientNetworkLatencyRequirementForRole  = validationID(models.HostValidationIDSufficientNetworkLatencyRequirementForRole)
-	HasSufficientPacketLossRequirementForRole      = validationID(models.HostValidationIDSufficientPacketLossRequirementForRole)
-	HasDefaultRoute                                = validationID(models.HostValidationIDHasDefaultRoute)
-	IsAPIDomainNameResolvedCorrectly               = validationID(models.HostValidationIDAPIDomainNameResolvedCorrectly)
-	IsAPIInternalDomainNameResolvedCorrectly       = validationID(models.HostValidationIDAPIIntDomainNameResolvedCorrectly)
-	IsAppsDomainNameResolvedCorrectly              = validationID(models.HostValidationIDAppsDomainNameResolvedCorrectly)
-	IsReleaseDomainNameResolvedCorrectly           = validationID(models.HostValidationIDReleaseDomainNameResolvedCorrectly)
-	CompatibleWithClusterPlatform                  = validationID(models.HostValidationIDCompatibleWithClusterPlatform)
-	IsDNSWildcardNotConfigured                     = validationID(models.HostValidationIDDNSWildcardNotConfigured)
-	DiskEncryptionRequirementsSatisfied            = validationID(models.HostValidationIDDiskEncryptionRequirementsSatisfied)
-	NonOverlappingSubnets                          = validationID(models.HostValidationIDNonOverlappingSubnets)
-	VSphereHostUUIDEnabled                         = validationID(models.HostValidationIDVsphereDiskUUIDEnabled)
-	CompatibleAgent                                = validationID(models.HostValidationIDCompatibleAgent)
-	NoSkipInstallationDisk                         = validationID(models.HostValidationIDNoSkipInstallationDisk)
-	NoSkipMissingDisk                              = validationID(models.HostValidationIDNoSkipMissingDisk)
-	NoIPCollisionsInNetwork                        = validationID(models.HostValidationIDNoIPCollisionsInNetwork)
-	NoIscsiNicBelongsToMachineCidr                 = validationID(models.HostValidationIDNoIscsiNicBelongsToMachineCidr)
-	AreNodeFeatureDiscoveryRequirementsSatisfied   = validationID(models.HostValidationIDNodeFeatureDiscoveryRequirementsSatisfied)
-	AreNvidiaGPURequirementsSatisfied              = validationID(models.HostValidationIDNvidiaGpuRequirementsSatisfied)
-	ArePipelinesRequirementsSatisfied              = validationID(models.HostValidationIDPipelinesRequirementsSatisfied)
-	AreServiceMeshRequirementsSatisfied            = validationID(models.HostValidationIDServicemeshRequirementsSatisfied)
-	AreServerLessRequirementsSatisfied             = validationID(models.HostValidationIDServerlessRequirementsSatisfied)
-	AreOpenShiftAIRequirementsSatisfied            = validationID(models.HostValidationIDOpenshiftAiRequirementsSatisfied)
-	AreAuthorinoRequirementsSatisfied              = validationID(models.HostValidationIDAuthorinoRequirementsSatisfied)
-	IsMtuValid                                     = validationID(models.HostValidationIDMtuValid)
-	AreNmstateRequirementsSatisfied                = validationID(models.HostValidationIDNmstateRequirementsSatisfied)
-	AreAMDGPURequirementsSatisfied                 = validationID(models.HostValidationIDAmdGpuRequirementsSatisfied)
-	AreKMMRequirementsSatisfied                    = validationID(models.HostValidationIDKmmRequirementsSatisfied)
-	AreNodeHealthcheckRequirementsSatisfied        = validationID(models.HostValidationIDNodeHealthcheckRequirementsSatisfied)
-	AreSelfNodeRemediationRequirementsSatisfied    = validationID(models.HostValidationIDSelfNodeRemediationRequirementsSatisfied)
-	AreFenceAgentsRemediationRequirementsSatisfied = validationID(models.HostValidationIDFenceAgentsRemediationRequirementsSatisfied)
-	AreNodeMaintenanceRequirementsSatisfied        = validationID(models.HostValidationIDNodeMaintenanceRequirementsSatisfied)
-	AreKubeDeschedulerRequirementsSatisfied        = validationID(models.HostValidationIDKubeDeschedulerRequirementsSatisfied)
+	IsMediaConnected                                = validationID(models.HostValidationIDMediaConnected)
+	IsConnected                                     = validationID(models.HostValidationIDConnected)
+	HasInventory                                    = validationID(models.HostValidationIDHasInventory)
+	IsMachineCidrDefined                            = validationID(models.HostValidationIDMachineCidrDefined)
+	BelongsToMachineCidr                            = validationID(models.HostValidationIDBelongsToMachineCidr)
+	HasMinCPUCores                                  = validationID(models.HostValidationIDHasMinCPUCores)
+	HasMinValidDisks                                = validationID(models.HostValidationIDHasMinValidDisks)
+	HasMinMemory                                    = validationID(models.HostValidationIDHasMinMemory)
+	HasCPUCoresForRole                              = validationID(models.HostValidationIDHasCPUCoresForRole)
+	HasMemoryForRole                                = validationID(models.HostValidationIDHasMemoryForRole)
+	IsHostnameUnique                                = validationID(models.HostValidationIDHostnameUnique)
+	IsHostnameValid                                 = validationID(models.HostValidationIDHostnameValid)
+	IsIgnitionDownloadable                          = validationID(models.HostValidationIDIgnitionDownloadable)
+	BelongsToMajorityGroup                          = validationID(models.HostValidationIDBelongsToMajorityGroup)
+	IsPlatformNetworkSettingsValid                  = validationID(models.HostValidationIDValidPlatformNetworkSettings)
+	IsNTPSynced                                     = validationID(models.HostValidationIDNtpSynced)
+	IsTimeSyncedBetweenHostAndService               = validationID(models.HostValidationIDTimeSyncedBetweenHostAndService)
+	SucessfullOrUnknownContainerImagesAvailability  = validationID(models.HostValidationIDContainerImagesAvailable)
+	AreLsoRequirementsSatisfied                     = validationID(models.HostValidationIDLsoRequirementsSatisfied)
+	AreOdfRequirementsSatisfied                     = validationID(models.HostValidationIDOdfRequirementsSatisfied)
+	AreCnvRequirementsSatisfied                     = validationID(models.HostValidationIDCnvRequirementsSatisfied)
+	AreLvmRequirementsSatisfied                     = validationID(models.HostValidationIDLvmRequirementsSatisfied)
+	AreMceRequirementsSatisfied                     = validationID(models.HostValidationIDMceRequirementsSatisfied)
+	AreMtvRequirementsSatisfied                     = validationID(models.HostValidationIDMtvRequirementsSatisfied)
+	AreOscRequirementsSatisfied                     = validationID(models.HostValidationIDOscRequirementsSatisfied)
+	SufficientOrUnknownInstallationDiskSpeed        = validationID(models.HostValidationIDSufficientInstallationDiskSpeed)
+	HasSufficientNetworkLatencyRequirementForRole   = validationID(models.HostValidationIDSufficientNetworkLatencyRequirementForRole)
+	HasSufficientPacketLossRequirementForRole       = validationID(models.HostValidationIDSufficientPacketLossRequirementForRole)
+	HasSufficientNetworkBandwidthRequirementForRole = validationID(models.HostValidationIDSufficientNetworkBandwidthRequirementForRole)
+	HasDefaultRoute                                 = validationID(models.HostValidationIDHasDefaultRoute)
+	IsAPIDomainNameResolvedCorrectly                = validationID(models.HostValidationIDAPIDomainNameResolvedCorrectly)
+	IsAPIInternalDomainNameResolvedCorrectly        = validationID(models.HostValidationIDAPIIntDomainNameResolvedCorrectly)
+	IsAppsDomainNameResolvedCorrectly               = validationID(models.HostValidationIDAppsDomainNameResolvedCorrectly)
+	IsReleaseDomainNameResolvedCorrectly            = validationID(models.HostValidationIDReleaseDomainNameResolvedCorrectly)
+	CompatibleWithClusterPlatform                   = validationID(models.HostValidationIDCompatibleWithClusterPlatform)
+	IsDNSWildcardNotConfigured                      = validationID(models.HostValidationIDDNSWildcardNotConfigured)
+	DiskEncryptionRequirementsSatisfied             = validationID(models.HostValidationIDDiskEncryptionRequirementsSatisfied)
+	NonOverlappingSubnets                           = validationID(models.HostValidationIDNonOverlappingSubnets)
+	VSphereHostUUIDEnabled                          = validationID(models.HostValidationIDVsphereDiskUUIDEnabled)
+	CompatibleAgent                                 = validationID(models.HostValidationIDCompatibleAgent)
+	NoSkipInstallationDisk                          = validationID(models.HostValidationIDNoSkipInstallationDisk)
+	NoSkipMissingDisk                               = validationID(models.HostValidationIDNoSkipMissingDisk)
+	NoUnhealthyDisks                                = validationID(models.HostValidationIDNoUnhealthyDisks)
+	CompatibleSecureBootState                       = validationID(models.HostValidationIDCompatibleSecureBootState)
+	NoIPCollisionsInNetwork                         = validationID(models.HostValidationIDNoIPCollisionsInNetwork)
+	NoIscsiNicBelongsToMachineCidr                  = validationID(models.HostValidationIDNoIscsiNicBelongsToMachineCidr)
+	AreNodeFeatureDiscoveryRequirementsSatisfied    = validationID(models.HostValidationIDNodeFeatureDiscoveryRequirementsSatisfied)
+	AreNvidiaGPURequirementsSatisfied               = validationID(models.HostValidationIDNvidiaGpuRequirementsSatisfied)
+	ArePipelinesRequirementsSatisfied               = validationID(models.HostValidationIDPipelinesRequirementsSatisfied)
+	AreServiceMeshRequirementsSatisfied             = validationID(models.HostValidationIDServicemeshRequirementsSatisfied)
+	AreServerLessRequirementsSatisfied              = validationID(models.HostValidationIDServerlessRequirementsSatisfied)
+	AreOpenShiftAIRequirementsSatisfied             = validationID(models.HostValidationIDOpenshiftAiRequirementsSatisfied)
+	AreAuthorinoRequirementsSatisfied               = validationID(models.HostValidationIDAuthorinoRequirementsSatisfied)
+	IsMtuValid                                      = validationID(models.HostValidationIDMtuValid)
+	AreNmstateRequirementsSatisfied                 = validationID(models.HostValidationIDNmstateRequirementsSatisfied)
+	AreAMDGPURequirementsSatisfied                  = validationID(models.HostValidationIDAmdGpuRequirementsSatisfied)
+	AreKMMRequirementsSatisfied                     = validationID(models.HostValidationIDKmmRequirementsSatisfied)
+	AreNodeHealthcheckRequirementsSatisfied         = validationID(models.HostValidationIDNodeHealthcheckRequirementsSatisfied)
+	AreSelfNodeRemediationRequirementsSatisfied     = validationID(models.HostValidationIDSelfNodeRemediationRequirementsSatisfied)
+	AreFenceAgentsRemediationRequirementsSatisfied  = validationID(models.HostValidationIDFenceAgentsRemediationRequirementsSatisfied)
+	AreNodeMaintenanceRequirementsSatisfied         = validationID(models.HostValidationIDNodeMaintenanceRequirementsSatisfied)
+	AreKubeDeschedulerRequirementsSatisfied         = validationID(models.HostValidationIDKubeDeschedulerRequirementsSatisfied)
+	ArePluginOperatorsRequirementsSatisfied         = validationID(models.HostValidationIDPluginOperatorsRequirementsSatisfied)
 )
 
 func (v validationID) category() (string, error) {
@@ -85,6 +89,7 @@ func (v validationID) category() (string, error) {
 		SucessfullOrUnknownContainerImagesAvailability,
 		HasSufficientNetworkLatencyRequirementForRole,
 		HasSufficientPacketLossRequirementForRole,
+		HasSufficientNetworkBandwidthRequirementForRole,
 		HasDefaultRoute,
 		IsAPIDomainNameResolvedCorrectly,
 		IsAPIInternalDomainNameResolvedCorrectly,
@@ -111,7 +116,9 @@ func (v validationID) category() (string, error) {
 		DiskEncryptionRequirementsSatisfied,
 		CompatibleAgent,
 		NoSkipInstallationDisk,
-		NoSkipMissingDisk:
+		NoSkipMissingDisk,
+		NoUnhealthyDisks,
+		CompatibleSecureBootState:
 		return "hardware", nil
 	case AreLsoRequirementsSatisfied,
 		AreOdfRequirementsSatisfied,
@@ -134,7 +141,8 @@ func (v validationID) category() (string, error) {
 		AreSelfNodeRemediationRequirementsSatisfied,
 		AreFenceAgentsRemediationRequirementsSatisfied,
 		AreNodeMaintenanceRequirementsSatisfied,
-		AreKubeDeschedulerRequirementsSatisfied:
+		AreKubeDeschedulerRequirementsSatisfied,
+		ArePluginOperatorsRequirementsSatisfied:
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected validation id %s", string(v)))
@@ -22,11 +22,14 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/hardware"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/operators/lvm"
+	"github.com/openshift/assisted-service/internal/operators/odf"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -2025,6 +2028,149 @@ var _ = Describe("Validations test", func() {
 		}
 	})
 
+	Context("No unhealthy disks", func() {
+		var hostValidator *validator
+
+		BeforeEach(func() {
+			hostValidator = &validator{
+				log: common.GetTestLog(),
+				hwValidatorCfg: &hardware.ValidatorCfg{
+					DiskHealth: smart.Thresholds{
+						CheckOverallHealth:    true,
+						MaxReallocatedSectors: 50,
+						MaxMediaErrors:        0,
+						MaxPercentageUsed:     95,
+					},
+				},
+			}
+		})
+
+		const failing = `{"smart_status":{"passed":false}}`
+
+		validate := func(operators []*models.MonitoredOperator, disks ...*models.Disk) (ValidationStatus, string) {
+			return hostValidator.noUnhealthyDisks(&validationContext{
+				host:      &models.Host{ID: &hostID, InstallationDiskID: "/dev/disk/by-id/sda"},
+				cluster:   &common.Cluster{Cluster: models.Cluster{MonitoredOperators: operators}},
+				inventory: &models.Inventory{Disks: disks},
+			})
+		}
+
+		storageDisk := func(name, smartData string) *models.Disk {
+			return &models.Disk{ID: "/dev/disk/by-id/" + name, Name: name, DriveType: models.DriveTypeSSD,
+				SizeBytes: 100 * conversions.GB, Smart: smartData}
+		}
+
+		It("is pending without inventory", func() {
+			status, message := hostValidator.noUnhealthyDisks(&validationContext{host: &models.Host{ID: &hostID}})
+			Expect(status).To(Equal(ValidationPending))
+			Expect(message).To(Equal("Missing inventory"))
+		})
+
+		It("succeeds when the disks are healthy or have no SMART data", func() {
+			status, message := validate([]*models.MonitoredOperator{&lvm.Operator},
+				storageDisk("sda", `{"smart_status":{"passed":true},"ata_smart_attributes":{"table":[{"id":5,"raw":{"value":3}}]}}`),
+				storageDisk("sdb", ""),
+			)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("No unhealthy disks were detected"))
+		})
+
+		It("ignores failing disks other than the installation disk without storage operators", func() {
+			status, _ := validate(nil, storageDisk("sda", ""), storageDisk("sdb", failing))
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("ignores the disks that the storage operators don't consume", func() {
+			status, _ := validate([]*models.MonitoredOperator{&odf.Operator},
+				storageDisk("sda", ""),
+				&models.Disk{ID: "/dev/disk/by-id/sr0", Name: "sr0", DriveType: models.DriveTypeODD, IsInstallationMedia: true, Smart: failing},
+				&models.Disk{ID: "/dev/disk/by-id/sdc", Name: "sdc", DriveType: models.DriveTypeHDD, Smart: failing},
+			)
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("fails when the installation disk is failing", func() {
+			status, message := validate(nil, storageDisk("sda", failing), storageDisk("sdb", failing))
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The SMART data of the following disks shows they are failing: sda (the SMART overall-health self-assessment test failed). " +
+				"Replace these disks or remove them from the host"))
+		})
+
+		It("fails when a disk consumed by a storage operator is failing", func() {
+			status, message := validate([]*models.MonitoredOperator{&lvm.Operator},
+				storageDisk("sda", `{"smart_status":{"passed":true}}`),
+				storageDisk("nvme0n1", `{"smart_status":{"passed":false},"nvme_smart_health_information_log":{"media_errors":4,"percentage_used":20}}`),
+			)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The SMART data of the following disks shows they are failing: nvme0n1 (the SMART overall-health self-assessment test failed, " +
+				"4 media errors were reported, the maximum is 0). Replace these disks or remove them from the host"))
+		})
+	})
+
+	Context("Compatible secure boot state", func() {
+		hostValidator := &validator{log: common.GetTestLog()}
+
+		validate := func(mode string, boot *models.Boot) (ValidationStatus, string) {
+			return hostValidator.compatibleSecureBootState(&validationContext{
+				host:      &models.Host{ID: &hostID},
+				cluster:   &common.Cluster{Cluster: models.Cluster{SecureBootMode: mode}},
+				inventory: &models.Inventory{Boot: boot},
+			})
+		}
+
+		It("succeeds when the cluster doesn't require a state", func() {
+			for _, mode := range []string{"", models.ClusterSecureBootModeAny} {
+				status, _ := validate(mode, &models.Boot{SecureBootState: models.SecureBootStateDisabled})
+				Expect(status).To(Equal(ValidationSuccess))
+			}
+		})
+
+		It("is pending without inventory", func() {
+			status, message := hostValidator.compatibleSecureBootState(&validationContext{
+				host:    &models.Host{ID: &hostID},
+				cluster: &common.Cluster{Cluster: models.Cluster{SecureBootMode: models.ClusterSecureBootModeEnabled}},
+			})
+			Expect(status).To(Equal(ValidationPending))
+			Expect(message).To(Equal("Missing inventory"))
+		})
+
+		It("succeeds when the state matches the required mode", func() {
+			status, _ := validate(models.ClusterSecureBootModeEnabled,
+				&models.Boot{CurrentBootMode: "uefi", SecureBootState: models.SecureBootStateEnabled})
+			Expect(status).To(Equal(ValidationSuccess))
+			status, _ = validate(models.ClusterSecureBootModeDisabled,
+				&models.Boot{CurrentBootMode: "bios", SecureBootState: models.SecureBootStateNotSupported})
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("asks to enable secure boot when it is disabled", func() {
+			status, message := validate(models.ClusterSecureBootModeEnabled,
+				&models.Boot{CurrentBootMode: "uefi", SecureBootState: models.SecureBootStateDisabled})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("Enable secure boot in the UEFI firmware settings of the host"))
+		})
+
+		It("asks to switch to UEFI when the host boots in legacy BIOS mode", func() {
+			status, message := validate(models.ClusterSecureBootModeEnabled,
+				&models.Boot{CurrentBootMode: "bios", SecureBootState: models.SecureBootStateNotSupported})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("Switch the boot mode of the host to UEFI"))
+		})
+
+		It("fails when the host doesn't report the state", func() {
+			status, message := validate(models.ClusterSecureBootModeEnabled, nil)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("the host doesn't report it as supported"))
+		})
+
+		It("asks to disable secure boot when it is enabled", func() {
+			status, message := validate(models.ClusterSecureBootModeDisabled,
+				&models.Boot{CurrentBootMode: "uefi", SecureBootState: models.SecureBootStateEnabled})
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("Disable secure boot in the UEFI firmware settings of the host"))
+		})
+	})
+
 	Context("Has sufficient packet loss requirements for role", func() {
 		var (
 			host    models.Host
@@ -2053,6 +2199,126 @@ var _ = Describe("Validations test", func() {
 		})
 	})
 
+	Context("Has sufficient network bandwidth requirements for role", func() {
+		var (
+			hostValidator          = &validator{log: common.GetTestLog()}
+			host, master1, master2 *models.Host
+			cluster                *common.Cluster
+			requirements           *models.ClusterHostRequirements
+		)
+
+		newHost := func(hostname string) *models.Host {
+			id := strfmt.UUID(uuid.New().String())
+			h := hostutil.GenerateTestHostByKind(id, infraEnvID, &clusterID, models.HostStatusKnown, models.HostKindHost, models.HostRoleMaster)
+			h.Inventory = hostutil.GenerateMasterInventoryWithHostname(hostname)
+			return &h
+		}
+
+		setThroughput := func(throughputs ...*models.ThroughputReport) {
+			report := models.ConnectivityReport{}
+			for i, other := range []*models.Host{master1, master2} {
+				remoteHost := &models.ConnectivityRemoteHost{HostID: *other.ID}
+				if i < len(throughputs) && throughputs[i] != nil {
+					remoteHost.ThroughputReport = []*models.ThroughputReport{throughputs[i]}
+				}
+				report.RemoteHosts = append(report.RemoteHosts, remoteHost)
+			}
+			b, err := json.Marshal(&report)
+			Expect(err).ToNot(HaveOccurred())
+			host.Connectivity = string(b)
+		}
+
+		validate := func() (ValidationStatus, string) {
+			inventory, err := common.UnmarshalInventory(host.Inventory)
+			Expect(err).ToNot(HaveOccurred())
+			return hostValidator.hasSufficientNetworkBandwidthRequirementForRole(&validationContext{
+				host:                    host,
+				cluster:                 cluster,
+				inventory:               inventory,
+				inventoryCache:          InventoryCache{},
+				clusterHostRequirements: requirements,
+			})
+		}
+
+		BeforeEach(func() {
+			host = newHost("master-0")
+			master1 = newHost("master-1")
+			master2 = newHost("master-2")
+			majorityGroups, err := json.Marshal(&network.Connectivity{
+				MajorityGroups: map[string][]strfmt.UUID{
+					network.IPv4.String(): {*host.ID, *master1.ID, *master2.ID},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			cluster = &common.Cluster{Cluster: models.Cluster{
+				ID:                         &clusterID,
+				Hosts:                      []*models.Host{host, master1, master2},
+				ConnectivityMajorityGroups: string(majorityGroups),
+			}}
+			requirements = &models.ClusterHostRequirements{
+				Total: &models.ClusterHostRequirementsDetails{NetworkBandwidthMbps: swag.Float64(1000)},
+			}
+		})
+
+		It("succeeds when no bandwidth is required", func() {
+			requirements.Total.NetworkBandwidthMbps = nil
+			status, message := validate()
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Network bandwidth requirement has been satisfied."))
+		})
+
+		It("is pending while the throughput wasn't measured", func() {
+			setThroughput(&models.ThroughputReport{RemoteIPAddress: "1.2.3.5", ThroughputMbps: 9400, Successful: true})
+			status, message := validate()
+			Expect(status).To(Equal(ValidationPending))
+			Expect(message).To(Equal("Missing network bandwidth information."))
+		})
+
+		It("is pending while the throughput tests failed", func() {
+			setThroughput(
+				&models.ThroughputReport{RemoteIPAddress: "1.2.3.5", ThroughputMbps: 9400, Successful: true},
+				&models.ThroughputReport{RemoteIPAddress: "1.2.3.6"},
+			)
+			status, _ := validate()
+			Expect(status).To(Equal(ValidationPending))
+		})
+
+		It("succeeds when the throughput to all the hosts is sufficient", func() {
+			setThroughput(
+				&models.ThroughputReport{RemoteIPAddress: "1.2.3.5", ThroughputMbps: 9400, Successful: true},
+				&models.ThroughputReport{RemoteIPAddress: "1.2.3.6", ThroughputMbps: 1000, Successful: true},
+			)
+			status, message := validate()
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("Network bandwidth requirement has been satisfied."))
+		})
+
+		It("ignores the hosts with another role", func() {
+			master2.Role = models.HostRoleWorker
+			setThroughput(&models.ThroughputReport{RemoteIPAddress: "1.2.3.5", ThroughputMbps: 9400, Successful: true})
+			status, _ := validate()
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("fails when the throughput to a host is insufficient", func() {
+			setThroughput(
+				&models.ThroughputReport{RemoteIPAddress: "1.2.3.5", ThroughputMbps: 9400, Successful: true},
+				&models.ThroughputReport{RemoteIPAddress: "1.2.3.6", ThroughputMbps: 940, Successful: true},
+			)
+			status, message := validate()
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("A network throughput below the required bandwidth of 1000.00 Mbps was measured between host"))
+			Expect(message).To(ContainSubstring("master-2 (940.00 Mbps)"))
+			Expect(message).To(ContainSubstring("ethtool"))
+		})
+
+		It("fails on a broken connectivity report", func() {
+			host.Connectivity = "broken"
+			status, _ := validate()
+			Expect(status).To(Equal(ValidationError))
+		})
+	})
+
 	Context("Has sufficient network latency requirements for role", func() {
 		var (
 			host    models.Host
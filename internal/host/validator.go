@@ -21,6 +21,9 @@ import (
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/internal/operators/lvm"
+	"github.com/openshift/assisted-service/internal/operators/odf"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -1221,6 +1224,117 @@ func (v *validator) generateExcessiveLatencyAdvisoryForHost(c *validationContext
 	return message
 }
 
+func (v *validator) hasSufficientNetworkBandwidthRequirementForRole(c *validationContext) (ValidationStatus, string) {
+	if c.inventory == nil {
+		return ValidationPending, "The inventory is not available yet."
+	}
+	if c.infraEnv != nil {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if len(c.cluster.Hosts) == 1 || c.clusterHostRequirements.Total.NetworkBandwidthMbps == nil || common.GetEffectiveRole(c.host) == models.HostRoleAutoAssign || hostutil.IsDay2Host(c.host) {
+		// Single Node use case || no requirements defined || role is auto assign
+		return ValidationSuccess, "Network bandwidth requirement has been satisfied."
+	}
+	if len(c.host.Connectivity) == 0 {
+		return ValidationPending, "Missing network bandwidth information."
+	}
+	status, hostMetrics, err := v.networkBandwidthTest(c)
+	switch status {
+	case ValidationSuccess:
+		return status, "Network bandwidth requirement has been satisfied."
+	case ValidationPending:
+		return status, "Missing network bandwidth information."
+	case ValidationFailure:
+		// When logging, make sure the full throughput metrics are logged.
+		v.log.Info(fmt.Sprintf(`A network throughput below the required bandwidth of %.2f Mbps was measured between host %s and %s`,
+			*c.clusterHostRequirements.Total.NetworkBandwidthMbps,
+			c.host.ID,
+			v.summarizeHostTimingMetrics(hostMetrics, false),
+		))
+		return status, fmt.Sprintf("A network throughput below the required bandwidth of %.2f Mbps was measured between host %s and %s\n",
+			*c.clusterHostRequirements.Total.NetworkBandwidthMbps,
+			c.host.ID,
+			v.summarizeHostTimingMetrics(hostMetrics, true),
+		) + v.generateNetworkBandwidthAdvisoryForHost(c)
+	}
+	v.log.WithError(err).Errorf("Failed to validate the network bandwidth of host %s", c.host.ID)
+	return ValidationError, "Parse error while attempting to process the network throughput report"
+}
+
+// networkBandwidthTest checks the best throughput measured from the host to each host with the same role in its L3
+// majority group. It is pending while the throughput to some of these hosts wasn't measured successfully yet.
+func (v *validator) networkBandwidthTest(c *validationContext) (ValidationStatus, []hostTimingMetric, error) {
+	connectivityReport, err := hostutil.UnmarshalConnectivityReport(c.host.Connectivity)
+	if err != nil {
+		return ValidationError, nil, err
+	}
+	group, _, err := network.GetL3MajorityGroup(c.cluster.ConnectivityMajorityGroups, *c.host.ID)
+	if err != nil {
+		return ValidationError, nil, err
+	}
+	if len(group) == 0 {
+		return ValidationPending, nil, nil
+	}
+	role := common.GetEffectiveRole(c.host)
+	missing := false
+	failedHostMetrics := []hostTimingMetric{}
+	for _, hostID := range group {
+		if hostID == *c.host.ID {
+			continue
+		}
+		hostname, otherRole, err := GetHostnameAndEffectiveRoleByHostID(hostID, c.cluster.Hosts, c.inventoryCache)
+		if err != nil || otherRole != role {
+			continue
+		}
+		remoteHost, found := funk.Find(connectivityReport.RemoteHosts, func(r *models.ConnectivityRemoteHost) bool {
+			return r.HostID == hostID
+		}).(*models.ConnectivityRemoteHost)
+		if !found {
+			missing = true
+			continue
+		}
+		var throughput *float64
+		for _, report := range remoteHost.ThroughputReport {
+			if report.Successful && (throughput == nil || report.ThroughputMbps > *throughput) {
+				throughput = swag.Float64(report.ThroughputMbps)
+			}
+		}
+		switch {
+		case throughput == nil:
+			missing = true
+		case *throughput < *c.clusterHostRequirements.Total.NetworkBandwidthMbps:
+			failedHostMetrics = append(failedHostMetrics, hostTimingMetric{otherHostName: hostname, timingMetric: *throughput, timingSuffix: " Mbps"})
+		}
+	}
+	if len(failedHostMetrics) > 0 {
+		return ValidationFailure, failedHostMetrics, nil
+	}
+	if missing {
+		return ValidationPending, nil, nil
+	}
+	return ValidationSuccess, nil, nil
+}
+
+func (v *validator) generateNetworkBandwidthAdvisoryForHost(c *validationContext) string {
+	message := "Actions:\n"
+	message += "1: Check that the link speed and the duplex mode of the network interfaces of the hosts match the speed of the switch ports they are connected to, for example with 'ethtool <interface>'.\n"
+	message += "2: Check the switch ports and the cables for errors, and that the hosts are not connected through a slower link.\n"
+	inventory, err := c.inventoryCache.GetOrUnmarshal(c.host)
+	if err != nil || inventory == nil {
+		return message
+	}
+	var interfaces []string
+	for _, intf := range inventory.Interfaces {
+		if intf.SpeedMbps > 0 {
+			interfaces = append(interfaces, fmt.Sprintf("%s (%d Mbps)", intf.Name, intf.SpeedMbps))
+		}
+	}
+	if len(interfaces) > 0 {
+		message += fmt.Sprintf("The network interfaces of the host %s report the following link speeds: %s\n", getRealHostname(c.host, inventory), strings.Join(interfaces, ", "))
+	}
+	return message
+}
+
 func (v *validator) hasDefaultRoute(c *validationContext) (ValidationStatus, string) {
 	if c.inventory == nil {
 		return ValidationPending, "Missing default routing information."
@@ -1850,6 +1964,66 @@ func (v *validator) noSkipMissingDisk(c *validationContext) (ValidationStatus, s
 	return ValidationSuccess, successMessage
 }
 
+// noUnhealthyDisks checks the SMART data of the installation disk and, when a storage operator of the cluster consumes
+// the other disks of the host, of those disks. The other unhealthy disks are only not eligible for installation.
+func (v *validator) noUnhealthyDisks(c *validationContext) (ValidationStatus, string) {
+	if c.inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	storageOperatorEnabled := c.cluster != nil && funk.Contains(c.cluster.MonitoredOperators, func(operator *models.MonitoredOperator) bool {
+		return operator.Name == lvm.Operator.Name || operator.Name == odf.Operator.Name
+	})
+	var unhealthyDisks []string
+	for _, disk := range c.inventory.Disks {
+		installationDisk := c.host.InstallationDiskID != "" && disk.ID == c.host.InstallationDiskID
+		if !installationDisk && !(storageOperatorEnabled && operatorscommon.IsNonInstallationDisk(disk, c.host.InstallationDiskID)) {
+			continue
+		}
+		if problems := v.hwValidatorCfg.DiskHealth.DiskProblems(disk); len(problems) > 0 {
+			unhealthyDisks = append(unhealthyDisks, fmt.Sprintf("%s (%s)", disk.Name, strings.Join(problems, ", ")))
+		}
+	}
+	if len(unhealthyDisks) > 0 {
+		return ValidationFailure, fmt.Sprintf("The SMART data of the following disks shows they are failing: %s. Replace these disks or remove them from the host",
+			strings.Join(unhealthyDisks, "; "))
+	}
+	return ValidationSuccess, "No unhealthy disks were detected"
+}
+
+// compatibleSecureBootState checks that the secure boot state of the host matches the mode that the cluster requires.
+// The state can only be changed in the firmware settings of the host, so the messages tell what to change there.
+func (v *validator) compatibleSecureBootState(c *validationContext) (ValidationStatus, string) {
+	if c.cluster == nil || c.cluster.SecureBootMode == "" || c.cluster.SecureBootMode == models.ClusterSecureBootModeAny {
+		return ValidationSuccess, "The cluster doesn't require a secure boot state"
+	}
+	if c.inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	boot := c.inventory.Boot
+	if boot == nil {
+		boot = &models.Boot{}
+	}
+	switch c.cluster.SecureBootMode {
+	case models.ClusterSecureBootModeEnabled:
+		switch {
+		case boot.SecureBootState == models.SecureBootStateEnabled:
+			return ValidationSuccess, "Secure boot is enabled as required by the cluster"
+		case boot.CurrentBootMode == "bios":
+			return ValidationFailure, "The cluster requires secure boot, but the host boots in legacy BIOS mode, which doesn't support it. Switch the boot mode of the host to UEFI and enable secure boot in its firmware settings"
+		case boot.SecureBootState == models.SecureBootStateDisabled:
+			return ValidationFailure, "The cluster requires secure boot, but it is disabled on the host. Enable secure boot in the UEFI firmware settings of the host and reboot it"
+		default:
+			return ValidationFailure, "The cluster requires secure boot, but the host doesn't report it as supported. Make sure that the firmware of the host supports secure boot, enable it in the UEFI firmware settings of the host and reboot it"
+		}
+	case models.ClusterSecureBootModeDisabled:
+		if boot.SecureBootState == models.SecureBootStateEnabled {
+			return ValidationFailure, "The cluster requires secure boot to be disabled, but it is enabled on the host. Disable secure boot in the UEFI firmware settings of the host and reboot it"
+		}
+		return ValidationSuccess, "Secure boot is disabled as required by the cluster"
+	}
+	return ValidationSuccess, "The cluster doesn't require a secure boot state"
+}
+
 func (v *validator) noIPCollisionsInNetwork(c *validationContext) (ValidationStatus, string) {
 	if c.cluster == nil {
 		return ValidationSuccess, "Cluster has not yet been defined, skipping validation."
@@ -88,7 +88,16 @@ func (m Manager) DeregisterInfraEnv(ctx context.Context, infraEnvId strfmt.UUID)
 		return err
 	}
 
-	if err = m.db.Delete(infraEnv).Error; err != nil {
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		if err = common.DeleteEventSubscriptions(tx, infraEnvId); err != nil {
+			return err
+		}
+		if err = common.DeleteRoleBindings(tx, infraEnvId); err != nil {
+			return err
+		}
+		return tx.Delete(infraEnv).Error
+	})
+	if err != nil {
 		log.WithError(err).Errorf("failed to deregister infraEnv %s", infraEnvId)
 		return err
 	}
@@ -52,6 +52,37 @@ var _ = Describe("DeregisterInfraEnv", func() {
 		Expect(errors.Is(err, gorm.ErrRecordNotFound)).Should(Equal(true))
 	})
 
+	It("deletes the event subscriptions of the infraEnv", func() {
+		subscriptionID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.EventSubscription{EventSubscription: models.EventSubscription{
+			ID:         &subscriptionID,
+			InfraEnvID: infraEnv.ID,
+			URL:        swag.String("https://example.com/hook"),
+		}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.EventSubscriptionDelivery{EventSubscriptionDelivery: models.EventSubscriptionDelivery{
+			SubscriptionID: subscriptionID,
+			Status:         models.EventSubscriptionDeliveryStatusPending,
+		}}).Error).ShouldNot(HaveOccurred())
+
+		Expect(state.DeregisterInfraEnv(ctx, *infraEnv.ID)).ShouldNot(HaveOccurred())
+		Expect(db.First(&common.EventSubscription{}, "id = ?", subscriptionID).Error).Should(MatchError(gorm.ErrRecordNotFound))
+		Expect(db.First(&common.EventSubscriptionDelivery{}, "subscription_id = ?", subscriptionID).Error).Should(MatchError(gorm.ErrRecordNotFound))
+	})
+
+	It("deletes the role bindings of the infraEnv", func() {
+		roleBindingID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.RoleBinding{RoleBinding: models.RoleBinding{
+			ID:          &roleBindingID,
+			InfraEnvID:  infraEnv.ID,
+			Role:        swag.String(models.RoleBindingRoleViewer),
+			SubjectKind: swag.String(models.RoleBindingSubjectKindUser),
+			Subject:     swag.String("technician"),
+		}}).Error).ShouldNot(HaveOccurred())
+
+		Expect(state.DeregisterInfraEnv(ctx, *infraEnv.ID)).ShouldNot(HaveOccurred())
+		Expect(db.First(&common.RoleBinding{}, "id = ?", roleBindingID).Error).Should(MatchError(gorm.ErrRecordNotFound))
+	})
+
 	AfterEach(func() {
 		ctrl.Finish()
 		common.DeleteTestDB(db, dbName)
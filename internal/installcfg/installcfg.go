@@ -12,6 +12,7 @@ type Platform struct {
 	Vsphere   *VsphereInstallConfigPlatform   `json:"vsphere,omitempty"`
 	Nutanix   *NutanixInstallConfigPlatform   `json:"nutanix,omitempty"`
 	External  *ExternalInstallConfigPlatform  `json:"external,omitempty"`
+	Openstack *OpenstackInstallConfigPlatform `json:"openstack,omitempty"`
 }
 
 type BMC struct {
@@ -110,6 +111,16 @@ type VsphereInstallConfigPlatform struct {
 	LoadBalancer               *configv1.VSpherePlatformLoadBalancer `json:"loadBalancer,omitempty"`
 }
 
+type OpenstackInstallConfigPlatform struct {
+	Cloud             string                                  `json:"cloud"`
+	ExternalNetwork   string                                  `json:"externalNetwork,omitempty"`
+	APIFloatingIP     string                                  `json:"apiFloatingIP,omitempty"`
+	IngressFloatingIP string                                  `json:"ingressFloatingIP,omitempty"`
+	APIVIPs           []string                                `json:"apiVIPs,omitempty"`
+	IngressVIPs       []string                                `json:"ingressVIPs,omitempty"`
+	LoadBalancer      *configv1.OpenStackPlatformLoadBalancer `json:"loadBalancer,omitempty"`
+}
+
 type NutanixInstallConfigPlatform struct {
 	ID                   int                   `json:"-"`
 	APIVIPs              []string              `json:"apiVIPs,omitempty"`
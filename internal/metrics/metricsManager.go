@@ -42,6 +42,9 @@ const (
 	histogramMonitoredClustersDurationMs          = "assisted_installer_monitored_clusters_duration_ms"
 	counterInstallerReleaseCache                  = "assisted_installer_release_cache"
 	counterInstallerReleaseCacheEviction          = "assisted_installer_release_cache_eviction"
+	histogramNotificationOutboxDeliverySeconds    = "assisted_installer_notification_outbox_delivery_seconds"
+	gaugeNotificationOutboxLagSeconds             = "assisted_installer_notification_outbox_lag_seconds"
+	counterLimitHits                              = "assisted_installer_limit_hits"
 )
 
 const (
@@ -66,6 +69,9 @@ const (
 	histogramDescriptionMonitoredClustersDurationMs          = "Histogram/sum/count of monitored clusters duration (ms)"
 	counterDescriptionInstallerReleaseCache                  = "Counts the cache hit status for the labelled release"
 	counterDescriptionInstallerReleaseCacheEviction          = "Counts the number of times that at least one release was evicted"
+	histogramDescriptionNotificationOutboxDeliverySeconds    = "Histogram/sum/count of the time between storing a notification in the outbox and its delivery, by result"
+	gaugeDescriptionNotificationOutboxLagSeconds             = "Age in seconds of the oldest notification waiting in the outbox"
+	counterDescriptionLimitHits                              = "Number of requests rejected by a quota or a rate limit, by limit, endpoint class"
 )
 
 const (
@@ -85,6 +91,8 @@ const (
 	labelCacheHit              = "hit"
 	labelReleaseID             = "releaseId"
 	labelSuccess               = "success"
+	limitLabel                 = "limit"
+	endpointClassLabel         = "endpointClass"
 )
 
 type API interface {
@@ -104,6 +112,9 @@ type API interface {
 	MonitoredClustersDurationMs(monitoredClustersMillis float64)
 	InstallerCacheGetReleaseCached(releaseId string, cacheHit bool)
 	InstallerCacheReleaseEvicted(success bool)
+	NotificationOutboxDelivered(lag time.Duration, delivered bool)
+	NotificationOutboxLag(lag time.Duration)
+	LimitHit(limit, endpointClass string)
 }
 
 type MetricsManager struct {
@@ -131,6 +142,9 @@ type MetricsManager struct {
 	serviceLogicMonitoredClustersDurationMs            *prometheus.HistogramVec
 	serviceLogicInstallerReleaseCache                  *prometheus.CounterVec
 	serviceLogicInstallerReleaseEvicted                *prometheus.CounterVec
+	serviceLogicNotificationOutboxDeliverySeconds      *prometheus.HistogramVec
+	serviceLogicNotificationOutboxLagSeconds           *prometheus.GaugeVec
+	serviceLogicLimitHits                              *prometheus.CounterVec
 
 	collectors []prometheus.Collector
 }
@@ -319,6 +333,31 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 				Name:      counterInstallerReleaseCacheEviction,
 				Help:      counterDescriptionInstallerReleaseCacheEviction,
 			}, []string{labelSuccess}),
+
+		serviceLogicNotificationOutboxDeliverySeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      histogramNotificationOutboxDeliverySeconds,
+			Help:      histogramDescriptionNotificationOutboxDeliverySeconds,
+			Buckets:   []float64{0.1, 0.5, 1, 2, 5, 10, 30, 60, 300, 900, 3600},
+		}, []string{resultLabel}),
+
+		serviceLogicNotificationOutboxLagSeconds: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      gaugeNotificationOutboxLagSeconds,
+				Help:      gaugeDescriptionNotificationOutboxLagSeconds,
+			}, []string{},
+		),
+
+		serviceLogicLimitHits: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterLimitHits,
+				Help:      counterDescriptionLimitHits,
+			}, []string{limitLabel, endpointClassLabel}),
 	}
 
 	m.collectors = append(m.collectors, newDirectoryUsageCollector(metricsManagerConfig.DirectoryUsageMonitorConfig.Directories, diskStatsHelper, log))
@@ -345,6 +384,9 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 		m.serviceLogicMonitoredClustersDurationMs,
 		m.serviceLogicInstallerReleaseCache,
 		m.serviceLogicInstallerReleaseEvicted,
+		m.serviceLogicNotificationOutboxDeliverySeconds,
+		m.serviceLogicNotificationOutboxLagSeconds,
+		m.serviceLogicLimitHits,
 	)
 
 	for _, collector := range m.collectors {
@@ -516,6 +558,24 @@ func (m *MetricsManager) MonitoredClustersDurationMs(monitoredClustersMs float64
 	m.serviceLogicMonitoredClustersDurationMs.WithLabelValues().Observe(monitoredClustersMs)
 }
 
+func (m *MetricsManager) NotificationOutboxDelivered(lag time.Duration, delivered bool) {
+	result := "delivered"
+	if !delivered {
+		result = "dead_letter"
+	}
+	m.serviceLogicNotificationOutboxDeliverySeconds.WithLabelValues(result).Observe(lag.Seconds())
+}
+
+func (m *MetricsManager) NotificationOutboxLag(lag time.Duration) {
+	m.serviceLogicNotificationOutboxLagSeconds.WithLabelValues().Set(lag.Seconds())
+}
+
+// LimitHit counts the requests rejected by a limit. The endpoint class is empty for the quotas
+// that are not request rates.
+func (m *MetricsManager) LimitHit(limit, endpointClass string) {
+	m.serviceLogicLimitHits.WithLabelValues(limit, endpointClass).Inc()
+}
+
 func bytesToGib(bytes int64) int64 {
 	return bytes / int64(units.GiB)
 }
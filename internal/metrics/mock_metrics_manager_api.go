@@ -193,6 +193,18 @@ func (mr *MockAPIMockRecorder) InstallerCacheReleaseEvicted(success interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallerCacheReleaseEvicted", reflect.TypeOf((*MockAPI)(nil).InstallerCacheReleaseEvicted), success)
 }
 
+// LimitHit mocks base method.
+func (m *MockAPI) LimitHit(limit, endpointClass string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LimitHit", limit, endpointClass)
+}
+
+// LimitHit indicates an expected call of LimitHit.
+func (mr *MockAPIMockRecorder) LimitHit(limit, endpointClass interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitHit", reflect.TypeOf((*MockAPI)(nil).LimitHit), limit, endpointClass)
+}
+
 // MonitoredClustersDurationMs mocks base method.
 func (m *MockAPI) MonitoredClustersDurationMs(monitoredClustersMillis float64) {
 	m.ctrl.T.Helper()
@@ -217,6 +229,30 @@ func (mr *MockAPIMockRecorder) MonitoredHostsDurationMs(monitoredHostsMillis int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitoredHostsDurationMs", reflect.TypeOf((*MockAPI)(nil).MonitoredHostsDurationMs), monitoredHostsMillis)
 }
 
+// NotificationOutboxDelivered mocks base method.
+func (m *MockAPI) NotificationOutboxDelivered(lag time.Duration, delivered bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotificationOutboxDelivered", lag, delivered)
+}
+
+// NotificationOutboxDelivered indicates an expected call of NotificationOutboxDelivered.
+func (mr *MockAPIMockRecorder) NotificationOutboxDelivered(lag, delivered interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationOutboxDelivered", reflect.TypeOf((*MockAPI)(nil).NotificationOutboxDelivered), lag, delivered)
+}
+
+// NotificationOutboxLag mocks base method.
+func (m *MockAPI) NotificationOutboxLag(lag time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotificationOutboxLag", lag)
+}
+
+// NotificationOutboxLag indicates an expected call of NotificationOutboxLag.
+func (mr *MockAPIMockRecorder) NotificationOutboxLag(lag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationOutboxLag", reflect.TypeOf((*MockAPI)(nil).NotificationOutboxLag), lag)
+}
+
 // ReportHostInstallationMetrics mocks base method.
 func (m *MockAPI) ReportHostInstallationMetrics(ctx context.Context, clusterVersion string, clusterID strfmt.UUID, emailDomain string, boot *models.Disk, h *models.Host, previousProgress *models.HostProgressInfo, currentStage models.HostStage) {
 	m.ctrl.T.Helper()
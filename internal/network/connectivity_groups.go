@@ -635,3 +635,36 @@ func GatherL3ConnectedAddresses(hosts []*models.Host) (map[strfmt.UUID][]string,
 	}
 	return ret, nil
 }
+
+// GetL3MajorityGroup returns the L3 majority group that the host belongs to, and the address family of the group. The
+// IPv4 group is preferred on dual-stack clusters. No group is returned when the host doesn't belong to any.
+func GetL3MajorityGroup(majorityGroups string, hostID strfmt.UUID) ([]strfmt.UUID, AddressFamily, error) {
+	if majorityGroups == "" {
+		return nil, 0, nil
+	}
+	var connectivity Connectivity
+	if err := json.Unmarshal([]byte(majorityGroups), &connectivity); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to unmarshal the connectivity majority groups")
+	}
+	for _, family := range []AddressFamily{IPv4, IPv6} {
+		group := connectivity.MajorityGroups[family.String()]
+		if funk.Contains(group, hostID) {
+			return group, family, nil
+		}
+	}
+	return nil, 0, nil
+}
+
+// GetL3RemoteAddress returns an address of the remote host that was reached at L3 in the given address family, or an
+// empty string if the remote host wasn't reached.
+func GetL3RemoteAddress(remoteHost *models.ConnectivityRemoteHost, family AddressFamily) string {
+	for _, l3 := range remoteHost.L3Connectivity {
+		if !l3.Successful {
+			continue
+		}
+		if family == IPv4 && IsIPv4Addr(l3.RemoteIPAddress) || family == IPv6 && IsIPv6Addr(l3.RemoteIPAddress) {
+			return l3.RemoteIPAddress
+		}
+	}
+	return ""
+}
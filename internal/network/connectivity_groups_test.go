@@ -1176,3 +1176,71 @@ func GenerateL3ConnectivityGroupTests(ipV4 bool, net1CIDR, net2CIDR string) {
 		})
 	})
 }
+
+var _ = Describe("L3 majority group of host", func() {
+	var (
+		host1, host2, host3 strfmt.UUID
+		majorityGroups      string
+	)
+
+	BeforeEach(func() {
+		host1 = strfmt.UUID(uuid.New().String())
+		host2 = strfmt.UUID(uuid.New().String())
+		host3 = strfmt.UUID(uuid.New().String())
+		b, err := json.Marshal(&Connectivity{
+			MajorityGroups: map[string][]strfmt.UUID{
+				"1.2.3.0/24":  {host1, host2, host3},
+				IPv4.String(): {host1, host2},
+				IPv6.String(): {host1, host2, host3},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		majorityGroups = string(b)
+	})
+
+	It("prefers the IPv4 group", func() {
+		group, family, err := GetL3MajorityGroup(majorityGroups, host1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(family).To(Equal(IPv4))
+		Expect(group).To(Equal([]strfmt.UUID{host1, host2}))
+	})
+
+	It("falls back to the IPv6 group", func() {
+		group, family, err := GetL3MajorityGroup(majorityGroups, host3)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(family).To(Equal(IPv6))
+		Expect(group).To(HaveLen(3))
+	})
+
+	It("returns no group for hosts outside the groups", func() {
+		group, _, err := GetL3MajorityGroup(majorityGroups, strfmt.UUID(uuid.New().String()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(group).To(BeEmpty())
+	})
+
+	It("returns no group without majority groups", func() {
+		group, _, err := GetL3MajorityGroup("", host1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(group).To(BeEmpty())
+	})
+
+	It("fails on invalid majority groups", func() {
+		_, _, err := GetL3MajorityGroup("{", host1)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns the reached address of the family", func() {
+		remoteHost := &models.ConnectivityRemoteHost{
+			HostID: host2,
+			L3Connectivity: []*models.L3Connectivity{
+				{RemoteIPAddress: "1.2.3.5", Successful: false},
+				{RemoteIPAddress: "1001:db8::5", Successful: true},
+				{RemoteIPAddress: "1.2.4.5", Successful: true},
+			},
+		}
+		Expect(GetL3RemoteAddress(remoteHost, IPv4)).To(Equal("1.2.4.5"))
+		Expect(GetL3RemoteAddress(remoteHost, IPv6)).To(Equal("1001:db8::5"))
+		remoteHost.L3Connectivity = remoteHost.L3Connectivity[:1]
+		Expect(GetL3RemoteAddress(remoteHost, IPv4)).To(BeEmpty())
+	})
+})
@@ -59,6 +59,13 @@ type Operator interface {
 	GetBundleLabels() []string
 }
 
+// ChannelsProvider is implemented by the operators that know the channels of their package that can be selected
+// instead of the default one.
+type ChannelsProvider interface {
+	// GetChannels returns the channels that can be selected for the given cluster
+	GetChannels(cluster *common.Cluster) []string
+}
+
 // Storage Operator provide a generic API for storage operators
 type StorageOperator interface {
 	Operator
@@ -1,6 +1,10 @@
 package operators
 
 import (
+	"fmt"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/operators/amdgpu"
 	"github.com/openshift/assisted-service/internal/operators/api"
@@ -22,6 +26,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/openshiftai"
 	"github.com/openshift/assisted-service/internal/operators/osc"
 	"github.com/openshift/assisted-service/internal/operators/pipelines"
+	"github.com/openshift/assisted-service/internal/operators/plugin"
 	"github.com/openshift/assisted-service/internal/operators/selfnoderemediation"
 	"github.com/openshift/assisted-service/internal/operators/serverless"
 	"github.com/openshift/assisted-service/internal/operators/servicemesh"
@@ -45,6 +50,17 @@ var OperatorConsole = models.MonitoredOperator{
 type Options struct {
 	CheckClusterVersion bool
 	CNVConfig           cnv.Config
+
+	// DiskHealth is loaded once by the service and shared with the hardware validator
+	DiskHealth smart.Thresholds `ignored:"true"`
+
+	// PluginsDir is the directory containing the descriptors of the operator plugins, usually a mounted config
+	// map. When empty no plugins are loaded.
+	PluginsDir string `envconfig:"OPERATOR_PLUGINS_DIR" default:""`
+
+	// CatalogSources is a JSON list of catalog sources, for example mirrored catalogs in disconnected environments,
+	// that can be selected for the subscriptions of the operators in addition to the default OpenShift ones.
+	CatalogSources string `envconfig:"OPERATOR_CATALOG_SOURCES" default:""`
 }
 
 // NewManager creates new instance of an Operator Manager
@@ -52,13 +68,12 @@ func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, o
 	nvidiaGPUOperator := nvidiagpu.NewNvidiaGPUOperator(log)
 	amdGPUOperator := amdgpu.NewAMDGPUOperator(log)
 
-	return NewManagerWithOperators(
-		log, manifestAPI, options, objectHandler,
+	builtinOperators := []api.Operator{
 		lso.NewLSOperator(),
 		odf.NewOcsOperator(log),
-		odf.NewOdfOperator(log),
+		odf.NewOdfOperator(log, options.DiskHealth),
 		cnv.NewCNVOperator(log, options.CNVConfig),
-		lvm.NewLvmOperator(log),
+		lvm.NewLvmOperator(log, options.DiskHealth),
 		mce.NewMceOperator(log),
 		mtv.NewMTVOperator(log),
 		nodefeaturediscovery.NewNodeFeatureDiscoveryOperator(log),
@@ -77,9 +92,51 @@ func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, o
 		fenceagentsremediation.NewFenceAgentsRemediationOperator(log),
 		nodemaintenance.NewNodeMaintenanceOperator(log),
 		kubedescheduler.NewKubeDeschedulerOperator(log),
+	}
+
+	pluginOperators, err := plugin.LoadOperators(log, options.PluginsDir)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	err = checkPluginOperators(builtinOperators, pluginOperators)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	return NewManagerWithOperators(
+		log, manifestAPI, options, objectHandler,
+		append(builtinOperators, pluginOperators...)...,
 	)
 }
 
+// checkPluginOperators verifies that the names of the plugins don't collide with the built-in operators and that
+// all their dependencies exist.
+func checkPluginOperators(builtinOperators, pluginOperators []api.Operator) error {
+	names := map[string]bool{}
+	for _, operator := range builtinOperators {
+		names[operator.GetName()] = true
+	}
+	for _, operator := range pluginOperators {
+		if names[operator.GetName()] {
+			return fmt.Errorf("operator plugin '%s' has the same name as a built-in operator", operator.GetName())
+		}
+		names[operator.GetName()] = true
+	}
+	for _, operator := range pluginOperators {
+		dependencies, err := operator.GetDependencies(&common.Cluster{})
+		if err != nil {
+			return err
+		}
+		for _, dependency := range dependencies {
+			if !names[dependency] {
+				return fmt.Errorf("operator plugin '%s' depends on operator '%s', which doesn't exist",
+					operator.GetName(), dependency)
+			}
+		}
+	}
+	return nil
+}
+
 // NewManagerWithOperators creates new instance of an Operator Manager and configures it with given operators
 func NewManagerWithOperators(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, options Options, objectHandler s3wrapper.API, olmOperators ...api.Operator) *Manager {
 	nameToOperator := make(map[string]api.Operator)
@@ -94,6 +151,11 @@ func NewManagerWithOperators(log logrus.FieldLogger, manifestAPI manifestsapi.Ma
 		monitoredOperators[OperatorCVO.Name] = &OperatorCVO
 	}
 
+	catalogSources, err := parseCatalogSources(options.CatalogSources)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
 	for _, olmOperator := range olmOperators {
 		nameToOperator[olmOperator.GetName()] = olmOperator
 		// Add OLM operator to the monitoredOperators map
@@ -106,5 +168,6 @@ func NewManagerWithOperators(log logrus.FieldLogger, manifestAPI manifestsapi.Ma
 		monitoredOperators: monitoredOperators,
 		manifestsAPI:       manifestAPI,
 		objectHandler:      objectHandler,
+		catalogSources:     catalogSources,
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/lib/pq"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/hardware/firmware"
 	"github.com/openshift/assisted-service/internal/hardware/virt"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
@@ -138,6 +139,14 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{"CPU does not have virtualization support"}}, nil
 	}
 
+	report, err := firmware.Parse(host.Firmware)
+	if err != nil {
+		o.log.WithError(err).Warnf("Failed to get the firmware report of host with id %s", host.ID)
+	}
+	if firmware.IsVirtualizationDisabled(report) {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{firmware.VirtualizationDisabledReason}}, nil
+	}
+
 	if shouldInstallHPP(o.config, cluster) {
 		if err = validDiscoverableSNODisk(inventory.Disks, host.InstallationDiskID, o.config.SNOPoolSizeRequestHPPGib); err != nil {
 			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{err.Error()}}, nil
@@ -169,8 +178,16 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{fmt.Sprintf("Insufficient memory to deploy OpenShift Virtualization. Required memory is %d MiB but found %d MiB", mem, usableMemory)}}, nil
 	}
 
-	// TODO: validate available devices on worker node like gpu and sr-iov and check whether there is enough memory to support them
-	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID()}, nil
+	var warnings []string
+	if o.config.ValidateDeviceFirmware {
+		var failures []string
+		failures, warnings = o.getDeviceFirmwareReasons(inventory, report)
+		if len(failures) > 0 {
+			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: failures}, nil
+		}
+	}
+
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID(), Reasons: warnings}, nil
 }
 
 // GenerateManifests generates manifests for the operator
@@ -242,7 +259,7 @@ func (o *operator) GetPreflightRequirements(_ context.Context, cluster *common.C
 	qualitativeRequirements := []string{
 		"Additional 1GiB of RAM per each supported GPU",
 		"Additional 1GiB of RAM per each supported SR-IOV NIC",
-		"CPU has virtualization flag (vmx or svm)",
+		"CPU has virtualization flag (vmx or svm) and virtualization is enabled in the firmware",
 	}
 	if shouldInstallHPP(o.config, cluster) {
 		qualitativeRequirements = append(qualitativeRequirements, fmt.Sprintf("Additional disk with %d Gi", o.config.SNOPoolSizeRequestHPPGib))
@@ -317,6 +334,30 @@ func (o *operator) getSrIovNicCount(inventory models.Inventory) int64 {
 	return srIovCount
 }
 
+// getDeviceFirmwareReasons returns the reasons why the supported GPUs and SR-IOV NICs of the host, that its memory
+// requirements account for, can't be assigned to virtual machines because of the firmware settings of the host. The
+// GPUs fail the host, while the SR-IOV NICs only warn about it, since CNV doesn't need SR-IOV and the cluster may not
+// use it.
+func (o *operator) getDeviceFirmwareReasons(inventory *models.Inventory, report *models.FirmwareInspectionResponse) (failures, warnings []string) {
+	var srIovNics []string
+	for _, nic := range inventory.Interfaces {
+		if o.config.SupportedSRIOVNetworkIC[getDeviceKeyForInterface(nic)] {
+			srIovNics = append(srIovNics, nic.Name)
+		}
+	}
+	if firmware.IsIommuDisabled(report) {
+		if o.getGPUCount(*inventory) > 0 {
+			failures = append(failures, firmware.IommuDisabledReason)
+		} else if len(srIovNics) > 0 {
+			warnings = append(warnings, firmware.IommuDisabledReason)
+		}
+	}
+	if disabled := firmware.SriovDisabledInterfaces(report, srIovNics); len(disabled) > 0 {
+		warnings = append(warnings, firmware.SriovDisabledReason(disabled))
+	}
+	return failures, warnings
+}
+
 func getDeviceKeyForGPU(gpu *models.Gpu) string {
 	return getDeviceKey(gpu.VendorID, gpu.DeviceID)
 }
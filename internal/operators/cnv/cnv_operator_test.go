@@ -297,6 +297,82 @@ var _ = Describe("CNV operator", func() {
 		)
 	})
 
+	Context("ValidateHost with a firmware report", func() {
+		cfg := cnv.Config{
+			SupportedGPUs: map[string]bool{
+				"10de:1db6": true,
+			},
+			SupportedSRIOVNetworkIC: map[string]bool{
+				"8086:158b": true,
+			},
+			ValidateDeviceFirmware: true,
+		}
+		cnvOperator := cnv.NewCNVOperator(log, cfg)
+		cluster := &common.Cluster{Cluster: models.Cluster{OpenshiftVersion: "4.16", ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode}}
+
+		newWorker := func(firmware string, gpus ...*models.Gpu) *models.Host {
+			inventory := models.Inventory{
+				Interfaces: []*models.Interface{
+					{Name: "eth0"},
+					{Name: "ens1f0", Vendor: "0x8086", Product: "0x158b"},
+				},
+				Gpus:   gpus,
+				CPU:    &models.CPU{Count: 12, Flags: []string{"vmx"}},
+				Memory: &models.Memory{UsableBytes: 32 * conversions.GiB},
+			}
+			return &models.Host{Role: models.HostRoleWorker, Inventory: marshal(inventory), Firmware: firmware}
+		}
+
+		validate := func(firmware string, gpus ...*models.Gpu) api.ValidationResult {
+			res, err := cnvOperator.ValidateHost(context.TODO(), cluster, newWorker(firmware, gpus...), nil)
+			Expect(err).ToNot(HaveOccurred())
+			return res
+		}
+
+		It("succeeds when the host didn't report its firmware settings", func() {
+			Expect(validate("").Status).To(Equal(api.Success))
+		})
+
+		It("succeeds when the firmware settings allow virtualization and device assignment", func() {
+			res := validate(`{"virtualization_enabled":true,"iommu_enabled":true,"sriov_interfaces":[{"name":"ens1f0","total_vfs":64}]}`)
+			Expect(res.Status).To(Equal(api.Success))
+		})
+
+		It("fails when virtualization is disabled in the firmware", func() {
+			res := validate(`{"virtualization_enabled":false}`)
+			Expect(res.Status).To(Equal(api.Failure))
+			Expect(res.Reasons).To(ConsistOf(ContainSubstring("Enable them in the BIOS/UEFI settings of the host")))
+		})
+
+		It("fails when the IOMMU is disabled for a supported GPU", func() {
+			res := validate(`{"virtualization_enabled":true,"iommu_enabled":false}`, &models.Gpu{VendorID: "10de", DeviceID: "1db6"})
+			Expect(res.Status).To(Equal(api.Failure))
+			Expect(res.Reasons).To(ConsistOf(ContainSubstring("The IOMMU (Intel VT-d or AMD-Vi) is disabled on the host")))
+		})
+
+		It("only warns when the IOMMU and SR-IOV are disabled for a supported SR-IOV NIC", func() {
+			res := validate(`{"virtualization_enabled":true,"iommu_enabled":false,"sriov_interfaces":[{"name":"ens1f0","total_vfs":0}]}`)
+			Expect(res.Status).To(Equal(api.Success))
+			Expect(res.Reasons).To(ConsistOf(
+				ContainSubstring("The IOMMU (Intel VT-d or AMD-Vi) is disabled on the host"),
+				ContainSubstring("SR-IOV is disabled in the firmware for the network interfaces ens1f0"),
+			))
+		})
+
+		It("doesn't warn about the SR-IOV NICs missing from the report", func() {
+			res := validate(`{"virtualization_enabled":true,"iommu_enabled":true,"sriov_interfaces":[]}`)
+			Expect(res.Status).To(Equal(api.Success))
+			Expect(res.Reasons).To(BeEmpty())
+		})
+
+		It("doesn't check the devices when it is disabled", func() {
+			operator := cnv.NewCNVOperator(log, cnv.Config{SupportedSRIOVNetworkIC: cfg.SupportedSRIOVNetworkIC})
+			res, err := operator.ValidateHost(context.TODO(), cluster, newWorker(`{"iommu_enabled":false,"sriov_interfaces":[]}`), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Status).To(Equal(api.Success))
+		})
+	})
+
 	DescribeTable("GetPreflightRequirements, should be returned", func(cfg cnv.Config, cluster common.Cluster) {
 		cnvOperator := cnv.NewCNVOperator(log, cfg)
 		requirements, err := cnvOperator.GetPreflightRequirements(context.TODO(), &cluster)
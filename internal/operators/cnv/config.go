@@ -17,6 +17,9 @@ type Config struct {
 	SNOInstallHPP bool `envconfig:"CNV_SNO_INSTALL_HPP" default:"true"`
 	// In CNV+SNO we'll deploy the HPP storage provisioner. This defines the request size for the storage pool that backs HPP; we validate by checking host's disks against this value
 	SNOPoolSizeRequestHPPGib int64 `envconfig:"CNV_SNO_POOL_SIZE_REQUEST_HPP_GIB" default:"50"`
+	// Whether to check that the firmware settings of the hosts allow assigning their supported GPUs and SR-IOV NICs to virtual
+	// machines. The GPUs fail the host, the SR-IOV NICs only add a warning to its validation.
+	ValidateDeviceFirmware bool `envconfig:"CNV_VALIDATE_DEVICE_FIRMWARE" default:"true"`
 }
 
 func (d *DeviceIDDecoder) Decode(value string) error {
@@ -5,20 +5,23 @@ import (
 	"fmt"
 	"io/fs"
 	"path"
+	"strings"
 	"text/template"
 
+	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
 )
 
 // Returns count for disks that are not installion disk and fulfill size requirements (eligible disks) and
-// disks that are not installation disk (available disks)
+// disks that are not installion disk (available disks)
 func NonInstallationDiskCount(disks []*models.Disk, installationDiskID string, minSizeGB int64) (int64, int64) {
 	var eligibleDisks int64
 	var availableDisks int64
 
 	for _, disk := range disks {
-		if (disk.DriveType == models.DriveTypeSSD || disk.DriveType == models.DriveTypeHDD) && installationDiskID != disk.ID && disk.SizeBytes != 0 {
+		if IsNonInstallationDisk(disk, installationDiskID) {
 			if disk.SizeBytes >= conversions.GbToBytes(minSizeGB) {
 				eligibleDisks++
 			} else {
@@ -30,6 +33,38 @@ func NonInstallationDiskCount(disks []*models.Disk, installationDiskID string, m
 	return eligibleDisks, availableDisks
 }
 
+// IsNonInstallationDisk returns whether the disk is one of the disks, other than the installation disk, that the
+// storage operators consume.
+func IsNonInstallationDisk(disk *models.Disk, installationDiskID string) bool {
+	return (disk.DriveType == models.DriveTypeSSD || disk.DriveType == models.DriveTypeHDD) && installationDiskID != disk.ID && disk.SizeBytes != 0
+}
+
+// UnhealthyNonInstallationDiskReasons returns a reason for each disk counted by NonInstallationDiskCount, regardless
+// of its size, that is unhealthy according to its SMART data. Storage operators consume these disks, so they must
+// not be failing.
+func UnhealthyNonInstallationDiskReasons(disks []*models.Disk, installationDiskID string, thresholds smart.Thresholds) []string {
+	var reasons []string
+	for _, disk := range disks {
+		if IsNonInstallationDisk(disk, installationDiskID) {
+			if problems := thresholds.DiskProblems(disk); len(problems) > 0 {
+				reasons = append(reasons, fmt.Sprintf("Disk %s is unhealthy: %s", disk.Name, strings.Join(problems, ", ")))
+			}
+		}
+	}
+	return reasons
+}
+
+// StableChannels returns the "stable-<major>.<minor>" channel of the OpenShift version, the channel that the operators
+// released together with OpenShift publish for it, or no channels if the version is invalid.
+func StableChannels(openshiftVersion string) []string {
+	v, err := version.NewVersion(openshiftVersion)
+	if err != nil {
+		return nil
+	}
+	segments := v.Segments()
+	return []string{fmt.Sprintf("stable-%d.%d", segments[0], segments[1])}
+}
+
 func HasOperator(operators []*models.MonitoredOperator, operatorName string) bool {
 	for _, o := range operators {
 		if o.Name == operatorName {
@@ -55,6 +55,17 @@ var _ = DescribeTable(
 	Entry("matching a operator", []*models.MonitoredOperator{&odf.Operator, &mce.Operator}, mce.Operator.Name, true),
 )
 
+var _ = DescribeTable(
+	"stable channels",
+	func(openshiftVersion string, expected []string) {
+		Expect(common.StableChannels(openshiftVersion)).To(Equal(expected))
+	},
+	Entry("minor version", "4.16", []string{"stable-4.16"}),
+	Entry("patch version", "4.15.3", []string{"stable-4.15"}),
+	Entry("pre-release version", "4.17.0-ec.2", []string{"stable-4.17"}),
+	Entry("invalid version", "latest", []string(nil)),
+)
+
 func TestHandler(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Operators common test suite")
@@ -2,6 +2,7 @@ package handler_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	operatorsHandler "github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/operators/lso"
 	"github.com/openshift/assisted-service/models"
+	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -205,6 +207,45 @@ var _ = Describe("Operators manager", func() {
 			}
 		})
 	})
+
+	Context("V2ListOperatorInstallOptions", func() {
+		It("should return the install options of the operator", func() {
+			options := &models.OperatorInstallOptions{OperatorName: lso.Operator.Name, OpenshiftVersion: "4.16"}
+			mockApi.EXPECT().GetSupportedOperators().Return([]string{lso.Operator.Name})
+			mockApi.EXPECT().GetOperatorInstallOptions(lso.Operator.Name, "4.16").Return(options, nil)
+
+			reply := handler.V2ListOperatorInstallOptions(context.TODO(), restoperators.V2ListOperatorInstallOptionsParams{
+				OperatorName:     lso.Operator.Name,
+				OpenshiftVersion: "4.16",
+			})
+
+			Expect(reply).To(BeAssignableToTypeOf(restoperators.NewV2ListOperatorInstallOptionsOK()))
+			Expect(reply.(*restoperators.V2ListOperatorInstallOptionsOK).Payload).To(Equal(options))
+		})
+
+		It("should fail for an unknown operator", func() {
+			mockApi.EXPECT().GetSupportedOperators().Return([]string{lso.Operator.Name})
+
+			reply := handler.V2ListOperatorInstallOptions(context.TODO(), restoperators.V2ListOperatorInstallOptionsParams{
+				OperatorName:     "unknown",
+				OpenshiftVersion: "4.16",
+			})
+
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusNotFound))
+		})
+
+		It("should fail for an invalid OpenShift version", func() {
+			mockApi.EXPECT().GetSupportedOperators().Return([]string{lso.Operator.Name})
+			mockApi.EXPECT().GetOperatorInstallOptions(lso.Operator.Name, "latest").Return(nil, errors.New("invalid OpenShift version latest"))
+
+			reply := handler.V2ListOperatorInstallOptions(context.TODO(), restoperators.V2ListOperatorInstallOptionsParams{
+				OperatorName:     lso.Operator.Name,
+				OpenshiftVersion: "latest",
+			})
+
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusBadRequest))
+		})
+	})
 })
 
 func from(prototype models.MonitoredOperator) *models.MonitoredOperator {
@@ -2,11 +2,14 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openshift/assisted-service/internal/common"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/thoas/go-funk"
 )
 
 // V2ListOfClusterOperators Lists operators to be monitored for a cluster.
@@ -31,6 +34,21 @@ func (h *Handler) V2ListOperatorProperties(ctx context.Context, params restopera
 		WithPayload(properties)
 }
 
+// V2ListOperatorInstallOptions Lists the channels, catalog sources and install plan approval modes of an operator.
+func (h *Handler) V2ListOperatorInstallOptions(ctx context.Context, params restoperators.V2ListOperatorInstallOptionsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, h.log)
+	if !funk.ContainsString(h.operatorsAPI.GetSupportedOperators(), params.OperatorName) {
+		log.Errorf("%s operator has not been found", params.OperatorName)
+		return common.NewApiError(http.StatusNotFound, fmt.Errorf("operator %s not found", params.OperatorName))
+	}
+	options, err := h.operatorsAPI.GetOperatorInstallOptions(params.OperatorName, params.OpenshiftVersion)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get the install options of operator %s", params.OperatorName)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	return restoperators.NewV2ListOperatorInstallOptionsOK().WithPayload(options)
+}
+
 // V2ListSupportedOperators Retrieves the list of supported operators.
 func (h *Handler) V2ListSupportedOperators(_ context.Context, _ restoperators.V2ListSupportedOperatorsParams) middleware.Responder {
 	return restoperators.NewV2ListSupportedOperatorsOK().
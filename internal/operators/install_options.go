@@ -0,0 +1,378 @@
+package operators
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"sigs.k8s.io/yaml"
+)
+
+// marketplaceNamespace is the namespace where OpenShift creates the default catalog sources, and where the catalog
+// sources requested by the users are created.
+const marketplaceNamespace = "openshift-marketplace"
+
+// defaultCatalogSources are the catalog sources that OpenShift creates by default.
+var defaultCatalogSources = []*models.OperatorCatalogSource{
+	{
+		Name:        "redhat-operators",
+		Namespace:   marketplaceNamespace,
+		DisplayName: "Red Hat Operators",
+	},
+	{
+		Name:        "certified-operators",
+		Namespace:   marketplaceNamespace,
+		DisplayName: "Certified Operators",
+	},
+	{
+		Name:        "community-operators",
+		Namespace:   marketplaceNamespace,
+		DisplayName: "Community Operators",
+	},
+	{
+		Name:        "redhat-marketplace",
+		Namespace:   marketplaceNamespace,
+		DisplayName: "Red Hat Marketplace",
+	},
+}
+
+// installPlanApprovals are the install plan approval modes that can be selected for all the operators.
+var installPlanApprovals = []models.OperatorInstallPlanApproval{
+	models.OperatorInstallPlanApprovalAutomatic,
+}
+
+// parseCatalogSources parses the additional catalog sources configured for the service, a JSON list of catalog
+// sources. Catalog sources without namespace are created in the marketplace namespace.
+func parseCatalogSources(text string) ([]*models.OperatorCatalogSource, error) {
+	if text == "" {
+		return nil, nil
+	}
+	var catalogSources []*models.OperatorCatalogSource
+	if err := json.Unmarshal([]byte(text), &catalogSources); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the operator catalog sources")
+	}
+	names := map[string]bool{}
+	for _, catalogSource := range defaultCatalogSources {
+		names[catalogSource.Name] = true
+	}
+	for _, catalogSource := range catalogSources {
+		if catalogSource.Name == "" {
+			return nil, errors.New("operator catalog sources must have a name")
+		}
+		if names[catalogSource.Name] {
+			return nil, errors.Errorf("operator catalog source '%s' is defined more than once", catalogSource.Name)
+		}
+		names[catalogSource.Name] = true
+		if catalogSource.Namespace == "" {
+			catalogSource.Namespace = marketplaceNamespace
+		}
+	}
+	return catalogSources, nil
+}
+
+// hasInstallOptions checks if the operator selects the channel, the version, the catalog source or the install plan
+// approval mode instead of using the defaults.
+func hasInstallOptions(operator *models.MonitoredOperator) bool {
+	return operator.Channel != "" || operator.StartingCsv != "" || operator.CatalogSource != "" ||
+		operator.CatalogSourceImage != "" || operator.InstallPlanApproval != ""
+}
+
+// catalogSourceName returns the name of the catalog source that the subscription of the operator uses, or an empty
+// string if the operator uses its default catalog source.
+func catalogSourceName(operator *models.MonitoredOperator) string {
+	if operator.CatalogSource == "" && operator.CatalogSourceImage != "" {
+		return fmt.Sprintf("%s-catalog", operator.Name)
+	}
+	return operator.CatalogSource
+}
+
+// getCatalogSources returns the catalog sources that can be selected for the operators.
+func (mgr *Manager) getCatalogSources() []*models.OperatorCatalogSource {
+	return append(append([]*models.OperatorCatalogSource{}, defaultCatalogSources...), mgr.catalogSources...)
+}
+
+func (mgr *Manager) lookupCatalogSource(name string) *models.OperatorCatalogSource {
+	for _, catalogSource := range mgr.getCatalogSources() {
+		if catalogSource.Name == name {
+			return catalogSource
+		}
+	}
+	return nil
+}
+
+// GetOperatorInstallOptions returns the channels, catalog sources and install plan approval modes that can be selected
+// to install the operator on the given OpenShift version.
+func (mgr *Manager) GetOperatorInstallOptions(operatorName, openshiftVersion string) (*models.OperatorInstallOptions, error) {
+	operator, ok := mgr.olmOperators[operatorName]
+	if !ok {
+		return nil, errors.Errorf("Operator %s not found", operatorName)
+	}
+	if _, err := common.GetMajorMinorVersion(openshiftVersion); err != nil {
+		return nil, errors.Errorf("invalid OpenShift version %s", openshiftVersion)
+	}
+
+	cluster := installOptionsCluster(openshiftVersion, common.DefaultCPUArchitecture)
+	subscription, err := mgr.getSubscriptionSpec(cluster, operator)
+	if err != nil {
+		return nil, err
+	}
+	defaultChannel, _ := subscription["channel"].(string)
+	defaultCatalogSource, _ := subscription["source"].(string)
+
+	supportLevel := featuresupport.GetSupportLevel(models.FeatureSupportLevelIDOPERATORVERSIONPINNING,
+		featuresupport.SupportLevelFilters{OpenshiftVersion: openshiftVersion})
+	featureID := operator.GetFeatureSupportID()
+	if featureID != "" && !featuresupport.IsFeatureAvailable(featureID, openshiftVersion, nil) {
+		supportLevel = models.SupportLevelUnavailable
+	}
+
+	return &models.OperatorInstallOptions{
+		OperatorName:         operatorName,
+		OpenshiftVersion:     openshiftVersion,
+		DefaultChannel:       defaultChannel,
+		Channels:             getChannels(cluster, operator, subscription),
+		DefaultCatalogSource: defaultCatalogSource,
+		CatalogSources:       mgr.getCatalogSources(),
+		InstallPlanApprovals: installPlanApprovals,
+		SupportLevel:         supportLevel,
+	}, nil
+}
+
+// installOptionsCluster returns the cluster used to generate the subscriptions of the operators when their install
+// options are listed or validated.
+func installOptionsCluster(openshiftVersion, cpuArchitecture string) *common.Cluster {
+	return &common.Cluster{Cluster: models.Cluster{
+		OpenshiftVersion: openshiftVersion,
+		CPUArchitecture:  cpuArchitecture,
+	}}
+}
+
+// getChannels returns the default channel of the subscription of the operator followed by the other channels that the
+// operator knows can be selected.
+func getChannels(cluster *common.Cluster, operator api.Operator, subscription map[string]interface{}) []string {
+	channels := []string{}
+	if defaultChannel, _ := subscription["channel"].(string); defaultChannel != "" {
+		channels = append(channels, defaultChannel)
+	}
+	if provider, ok := operator.(api.ChannelsProvider); ok {
+		for _, channel := range provider.GetChannels(cluster) {
+			if !funk.ContainsString(channels, channel) {
+				channels = append(channels, channel)
+			}
+		}
+	}
+	return channels
+}
+
+// getSubscriptionSpec returns the specification of the subscription that the operator generates for the cluster.
+func (mgr *Manager) getSubscriptionSpec(cluster *common.Cluster, operator api.Operator) (map[string]interface{}, error) {
+	openshiftManifests, _, err := operator.GenerateManifests(cluster)
+	if err != nil {
+		return nil, err
+	}
+	for _, content := range openshiftManifests {
+		object, err := parseSubscription(content)
+		if err != nil {
+			return nil, err
+		}
+		if object != nil {
+			spec, _ := object["spec"].(map[string]interface{})
+			return spec, nil
+		}
+	}
+	return nil, errors.Errorf("operator %s doesn't have a subscription", operator.GetName())
+}
+
+// parseSubscription parses the manifest and returns it if it is a subscription, or nil otherwise.
+func parseSubscription(content []byte) (map[string]interface{}, error) {
+	var object map[string]interface{}
+	if err := yaml.Unmarshal(content, &object); err != nil {
+		return nil, err
+	}
+	if kind, _ := object["kind"].(string); kind != "Subscription" {
+		return nil, nil
+	}
+	if _, ok := object["spec"].(map[string]interface{}); !ok {
+		object["spec"] = map[string]interface{}{}
+	}
+	return object, nil
+}
+
+// ValidateOperatorInstallOptions checks the install options selected for the operators: the channel must be one of the
+// channels known for the operator when it uses its default catalog source, the version must be a cluster service
+// version of the package of the subscription, and the catalog sources must exist, or the operators must provide the
+// image of the catalog source that will be created.
+func (mgr *Manager) ValidateOperatorInstallOptions(openshiftVersion, cpuArchitecture string, operators []*models.MonitoredOperator) error {
+	images := map[string]string{}
+	for _, operator := range operators {
+		if !hasInstallOptions(operator) {
+			continue
+		}
+		olmOperator, ok := mgr.olmOperators[operator.Name]
+		if !ok || operator.OperatorType != models.OperatorTypeOlm {
+			return errors.Errorf("operator %s isn't installed with a subscription, so its channel, version or "+
+				"catalog source can't be selected", operator.Name)
+		}
+		if operator.InstallPlanApproval != "" && !funk.Contains(installPlanApprovals, operator.InstallPlanApproval) {
+			return errors.Errorf("invalid install plan approval '%s' for operator %s", operator.InstallPlanApproval,
+				operator.Name)
+		}
+		if err := mgr.validateChannelAndVersion(openshiftVersion, cpuArchitecture, olmOperator, operator); err != nil {
+			return err
+		}
+		name := catalogSourceName(operator)
+		if name == "" {
+			continue
+		}
+		image := operator.CatalogSourceImage
+		if image == "" {
+			catalogSource := mgr.lookupCatalogSource(name)
+			if catalogSource == nil {
+				return errors.Errorf("catalog source '%s' of operator %s doesn't exist, the image of the catalog "+
+					"source is required to create it", name, operator.Name)
+			}
+			image = catalogSource.Image
+		} else if catalogSource := mgr.lookupCatalogSource(name); catalogSource != nil && catalogSource.Image != image {
+			return errors.Errorf("catalog source '%s' of operator %s already exists with a different image", name,
+				operator.Name)
+		}
+		if previous, ok := images[name]; ok && previous != image {
+			return errors.Errorf("catalog source '%s' is used with different images", name)
+		}
+		images[name] = image
+	}
+	return nil
+}
+
+// validateChannelAndVersion checks the channel and the starting cluster service version selected for the operator
+// against its subscription. Catalog sources other than the default one may publish any channel, so the channel is only
+// checked when the operator uses its default catalog source and its channels are known.
+func (mgr *Manager) validateChannelAndVersion(openshiftVersion, cpuArchitecture string, olmOperator api.Operator, operator *models.MonitoredOperator) error {
+	if operator.Channel == "" && operator.StartingCsv == "" {
+		return nil
+	}
+	cluster := installOptionsCluster(openshiftVersion, cpuArchitecture)
+	subscription, err := mgr.getSubscriptionSpec(cluster, olmOperator)
+	if err != nil {
+		return err
+	}
+	if operator.Channel != "" && catalogSourceName(operator) == "" {
+		channels := getChannels(cluster, olmOperator, subscription)
+		if len(channels) > 0 && !funk.ContainsString(channels, operator.Channel) {
+			return errors.Errorf("channel '%s' of operator %s isn't available for OpenShift %s, the available "+
+				"channels are %s", operator.Channel, operator.Name, openshiftVersion, strings.Join(channels, ", "))
+		}
+	}
+	if operator.StartingCsv != "" {
+		packageName, _ := subscription["name"].(string)
+		prefix := packageName + ".v"
+		if packageName == "" || !strings.HasPrefix(operator.StartingCsv, prefix) {
+			return errors.Errorf("starting CSV '%s' of operator %s must be in the %s.v<version> form",
+				operator.StartingCsv, operator.Name, packageName)
+		}
+		if _, err := version.NewVersion(strings.TrimPrefix(operator.StartingCsv, prefix)); err != nil {
+			return errors.Errorf("starting CSV '%s' of operator %s doesn't have a valid version: %s",
+				operator.StartingCsv, operator.Name, err)
+		}
+	}
+	return nil
+}
+
+// applyInstallOptions replaces the channel, version, catalog source and install plan approval mode of the
+// subscription of the operator, if it selects them, and returns the catalog source that needs to be created, if any.
+func (mgr *Manager) applyInstallOptions(operator *models.MonitoredOperator, openshiftManifests map[string][]byte) (*models.OperatorCatalogSource, error) {
+	if !hasInstallOptions(operator) {
+		return nil, nil
+	}
+
+	var catalogSource *models.OperatorCatalogSource
+	if name := catalogSourceName(operator); name != "" {
+		catalogSource = mgr.lookupCatalogSource(name)
+		if operator.CatalogSourceImage != "" && catalogSource == nil {
+			catalogSource = &models.OperatorCatalogSource{
+				Name:        name,
+				Namespace:   marketplaceNamespace,
+				DisplayName: name,
+				Image:       operator.CatalogSourceImage,
+			}
+		}
+		if catalogSource == nil {
+			return nil, errors.Errorf("catalog source '%s' of operator %s doesn't exist", name, operator.Name)
+		}
+	}
+
+	patched := false
+	for name, content := range openshiftManifests {
+		object, err := parseSubscription(content)
+		if err != nil {
+			return nil, err
+		}
+		if object == nil {
+			continue
+		}
+		spec := object["spec"].(map[string]interface{})
+		if operator.Channel != "" {
+			spec["channel"] = operator.Channel
+		}
+		if operator.StartingCsv != "" {
+			spec["startingCSV"] = operator.StartingCsv
+		}
+		if operator.InstallPlanApproval != "" {
+			spec["installPlanApproval"] = string(operator.InstallPlanApproval)
+		}
+		if catalogSource != nil {
+			spec["source"] = catalogSource.Name
+			spec["sourceNamespace"] = catalogSource.Namespace
+		}
+		content, err = yaml.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		openshiftManifests[name] = content
+		patched = true
+	}
+	if !patched {
+		return nil, errors.Errorf("operator %s doesn't have a subscription", operator.Name)
+	}
+
+	if catalogSource == nil || catalogSource.Image == "" {
+		return nil, nil
+	}
+	return catalogSource, nil
+}
+
+// generateCatalogSourceManifests generates the manifests that create the catalog sources used by the operators.
+func generateCatalogSourceManifests(catalogSources map[string]*models.OperatorCatalogSource) (map[string][]byte, error) {
+	manifests := make(map[string][]byte, len(catalogSources))
+	for _, catalogSource := range catalogSources {
+		displayName := catalogSource.DisplayName
+		if displayName == "" {
+			displayName = catalogSource.Name
+		}
+		content, err := yaml.Marshal(map[string]interface{}{
+			"apiVersion": "operators.coreos.com/v1alpha1",
+			"kind":       "CatalogSource",
+			"metadata": map[string]interface{}{
+				"name":      catalogSource.Name,
+				"namespace": catalogSource.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"sourceType":  "grpc",
+				"image":       catalogSource.Image,
+				"displayName": displayName,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		manifests[fmt.Sprintf("50_%s_catalogsource.yaml", strings.ToLower(catalogSource.Name))] = content
+	}
+	return manifests, nil
+}
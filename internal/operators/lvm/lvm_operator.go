@@ -6,6 +6,7 @@ import (
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/models"
@@ -20,8 +21,9 @@ const (
 
 // operator is an ODF LVM OLM operator plugin; it implements api.Operator
 type operator struct {
-	log    logrus.FieldLogger
-	Config *Config
+	log        logrus.FieldLogger
+	Config     *Config
+	diskHealth smart.Thresholds
 }
 
 const defaultStorageClassName = "lvms-" + defaultDeviceName
@@ -35,20 +37,21 @@ var Operator = models.MonitoredOperator{
 }
 
 // NewLvmOperator creates new LvmOperator
-func NewLvmOperator(log logrus.FieldLogger) *operator {
+func NewLvmOperator(log logrus.FieldLogger, diskHealth smart.Thresholds) *operator {
 	cfg := Config{}
 	err := envconfig.Process(common.EnvConfigPrefix, &cfg)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	return newLvmOperatorWithConfig(log, &cfg)
+	return newLvmOperatorWithConfig(log, &cfg, diskHealth)
 }
 
 // newOdfOperatorWithConfig creates new ODFOperator with given configuration
-func newLvmOperatorWithConfig(log logrus.FieldLogger, config *Config) *operator {
+func newLvmOperatorWithConfig(log logrus.FieldLogger, config *Config, diskHealth smart.Thresholds) *operator {
 	return &operator{
-		log:    log,
-		Config: config,
+		log:        log,
+		Config:     config,
+		diskHealth: diskHealth,
 	}
 }
 
@@ -141,6 +144,11 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 		if diskCount == 0 {
 			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{message}}, nil
 		}
+		// Logical Volume Manager consumes all the non-installation disks of the host, so none of them may be failing.
+		if reasons := operatorscommon.UnhealthyNonInstallationDiskReasons(inventory.Disks, host.InstallationDiskID, o.diskHealth); len(reasons) > 0 {
+			reasons = append(reasons, "Logical Volume Manager would consume these disks, replace them or remove them from the host.")
+			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: reasons}, nil
+		}
 	} else {
 		if role == models.HostRoleAutoAssign {
 			status := "For Logical Volume Manager Standard Mode, host role must be assigned to master or worker."
@@ -230,6 +238,11 @@ func (o *operator) GetPreflightRequirements(context context.Context, cluster *co
 	}, nil
 }
 
+// GetChannels returns the channel of the OpenShift version of the cluster, LVM storage is released together with OpenShift
+func (o *operator) GetChannels(cluster *common.Cluster) []string {
+	return operatorscommon.StableChannels(cluster.OpenshiftVersion)
+}
+
 func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
 	return models.FeatureSupportLevelIDLVM
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common/testing"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
@@ -48,7 +49,7 @@ var _ = Describe("Lvm Operator", func() {
 		diskID1 = "/dev/disk/by-id/test-disk-1"
 		diskID2 = "/dev/disk/by-id/test-disk-2"
 
-		operator         = NewLvmOperator(common.GetTestLog())
+		operator         = NewLvmOperator(common.GetTestLog(), smart.Thresholds{CheckOverallHealth: true, MaxReallocatedSectors: 50, MaxMediaErrors: 0, MaxPercentageUsed: 95})
 		lvmMemMB         = conversions.MibToBytes(operator.Config.LvmMemoryPerHostMiB)
 		lvmMemMB_pre4_13 = conversions.MibToBytes(operator.Config.LvmMemoryPerHostMiBBefore4_13)
 
@@ -534,5 +535,32 @@ var _ = Describe("Lvm Operator", func() {
 			})
 		}
 
+		It("ValidateHost , unhealthy disk", func() {
+			testHost := templateHost
+			testHost.Role = models.HostRoleWorker
+			testHost.Inventory = Inventory(&InventoryResources{
+				Cpus: 3,
+				Ram:  int64(8*conversions.GiB + float32(lvmMemMB)),
+				Disks: []*models.Disk{
+					{ID: diskID1, Name: "sda", DriveType: models.DriveTypeHDD, SizeBytes: 20 * conversions.GB},
+					{ID: "/dev/disk/by-id/test-disk-2", Name: "sdb", DriveType: models.DriveTypeHDD, SizeBytes: 20 * conversions.GB,
+						Smart: `{"smart_status":{"passed":false}}`},
+				},
+			})
+			cluster := &common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion:  "4.15.0",
+				Hosts:             []*models.Host{masterNode, masterNode, masterNode, testHost},
+				ControlPlaneCount: common.MinMasterHostsNeededForInstallationInHaMode,
+			}}
+
+			res, _ := operator.ValidateHost(ctx, cluster, testHost, nil)
+
+			Expect(res.Status).Should(Equal(api.Failure))
+			Expect(res.Reasons).Should(Equal([]string{
+				"Disk sdb is unhealthy: the SMART overall-health self-assessment test failed",
+				"Logical Volume Manager would consume these disks, replace them or remove them from the host.",
+			}))
+		})
+
 	})
 })
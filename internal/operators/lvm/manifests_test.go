@@ -4,12 +4,13 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/models"
 	"sigs.k8s.io/yaml"
 )
 
 var _ = Describe("LVM manifest generation", func() {
-	operator := NewLvmOperator(common.GetTestLog())
+	operator := NewLvmOperator(common.GetTestLog(), smart.Thresholds{})
 	var cluster *common.Cluster
 
 	getCluster := func(openshiftVersion string) *common.Cluster {
@@ -49,6 +49,7 @@ type Manager struct {
 	monitoredOperators map[string]*models.MonitoredOperator
 	manifestsAPI       manifestsapi.ManifestsAPI
 	objectHandler      s3wrapper.API
+	catalogSources     []*models.OperatorCatalogSource
 }
 
 // API defines Operator management operation
@@ -86,6 +87,8 @@ type API interface {
 	ListBundles() []*models.Bundle
 	// GetBundle returns the Bundle object
 	GetBundle(bundleID string) (*models.Bundle, error)
+	// GetOperatorInstallOptions returns the choices available to install an operator on an OpenShift version
+	GetOperatorInstallOptions(operatorName, openshiftVersion string) (*models.OperatorInstallOptions, error)
 }
 
 // GetPreflightRequirementsBreakdownForCluster provides host requirements breakdown for each supported OLM operator
@@ -157,6 +160,7 @@ func hasMCEAndStorage(operators []*models.MonitoredOperator) bool {
 // Returns map assigning manifest content to its desired file name
 func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Cluster) error {
 	var controllerManifests []Manifest
+	catalogSources := make(map[string]*models.OperatorCatalogSource)
 	// Generate manifests for all the generic operators
 	for _, clusterOperator := range cluster.MonitoredOperators {
 		if clusterOperator.OperatorType != models.OperatorTypeOlm {
@@ -170,6 +174,14 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 				mgr.log.Error(fmt.Sprintf("Cannot generate %s manifests due to ", clusterOperator.Name), err)
 				return err
 			}
+			catalogSource, err := mgr.applyInstallOptions(clusterOperator, openshiftManifests)
+			if err != nil {
+				mgr.log.WithError(err).Errorf("Cannot apply the install options of operator %s", clusterOperator.Name)
+				return err
+			}
+			if catalogSource != nil {
+				catalogSources[catalogSource.Name] = catalogSource
+			}
 			for k, v := range openshiftManifests {
 				err = mgr.createInstallManifests(ctx, cluster, k, v, models.ManifestFolderOpenshift)
 				if err != nil {
@@ -181,6 +193,17 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 		}
 	}
 
+	catalogSourceManifests, err := generateCatalogSourceManifests(catalogSources)
+	if err != nil {
+		return err
+	}
+	for k, v := range catalogSourceManifests {
+		err = mgr.createInstallManifests(ctx, cluster, k, v, models.ManifestFolderOpenshift)
+		if err != nil {
+			return err
+		}
+	}
+
 	if hasMCEAndStorage(cluster.Cluster.MonitoredOperators) {
 		storageOperator, err := mgr.getStorageOperator(&cluster.Cluster)
 		if err != nil {
@@ -289,7 +312,7 @@ func (mgr *Manager) ValidateHost(ctx context.Context, cluster *common.Cluster, h
 		}
 		results = append(results, result)
 	}
-	return results, nil
+	return mergeValidationResults(results), nil
 }
 
 // ValidateCluster validates cluster requirements
@@ -330,7 +353,38 @@ func (mgr *Manager) ValidateCluster(ctx context.Context, cluster *common.Cluster
 			results = append(results, result)
 		}
 	}
-	return results, nil
+	return mergeValidationResults(results), nil
+}
+
+// mergeValidationResults combines the results that have the same validation identifier, as is the case for the
+// operator plugins, into a single result. The combined status is the most severe one, and the reasons are those of
+// the results that have that status.
+func mergeValidationResults(results []api.ValidationResult) []api.ValidationResult {
+	merged := make([]api.ValidationResult, 0, len(results))
+	indexes := map[string]int{}
+	for _, result := range results {
+		index, ok := indexes[result.ValidationId]
+		if !ok {
+			indexes[result.ValidationId] = len(merged)
+			merged = append(merged, result)
+			continue
+		}
+		current := &merged[index]
+		switch {
+		case validationStatusSeverity[result.Status] > validationStatusSeverity[current.Status]:
+			current.Status = result.Status
+			current.Reasons = result.Reasons
+		case result.Status == current.Status:
+			current.Reasons = append(append([]string{}, current.Reasons...), result.Reasons...)
+		}
+	}
+	return merged
+}
+
+var validationStatusSeverity = map[api.ValidationStatus]int{
+	api.Success: 0,
+	api.Pending: 1,
+	api.Failure: 2,
 }
 
 // GetSupportedOperators returns a list of OLM operators that are supported
@@ -481,6 +535,11 @@ func (mgr *Manager) GetSupportedOperatorsByType(operatorType models.OperatorType
 
 func isOperatorCompatibleWithArchitecture(cluster *common.Cluster, cpuArchitecture string, operator api.Operator) bool {
 	featureId := operator.GetFeatureSupportID()
+	if featureId == "" {
+		// Operators that aren't part of the feature support levels, like the plugins, check the architecture in
+		// their cluster validation.
+		return true
+	}
 	return featuresupport.IsFeatureCompatibleWithArchitecture(featureId, cluster.OpenshiftVersion, cpuArchitecture)
 }
 
@@ -554,6 +613,11 @@ func (mgr *Manager) EnsureOperatorPrerequisite(cluster *common.Cluster, openshif
 		return err
 	}
 
+	err = mgr.ValidateOperatorInstallOptions(openshiftVersion, cpuArchitecture, operators)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
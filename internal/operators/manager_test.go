@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/golang/mock/gomock"
@@ -15,6 +18,7 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
@@ -763,6 +767,103 @@ var _ = Describe("Operators manager", func() {
 		})
 	})
 
+	Context("Operator plugins", func() {
+		var pluginsDir string
+
+		BeforeEach(func() {
+			var err error
+			pluginsDir, err = os.MkdirTemp("", "plugins-*")
+			Expect(err).ToNot(HaveOccurred())
+			for name, content := range map[string]string{
+				"first.yaml": `
+					name: first
+					full_name: First
+					namespace: first-ns
+					subscription:
+					  name: first-package
+					  channel: stable
+					  source: redhat-operators
+					min_openshift_version: "4.16"
+				`,
+				"second.yaml": `
+					name: second
+					full_name: Second
+					namespace: second-ns
+					subscription:
+					  name: second-package
+					  channel: stable
+					  source: redhat-operators
+					dependencies:
+					- first
+				`,
+			} {
+				err = os.WriteFile(filepath.Join(pluginsDir, name), []byte(dedent(content)), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			manager = operators.NewManager(log, manifestsAPI, operators.Options{PluginsDir: pluginsDir}, mockS3Api)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(pluginsDir)).To(Succeed())
+		})
+
+		It("should be supported together with the built-in operators", func() {
+			supportedOperators := manager.GetSupportedOperators()
+			Expect(supportedOperators).To(ContainElements("first", "second", "odf"))
+
+			operator, err := manager.GetOperatorByName("second")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operator.Namespace).To(Equal("second-ns"))
+			Expect(operator.SubscriptionName).To(Equal("second-package"))
+			Expect(operator.OperatorType).To(Equal(models.OperatorTypeOlm))
+		})
+
+		It("should resolve the dependencies of a plugin", func() {
+			operator, err := manager.GetOperatorByName("second")
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := manager.ResolveDependencies(cluster, []*models.MonitoredOperator{operator})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(2))
+			Expect(result[1].Name).To(Equal("first"))
+			Expect(result[1].DependencyOnly).To(BeTrue())
+		})
+
+		It("should merge the cluster validation results of the plugins", func() {
+			operator, err := manager.GetOperatorByName("first")
+			Expect(err).ToNot(HaveOccurred())
+			cluster.MonitoredOperators = []*models.MonitoredOperator{operator}
+
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(23))
+			Expect(results).To(ContainElement(api.ValidationResult{
+				Status:       api.Failure,
+				ValidationId: string(models.ClusterValidationIDPluginOperatorsRequirementsSatisfied),
+				Reasons:      []string{"First is only supported for openshift versions 4.16 and above"},
+			}))
+		})
+
+		It("should merge the host validation results of the plugins", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{}
+
+			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(23))
+			var pluginsResult *api.ValidationResult
+			for i := range results {
+				if results[i].ValidationId == string(models.HostValidationIDPluginOperatorsRequirementsSatisfied) {
+					pluginsResult = &results[i]
+				}
+			}
+			Expect(pluginsResult).ToNot(BeNil())
+			Expect(pluginsResult.Status).To(Equal(api.Success))
+			Expect(pluginsResult.Reasons).To(ConsistOf("first is disabled", "second is disabled"))
+		})
+	})
+
 	Context("Host requirements", func() {
 		const (
 			operatorName1 = "operator-1"
@@ -857,6 +958,211 @@ var _ = Describe("Operators manager", func() {
 		})
 	})
 
+	Context("Install options", func() {
+		const mirrorImage = "registry.example.com/mirror/redhat-operator-index:v4.16"
+
+		BeforeEach(func() {
+			manager = operators.NewManager(log, manifestsAPI, operators.Options{
+				CatalogSources: `[{"name": "mirrored-operators", "image": "` + mirrorImage + `"}]`,
+			}, mockS3Api)
+		})
+
+		pinned := func(operator models.MonitoredOperator, update func(*models.MonitoredOperator)) *models.MonitoredOperator {
+			update(&operator)
+			return &operator
+		}
+
+		// generateManifests generates the manifests of the cluster and returns them indexed by file name.
+		generateManifests := func() map[string]map[string]interface{} {
+			manifests := map[string]map[string]interface{}{}
+			mockS3Api.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			manifestsAPI.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any(), false).DoAndReturn(
+				func(_ context.Context, params operations.V2CreateClusterManifestParams, _ bool) (*models.Manifest, error) {
+					content, err := base64.StdEncoding.DecodeString(*params.CreateManifestParams.Content)
+					Expect(err).ToNot(HaveOccurred())
+					var object map[string]interface{}
+					Expect(yaml.Unmarshal(content, &object)).To(Succeed())
+					manifests[*params.CreateManifestParams.FileName] = object
+					return &models.Manifest{}, nil
+				}).AnyTimes()
+			Expect(manager.GenerateManifests(ctx, cluster)).To(Succeed())
+			return manifests
+		}
+
+		It("should be provided for all the supported operators", func() {
+			for _, name := range manager.GetSupportedOperators() {
+				options, err := manager.GetOperatorInstallOptions(name, "4.16")
+				Expect(err).ToNot(HaveOccurred(), name)
+				Expect(options.OperatorName).To(Equal(name))
+				Expect(options.DefaultCatalogSource).ToNot(BeEmpty(), name)
+			}
+		})
+
+		It("should describe the defaults and the choices", func() {
+			options, err := manager.GetOperatorInstallOptions(nodehealthcheck.Operator.Name, "4.16")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.OpenshiftVersion).To(Equal("4.16"))
+			Expect(options.DefaultChannel).To(Equal("stable"))
+			Expect(options.Channels).To(ConsistOf("stable"))
+			Expect(options.DefaultCatalogSource).To(Equal("redhat-operators"))
+			Expect(options.InstallPlanApprovals).To(ConsistOf(models.OperatorInstallPlanApprovalAutomatic))
+			Expect(options.SupportLevel).To(Equal(models.SupportLevelTechPreview))
+			var names []string
+			for _, catalogSource := range options.CatalogSources {
+				names = append(names, catalogSource.Name)
+			}
+			Expect(names).To(ContainElements("redhat-operators", "mirrored-operators"))
+			Expect(options.CatalogSources[len(options.CatalogSources)-1]).To(Equal(&models.OperatorCatalogSource{
+				Name:      "mirrored-operators",
+				Namespace: "openshift-marketplace",
+				Image:     mirrorImage,
+			}))
+		})
+
+		It("should list the channel of the OpenShift version for the storage operators", func() {
+			options, err := manager.GetOperatorInstallOptions(lvm.Operator.Name, "4.16")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.DefaultChannel).To(BeEmpty())
+			Expect(options.Channels).To(ConsistOf("stable-4.16"))
+			options, err = manager.GetOperatorInstallOptions(odf.Operator.Name, "4.15")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.Channels).To(ConsistOf("stable-4.15"))
+		})
+
+		It("should be unavailable when the operator isn't available for the OpenShift version", func() {
+			options, err := manager.GetOperatorInstallOptions(lvm.Operator.Name, "4.10")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.SupportLevel).To(Equal(models.SupportLevelUnavailable))
+		})
+
+		It("should fail for an unknown operator or an invalid OpenShift version", func() {
+			_, err := manager.GetOperatorInstallOptions("unknown", "4.16")
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+			_, err = manager.GetOperatorInstallOptions(lvm.Operator.Name, "latest")
+			Expect(err).To(MatchError(ContainSubstring("invalid OpenShift version")))
+		})
+
+		DescribeTable("should validate the selected options",
+			func(update func(*models.MonitoredOperator), expectedError string) {
+				err := manager.ValidateOperatorInstallOptions("4.16", common.X86CPUArchitecture, []*models.MonitoredOperator{
+					&lso.Operator,
+					pinned(lvm.Operator, update),
+				})
+				if expectedError == "" {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(ContainSubstring(expectedError)))
+				}
+			},
+			Entry("without options", func(*models.MonitoredOperator) {}, ""),
+			Entry("with a channel and a version", func(operator *models.MonitoredOperator) {
+				operator.Channel = "stable-4.16"
+				operator.StartingCsv = "lvms-operator.v4.16.3"
+				operator.InstallPlanApproval = models.OperatorInstallPlanApprovalAutomatic
+			}, ""),
+			Entry("with a channel of another OpenShift version", func(operator *models.MonitoredOperator) {
+				operator.Channel = "stable-4.15"
+			}, "channel 'stable-4.15' of operator lvm isn't available for OpenShift 4.16"),
+			Entry("with a channel of another catalog source", func(operator *models.MonitoredOperator) {
+				operator.Channel = "candidate"
+				operator.CatalogSource = "mirrored-operators"
+			}, ""),
+			Entry("with the version of another package", func(operator *models.MonitoredOperator) {
+				operator.StartingCsv = "odf-operator.v4.16.3"
+			}, "must be in the lvms-operator.v<version> form"),
+			Entry("with an invalid version", func(operator *models.MonitoredOperator) {
+				operator.StartingCsv = "lvms-operator.vlatest"
+			}, "doesn't have a valid version"),
+			Entry("with the manual install plan approval", func(operator *models.MonitoredOperator) {
+				operator.InstallPlanApproval = "Manual"
+			}, "invalid install plan approval 'Manual' for operator lvm"),
+			Entry("with a default catalog source", func(operator *models.MonitoredOperator) {
+				operator.CatalogSource = "certified-operators"
+			}, ""),
+			Entry("with a configured catalog source", func(operator *models.MonitoredOperator) {
+				operator.CatalogSource = "mirrored-operators"
+			}, ""),
+			Entry("with the image of a new catalog source", func(operator *models.MonitoredOperator) {
+				operator.CatalogSource = "my-operators"
+				operator.CatalogSourceImage = "registry.example.com/my-operators:latest"
+			}, ""),
+			Entry("with an unknown catalog source", func(operator *models.MonitoredOperator) {
+				operator.CatalogSource = "my-operators"
+			}, "catalog source 'my-operators' of operator lvm doesn't exist"),
+			Entry("with a different image for a configured catalog source", func(operator *models.MonitoredOperator) {
+				operator.CatalogSource = "mirrored-operators"
+				operator.CatalogSourceImage = "registry.example.com/other:latest"
+			}, "already exists with a different image"),
+			Entry("with an invalid install plan approval", func(operator *models.MonitoredOperator) {
+				operator.InstallPlanApproval = "Sometimes"
+			}, "invalid install plan approval 'Sometimes'"),
+		)
+
+		It("should reject options for operators that aren't installed with a subscription", func() {
+			err := manager.ValidateOperatorInstallOptions("4.16", common.X86CPUArchitecture, []*models.MonitoredOperator{
+				pinned(operators.OperatorConsole, func(operator *models.MonitoredOperator) {
+					operator.Channel = "stable"
+				}),
+			})
+			Expect(err).To(MatchError(ContainSubstring("isn't installed with a subscription")))
+		})
+
+		It("should keep the subscriptions of the operators without options", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&nodehealthcheck.Operator}
+			manifests := generateManifests()
+			spec := manifests["50_node_healthcheck_subscription.yaml"]["spec"]
+			Expect(spec).To(HaveKeyWithValue("channel", "stable"))
+			Expect(spec).To(HaveKeyWithValue("source", "redhat-operators"))
+			Expect(spec).ToNot(HaveKey("startingCSV"))
+		})
+
+		It("should replace the options in the subscription", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				pinned(nodehealthcheck.Operator, func(operator *models.MonitoredOperator) {
+					operator.Channel = "4.16-eus"
+					operator.StartingCsv = "node-healthcheck-operator.v0.8.0"
+					operator.CatalogSource = "mirrored-operators"
+					operator.InstallPlanApproval = models.OperatorInstallPlanApprovalAutomatic
+				}),
+			}
+			manifests := generateManifests()
+			Expect(manifests["50_node_healthcheck_subscription.yaml"]["spec"]).To(Equal(map[string]interface{}{
+				"name":                "node-healthcheck-operator",
+				"channel":             "4.16-eus",
+				"startingCSV":         "node-healthcheck-operator.v0.8.0",
+				"installPlanApproval": "Automatic",
+				"source":              "mirrored-operators",
+				"sourceNamespace":     "openshift-marketplace",
+			}))
+			catalogSource := manifests["50_mirrored-operators_catalogsource.yaml"]
+			Expect(catalogSource).To(HaveKeyWithValue("kind", "CatalogSource"))
+			Expect(catalogSource["spec"]).To(HaveKeyWithValue("image", mirrorImage))
+			Expect(catalogSource["spec"]).To(HaveKeyWithValue("sourceType", "grpc"))
+		})
+
+		It("should create a single catalog source from the image selected for the operators", func() {
+			image := "registry.example.com/my-operators:latest"
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				pinned(nodehealthcheck.Operator, func(operator *models.MonitoredOperator) {
+					operator.CatalogSourceImage = image
+				}),
+				pinned(selfnoderemediation.Operator, func(operator *models.MonitoredOperator) {
+					operator.CatalogSource = "self-node-remediation-catalog"
+					operator.CatalogSourceImage = image
+				}),
+			}
+			manifests := generateManifests()
+			Expect(manifests["50_node_healthcheck_subscription.yaml"]["spec"]).To(
+				HaveKeyWithValue("source", "node-healthcheck-catalog"))
+			Expect(manifests["50_self_node_remediation_subscription.yaml"]["spec"]).To(
+				HaveKeyWithValue("source", "self-node-remediation-catalog"))
+			Expect(manifests).To(HaveKey("50_node-healthcheck-catalog_catalogsource.yaml"))
+			Expect(manifests).To(HaveKey("50_self-node-remediation-catalog_catalogsource.yaml"))
+			Expect(manifests["50_node-healthcheck-catalog_catalogsource.yaml"]["metadata"]).To(
+				HaveKeyWithValue("namespace", "openshift-marketplace"))
+		})
+	})
+
 	Context("Bundles", func() {
 		// we use the real operators here, as we want to test the manager's ability to group them into bundles
 		var (
@@ -868,7 +1174,7 @@ var _ = Describe("Operators manager", func() {
 			cfg := cnv.Config{}
 			cnvOperator = cnv.NewCNVOperator(log, cfg)
 			// note that odf belongs to both Virtualization and Openshift AI bundles
-			odfOperator = odf.NewOdfOperator(log)
+			odfOperator = odf.NewOdfOperator(log, smart.Thresholds{})
 			oaiOperator = openshiftai.NewOpenShiftAIOperator(log)
 			nmstateOperator = nmstate.NewNmstateOperator(log)
 			serverlessOperator = serverless.NewServerLessOperator(log)
@@ -984,3 +1290,12 @@ func getMockHostWithDisks(sizeDiskA, sizeDiskB int64) *models.Host {
 	Expect(err).To(Not(HaveOccurred()))
 	return &models.Host{ID: &hostID, Inventory: b, Role: models.HostRoleMaster, InstallationDiskID: common.TestDiskId}
 }
+
+// dedent removes the tabs used to indent the YAML documents embedded in the tests.
+func dedent(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimLeft(line, "\t")
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -123,6 +123,21 @@ func (mr *MockAPIMockRecorder) GetOperatorByName(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperatorByName", reflect.TypeOf((*MockAPI)(nil).GetOperatorByName), arg0)
 }
 
+// GetOperatorInstallOptions mocks base method.
+func (m *MockAPI) GetOperatorInstallOptions(arg0, arg1 string) (*models.OperatorInstallOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperatorInstallOptions", arg0, arg1)
+	ret0, _ := ret[0].(*models.OperatorInstallOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperatorInstallOptions indicates an expected call of GetOperatorInstallOptions.
+func (mr *MockAPIMockRecorder) GetOperatorInstallOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperatorInstallOptions", reflect.TypeOf((*MockAPI)(nil).GetOperatorInstallOptions), arg0, arg1)
+}
+
 // GetOperatorProperties mocks base method.
 func (m *MockAPI) GetOperatorProperties(arg0 string) (models.OperatorProperties, error) {
 	m.ctrl.T.Helper()
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
 	"sigs.k8s.io/yaml"
@@ -20,7 +21,7 @@ var _ = Describe("OCS manifest generation", func() {
 		} `yaml:"spec"`
 	}
 
-	operator := NewOdfOperator(common.GetTestLog())
+	operator := NewOdfOperator(common.GetTestLog(), smart.Thresholds{})
 
 	Context("Create OCS Manifests for all deployment modes with openshiftVersion as 4.8.X", func() {
 		It("Check YAMLs of OCS in Compact Mode", func() {
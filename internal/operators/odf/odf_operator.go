@@ -9,6 +9,7 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	"github.com/lib/pq"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/internal/operators/lso"
@@ -36,8 +37,9 @@ func NewOcsOperator(log logrus.FieldLogger) *operator {
 
 // operator is an ODF OLM operator plugin; it implements api.Operator
 type operator struct {
-	log    logrus.FieldLogger
-	config *Config
+	log        logrus.FieldLogger
+	config     *Config
+	diskHealth smart.Thresholds
 }
 
 var Operator = models.MonitoredOperator{
@@ -52,20 +54,21 @@ var Operator = models.MonitoredOperator{
 }
 
 // NewOdfOperator creates new ODFOperator
-func NewOdfOperator(log logrus.FieldLogger) *operator {
+func NewOdfOperator(log logrus.FieldLogger, diskHealth smart.Thresholds) *operator {
 	cfg := Config{}
 	err := envconfig.Process(common.EnvConfigPrefix, &cfg)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	return newOdfOperatorWithConfig(log, &cfg)
+	return newOdfOperatorWithConfig(log, &cfg, diskHealth)
 }
 
 // newOdfOperatorWithConfig creates new ODFOperator with given configuration
-func newOdfOperatorWithConfig(log logrus.FieldLogger, config *Config) *operator {
+func newOdfOperatorWithConfig(log logrus.FieldLogger, config *Config, diskHealth smart.Thresholds) *operator {
 	return &operator{
-		log:    log,
-		config: config,
+		log:        log,
+		config:     config,
+		diskHealth: diskHealth,
 	}
 }
 
@@ -175,6 +178,15 @@ func (o *operator) ValidateHost(_ context.Context, cluster *common.Cluster, host
 		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{message}}, err
 	}
 
+	// ODF consumes all the non-installation disks of the host, so none of them may be failing.
+	if reasons := operatorscommon.UnhealthyNonInstallationDiskReasons(inventory.Disks, host.InstallationDiskID, o.diskHealth); len(reasons) > 0 {
+		return api.ValidationResult{
+			Status:       api.Failure,
+			ValidationId: o.GetHostValidationID(),
+			Reasons:      append(reasons, "ODF would consume these disks, replace them or remove them from the host."),
+		}, nil
+	}
+
 	// getValidDiskCount counts the total number of valid disks in each host and return a error if we don't have the disk of required size.
 	diskCount, err := o.getValidDiskCount(inventory.Disks, host.InstallationDiskID, additionalOperatorRequirements, mode)
 	if err != nil {
@@ -320,6 +332,11 @@ func (o *operator) GetPreflightRequirements(context context.Context, cluster *co
 	}, nil
 }
 
+// GetChannels returns the channel of the OpenShift version of the cluster, ODF is released together with OpenShift
+func (o *operator) GetChannels(cluster *common.Cluster) []string {
+	return operatorscommon.StableChannels(cluster.OpenshiftVersion)
+}
+
 func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
 	return models.FeatureSupportLevelIDODF
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/smart"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
@@ -31,7 +32,7 @@ func getHostID() *strfmt.UUID {
 var _ = Describe("Odf Operator", func() {
 	var (
 		ctx                 = context.TODO()
-		operator            = NewOdfOperator(common.GetTestLog())
+		operator            = NewOdfOperator(common.GetTestLog(), smart.Thresholds{CheckOverallHealth: true, MaxReallocatedSectors: 50, MaxMediaErrors: 0, MaxPercentageUsed: 95})
 		masterWithThreeDisk = &models.Host{ID: getHostID(), Role: models.HostRoleMaster, InstallationDiskID: diskID1,
 			Inventory: Inventory(&InventoryResources{Cpus: 12, Ram: 32 * conversions.GiB,
 				Disks: []*models.Disk{
@@ -46,6 +47,13 @@ var _ = Describe("Odf Operator", func() {
 					{SizeBytes: 40 * conversions.GB, DriveType: models.DriveTypeSSD, ID: diskID2},
 					{SizeBytes: 0 * conversions.GB, DriveType: models.DriveTypeSSD, ID: diskID3},
 				}})}
+		masterWithUnhealthyDisk = &models.Host{ID: getHostID(), Role: models.HostRoleMaster, InstallationDiskID: diskID1,
+			Inventory: Inventory(&InventoryResources{Cpus: 12, Ram: 32 * conversions.GiB,
+				Disks: []*models.Disk{
+					{SizeBytes: 20 * conversions.GB, DriveType: models.DriveTypeHDD, ID: diskID1, Name: "sda"},
+					{SizeBytes: 40 * conversions.GB, DriveType: models.DriveTypeSSD, ID: diskID2, Name: "sdb",
+						Smart: `{"smart_status":{"passed":true},"nvme_smart_health_information_log":{"media_errors":12}}`},
+				}})}
 		masterWithNoDisk           = &models.Host{ID: getHostID(), Role: models.HostRoleMaster, Inventory: Inventory(&InventoryResources{Cpus: 12, Ram: 32 * conversions.GiB})}
 		masterWithNoInventory      = &models.Host{ID: getHostID(), Role: models.HostRoleMaster}
 		masterWithInvalidInventory = &models.Host{ID: getHostID(), Role: models.HostRoleMaster, Inventory: "invalid"}
@@ -572,6 +580,21 @@ var _ = Describe("Odf Operator", func() {
 				},
 			),
 
+			table.Entry("there is a master with an unhealthy disk",
+				&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Hosts: []*models.Host{
+					masterWithThreeDisk, masterWithLessDiskSize, masterWithUnhealthyDisk,
+				}}},
+				masterWithUnhealthyDisk,
+				api.ValidationResult{
+					Status:       api.Failure,
+					ValidationId: operator.GetHostValidationID(),
+					Reasons: []string{
+						"Disk sdb is unhealthy: 12 media errors were reported, the maximum is 0",
+						"ODF would consume these disks, replace them or remove them from the host.",
+					},
+				},
+			),
+
 			table.Entry("there is a master with missing inventory",
 				&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Hosts: []*models.Host{
 					masterWithThreeDisk, masterWithLessDiskSize, masterWithNoInventory,
@@ -7,6 +7,7 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/hardware/firmware"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
@@ -112,6 +113,15 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
 	}
 
+	// The sandboxed containers run in lightweight virtual machines, so they need the virtualization extensions
+	report, err := firmware.Parse(host.Firmware)
+	if err != nil {
+		o.log.WithError(err).Warnf("Failed to get the firmware report of host with id %s", host.ID)
+	}
+	if firmware.IsVirtualizationDisabled(report) {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{firmware.VirtualizationDisabledReason}}, nil
+	}
+
 	requirements, err := o.GetHostRequirements(ctx, cluster, host)
 	if err != nil {
 		message := fmt.Sprintf("Failed to get host requirements for host with id %s", host.ID)
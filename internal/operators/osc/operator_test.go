@@ -129,6 +129,23 @@ var _ = Describe("OSC Operator", func() {
 			Expect(result.Status).To(Equal(api.Pending))
 		})
 
+		It("worker host should be fail - virtualization disabled in the firmware", func() {
+			host := models.Host{Role: models.HostRoleWorker, Inventory: getInventory(int64(1024)), Firmware: `{"virtualization_enabled":false}`}
+
+			result, err := operator.ValidateHost(context.TODO(), &cluster, &host, nil)
+			Expect(err).To(BeNil())
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf(ContainSubstring("Enable them in the BIOS/UEFI settings of the host")))
+		})
+
+		It("worker host should be valid - virtualization enabled in the firmware", func() {
+			host := models.Host{Role: models.HostRoleWorker, Inventory: getInventory(int64(1024)), Firmware: `{"virtualization_enabled":true}`}
+
+			result, err := operator.ValidateHost(context.TODO(), &cluster, &host, nil)
+			Expect(err).To(BeNil())
+			Expect(result.Status).To(Equal(api.Success))
+		})
+
 	})
 })
 
@@ -0,0 +1,227 @@
+package plugin
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	defaultSourceNamespace = "openshift-marketplace"
+	defaultTimeoutSeconds  = 30 * 60
+)
+
+var nameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+var supportedCPUArchitectures = []string{
+	common.X86CPUArchitecture,
+	common.ARM64CPUArchitecture,
+	common.PowerCPUArchitecture,
+	common.S390xCPUArchitecture,
+}
+
+// Descriptor is the declarative description of an OLM operator that isn't built into the service. Descriptors are
+// loaded from YAML or JSON files when the service starts.
+type Descriptor struct {
+	// Name is the short name of the operator, used in the API to enable it. It must be a valid DNS label.
+	Name string `json:"name"`
+
+	// FullName is the human readable name of the operator. Defaults to the name.
+	FullName string `json:"full_name,omitempty"`
+
+	// Namespace where the operator will be installed.
+	Namespace string `json:"namespace"`
+
+	// Subscription describes the OLM subscription used to install the operator.
+	Subscription Subscription `json:"subscription"`
+
+	// TimeoutSeconds is the time that the installer will wait for the operator to be available. Defaults to 30
+	// minutes.
+	TimeoutSeconds int64 `json:"timeout_seconds,omitempty"`
+
+	// Dependencies contains the names of the operators that need to be installed together with this one.
+	Dependencies []string `json:"dependencies,omitempty"`
+
+	// Bundles contains the identifiers of the bundles that the operator is part of.
+	Bundles []string `json:"bundles,omitempty"`
+
+	// MinOpenshiftVersion is the minimum OpenShift version supported by the operator.
+	MinOpenshiftVersion string `json:"min_openshift_version,omitempty"`
+
+	// CPUArchitectures contains the CPU architectures supported by the operator. Empty means all.
+	CPUArchitectures []string `json:"cpu_architectures,omitempty"`
+
+	// Requirements contains the additional hardware requirements of the operator for each host role.
+	Requirements Requirements `json:"requirements,omitempty"`
+
+	// Manifests contains the templates of the manifests that will be added to the cluster.
+	Manifests Manifests `json:"manifests,omitempty"`
+}
+
+// Subscription describes the OLM subscription of an operator.
+type Subscription struct {
+	// Name of the package in the catalog. Also used as the name of the subscription object.
+	Name string `json:"name"`
+
+	// Channel of the package.
+	Channel string `json:"channel"`
+
+	// Channels contains other channels of the package that users can select instead of the default one.
+	Channels []string `json:"channels,omitempty"`
+
+	// Source is the name of the catalog source.
+	Source string `json:"source"`
+
+	// SourceNamespace is the namespace of the catalog source. Defaults to 'openshift-marketplace'.
+	SourceNamespace string `json:"source_namespace,omitempty"`
+}
+
+// Requirements contains the additional hardware requirements of an operator for each host role.
+type Requirements struct {
+	Master *RoleRequirements `json:"master,omitempty"`
+	Worker *RoleRequirements `json:"worker,omitempty"`
+}
+
+// RoleRequirements contains the additional hardware requirements of an operator for a host role.
+type RoleRequirements struct {
+	CPUCores    int64    `json:"cpu_cores,omitempty"`
+	RAMMib      int64    `json:"ram_mib,omitempty"`
+	DiskSizeGb  int64    `json:"disk_size_gb,omitempty"`
+	Qualitative []string `json:"qualitative,omitempty"`
+}
+
+// Manifests contains the templates of the manifests of an operator.
+type Manifests struct {
+	// OpenShift manifests are added to the 'openshift' directory of the installer. The namespace, operator group
+	// and subscription manifests are generated by default, named '50_<name>_namespace.yaml',
+	// '50_<name>_operatorgroup.yaml' and '50_<name>_subscription.yaml'. A template with one of those names
+	// replaces the default one.
+	OpenShift []Manifest `json:"openshift,omitempty"`
+
+	// Custom manifests are applied by the installer controller once the cluster is available, typically to
+	// create the custom resources of the operator.
+	Custom []Manifest `json:"custom,omitempty"`
+}
+
+// Manifest is a named Go template. The template receives the monitored operator as `.Operator`, the descriptor as
+// `.Plugin` and the cluster as `.Cluster`.
+type Manifest struct {
+	Name     string `json:"name"`
+	Template string `json:"template"`
+}
+
+// setDefaults fills the optional fields that have a default value.
+func (d *Descriptor) setDefaults() {
+	if d.FullName == "" {
+		d.FullName = d.Name
+	}
+	if d.Subscription.SourceNamespace == "" {
+		d.Subscription.SourceNamespace = defaultSourceNamespace
+	}
+	if d.TimeoutSeconds == 0 {
+		d.TimeoutSeconds = defaultTimeoutSeconds
+	}
+}
+
+// validate checks that the descriptor contains all the mandatory fields and that their values are valid.
+func (d *Descriptor) validate() error {
+	if !nameRegexp.MatchString(d.Name) {
+		return fmt.Errorf("name '%s' isn't a valid DNS label", d.Name)
+	}
+	if !nameRegexp.MatchString(d.Namespace) {
+		return fmt.Errorf("namespace '%s' isn't a valid DNS label", d.Namespace)
+	}
+	if d.Subscription.Name == "" || d.Subscription.Channel == "" || d.Subscription.Source == "" {
+		return fmt.Errorf("subscription name, channel and source are mandatory")
+	}
+	if d.TimeoutSeconds < 0 {
+		return fmt.Errorf("timeout %d is negative", d.TimeoutSeconds)
+	}
+	if funk.ContainsString(d.Dependencies, d.Name) {
+		return fmt.Errorf("operator can't depend on itself")
+	}
+	for _, bundle := range d.Bundles {
+		if !isKnownBundle(bundle) {
+			return fmt.Errorf("bundle '%s' doesn't exist", bundle)
+		}
+	}
+	if d.MinOpenshiftVersion != "" {
+		if _, err := common.BaseVersionGreaterOrEqual(d.MinOpenshiftVersion, d.MinOpenshiftVersion); err != nil {
+			return fmt.Errorf("minimum OpenShift version '%s' isn't valid: %w", d.MinOpenshiftVersion, err)
+		}
+	}
+	for _, architecture := range d.CPUArchitectures {
+		if !funk.ContainsString(supportedCPUArchitectures, architecture) {
+			return fmt.Errorf("CPU architecture '%s' isn't supported, it should be one of %s", architecture,
+				strings.Join(supportedCPUArchitectures, ", "))
+		}
+	}
+	for role, requirements := range map[string]*RoleRequirements{
+		string(models.HostRoleMaster): d.Requirements.Master,
+		string(models.HostRoleWorker): d.Requirements.Worker,
+	} {
+		if requirements != nil && (requirements.CPUCores < 0 || requirements.RAMMib < 0 || requirements.DiskSizeGb < 0) {
+			return fmt.Errorf("%s requirements can't be negative", role)
+		}
+	}
+	if err := validateManifests(d.Manifests.OpenShift); err != nil {
+		return fmt.Errorf("invalid OpenShift manifests: %w", err)
+	}
+	if err := validateManifests(d.Manifests.Custom); err != nil {
+		return fmt.Errorf("invalid custom manifests: %w", err)
+	}
+	return nil
+}
+
+func validateManifests(manifests []Manifest) error {
+	names := map[string]bool{}
+	for _, manifest := range manifests {
+		if manifest.Name == "" || manifest.Name != path.Base(manifest.Name) {
+			return fmt.Errorf("manifest name '%s' isn't a valid file name", manifest.Name)
+		}
+		extension := path.Ext(manifest.Name)
+		if extension != ".yaml" && extension != ".yml" {
+			return fmt.Errorf("manifest name '%s' should have a '.yaml' or '.yml' extension", manifest.Name)
+		}
+		if names[manifest.Name] {
+			return fmt.Errorf("manifest name '%s' is duplicated", manifest.Name)
+		}
+		names[manifest.Name] = true
+		if strings.TrimSpace(manifest.Template) == "" {
+			return fmt.Errorf("manifest '%s' is empty", manifest.Name)
+		}
+	}
+	return nil
+}
+
+func isKnownBundle(id string) bool {
+	for _, bundle := range operatorscommon.Bundles {
+		if bundle.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// hostRequirements converts the requirements of a role to the representation used by the API.
+func (r *RoleRequirements) hostRequirements() *models.HostTypeHardwareRequirements {
+	if r == nil {
+		return &models.HostTypeHardwareRequirements{
+			Quantitative: &models.ClusterHostRequirementsDetails{},
+		}
+	}
+	return &models.HostTypeHardwareRequirements{
+		Qualitative: append([]string{}, r.Qualitative...),
+		Quantitative: &models.ClusterHostRequirementsDetails{
+			CPUCores:   r.CPUCores,
+			RAMMib:     r.RAMMib,
+			DiskSizeGb: r.DiskSizeGb,
+		},
+	}
+}
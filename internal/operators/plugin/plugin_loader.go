@@ -0,0 +1,82 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// LoadOperators loads the operator descriptors from the YAML or JSON files contained in the given directory, usually
+// a mounted config map, and creates the corresponding operators. Hidden files and directories are ignored, as config
+// maps mounts use them to store the actual data. An empty directory name means that there are no plugins.
+func LoadOperators(log logrus.FieldLogger, dir string) (result []api.Operator, err error) {
+	if dir == "" {
+		return
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		err = fmt.Errorf("failed to read operator plugins directory '%s': %w", dir, err)
+		return
+	}
+	names := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		switch filepath.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		file := filepath.Join(dir, entry.Name())
+		var descriptor *Descriptor
+		descriptor, err = LoadDescriptor(file)
+		if err != nil {
+			return
+		}
+		if previous, ok := names[descriptor.Name]; ok {
+			err = fmt.Errorf("operator plugin '%s' is defined in files '%s' and '%s'", descriptor.Name, previous,
+				file)
+			return
+		}
+		names[descriptor.Name] = file
+		var operator *operator
+		operator, err = newOperator(log, descriptor)
+		if err != nil {
+			err = fmt.Errorf("failed to create operator plugin from file '%s': %w", file, err)
+			return
+		}
+		log.Infof("Loaded operator plugin '%s' from file '%s'", descriptor.Name, file)
+		result = append(result, operator)
+	}
+	return
+}
+
+// LoadDescriptor reads an operator descriptor from the given YAML or JSON file, fills the default values and checks
+// that it is valid. Unknown fields are rejected in order to detect typos.
+func LoadDescriptor(file string) (result *Descriptor, err error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		err = fmt.Errorf("failed to read operator plugin file '%s': %w", file, err)
+		return
+	}
+	descriptor := &Descriptor{}
+	err = yaml.UnmarshalStrict(data, descriptor)
+	if err != nil {
+		err = fmt.Errorf("failed to parse operator plugin file '%s': %w", file, err)
+		return
+	}
+	descriptor.setDefaults()
+	err = descriptor.validate()
+	if err != nil {
+		err = fmt.Errorf("operator plugin file '%s' isn't valid: %w", file, err)
+		return
+	}
+	result = descriptor
+	return
+}
@@ -0,0 +1,165 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+const minimalDescriptor = `
+name: example
+namespace: example-ns
+subscription:
+  name: example-operator
+  channel: stable
+  source: redhat-operators
+`
+
+var _ = Describe("Loader", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "plugins-*")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	write := func(name, content string) string {
+		file := filepath.Join(dir, name)
+		Expect(os.WriteFile(file, []byte(content), 0600)).To(Succeed())
+		return file
+	}
+
+	It("Doesn't load anything when the directory isn't set", func() {
+		operators, err := LoadOperators(common.GetTestLog(), "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operators).To(BeEmpty())
+	})
+
+	It("Fails if the directory doesn't exist", func() {
+		_, err := LoadOperators(common.GetTestLog(), filepath.Join(dir, "missing"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Loads the YAML and JSON files and ignores the rest", func() {
+		write("first.yaml", minimalDescriptor)
+		write("second.json", `{
+			"name": "second",
+			"namespace": "second-ns",
+			"subscription": {
+				"name": "second-operator",
+				"channel": "stable",
+				"source": "redhat-operators"
+			}
+		}`)
+		write("README.md", "Not a descriptor")
+		write(".hidden.yaml", "Not a descriptor")
+		Expect(os.Mkdir(filepath.Join(dir, "..data"), 0700)).To(Succeed())
+
+		operators, err := LoadOperators(common.GetTestLog(), dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operators).To(HaveLen(2))
+		Expect(operators[0].GetName()).To(Equal("example"))
+		Expect(operators[1].GetName()).To(Equal("second"))
+	})
+
+	It("Fails if two files define the same operator", func() {
+		write("first.yaml", minimalDescriptor)
+		write("second.yaml", minimalDescriptor)
+
+		_, err := LoadOperators(common.GetTestLog(), dir)
+		Expect(err).To(MatchError(ContainSubstring("operator plugin 'example' is defined in files")))
+	})
+
+	It("Fills the default values", func() {
+		descriptor, err := LoadDescriptor(write("example.yaml", minimalDescriptor))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(descriptor.FullName).To(Equal("example"))
+		Expect(descriptor.Subscription.SourceNamespace).To(Equal("openshift-marketplace"))
+		Expect(descriptor.TimeoutSeconds).To(BeEquivalentTo(30 * 60))
+	})
+
+	DescribeTable("Rejects invalid descriptors",
+		func(content string, expected string) {
+			_, err := LoadDescriptor(write("invalid.yaml", content))
+			Expect(err).To(MatchError(ContainSubstring(expected)))
+		},
+		Entry("Unknown field", minimalDescriptor+"color: blue\n", "unknown field"),
+		Entry("Invalid name", `
+name: Example_Operator
+namespace: example-ns
+subscription:
+  name: example-operator
+  channel: stable
+  source: redhat-operators
+`, "name 'Example_Operator' isn't a valid DNS label"),
+		Entry("Missing namespace", `
+name: example
+subscription:
+  name: example-operator
+  channel: stable
+  source: redhat-operators
+`, "namespace '' isn't a valid DNS label"),
+		Entry("Missing channel", `
+name: example
+namespace: example-ns
+subscription:
+  name: example-operator
+  source: redhat-operators
+`, "subscription name, channel and source are mandatory"),
+		Entry("Self dependency", minimalDescriptor+"dependencies:\n- example\n",
+			"operator can't depend on itself"),
+		Entry("Unknown bundle", minimalDescriptor+"bundles:\n- games\n", "bundle 'games' doesn't exist"),
+		Entry("Invalid version", minimalDescriptor+"min_openshift_version: latest\n",
+			"minimum OpenShift version 'latest' isn't valid"),
+		Entry("Unsupported architecture", minimalDescriptor+"cpu_architectures:\n- riscv64\n",
+			"CPU architecture 'riscv64' isn't supported"),
+		Entry("Negative requirements", minimalDescriptor+"requirements:\n  worker:\n    cpu_cores: -1\n",
+			"worker requirements can't be negative"),
+		Entry("Manifest in a subdirectory", minimalDescriptor+`
+manifests:
+  openshift:
+  - name: dir/config.yaml
+    template: "kind: ConfigMap"
+`, "manifest name 'dir/config.yaml' isn't a valid file name"),
+		Entry("Manifest without extension", minimalDescriptor+`
+manifests:
+  custom:
+  - name: config
+    template: "kind: ConfigMap"
+`, "manifest name 'config' should have a '.yaml' or '.yml' extension"),
+		Entry("Duplicated manifest", minimalDescriptor+`
+manifests:
+  custom:
+  - name: config.yaml
+    template: "kind: ConfigMap"
+  - name: config.yaml
+    template: "kind: Secret"
+`, "manifest name 'config.yaml' is duplicated"),
+		Entry("Empty manifest", minimalDescriptor+`
+manifests:
+  custom:
+  - name: config.yaml
+    template: ""
+`, "manifest 'config.yaml' is empty"),
+	)
+
+	It("Fails if a template can't be parsed", func() {
+		write("example.yaml", minimalDescriptor+`
+manifests:
+  custom:
+  - name: config.yaml
+    template: "name: {{ .Operator.Name"
+`)
+		_, err := LoadOperators(common.GetTestLog(), dir)
+		Expect(err).To(MatchError(ContainSubstring("failed to parse template of manifest 'config.yaml'")))
+	})
+})
@@ -0,0 +1,109 @@
+package plugin
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/templating"
+	"github.com/openshift/assisted-service/models"
+)
+
+// defaultManifests are the names of the templates used to generate the namespace, operator group and subscription
+// of every plugin.
+var defaultManifests = []string{
+	"namespace",
+	"operatorgroup",
+	"subscription",
+}
+
+// loadTemplates loads the default templates and then adds the templates of the descriptor, using the 'openshift/'
+// and 'custom/' prefixes like the templates of the built-in operators.
+func loadTemplates(descriptor *Descriptor) (result *template.Template, err error) {
+	templates, err := templating.LoadTemplates(templatesRoot)
+	if err != nil {
+		return
+	}
+	for dir, manifests := range map[string][]Manifest{
+		"openshift": descriptor.Manifests.OpenShift,
+		"custom":    descriptor.Manifests.Custom,
+	} {
+		for _, manifest := range manifests {
+			_, err = templates.New(path.Join(dir, manifest.Name)).Parse(manifest.Template)
+			if err != nil {
+				err = fmt.Errorf("failed to parse template of manifest '%s': %w", manifest.Name, err)
+				return
+			}
+		}
+	}
+	result = templates
+	return
+}
+
+// GenerateManifests generates manifests for the operator.
+func (o *operator) GenerateManifests(cluster *common.Cluster) (openshiftManifests map[string][]byte,
+	customManifests []byte, err error) {
+	openshiftManifests = map[string][]byte{}
+	for _, name := range defaultManifests {
+		manifestName := fmt.Sprintf("50_%s_%s.yaml", o.descriptor.Name, name)
+		var manifestContent []byte
+		manifestContent, err = o.executeTemplate(path.Join("defaults", name+".yaml"), cluster)
+		if err != nil {
+			return
+		}
+		openshiftManifests[manifestName] = manifestContent
+	}
+	for _, manifest := range o.descriptor.Manifests.OpenShift {
+		var manifestContent []byte
+		manifestContent, err = o.executeTemplate(path.Join("openshift", manifest.Name), cluster)
+		if err != nil {
+			return
+		}
+		openshiftManifests[manifest.Name] = manifestContent
+	}
+
+	customManifestsBuffer := &bytes.Buffer{}
+	for _, manifest := range o.descriptor.Manifests.Custom {
+		var manifestContent []byte
+		manifestContent, err = o.executeTemplate(path.Join("custom", manifest.Name), cluster)
+		if err != nil {
+			return
+		}
+		customManifestsBuffer.WriteString("---\n")
+		customManifestsBuffer.Write(manifestContent)
+		customManifestsBuffer.WriteString("\n")
+	}
+	customManifests = customManifestsBuffer.Bytes()
+
+	return
+}
+
+func (o *operator) executeTemplate(name string, cluster *common.Cluster) (result []byte, err error) {
+	template := o.templates.Lookup(name)
+	if template == nil {
+		err = fmt.Errorf("failed to find template '%s'", name)
+		return
+	}
+	type Data struct {
+		Operator *models.MonitoredOperator
+		Plugin   *Descriptor
+		Cluster  *models.Cluster
+	}
+	data := &Data{
+		Operator: &o.monitored,
+		Plugin:   o.descriptor,
+	}
+	if cluster != nil {
+		data.Cluster = &cluster.Cluster
+	}
+	buffer := &bytes.Buffer{}
+	err = template.Execute(buffer, data)
+	if err != nil {
+		err = fmt.Errorf("failed to execute template '%s' of operator '%s': %w", name, o.descriptor.Name, err)
+		return
+	}
+	result = buffer.Bytes()
+	return
+}
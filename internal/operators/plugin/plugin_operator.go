@@ -0,0 +1,187 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"text/template"
+
+	"github.com/lib/pq"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	clusterValidationID = string(models.ClusterValidationIDPluginOperatorsRequirementsSatisfied)
+	hostValidationID    = string(models.HostValidationIDPluginOperatorsRequirementsSatisfied)
+)
+
+// operator is an OLM operator plugin created from a descriptor.
+type operator struct {
+	log        logrus.FieldLogger
+	descriptor *Descriptor
+	monitored  models.MonitoredOperator
+	templates  *template.Template
+}
+
+// newOperator creates an operator from a descriptor that has already been validated.
+func newOperator(log logrus.FieldLogger, descriptor *Descriptor) (result *operator, err error) {
+	templates, err := loadTemplates(descriptor)
+	if err != nil {
+		return
+	}
+	result = &operator{
+		log:        log,
+		descriptor: descriptor,
+		monitored: models.MonitoredOperator{
+			Name:             descriptor.Name,
+			Namespace:        descriptor.Namespace,
+			OperatorType:     models.OperatorTypeOlm,
+			SubscriptionName: descriptor.Subscription.Name,
+			TimeoutSeconds:   descriptor.TimeoutSeconds,
+			Bundles:          pq.StringArray(descriptor.Bundles),
+		},
+		templates: templates,
+	}
+	return
+}
+
+// GetName reports the name of an operator.
+func (o *operator) GetName() string {
+	return o.descriptor.Name
+}
+
+func (o *operator) GetFullName() string {
+	return o.descriptor.FullName
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies(c *common.Cluster) ([]string, error) {
+	return append([]string{}, o.descriptor.Dependencies...), nil
+}
+
+// GetClusterValidationIDs returns cluster validation IDs for the operator. Note that all the plugins share the same
+// validation identifiers.
+func (o *operator) GetClusterValidationIDs() []string {
+	return []string{clusterValidationID}
+}
+
+// GetHostValidationID returns host validation ID for the operator. Note that all the plugins share the same
+// validation identifier.
+func (o *operator) GetHostValidationID() string {
+	return hostValidationID
+}
+
+// ValidateCluster checks that the OpenShift version and the CPU architecture of the cluster are supported by the
+// operator.
+func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) ([]api.ValidationResult, error) {
+	result := api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: clusterValidationID,
+	}
+
+	if o.descriptor.MinOpenshiftVersion != "" {
+		if ok, _ := common.BaseVersionLessThan(o.descriptor.MinOpenshiftVersion, cluster.OpenshiftVersion); ok {
+			result.Status = api.Failure
+			result.Reasons = append(result.Reasons, fmt.Sprintf(
+				"%s is only supported for openshift versions %s and above",
+				o.descriptor.FullName, o.descriptor.MinOpenshiftVersion,
+			))
+		}
+	}
+
+	if len(o.descriptor.CPUArchitectures) > 0 && cluster.CPUArchitecture != common.MultiCPUArchitecture {
+		architecture := common.NormalizeCPUArchitecture(cluster.CPUArchitecture)
+		if !funk.ContainsString(o.descriptor.CPUArchitectures, architecture) {
+			result.Status = api.Failure
+			result.Reasons = append(result.Reasons, fmt.Sprintf(
+				"%s is not available when %s CPU architecture is selected",
+				o.descriptor.FullName, cluster.CPUArchitecture,
+			))
+		}
+	}
+
+	return []api.ValidationResult{result}, nil
+}
+
+// ValidateHost returns a pending result until the inventory of the host is available. The hardware requirements are
+// checked by the generic CPU, memory and disk validations.
+func (o *operator) ValidateHost(_ context.Context, _ *common.Cluster, host *models.Host,
+	_ *models.ClusterHostRequirementsDetails) (api.ValidationResult, error) {
+	if host.Inventory == "" {
+		return api.ValidationResult{
+			Status:       api.Pending,
+			ValidationId: hostValidationID,
+			Reasons:      []string{"Missing Inventory in some of the hosts"},
+		}, nil
+	}
+	return api.ValidationResult{
+		Status:       api.Success,
+		ValidationId: hostValidationID,
+	}, nil
+}
+
+// GetProperties provides description of operator properties.
+func (o *operator) GetProperties() models.OperatorProperties {
+	return models.OperatorProperties{}
+}
+
+// GetMonitoredOperator returns the information that describes how to monitor the operator.
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &o.monitored
+}
+
+// GetHostRequirements provides the requirements that the host needs to satisfy in order to be able to install the
+// operator.
+func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Cluster,
+	host *models.Host) (result *models.ClusterHostRequirementsDetails, err error) {
+	preflightRequirements, err := o.GetPreflightRequirements(ctx, cluster)
+	if err != nil {
+		o.log.WithError(err).Errorf("Cannot retrieve preflight requirements for cluster %s", cluster.ID)
+		return
+	}
+	switch common.GetEffectiveRole(host) {
+	case models.HostRoleMaster, models.HostRoleBootstrap:
+		result = preflightRequirements.Requirements.Master.Quantitative
+	case models.HostRoleWorker, models.HostRoleAutoAssign:
+		result = preflightRequirements.Requirements.Worker.Quantitative
+	default:
+		result = &models.ClusterHostRequirementsDetails{}
+	}
+	return
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only.
+func (o *operator) GetPreflightRequirements(_ context.Context,
+	cluster *common.Cluster) (result *models.OperatorHardwareRequirements, err error) {
+	dependencies, err := o.GetDependencies(cluster)
+	if err != nil {
+		return
+	}
+	result = &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: dependencies,
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: o.descriptor.Requirements.Master.hostRequirements(),
+			Worker: o.descriptor.Requirements.Worker.hostRequirements(),
+		},
+	}
+	return
+}
+
+// GetFeatureSupportID returns an empty identifier because plugins aren't part of the feature support levels. The CPU
+// architectures supported by the plugin are checked by the cluster validation instead.
+func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
+	return ""
+}
+
+func (o *operator) GetBundleLabels() []string {
+	return append([]string{}, o.descriptor.Bundles...)
+}
+
+// GetChannels returns the default channel of the subscription followed by the other channels that can be selected.
+func (o *operator) GetChannels(_ *common.Cluster) []string {
+	return append([]string{o.descriptor.Subscription.Channel}, o.descriptor.Subscription.Channels...)
+}
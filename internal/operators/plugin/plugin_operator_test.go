@@ -0,0 +1,197 @@
+package plugin
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Operator", func() {
+	var (
+		ctx        = context.Background()
+		descriptor *Descriptor
+		operator   *operator
+	)
+
+	BeforeEach(func() {
+		descriptor = &Descriptor{
+			Name:      "example",
+			FullName:  "Example",
+			Namespace: "example-ns",
+			Subscription: Subscription{
+				Name:     "example-operator",
+				Channel:  "stable-1.0",
+				Channels: []string{"stable-1.1", "fast"},
+				Source:   "redhat-operators",
+			},
+			Dependencies:        []string{"lso"},
+			Bundles:             []string{operatorscommon.BundleVirtualization.ID},
+			MinOpenshiftVersion: "4.15",
+			CPUArchitectures:    []string{common.X86CPUArchitecture, common.ARM64CPUArchitecture},
+			Requirements: Requirements{
+				Master: &RoleRequirements{CPUCores: 2, RAMMib: 2048},
+				Worker: &RoleRequirements{CPUCores: 1, RAMMib: 1024, DiskSizeGb: 50, Qualitative: []string{"A GPU"}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		descriptor.setDefaults()
+		Expect(descriptor.validate()).To(Succeed())
+		var err error
+		operator, err = newOperator(common.GetTestLog(), descriptor)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Is described by the descriptor", func() {
+		Expect(operator.GetName()).To(Equal("example"))
+		Expect(operator.GetFullName()).To(Equal("Example"))
+		Expect(operator.GetBundleLabels()).To(ConsistOf(operatorscommon.BundleVirtualization.ID))
+		Expect(operator.GetFeatureSupportID()).To(BeEmpty())
+		dependencies, err := operator.GetDependencies(&common.Cluster{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dependencies).To(ConsistOf("lso"))
+
+		monitored := operator.GetMonitoredOperator()
+		Expect(monitored.Name).To(Equal("example"))
+		Expect(monitored.Namespace).To(Equal("example-ns"))
+		Expect(monitored.SubscriptionName).To(Equal("example-operator"))
+		Expect(monitored.OperatorType).To(Equal(models.OperatorTypeOlm))
+		Expect(monitored.TimeoutSeconds).To(BeEquivalentTo(30 * 60))
+		Expect(operator.GetChannels(&common.Cluster{})).To(Equal([]string{"stable-1.0", "stable-1.1", "fast"}))
+	})
+
+	DescribeTable("Validates the cluster",
+		func(version, architecture string, status api.ValidationStatus, reasons []string) {
+			cluster := &common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion: version,
+				CPUArchitecture:  architecture,
+			}}
+			results, err := operator.ValidateCluster(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].ValidationId).To(Equal(string(models.ClusterValidationIDPluginOperatorsRequirementsSatisfied)))
+			Expect(results[0].Status).To(Equal(status))
+			Expect(results[0].Reasons).To(Equal(reasons))
+		},
+		Entry("Supported version and architecture", "4.16.0", common.X86CPUArchitecture, api.Success, nil),
+		Entry("Equivalent architecture", "4.16.0", common.AARCH64CPUArchitecture, api.Success, nil),
+		Entry("Multi architecture", "4.16.0", common.MultiCPUArchitecture, api.Success, nil),
+		Entry("Old version", "4.14.0", common.X86CPUArchitecture, api.Failure, []string{
+			"Example is only supported for openshift versions 4.15 and above",
+		}),
+		Entry("Unsupported architecture", "4.16.0", common.PowerCPUArchitecture, api.Failure, []string{
+			"Example is not available when ppc64le CPU architecture is selected",
+		}),
+	)
+
+	It("Waits for the inventory of the host", func() {
+		result, err := operator.ValidateHost(ctx, &common.Cluster{}, &models.Host{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status).To(Equal(api.Pending))
+		Expect(result.ValidationId).To(Equal(string(models.HostValidationIDPluginOperatorsRequirementsSatisfied)))
+
+		result, err = operator.ValidateHost(ctx, &common.Cluster{}, &models.Host{Inventory: "{}"}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status).To(Equal(api.Success))
+	})
+
+	DescribeTable("Returns the requirements of the role of the host",
+		func(role models.HostRole, expected *models.ClusterHostRequirementsDetails) {
+			requirements, err := operator.GetHostRequirements(ctx, &common.Cluster{}, &models.Host{Role: role})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements).To(Equal(expected))
+		},
+		Entry("Master", models.HostRoleMaster, &models.ClusterHostRequirementsDetails{CPUCores: 2, RAMMib: 2048}),
+		Entry("Worker", models.HostRoleWorker, &models.ClusterHostRequirementsDetails{CPUCores: 1, RAMMib: 1024, DiskSizeGb: 50}),
+		Entry("Auto assign", models.HostRoleAutoAssign, &models.ClusterHostRequirementsDetails{CPUCores: 1, RAMMib: 1024, DiskSizeGb: 50}),
+		Entry("Arbiter", models.HostRoleArbiter, &models.ClusterHostRequirementsDetails{}),
+	)
+
+	It("Returns the preflight requirements", func() {
+		requirements, err := operator.GetPreflightRequirements(ctx, &common.Cluster{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requirements.OperatorName).To(Equal("example"))
+		Expect(requirements.Dependencies).To(ConsistOf("lso"))
+		Expect(requirements.Requirements.Master.Quantitative.CPUCores).To(BeEquivalentTo(2))
+		Expect(requirements.Requirements.Worker.Qualitative).To(ConsistOf("A GPU"))
+	})
+
+	Context("Without requirements", func() {
+		BeforeEach(func() {
+			descriptor.Requirements = Requirements{}
+		})
+
+		It("Returns empty requirements", func() {
+			requirements, err := operator.GetHostRequirements(ctx, &common.Cluster{}, &models.Host{Role: models.HostRoleMaster})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements).To(Equal(&models.ClusterHostRequirementsDetails{}))
+		})
+	})
+
+	Context("Manifests", func() {
+		It("Generates the namespace, operator group and subscription by default", func() {
+			openshiftManifests, customManifests, err := operator.GenerateManifests(&common.Cluster{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(customManifests).To(BeEmpty())
+			Expect(openshiftManifests).To(HaveLen(3))
+			Expect(openshiftManifests).To(HaveKey("50_example_namespace.yaml"))
+			Expect(openshiftManifests).To(HaveKey("50_example_operatorgroup.yaml"))
+			Expect(openshiftManifests).To(HaveKey("50_example_subscription.yaml"))
+
+			var subscription map[string]any
+			Expect(yaml.Unmarshal(openshiftManifests["50_example_subscription.yaml"], &subscription)).To(Succeed())
+			Expect(subscription["metadata"]).To(Equal(map[string]any{
+				"name":      "example-operator",
+				"namespace": "example-ns",
+			}))
+			Expect(subscription["spec"]).To(Equal(map[string]any{
+				"name":                "example-operator",
+				"channel":             "stable-1.0",
+				"source":              "redhat-operators",
+				"sourceNamespace":     "openshift-marketplace",
+				"installPlanApproval": "Automatic",
+			}))
+		})
+
+		Context("With templates", func() {
+			BeforeEach(func() {
+				descriptor.Manifests = Manifests{
+					OpenShift: []Manifest{
+						{
+							Name:     "50_example_namespace.yaml",
+							Template: "kind: Namespace\nmetadata:\n  name: {{ .Operator.Namespace }}\n  labels:\n    custom: \"true\"\n",
+						},
+						{
+							Name:     "60_example_config.yaml",
+							Template: "kind: ConfigMap\nmetadata:\n  name: {{ .Cluster.Name }}\n",
+						},
+					},
+					Custom: []Manifest{
+						{
+							Name:     "example.yaml",
+							Template: "kind: Example\nspec:\n  channel: {{ .Plugin.Subscription.Channel }}\n",
+						},
+					},
+				}
+			})
+
+			It("Adds the templates and replaces the defaults with the same name", func() {
+				cluster := &common.Cluster{Cluster: models.Cluster{Name: "mycluster"}}
+				openshiftManifests, customManifests, err := operator.GenerateManifests(cluster)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(openshiftManifests).To(HaveLen(4))
+				Expect(string(openshiftManifests["50_example_namespace.yaml"])).To(ContainSubstring("custom: \"true\""))
+				Expect(string(openshiftManifests["60_example_config.yaml"])).To(ContainSubstring("name: mycluster"))
+				Expect(string(customManifests)).To(Equal("---\nkind: Example\nspec:\n  channel: stable-1.0\n\n"))
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package plugin
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPluginOperators(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Operator plugins")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/fingerprint"
 	"github.com/openshift/assisted-service/internal/provider"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
@@ -49,13 +50,5 @@ func (p *ociExternalProvider) IsProviderForPlatform(platform *models.Platform) b
 }
 
 func IsOciHost(host *models.Host) (bool, error) {
-	// during the discovery there is a short time that host didn't return its inventory to the service
-	if host.Inventory == "" {
-		return false, nil
-	}
-	hostInventory, err := common.UnmarshalInventory(host.Inventory)
-	if err != nil {
-		return false, fmt.Errorf("error marshaling host to inventory, error %w", err)
-	}
-	return hostInventory.SystemVendor.Manufacturer == OCIManufacturer, nil
+	return fingerprint.IsHostInEnvironment(host, models.HostFingerprintEnvironmentOci)
 }
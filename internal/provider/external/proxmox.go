@@ -0,0 +1,118 @@
+package external
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/fingerprint"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// QEMUManufacturer is the system vendor reported by Proxmox VE virtual machines using the default SMBIOS settings
+	QEMUManufacturer string = "QEMU"
+	// ProxmoxManufacturer is the system vendor usually set in the SMBIOS settings of Proxmox VE virtual machines
+	ProxmoxManufacturer string = "Proxmox"
+)
+
+// The QEMU guest agent lets Proxmox VE report the addresses of the nodes and shut them down gracefully
+const qemuGuestAgentMachineConfigManifest = `apiVersion: machineconfiguration.openshift.io/v1
+kind: MachineConfig
+metadata:
+  labels:
+    machineconfiguration.openshift.io/role: {{.ROLE}}
+  name: 99-{{.ROLE}}-proxmox-qemu-guest-agent
+spec:
+  config:
+    ignition:
+      version: 3.2.0
+    systemd:
+      units:
+      - name: qemu-guest-agent.service
+        enabled: true
+`
+
+type proxmoxExternalProvider struct {
+	baseExternalProvider
+}
+
+func NewProxmoxExternalProvider(log logrus.FieldLogger) provider.Provider {
+	p := &proxmoxExternalProvider{
+		baseExternalProvider: baseExternalProvider{
+			Log: log,
+		},
+	}
+	p.Provider = p
+	return p
+}
+
+func (p *proxmoxExternalProvider) IsHostSupported(host *models.Host) (bool, error) {
+	return IsProxmoxHost(host)
+}
+
+func (p *proxmoxExternalProvider) AreHostsSupported(hosts []*models.Host) (bool, error) {
+	for _, h := range hosts {
+		supported, err := p.IsHostSupported(h)
+		if err != nil {
+			return false, fmt.Errorf("error while checking if host is supported, error is: %w", err)
+		}
+		if !supported {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (p *proxmoxExternalProvider) IsProviderForPlatform(platform *models.Platform) bool {
+	return common.IsProxmoxExternalIntegrationEnabled(platform)
+}
+
+func (p *proxmoxExternalProvider) SetPlatformUsages(
+	usages map[string]models.Usage,
+	usageApi usage.API) error {
+	props := &map[string]interface{}{
+		"platform_type": p.Name(),
+		"platform_name": common.ExternalPlatformNameProxmox}
+	usageApi.Add(usages, usage.PlatformSelectionUsage, props)
+	return nil
+}
+
+func (p proxmoxExternalProvider) PostCreateManifestsHook(cluster *common.Cluster, _ *[]string, workDir string) error {
+	roles := []models.HostRole{models.HostRoleMaster, models.HostRoleWorker}
+	if common.IsClusterTopologyHighlyAvailableArbiter(cluster) {
+		roles = append(roles, models.HostRoleArbiter)
+	}
+
+	tmpl, err := template.New("qemuGuestAgent").Parse(qemuGuestAgentMachineConfigManifest)
+	if err != nil {
+		return fmt.Errorf("failed to parse the QEMU guest agent manifest template: %w", err)
+	}
+
+	for _, role := range roles {
+		var buf bytes.Buffer
+		if err = tmpl.Execute(&buf, map[string]interface{}{"ROLE": string(role)}); err != nil {
+			return fmt.Errorf("failed to render the QEMU guest agent manifest for role %s: %w", role, err)
+		}
+
+		fileName := filepath.Join(workDir, "openshift", fmt.Sprintf("99_openshift-machineconfig_99-%s-proxmox-qemu-guest-agent.yaml", role))
+		p.Log.Infof("Adding manifest %s", fileName)
+		if err = os.WriteFile(fileName, buf.Bytes(), 0600); err != nil {
+			return fmt.Errorf("failed to write manifest %s: %w", fileName, err)
+		}
+	}
+
+	return nil
+}
+
+// IsProxmoxHost checks if the host is a Proxmox VE virtual machine, the virtual machines using the default
+// SMBIOS settings can't be told apart from other QEMU guests so the generic KVM guests are accepted too
+func IsProxmoxHost(host *models.Host) (bool, error) {
+	return fingerprint.IsHostInEnvironment(host, models.HostFingerprintEnvironmentProxmox, models.HostFingerprintEnvironmentKvm)
+}
@@ -0,0 +1,128 @@
+package external
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("proxmox", func() {
+	var log = common.GetTestLog()
+	var provider provider.Provider
+
+	BeforeEach(func() {
+		provider = NewProxmoxExternalProvider(log)
+	})
+
+	Context("platform", func() {
+		It("is the proxmox external platform", func() {
+			Expect(provider.IsProviderForPlatform(&models.Platform{
+				Type:     models.PlatformTypeExternal.Pointer(),
+				External: &models.PlatformExternal{PlatformName: swag.String(common.ExternalPlatformNameProxmox)},
+			})).To(BeTrue())
+		})
+
+		It("is not another external platform", func() {
+			Expect(provider.IsProviderForPlatform(&models.Platform{
+				Type:     models.PlatformTypeExternal.Pointer(),
+				External: &models.PlatformExternal{PlatformName: swag.String(common.ExternalPlatformNameOci)},
+			})).To(BeFalse())
+		})
+	})
+
+	Context("host", func() {
+		var host *models.Host
+		BeforeEach(func() {
+			host = &models.Host{}
+		})
+
+		setHostInventory := func(inventory *models.Inventory, host *models.Host) {
+			data, err := json.Marshal(inventory)
+			Expect(err).To(BeNil())
+			host.Inventory = string(data)
+		}
+
+		DescribeTable("is supported",
+			func(systemVendor *models.SystemVendor, expected bool) {
+				setHostInventory(&models.Inventory{SystemVendor: systemVendor}, host)
+				supported, err := provider.IsHostSupported(host)
+				Expect(err).To(BeNil())
+				Expect(supported).To(Equal(expected))
+			},
+			Entry("QEMU virtual machine", &models.SystemVendor{Manufacturer: QEMUManufacturer, ProductName: "Standard PC (Q35 + ICH9, 2009)", Virtual: true}, true),
+			Entry("Proxmox manufacturer", &models.SystemVendor{Manufacturer: "Proxmox Server Solutions GmbH", Virtual: true}, true),
+			Entry("Proxmox product name", &models.SystemVendor{Manufacturer: "Custom", ProductName: "Proxmox VE"}, true),
+			Entry("bare metal", &models.SystemVendor{Manufacturer: "Dell Inc.", ProductName: "PowerEdge R640"}, false),
+			Entry("other virtual machine", &models.SystemVendor{Manufacturer: "VMware, Inc.", Virtual: true}, false),
+			Entry("no system vendor", nil, false),
+		)
+
+		It("are supported", func() {
+			setHostInventory(&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: QEMUManufacturer}}, host)
+			supported, err := provider.AreHostsSupported([]*models.Host{host, host})
+			Expect(err).To(BeNil())
+			Expect(supported).To(BeTrue())
+		})
+
+		It("are not supported", func() {
+			setHostInventory(&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: QEMUManufacturer}}, host)
+
+			notProxmoxHost := &models.Host{}
+			setHostInventory(&models.Inventory{SystemVendor: &models.SystemVendor{Manufacturer: ""}}, notProxmoxHost)
+
+			supported, err := provider.AreHostsSupported([]*models.Host{host, notProxmoxHost})
+			Expect(err).To(BeNil())
+			Expect(supported).To(BeFalse())
+		})
+
+		It("has no inventory", func() {
+			supported, err := provider.IsHostSupported(host)
+			Expect(err).To(BeNil())
+			Expect(supported).To(BeFalse())
+		})
+
+		It("has an invalid inventory", func() {
+			host.Inventory = "invalid-inventory"
+			supported, err := provider.IsHostSupported(host)
+			Expect(err).To(HaveOccurred())
+			Expect(supported).To(BeFalse())
+		})
+	})
+
+	Context("manifests", func() {
+		var workDir string
+
+		BeforeEach(func() {
+			var err error
+			workDir, err = os.MkdirTemp("", "proxmox-manifests-")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Mkdir(filepath.Join(workDir, "openshift"), 0755)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(workDir)
+		})
+
+		It("adds the QEMU guest agent machine configs", func() {
+			cluster := &common.Cluster{Cluster: models.Cluster{ControlPlaneCount: 3}}
+			Expect(provider.PostCreateManifestsHook(cluster, nil, workDir)).To(Succeed())
+
+			for _, role := range []string{"master", "worker"} {
+				content, err := os.ReadFile(filepath.Join(workDir, "openshift", "99_openshift-machineconfig_99-"+role+"-proxmox-qemu-guest-agent.yaml"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(ContainSubstring("machineconfiguration.openshift.io/role: " + role))
+				Expect(string(content)).To(ContainSubstring("name: qemu-guest-agent.service"))
+			}
+			_, err := os.Stat(filepath.Join(workDir, "openshift", "99_openshift-machineconfig_99-arbiter-proxmox-qemu-guest-agent.yaml"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
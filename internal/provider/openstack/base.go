@@ -0,0 +1,49 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/openshift/assisted-service/internal/hardware/fingerprint"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+type openstackProvider struct {
+	Log logrus.FieldLogger
+}
+
+// NewOpenstackProvider creates a new OpenStack provider.
+func NewOpenstackProvider(log logrus.FieldLogger) provider.Provider {
+	return &openstackProvider{
+		Log: log,
+	}
+}
+
+// Name returns the name of the provider
+func (p *openstackProvider) Name() models.PlatformType {
+	return models.PlatformTypeOpenstack
+}
+
+func (p *openstackProvider) IsHostSupported(host *models.Host) (bool, error) {
+	return fingerprint.IsHostInEnvironment(host, models.HostFingerprintEnvironmentOpenstack)
+}
+
+func (p *openstackProvider) AreHostsSupported(hosts []*models.Host) (bool, error) {
+	for _, h := range hosts {
+		supported, err := p.IsHostSupported(h)
+		if err != nil {
+			return false, fmt.Errorf("error while checking if host is supported, error is: %w", err)
+		}
+		if !supported {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (p *openstackProvider) IsProviderForPlatform(platform *models.Platform) bool {
+	return platform != nil &&
+		platform.Type != nil &&
+		*platform.Type == p.Name()
+}
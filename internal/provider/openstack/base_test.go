@@ -0,0 +1,57 @@
+package openstack
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("base", func() {
+	var log = common.GetTestLog()
+	Context("is host supported", func() {
+		var provider provider.Provider
+		var host *models.Host
+		BeforeEach(func() {
+			provider = NewOpenstackProvider(log)
+			host = &models.Host{}
+		})
+
+		setHostInventory := func(inventory *models.Inventory, host *models.Host) {
+			data, err := json.Marshal(inventory)
+			Expect(err).To(BeNil())
+			host.Inventory = string(data)
+		}
+
+		DescribeTable("supported",
+			func(systemVendor *models.SystemVendor, expected bool) {
+				setHostInventory(&models.Inventory{SystemVendor: systemVendor}, host)
+				supported, err := provider.IsHostSupported(host)
+				Expect(err).To(BeNil())
+				Expect(supported).To(Equal(expected))
+			},
+			Entry("OpenStack manufacturer", &models.SystemVendor{Manufacturer: OpenstackManufacturer, ProductName: "OpenStack Nova", Virtual: true}, true),
+			Entry("OpenStack product name", &models.SystemVendor{Manufacturer: "Red Hat", ProductName: "OpenStack Compute", Virtual: true}, true),
+			Entry("other virtual machine", &models.SystemVendor{Manufacturer: "VMware, Inc.", Virtual: true}, false),
+			Entry("empty manufacturer", &models.SystemVendor{Manufacturer: ""}, false),
+			Entry("no system vendor", nil, false),
+		)
+
+		It("no inventory", func() {
+			supported, err := provider.IsHostSupported(host)
+			Expect(err).To(BeNil())
+			Expect(supported).To(BeFalse())
+		})
+
+		It("invalid inventory", func() {
+			host.Inventory = "invalid-inventory"
+			supported, err := provider.IsHostSupported(host)
+			Expect(err).To(HaveOccurred())
+			Expect(supported).To(BeFalse())
+		})
+	})
+})
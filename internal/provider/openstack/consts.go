@@ -0,0 +1,14 @@
+package openstack
+
+import "github.com/openshift/assisted-service/internal/provider"
+
+const (
+	// PhCloud is the cloud used when none is set, it matches the default cloud of the OpenStack clients
+	PhCloud = provider.OpenstackDefaultCloud
+
+	OpenstackManufacturer string = "OpenStack Foundation"
+
+	cloudsYamlFileName = "clouds.yaml"
+	// cloudsYamlEnvVar points the OpenStack clients of openshift-install to the clouds.yaml
+	cloudsYamlEnvVar = "OS_CLIENT_CONFIG_FILE"
+)
@@ -0,0 +1,71 @@
+package openstack
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+// PreCreateManifestsHook writes the clouds.yaml of the cluster to the working directory, openshift-install
+// reads the credentials of the cloud from it to validate the install config and to generate the manifests
+func (p openstackProvider) PreCreateManifestsHook(cluster *common.Cluster, envVars *[]string, workDir string) error {
+	if cluster.OpenstackCloudsYaml == "" {
+		return errors.New("the OpenStack clouds.yaml is not set, it is required to generate the manifests")
+	}
+	cloudsYamlPath := filepath.Join(workDir, cloudsYamlFileName)
+	if err := os.WriteFile(cloudsYamlPath, []byte(cluster.OpenstackCloudsYaml), 0600); err != nil {
+		return fmt.Errorf("error writing %s: %w", cloudsYamlFileName, err)
+	}
+	*envVars = append(*envVars, fmt.Sprintf("%s=%s", cloudsYamlEnvVar, cloudsYamlPath))
+	return nil
+}
+
+func (p openstackProvider) PostCreateManifestsHook(_ *common.Cluster, _ *[]string, workDir string) error {
+	// Deleting machines and machineSets for openstack platform after manifest generation, the instances are
+	// provisioned by the user. The following steps are included in the Openshift UPI OpenStack installation guide:
+	// https://docs.openshift.com/container-platform/4.14/installing/installing_openstack/installing-openstack-user.html#installation-osp-user-manifests_installing-openstack-user
+
+	// Delete machines
+	p.Log.Info("Deleting machines manifests")
+	files, _ := filepath.Glob(path.Join(workDir, "openshift", "*_openshift-cluster-api_master-machines-*.yaml"))
+	err := p.deleteAllFiles(files)
+
+	if err != nil {
+		return fmt.Errorf("error deleting master machine: %w", err)
+	}
+
+	// Delete machine-set
+	p.Log.Info("Deleting machine set manifest")
+	files, _ = filepath.Glob(path.Join(workDir, "openshift", "*_openshift-cluster-api_worker-machineset-*.yaml"))
+	err = p.deleteAllFiles(files)
+
+	if err != nil {
+		return fmt.Errorf("error deleting machineset: %w", err)
+	}
+
+	// Delete machine-api control plane machine set manifest
+	p.Log.Info("Deleting machine-api control plane machine set manifest")
+	files, _ = filepath.Glob(path.Join(workDir, "openshift", "*_openshift-machine-api_master-control-plane-machine-set.yaml"))
+	err = p.deleteAllFiles(files)
+
+	if err != nil {
+		return fmt.Errorf("error deleting control plane machine set: %w", err)
+	}
+
+	return nil
+}
+
+func (p openstackProvider) deleteAllFiles(files []string) error {
+	for _, f := range files {
+		p.Log.Infof("Deleting manifest %s", f)
+
+		if err := os.Remove(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
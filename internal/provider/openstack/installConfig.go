@@ -0,0 +1,90 @@
+package openstack
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/swag"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	errorWrap "github.com/pkg/errors"
+)
+
+func setPlatformValues(platform *installcfg.OpenstackInstallConfigPlatform, settings *models.PlatformOpenstack) {
+	platform.Cloud = PhCloud
+	if settings == nil {
+		return
+	}
+
+	if swag.StringValue(settings.Cloud) != "" {
+		platform.Cloud = swag.StringValue(settings.Cloud)
+	}
+	platform.ExternalNetwork = swag.StringValue(settings.ExternalNetwork)
+	platform.APIFloatingIP = swag.StringValue(settings.APIFloatingIP)
+	platform.IngressFloatingIP = swag.StringValue(settings.IngressFloatingIP)
+}
+
+func (p openstackProvider) addLoadBalancer(cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster) error {
+	if cluster.LoadBalancer == nil {
+		return nil
+	}
+	switch cluster.LoadBalancer.Type {
+	case models.LoadBalancerTypeClusterManaged:
+		// Nothing, this is the default.
+	case models.LoadBalancerTypeUserManaged:
+		cfg.Platform.Openstack.LoadBalancer = &configv1.OpenStackPlatformLoadBalancer{
+			Type: configv1.LoadBalancerTypeUserManaged,
+		}
+	default:
+		return fmt.Errorf(
+			"load balancer type is set to unsupported value '%s', supported values are "+
+				"'%s' and '%s'",
+			cluster.LoadBalancer.Type,
+			models.LoadBalancerTypeClusterManaged,
+			models.LoadBalancerTypeUserManaged,
+		)
+	}
+	return nil
+}
+
+func (p openstackProvider) AddPlatformToInstallConfig(
+	cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster, infraEnvs []*common.InfraEnv) error {
+	osPlatform := &installcfg.OpenstackInstallConfigPlatform{}
+
+	if !swag.BoolValue(cluster.UserManagedNetworking) {
+		if len(cluster.APIVips) == 0 {
+			return errors.New("invalid cluster parameters, APIVip must be provided")
+		}
+
+		if len(cluster.IngressVips) == 0 {
+			return errors.New("invalid cluster parameters, IngressVip must be provided")
+		}
+
+		osPlatform.APIVIPs = network.GetApiVips(cluster)
+		osPlatform.IngressVIPs = network.GetIngressVips(cluster)
+	} else {
+		cfg.Networking.MachineNetwork = provider.GetMachineNetworkForUserManagedNetworking(p.Log, cluster)
+		if cluster.NetworkType != nil {
+			cfg.Networking.NetworkType = swag.StringValue(cluster.NetworkType)
+		}
+	}
+
+	var settings *models.PlatformOpenstack
+	if cluster.Platform != nil {
+		settings = cluster.Platform.Openstack
+	}
+	setPlatformValues(osPlatform, settings)
+	cfg.Platform = installcfg.Platform{
+		Openstack: osPlatform,
+	}
+
+	if err := p.addLoadBalancer(cfg, cluster); err != nil {
+		return errorWrap.Wrap(err, "failed to set OpenStack's cluster install-config.yaml load balancer as user-managed")
+	}
+
+	return nil
+}
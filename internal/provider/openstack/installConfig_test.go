@@ -0,0 +1,184 @@
+package openstack
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("AddPlatformToInstallConfig", func() {
+	var (
+		logger    logrus.FieldLogger
+		cluster   *common.Cluster
+		infraEnvs []*common.InfraEnv
+		cfg       *installcfg.InstallerConfigBaremetal
+		provider  provider.Provider
+	)
+
+	BeforeEach(func() {
+		logger = common.GetTestLog()
+		cluster = &common.Cluster{
+			Cluster: models.Cluster{
+				OpenshiftVersion: common.MinimumVersionForUserManagedLoadBalancerFeature,
+				Platform: &models.Platform{
+					Type: models.PlatformTypeOpenstack.Pointer(),
+				},
+				APIVips: []*models.APIVip{
+					{IP: "192.168.127.1"},
+				},
+				IngressVips: []*models.IngressVip{
+					{IP: "192.168.127.2"},
+				},
+			},
+		}
+		infraEnvs = []*common.InfraEnv{{
+			InfraEnv: models.InfraEnv{},
+		}}
+		cfg = &installcfg.InstallerConfigBaremetal{}
+		provider = NewOpenstackProvider(logger)
+	})
+
+	Context("platform settings", func() {
+		It("uses the default cloud when no settings are set", func() {
+			err := provider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Platform.Openstack).ToNot(BeNil())
+			Expect(cfg.Platform.Openstack.Cloud).To(Equal(PhCloud))
+			Expect(cfg.Platform.Openstack.APIVIPs).To(Equal([]string{"192.168.127.1"}))
+			Expect(cfg.Platform.Openstack.IngressVIPs).To(Equal([]string{"192.168.127.2"}))
+		})
+
+		It("sets the OpenStack settings", func() {
+			cluster.Platform.Openstack = &models.PlatformOpenstack{
+				Cloud:             swag.String("private-cloud"),
+				ExternalNetwork:   swag.String("public"),
+				APIFloatingIP:     swag.String("203.0.113.10"),
+				IngressFloatingIP: swag.String("203.0.113.11"),
+			}
+			err := provider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Platform.Openstack.Cloud).To(Equal("private-cloud"))
+			Expect(cfg.Platform.Openstack.ExternalNetwork).To(Equal("public"))
+			Expect(cfg.Platform.Openstack.APIFloatingIP).To(Equal("203.0.113.10"))
+			Expect(cfg.Platform.Openstack.IngressFloatingIP).To(Equal("203.0.113.11"))
+		})
+
+		It("fails when the VIPs are missing with cluster managed networking", func() {
+			cluster.APIVips = nil
+			err := provider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("APIVip must be provided"))
+		})
+
+		It("does not set the VIPs with user managed networking", func() {
+			cluster.UserManagedNetworking = swag.Bool(true)
+			cluster.NetworkType = swag.String(models.ClusterNetworkTypeOVNKubernetes)
+			err := provider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Platform.Openstack.APIVIPs).To(BeEmpty())
+			Expect(cfg.Platform.Openstack.IngressVIPs).To(BeEmpty())
+			Expect(cfg.Networking.NetworkType).To(Equal(models.ClusterNetworkTypeOVNKubernetes))
+		})
+	})
+
+	Context("addLoadBalancer", func() {
+		It("Does nothing if there is no load balancer", func() {
+			err := provider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Platform.Openstack.LoadBalancer).To(BeNil())
+		})
+
+		It("Adds user-managed load balancer", func() {
+			cluster.LoadBalancer = &models.LoadBalancer{Type: models.LoadBalancerTypeUserManaged}
+			err := provider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Platform.Openstack.LoadBalancer).ToNot(BeNil())
+			Expect(cfg.Platform.Openstack.LoadBalancer.Type).To(Equal(configv1.LoadBalancerTypeUserManaged))
+		})
+
+		It("Returns error if load balancer type is not supported", func() {
+			cluster.LoadBalancer = &models.LoadBalancer{Type: "unsupported"}
+			err := provider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("load balancer type is set to unsupported value 'unsupported'"))
+		})
+	})
+})
+
+var _ = Describe("PreCreateManifestsHook", func() {
+	var workDir string
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = os.MkdirTemp("", "openstack-manifests-")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(workDir)
+	})
+
+	It("writes the clouds.yaml for openshift-install", func() {
+		cloudsYaml := "clouds:\n  openstack:\n    auth:\n      password: top-secret\n"
+		envVars := []string{"PATH=/usr/bin"}
+		cluster := &common.Cluster{OpenstackCloudsYaml: cloudsYaml}
+		Expect(NewOpenstackProvider(common.GetTestLog()).PreCreateManifestsHook(cluster, &envVars, workDir)).To(Succeed())
+
+		cloudsYamlPath := filepath.Join(workDir, "clouds.yaml")
+		Expect(envVars).To(ConsistOf("PATH=/usr/bin", "OS_CLIENT_CONFIG_FILE="+cloudsYamlPath))
+		content, err := os.ReadFile(cloudsYamlPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal(cloudsYaml))
+	})
+
+	It("fails without a clouds.yaml", func() {
+		var envVars []string
+		err := NewOpenstackProvider(common.GetTestLog()).PreCreateManifestsHook(&common.Cluster{}, &envVars, workDir)
+		Expect(err).To(MatchError(ContainSubstring("clouds.yaml is not set")))
+	})
+})
+
+var _ = Describe("PostCreateManifestsHook", func() {
+	var workDir string
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = os.MkdirTemp("", "openstack-manifests-")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.Mkdir(filepath.Join(workDir, "openshift"), 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(workDir)
+	})
+
+	It("deletes the machines and machine sets manifests", func() {
+		manifests := []string{
+			"99_openshift-cluster-api_master-machines-0.yaml",
+			"99_openshift-cluster-api_worker-machineset-0.yaml",
+			"99_openshift-machine-api_master-control-plane-machine-set.yaml",
+		}
+		for _, manifest := range manifests {
+			Expect(os.WriteFile(filepath.Join(workDir, "openshift", manifest), []byte("{}"), 0600)).To(Succeed())
+		}
+		kept := filepath.Join(workDir, "openshift", "99_openshift-machineconfig_99-master-ssh.yaml")
+		Expect(os.WriteFile(kept, []byte("{}"), 0600)).To(Succeed())
+
+		Expect(NewOpenstackProvider(common.GetTestLog()).PostCreateManifestsHook(&common.Cluster{}, nil, workDir)).To(Succeed())
+
+		for _, manifest := range manifests {
+			_, err := os.Stat(filepath.Join(workDir, "openshift", manifest))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		}
+		Expect(kept).To(BeAnExistingFile())
+	})
+})
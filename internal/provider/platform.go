@@ -130,6 +130,10 @@ func checkPlatformWrongParamsInput(platform *models.Platform, userManagedNetwork
 		return err
 	}
 
+	if err := validateOpenstackPlatform(platform, cluster); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -327,7 +331,28 @@ func GetPlatformFeatureID(platformType models.PlatformType) models.FeatureSuppor
 		return models.FeatureSupportLevelIDVSPHEREINTEGRATION
 	case models.PlatformTypeNutanix:
 		return models.FeatureSupportLevelIDNUTANIXINTEGRATION
+	case models.PlatformTypeOpenstack:
+		return models.FeatureSupportLevelIDOPENSTACKINTEGRATION
 	default:
 		return "" // Return empty string on platform without a feature support ID
 	}
 }
+
+// GetPlatformTypeForEnvironment returns the platform type to suggest for hosts running on a hypervisor or a
+// cloud, as classified by their hardware fingerprint
+func GetPlatformTypeForEnvironment(environment string) models.PlatformType {
+	switch environment {
+	case models.HostFingerprintEnvironmentBaremetal:
+		return models.PlatformTypeBaremetal
+	case models.HostFingerprintEnvironmentVsphere:
+		return models.PlatformTypeVsphere
+	case models.HostFingerprintEnvironmentNutanix:
+		return models.PlatformTypeNutanix
+	case models.HostFingerprintEnvironmentOpenstack:
+		return models.PlatformTypeOpenstack
+	case models.HostFingerprintEnvironmentOci, models.HostFingerprintEnvironmentProxmox:
+		return models.PlatformTypeExternal
+	default:
+		return "" // Return empty string on environments without a dedicated platform
+	}
+}
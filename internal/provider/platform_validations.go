@@ -1,13 +1,19 @@
 package provider
 
 import (
+	"net"
 	"net/http"
 
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
 )
 
+// OpenstackDefaultCloud is the cloud used when none is set, it matches the default cloud of the OpenStack clients
+const OpenstackDefaultCloud = "openstack"
+
 // merge the current platform set in cluster with the platform given in input
 func mergePlatforms(platform *models.Platform, cluster *common.Cluster) *models.Platform {
 	if cluster == nil {
@@ -112,3 +118,119 @@ func validateExternalPlatform(platform *models.Platform, cluster *common.Cluster
 
 	return nil
 }
+
+// areOpenstackSettingsSet returns true when at least one of the parameters in platform.Openstack is set
+func areOpenstackSettingsSet(platform models.Platform) bool {
+	return platform.Openstack != nil &&
+		(platform.Openstack.Cloud != nil ||
+			platform.Openstack.CloudsYaml != nil ||
+			platform.Openstack.ExternalNetwork != nil ||
+			platform.Openstack.APIFloatingIP != nil ||
+			platform.Openstack.IngressFloatingIP != nil)
+}
+
+// mergeOpenstackSettings merges the openstack settings set in the cluster with the ones given in input
+func mergeOpenstackSettings(platform *models.Platform, cluster *common.Cluster) *models.PlatformOpenstack {
+	merged := models.PlatformOpenstack{}
+	if cluster != nil && cluster.Platform != nil && cluster.Platform.Openstack != nil {
+		merged = *cluster.Platform.Openstack
+	}
+
+	if platform.Openstack == nil {
+		return &merged
+	}
+
+	if platform.Openstack.Cloud != nil {
+		merged.Cloud = platform.Openstack.Cloud
+	}
+	if platform.Openstack.ExternalNetwork != nil {
+		merged.ExternalNetwork = platform.Openstack.ExternalNetwork
+	}
+	if platform.Openstack.APIFloatingIP != nil {
+		merged.APIFloatingIP = platform.Openstack.APIFloatingIP
+	}
+	if platform.Openstack.IngressFloatingIP != nil {
+		merged.IngressFloatingIP = platform.Openstack.IngressFloatingIP
+	}
+
+	return &merged
+}
+
+// validateOpenstackPlatform check if platform is a valid openstack platform definition, the validation can be performed against an existing cluster in case of update
+func validateOpenstackPlatform(platform *models.Platform, cluster *common.Cluster) error {
+	if platform == nil {
+		// nothing to check
+		return nil
+	}
+
+	platformType := platform.Type
+	if platformType == nil && cluster != nil && cluster.Platform != nil {
+		platformType = cluster.Platform.Type
+	}
+
+	if common.PlatformTypeValue(platformType) != models.PlatformTypeOpenstack {
+		if areOpenstackSettingsSet(*platform) {
+			// openstack settings shouldn't be set if platform type is not openstack
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("OpenStack settings can only be set with openstack platform type"))
+		}
+		return nil
+	}
+
+	settings := mergeOpenstackSettings(platform, cluster)
+	floatingIPs := []struct {
+		name  string
+		value string
+	}{
+		{name: "API floating IP", value: swag.StringValue(settings.APIFloatingIP)},
+		{name: "Ingress floating IP", value: swag.StringValue(settings.IngressFloatingIP)},
+	}
+	for _, floatingIP := range floatingIPs {
+		if floatingIP.value == "" {
+			continue
+		}
+
+		if net.ParseIP(floatingIP.value) == nil {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("%s %s is not a valid IP address", floatingIP.name, floatingIP.value))
+		}
+
+		if swag.StringValue(settings.ExternalNetwork) == "" {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("External network must be set when the %s is set", floatingIP.name))
+		}
+	}
+
+	if floatingIPs[0].value != "" && floatingIPs[0].value == floatingIPs[1].value {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("API floating IP and Ingress floating IP must be different"))
+	}
+
+	cloudsYaml := ""
+	if platform.Openstack != nil && platform.Openstack.CloudsYaml != nil {
+		cloudsYaml = *platform.Openstack.CloudsYaml
+	} else if cluster != nil {
+		cloudsYaml = cluster.OpenstackCloudsYaml
+	}
+	if cloudsYaml != "" {
+		cloud := swag.StringValue(settings.Cloud)
+		if cloud == "" {
+			cloud = OpenstackDefaultCloud
+		}
+		if err := validateOpenstackCloudsYaml(cloudsYaml, cloud); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	return nil
+}
+
+// validateOpenstackCloudsYaml checks that the clouds.yaml defines the cloud that the cluster is installed on
+func validateOpenstackCloudsYaml(cloudsYaml, cloud string) error {
+	var config struct {
+		Clouds map[string]interface{} `json:"clouds"`
+	}
+	if err := yaml.Unmarshal([]byte(cloudsYaml), &config); err != nil {
+		return errors.Wrap(err, "OpenStack clouds.yaml is not a valid YAML document")
+	}
+	if _, ok := config.Clouds[cloud]; !ok {
+		return errors.Errorf("OpenStack clouds.yaml does not define the %s cloud", cloud)
+	}
+	return nil
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/openshift/assisted-service/internal/provider/external"
 	"github.com/openshift/assisted-service/internal/provider/none"
 	"github.com/openshift/assisted-service/internal/provider/nutanix"
+	"github.com/openshift/assisted-service/internal/provider/openstack"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
 )
 
 // ErrNoSuchProvider is returned or thrown in panic when the specified provider is not registered.
@@ -131,6 +133,10 @@ func (r *registry) GetSupportedProvidersByHosts(hosts []*models.Host) ([]models.
 		return nil, nil
 	}
 	for _, p := range r.providers {
+		// several external providers share the external platform type
+		if funk.Contains(clusterSupportedPlatforms, p.Name()) {
+			continue
+		}
 		supported, err := p.AreHostsSupported(hosts)
 		if err != nil {
 			return nil, fmt.Errorf(
@@ -174,7 +180,9 @@ func InitProviderRegistry(log logrus.FieldLogger) ProviderRegistry {
 	providerRegistry.Register(baremetal.NewBaremetalProvider(log))
 	providerRegistry.Register(none.NewNoneProvider(log))
 	providerRegistry.Register(nutanix.NewNutanixProvider(log))
+	providerRegistry.Register(openstack.NewOpenstackProvider(log))
 	providerRegistry.Register(external.NewOciExternalProvider(log))
+	providerRegistry.Register(external.NewProxmoxExternalProvider(log))
 	providerRegistry.Register(external.NewExternalProvider(log))
 	return providerRegistry
 }
@@ -94,6 +94,15 @@ var _ = Describe("Test GetSupportedProvidersByHosts", func() {
 		Expect(len(platforms)).Should(Equal(3))
 		Expect(platforms).Should(ContainElements(models.PlatformTypeBaremetal, models.PlatformTypeNone, models.PlatformTypeExternal))
 	})
+	It("proxmox hosts report the external platform once", func() {
+		proxmoxInventory := getProxmoxInventoryStr("hostname0", "bootMode", true, false)
+		hosts := make([]*models.Host, 0)
+		hosts = append(hosts, createHost(true, models.HostStatusKnown, proxmoxInventory))
+		hosts = append(hosts, createHost(false, models.HostStatusKnown, proxmoxInventory))
+		platforms, err := providerRegistry.GetSupportedProvidersByHosts(hosts)
+		Expect(err).To(BeNil())
+		Expect(platforms).Should(ConsistOf(models.PlatformTypeBaremetal, models.PlatformTypeNone, models.PlatformTypeExternal))
+	})
 	It("host with an invalid inventory", func() {
 		hosts := make([]*models.Host, 0)
 		hosts = append(hosts, createHost(true, models.HostStatusKnown, invalidInventory))
@@ -399,6 +408,32 @@ var _ = Describe("Test AddPlatformToInstallConfig", func() {
 		})
 	})
 
+	Context("openstack", func() {
+		It("with cluster params", func() {
+			cfg := getInstallerConfigBaremetal()
+			hosts := make([]*models.Host, 0)
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getBaremetalInventoryStr("hostname0", "bootMode", true, false)))
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getBaremetalInventoryStr("hostname1", "bootMode", true, false)))
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getBaremetalInventoryStr("hostname2", "bootMode", true, false)))
+			cluster := createClusterFromHosts(hosts)
+			cluster.Platform = createOpenstackPlatformParams()
+			cluster.Platform.Openstack = &models.PlatformOpenstack{
+				Cloud:           swag.String("private-cloud"),
+				ExternalNetwork: swag.String("public"),
+				APIFloatingIP:   swag.String("203.0.113.10"),
+			}
+			err := providerRegistry.AddPlatformToInstallConfig(&cfg, &cluster, nil)
+			Expect(err).To(BeNil())
+			Expect(cfg.Platform.Openstack).ToNot(BeNil())
+			Expect(cfg.Platform.Openstack.APIVIPs[0]).Should(Equal("192.168.10.10"))
+			Expect(cfg.Platform.Openstack.IngressVIPs[0]).Should(Equal("192.168.10.11"))
+			Expect(cfg.Platform.Openstack.Cloud).Should(Equal("private-cloud"))
+			Expect(cfg.Platform.Openstack.ExternalNetwork).Should(Equal("public"))
+			Expect(cfg.Platform.Openstack.APIFloatingIP).Should(Equal("203.0.113.10"))
+			Expect(cfg.Platform.Baremetal).To(BeNil())
+		})
+	})
+
 	Context("oci", func() {
 		It("should set platform name to oci", func() {
 			cfg := getInstallerConfigBaremetal()
@@ -418,6 +453,25 @@ var _ = Describe("Test AddPlatformToInstallConfig", func() {
 		})
 	})
 
+	Context("proxmox", func() {
+		It("should set platform name to proxmox with user managed networking", func() {
+			cfg := getInstallerConfigBaremetal()
+			hosts := make([]*models.Host, 0)
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getBaremetalInventoryStr("hostname0", "bootMode", true, false)))
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getBaremetalInventoryStr("hostname1", "bootMode", true, false)))
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getBaremetalInventoryStr("hostname2", "bootMode", true, false)))
+			cluster := createClusterFromHosts(hosts)
+			cluster.Platform = createExternalProxmoxPlatformParams()
+			cluster.UserManagedNetworking = swag.Bool(true)
+			err := providerRegistry.AddPlatformToInstallConfig(&cfg, &cluster, nil)
+			Expect(err).To(BeNil())
+			Expect(cfg.Platform.External).ToNot(BeNil())
+			Expect(cfg.Platform.External.PlatformName).To(Equal(common.ExternalPlatformNameProxmox))
+			Expect(string(cfg.Platform.External.CloudControllerManager)).To(Equal(models.PlatformExternalCloudControllerManagerEmpty))
+			Expect(cfg.Platform.Baremetal).To(BeNil())
+		})
+	})
+
 	Context("external", func() {
 		It("should set platform name to external - CCM is empty", func() {
 			platformName := "platform-name"
@@ -505,6 +559,15 @@ var _ = Describe("Test SetPlatformUsages", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+	Context("openstack", func() {
+		It("success", func() {
+			props := &map[string]interface{}{"platform_type": models.PlatformTypeOpenstack}
+			usageApi.EXPECT().Add(gomock.Any(), usage.PlatformSelectionUsage, props).Times(1)
+			usageApi.EXPECT().Add(gomock.Any(), usage.OpenstackIntegration, props).Times(1)
+			err := providerRegistry.SetPlatformUsages(createOpenstackPlatformParams(), nil, usageApi)
+			Expect(err).To(BeNil())
+		})
+	})
 	Context("oci", func() {
 		It("success", func() {
 			usageApi.EXPECT().Add(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
@@ -512,6 +575,16 @@ var _ = Describe("Test SetPlatformUsages", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+	Context("proxmox", func() {
+		It("success", func() {
+			usageApi.EXPECT().Add(gomock.Any(), usage.PlatformSelectionUsage, &map[string]interface{}{
+				"platform_type": models.PlatformTypeExternal,
+				"platform_name": common.ExternalPlatformNameProxmox,
+			}).Times(1)
+			err := providerRegistry.SetPlatformUsages(createExternalProxmoxPlatformParams(), nil, usageApi)
+			Expect(err).To(BeNil())
+		})
+	})
 
 	Context("external", func() {
 		It("success", func() {
@@ -594,6 +667,17 @@ func getBaremetalInventoryStr(hostname, bootMode string, ipv4, ipv6 bool) string
 	return string(ret)
 }
 
+func getProxmoxInventoryStr(hostname, bootMode string, ipv4, ipv6 bool) string {
+	inventory := getInventory(hostname, bootMode, ipv4, ipv6)
+	inventory.SystemVendor = &models.SystemVendor{
+		Manufacturer: "QEMU",
+		ProductName:  "Standard PC (Q35 + ICH9, 2009)",
+		Virtual:      true,
+	}
+	ret, _ := json.Marshal(&inventory)
+	return string(ret)
+}
+
 func getNutanixInventoryStr(hostname, bootMode string, ipv4, ipv6 bool) string {
 	inventory := getInventory(hostname, bootMode, ipv4, ipv6)
 	inventory.SystemVendor = &models.SystemVendor{
@@ -628,12 +712,28 @@ func createExternalOciPlatformParams() *models.Platform {
 	}
 }
 
+func createExternalProxmoxPlatformParams() *models.Platform {
+	return &models.Platform{
+		Type: common.PlatformTypePtr(models.PlatformTypeExternal),
+		External: &models.PlatformExternal{
+			PlatformName:           swag.String(common.ExternalPlatformNameProxmox),
+			CloudControllerManager: swag.String(models.PlatformExternalCloudControllerManagerEmpty),
+		},
+	}
+}
+
 func createNutanixPlatformParams() *models.Platform {
 	return &models.Platform{
 		Type: common.PlatformTypePtr(models.PlatformTypeNutanix),
 	}
 }
 
+func createOpenstackPlatformParams() *models.Platform {
+	return &models.Platform{
+		Type: common.PlatformTypePtr(models.PlatformTypeOpenstack),
+	}
+}
+
 func createExternalPlatformParams() *models.Platform {
 	return &models.Platform{
 		Type: common.PlatformTypePtr(models.PlatformTypeExternal),
@@ -3,7 +3,7 @@ package vsphere
 import (
 	"fmt"
 
-	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/hardware/fingerprint"
 	"github.com/openshift/assisted-service/internal/provider"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
@@ -26,15 +26,7 @@ func (p *vsphereProvider) Name() models.PlatformType {
 }
 
 func (p *vsphereProvider) IsHostSupported(host *models.Host) (bool, error) {
-	// during the discovery there is a short time that host didn't return its inventory to the service
-	if host.Inventory == "" {
-		return false, nil
-	}
-	hostInventory, err := common.UnmarshalInventory(host.Inventory)
-	if err != nil {
-		return false, fmt.Errorf("error marshaling host to inventory, error %w", err)
-	}
-	return hostInventory.SystemVendor.Manufacturer == VmwareManufacturer, nil
+	return fingerprint.IsHostInEnvironment(host, models.HostFingerprintEnvironmentVsphere)
 }
 
 func (p *vsphereProvider) AreHostsSupported(hosts []*models.Host) (bool, error) {
@@ -0,0 +1,221 @@
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	LimitActiveClusters   = "active_clusters"
+	LimitHostsPerCluster  = "hosts_per_cluster"
+	LimitISOGenerations   = "iso_generations"
+	LimitRequestsPerClass = "requests"
+)
+
+// Limits are the quotas and rate limits of an organization, or of a user that is not a member
+// of one. Zero values are unlimited.
+type Limits struct {
+	MaxActiveClusters         int64   `json:"max_active_clusters" envconfig:"QUOTA_MAX_ACTIVE_CLUSTERS" default:"100"`
+	MaxHostsPerCluster        int64   `json:"max_hosts_per_cluster" envconfig:"QUOTA_MAX_HOSTS_PER_CLUSTER" default:"500"`
+	MaxISOGenerationsPerHour  int64   `json:"max_iso_generations_per_hour" envconfig:"QUOTA_MAX_ISO_GENERATIONS_PER_HOUR" default:"60"`
+	AgentRequestsPerSecond    float64 `json:"agent_requests_per_second" envconfig:"QUOTA_AGENT_REQUESTS_PER_SECOND" default:"50"`
+	DownloadRequestsPerSecond float64 `json:"download_requests_per_second" envconfig:"QUOTA_DOWNLOAD_REQUESTS_PER_SECOND" default:"2"`
+	ReadRequestsPerSecond     float64 `json:"read_requests_per_second" envconfig:"QUOTA_READ_REQUESTS_PER_SECOND" default:"20"`
+	WriteRequestsPerSecond    float64 `json:"write_requests_per_second" envconfig:"QUOTA_WRITE_REQUESTS_PER_SECOND" default:"5"`
+}
+
+type Config struct {
+	Enabled bool `envconfig:"ENABLE_QUOTAS" default:"false"`
+	Limits
+
+	// OrgOverrides is a JSON object that maps organization IDs, or user names, to the limits that
+	// they override, e.g. {"1234567": {"max_active_clusters": 500}}
+	OrgOverrides string `envconfig:"QUOTA_ORG_OVERRIDES" default:""`
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=quota -destination=mock_quota.go . API
+type API interface {
+	// CheckActiveClusters fails when the caller already has the maximum number of clusters that
+	// are not installed yet. It runs in the transaction that registers the cluster.
+	CheckActiveClusters(ctx context.Context, db *gorm.DB) error
+	// CheckClusterHosts fails when the cluster already has the maximum number of hosts. It runs in
+	// the transaction that adds the host.
+	CheckClusterHosts(ctx context.Context, db *gorm.DB, clusterID strfmt.UUID) error
+	// CheckISOGeneration counts the download of a discovery ISO of the infra-env and fails when
+	// the owner of the infra-env reached the maximum number of ISOs in the last hour
+	CheckISOGeneration(ctx context.Context, infraEnv *common.InfraEnv) error
+}
+
+// Manager applies the limits of the caller, as identified by its organization or its user
+// name. Admins, which include the controllers and the local agents, are not limited. The rates
+// are counted by each replica of the service.
+type Manager struct {
+	Config
+	overrides map[string]Limits
+	metricApi metrics.API
+	log       logrus.FieldLogger
+	buckets   *buckets
+}
+
+var _ API = &Manager{}
+
+func NewManager(cfg Config, metricApi metrics.API, log logrus.FieldLogger) (*Manager, error) {
+	overrides, err := parseOrgOverrides(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{
+		Config:    cfg,
+		overrides: overrides,
+		metricApi: metricApi,
+		log:       log,
+		buckets:   newBuckets(),
+	}, nil
+}
+
+// parseOrgOverrides applies the overrides of each organization on top of the default limits,
+// so only the overridden limits need to be given
+func parseOrgOverrides(cfg Config) (map[string]Limits, error) {
+	overrides := make(map[string]Limits)
+	if cfg.OrgOverrides == "" {
+		return overrides, nil
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(cfg.OrgOverrides), &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the quota overrides of the organizations")
+	}
+	for org, message := range raw {
+		limits := cfg.Limits
+		if err := json.Unmarshal(message, &limits); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the quota overrides of %s", org)
+		}
+		overrides[org] = limits
+	}
+	return overrides, nil
+}
+
+// identity is the organization of the caller, or its user name when it is not a member of one
+func identity(payload *ocm.AuthPayload) string {
+	if payload.Organization != "" {
+		return payload.Organization
+	}
+	return payload.Username
+}
+
+// limitsOf returns the limits that apply to the caller, false when it is not limited
+func (m *Manager) limitsOf(ctx context.Context) (string, Limits, bool) {
+	if !m.Enabled {
+		return "", Limits{}, false
+	}
+	payload := ocm.PayloadFromContext(ctx)
+	if payload.Role == ocm.AdminRole {
+		return "", Limits{}, false
+	}
+	id := identity(payload)
+	return id, m.limitsFor(id), true
+}
+
+func (m *Manager) limitsFor(id string) Limits {
+	if limits, ok := m.overrides[id]; ok {
+		return limits
+	}
+	return m.Limits
+}
+
+func (m *Manager) exceeded(limit string, err error) error {
+	m.metricApi.LimitHit(limit, "")
+	return common.NewApiError(http.StatusTooManyRequests, err)
+}
+
+// activeClusterStatuses are the statuses of the clusters that are being prepared or installed.
+// The installed, errored and cancelled clusters, and the day-2 clusters, are not counted.
+var activeClusterStatuses = []string{
+	models.ClusterStatusPendingForInput,
+	models.ClusterStatusInsufficient,
+	models.ClusterStatusReady,
+	models.ClusterStatusPreparingForInstallation,
+	models.ClusterStatusInstalling,
+	models.ClusterStatusInstallingPendingUserAction,
+	models.ClusterStatusFinalizing,
+}
+
+const activeClustersLockPrefix = "quota_active_clusters:"
+
+func (m *Manager) CheckActiveClusters(ctx context.Context, db *gorm.DB) error {
+	id, limits, ok := m.limitsOf(ctx)
+	if !ok || limits.MaxActiveClusters == 0 {
+		return nil
+	}
+	// Concurrent registrations of the same owner wait for each other, so that they can not all
+	// see the count below the limit
+	if err := db.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", activeClustersLockPrefix+id).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	payload := ocm.PayloadFromContext(ctx)
+	query := db.Model(&common.Cluster{}).
+		Where("status IN (?)", activeClusterStatuses).
+		Where("kind = ?", models.ClusterKindCluster)
+	if payload.Organization != "" {
+		query = query.Where("org_id = ?", payload.Organization)
+	} else {
+		query = query.Where("user_name = ?", payload.Username)
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if count >= limits.MaxActiveClusters {
+		return m.exceeded(LimitActiveClusters, errors.Errorf("%s reached its quota of %d active clusters", id, limits.MaxActiveClusters))
+	}
+	return nil
+}
+
+func (m *Manager) CheckClusterHosts(ctx context.Context, db *gorm.DB, clusterID strfmt.UUID) error {
+	_, limits, ok := m.limitsOf(ctx)
+	if !ok || limits.MaxHostsPerCluster == 0 {
+		return nil
+	}
+	var count int64
+	if err := db.Model(&common.Host{}).Where("cluster_id = ?", clusterID.String()).Count(&count).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if count >= limits.MaxHostsPerCluster {
+		return m.exceeded(LimitHostsPerCluster, errors.Errorf("cluster %s reached its quota of %d hosts", clusterID, limits.MaxHostsPerCluster))
+	}
+	return nil
+}
+
+// CheckISOGeneration is called by the downloads of the discovery ignition, which the image service
+// makes for every ISO that it generates. Those are authenticated with the image token of the
+// infra-env, so the ISOs are counted against the owner of the infra-env rather than the caller.
+// The infra-envs without an owner, such as the ones of the controllers, are not limited.
+func (m *Manager) CheckISOGeneration(ctx context.Context, infraEnv *common.InfraEnv) error {
+	if !m.Enabled {
+		return nil
+	}
+	id := infraEnv.OrgID
+	if id == "" {
+		id = infraEnv.UserName
+	}
+	limits := m.limitsFor(id)
+	if id == "" || limits.MaxISOGenerationsPerHour == 0 {
+		return nil
+	}
+	perHour := float64(limits.MaxISOGenerationsPerHour)
+	if delay := m.buckets.take(bucketKey{identity: id, limit: LimitISOGenerations}, perHour/time.Hour.Seconds(), int(perHour)); delay > 0 {
+		return m.exceeded(LimitISOGenerations, errors.Errorf("%s reached its quota of %d ISO generations per hour, retry in %s",
+			id, limits.MaxISOGenerationsPerHour, delay.Round(time.Second)))
+	}
+	return nil
+}
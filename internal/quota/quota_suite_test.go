@@ -0,0 +1,16 @@
+package quota
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+func TestQuota(t *testing.T) {
+	RegisterFailHandler(Fail)
+	common.InitializeDBTest()
+	defer common.TerminateDBTest()
+	RunSpecs(t, "Quota Suite")
+}
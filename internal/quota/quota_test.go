@@ -0,0 +1,112 @@
+package quota
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Quotas", func() {
+	var (
+		ctrl       *gomock.Controller
+		mockMetric *metrics.MockAPI
+		db         *gorm.DB
+		dbName     string
+		manager    *Manager
+		ctx        context.Context
+	)
+
+	createClusterOfKind := func(orgID, status, kind string) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:       &id,
+			OrgID:    orgID,
+			UserName: "jdoe",
+			Status:   swag.String(status),
+			Kind:     swag.String(kind),
+		}}).Error).ToNot(HaveOccurred())
+		return id
+	}
+
+	createCluster := func(orgID, status string) strfmt.UUID {
+		return createClusterOfKind(orgID, status, models.ClusterKindCluster)
+	}
+
+	createHost := func(clusterID strfmt.UUID) {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Host{Host: models.Host{
+			ID:         &id,
+			ClusterID:  &clusterID,
+			InfraEnvID: strfmt.UUID(uuid.New().String()),
+		}}).Error).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockMetric = metrics.NewMockAPI(ctrl)
+		db, dbName = common.PrepareTestDB()
+		var err error
+		manager, err = NewManager(Config{
+			Enabled: true,
+			Limits:  Limits{MaxActiveClusters: 2, MaxHostsPerCluster: 2},
+		}, mockMetric, common.GetTestLog())
+		Expect(err).ToNot(HaveOccurred())
+		ctx = withPayload(context.Background(), &ocm.AuthPayload{Username: "jdoe", Organization: "org1", Role: ocm.UserRole})
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	It("limits the active clusters of the organization", func() {
+		createCluster("org1", models.ClusterStatusInstalled)
+		createCluster("org1", models.ClusterStatusReady)
+		createCluster("org2", models.ClusterStatusReady)
+		Expect(manager.CheckActiveClusters(ctx, db)).To(Succeed())
+
+		createCluster("org1", models.ClusterStatusInstalling)
+		mockMetric.EXPECT().LimitHit(LimitActiveClusters, "")
+		err := manager.CheckActiveClusters(ctx, db)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusTooManyRequests)))
+	})
+
+	It("counts only the clusters that are being prepared or installed", func() {
+		createCluster("org1", models.ClusterStatusReady)
+		createCluster("org1", models.ClusterStatusError)
+		createCluster("org1", models.ClusterStatusCancelled)
+		createCluster("org1", models.ClusterStatusInstalled)
+		createClusterOfKind("org1", models.ClusterStatusAddingHosts, models.ClusterKindAddHostsCluster)
+		Expect(manager.CheckActiveClusters(ctx, db)).To(Succeed())
+	})
+
+	It("limits the hosts of a cluster", func() {
+		clusterID := createCluster("org1", models.ClusterStatusInsufficient)
+		createHost(clusterID)
+		Expect(manager.CheckClusterHosts(ctx, db, clusterID)).To(Succeed())
+
+		createHost(clusterID)
+		mockMetric.EXPECT().LimitHit(LimitHostsPerCluster, "")
+		err := manager.CheckClusterHosts(ctx, db, clusterID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusTooManyRequests)))
+	})
+
+	It("does not limit the admins", func() {
+		createCluster("", models.ClusterStatusReady)
+		createCluster("", models.ClusterStatusReady)
+		Expect(manager.CheckActiveClusters(withPayload(context.Background(), ocm.AdminPayload()), db)).To(Succeed())
+	})
+})
@@ -0,0 +1,158 @@
+package quota
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openshift/assisted-service/internal/common"
+	"golang.org/x/time/rate"
+)
+
+// The endpoint classes that the rates of requests are limited by
+const (
+	EndpointClassAgent    = "agent"
+	EndpointClassDownload = "download"
+	EndpointClassRead     = "read"
+	EndpointClassWrite    = "write"
+)
+
+const (
+	// burstSeconds is the number of seconds worth of requests that can be sent at once
+	burstSeconds = 2
+
+	// idleBucketTimeout is the time after which the bucket of a caller is dropped, it is full by
+	// then for all the limits
+	idleBucketTimeout = time.Hour
+)
+
+// RequestLimitError is returned by the authorizer when the caller exceeded its rate of requests
+type RequestLimitError struct {
+	*common.ApiErrorResponse
+	retryAfter time.Duration
+}
+
+// RetryAfter is the time after which the request would be allowed, it is returned in the
+// Retry-After header of the response
+func (e *RequestLimitError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// Authorizer wraps the authorizer of the API to limit the rate of requests of each caller by
+// endpoint class. It runs first, so the rejected requests cost no access review.
+func (m *Manager) Authorizer(authorizer func(*http.Request) error) func(*http.Request) error {
+	return func(r *http.Request) error {
+		if id, limits, ok := m.limitsOf(r.Context()); ok {
+			class := endpointClass(r)
+			if perSecond := limits.requestsPerSecond(class); perSecond > 0 {
+				if delay := m.buckets.take(bucketKey{identity: id, limit: class}, perSecond, requestsBurst(perSecond)); delay > 0 {
+					m.metricApi.LimitHit(LimitRequestsPerClass, class)
+					return &RequestLimitError{
+						ApiErrorResponse: common.NewApiError(http.StatusTooManyRequests,
+							fmt.Errorf("%s exceeded its rate of %g %s requests per second", id, perSecond, class)),
+						retryAfter: delay,
+					}
+				}
+			}
+		}
+		if authorizer == nil {
+			return nil
+		}
+		return authorizer(r)
+	}
+}
+
+// endpointClass classifies the requests: the ones of the agents, the downloads of files, which
+// are costly, and the other reads and writes
+func endpointClass(r *http.Request) string {
+	route := middleware.MatchedRouteFrom(r)
+	if route != nil && route.Authenticator != nil && len(route.Authenticator.Schemes) > 0 &&
+		route.Authenticator.Schemes[0] == "agentAuth" {
+		return EndpointClassAgent
+	}
+	if route != nil && route.Operation != nil && strings.Contains(strings.ToLower(route.Operation.ID), "download") {
+		return EndpointClassDownload
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return EndpointClassRead
+	default:
+		return EndpointClassWrite
+	}
+}
+
+func (l Limits) requestsPerSecond(class string) float64 {
+	switch class {
+	case EndpointClassAgent:
+		return l.AgentRequestsPerSecond
+	case EndpointClassDownload:
+		return l.DownloadRequestsPerSecond
+	case EndpointClassRead:
+		return l.ReadRequestsPerSecond
+	default:
+		return l.WriteRequestsPerSecond
+	}
+}
+
+func requestsBurst(perSecond float64) int {
+	return int(math.Max(1, math.Ceil(perSecond*burstSeconds)))
+}
+
+type bucketKey struct {
+	identity string
+	limit    string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// buckets are the token buckets of the callers, by limit
+type buckets struct {
+	sync.Mutex
+	limiters  map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+func newBuckets() *buckets {
+	return &buckets{
+		limiters:  make(map[bucketKey]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// take takes a token from the bucket of the key, it returns how long to wait for one when the
+// bucket is empty
+func (b *buckets) take(key bucketKey, perSecond float64, burst int) time.Duration {
+	b.Lock()
+	defer b.Unlock()
+
+	now := time.Now()
+	if now.Sub(b.lastSweep) > idleBucketTimeout {
+		for k, v := range b.limiters {
+			if now.Sub(v.lastUsed) > idleBucketTimeout {
+				delete(b.limiters, k)
+			}
+		}
+		b.lastSweep = now
+	}
+
+	current, ok := b.limiters[key]
+	if !ok {
+		current = &bucket{limiter: rate.NewLimiter(rate.Limit(perSecond), burst)}
+		b.limiters[key] = current
+	}
+	current.lastUsed = now
+
+	reservation := current.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay
+	}
+	return 0
+}
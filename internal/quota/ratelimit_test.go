@@ -0,0 +1,140 @@
+package quota
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+)
+
+func withPayload(ctx context.Context, payload *ocm.AuthPayload) context.Context {
+	return context.WithValue(ctx, restapi.AuthKey, payload)
+}
+
+var _ = Describe("parseOrgOverrides", func() {
+	It("overrides the given limits only", func() {
+		overrides, err := parseOrgOverrides(Config{
+			Limits:       Limits{MaxActiveClusters: 10, WriteRequestsPerSecond: 5},
+			OrgOverrides: `{"org1": {"max_active_clusters": 50}}`,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(overrides).To(HaveKey("org1"))
+		Expect(overrides["org1"].MaxActiveClusters).To(Equal(int64(50)))
+		Expect(overrides["org1"].WriteRequestsPerSecond).To(Equal(float64(5)))
+	})
+
+	It("fails on invalid overrides", func() {
+		_, err := parseOrgOverrides(Config{OrgOverrides: `{"org1": 50}`})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Rate limits", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockMetric    *metrics.MockAPI
+		manager       *Manager
+		authorizerHit int
+		authorize     func(*http.Request) error
+	)
+
+	newRequest := func(method string, payload *ocm.AuthPayload) *http.Request {
+		request := httptest.NewRequest(method, "/", nil)
+		return request.WithContext(withPayload(request.Context(), payload))
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockMetric = metrics.NewMockAPI(ctrl)
+		var err error
+		manager, err = NewManager(Config{
+			Enabled:      true,
+			Limits:       Limits{WriteRequestsPerSecond: 0.5, MaxISOGenerationsPerHour: 2},
+			OrgOverrides: `{"org2": {"write_requests_per_second": 0}}`,
+		}, mockMetric, common.GetTestLog())
+		Expect(err).ToNot(HaveOccurred())
+		authorizerHit = 0
+		authorize = manager.Authorizer(func(*http.Request) error {
+			authorizerHit++
+			return nil
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("limits the rate of requests by endpoint class", func() {
+		user := &ocm.AuthPayload{Username: "jdoe", Organization: "org1", Role: ocm.UserRole}
+		Expect(authorize(newRequest(http.MethodPost, user))).To(Succeed())
+
+		mockMetric.EXPECT().LimitHit(LimitRequestsPerClass, EndpointClassWrite)
+		err := authorize(newRequest(http.MethodPost, user))
+		Expect(err).To(BeAssignableToTypeOf(&RequestLimitError{}))
+		Expect(err.(*RequestLimitError).StatusCode()).To(Equal(int32(http.StatusTooManyRequests)))
+		Expect(err.(*RequestLimitError).RetryAfter()).To(BeNumerically(">", 0))
+
+		By("not limiting the reads, that have no limit")
+		Expect(authorize(newRequest(http.MethodGet, user))).To(Succeed())
+		Expect(authorizerHit).To(Equal(2))
+	})
+
+	It("limits each organization separately", func() {
+		Expect(authorize(newRequest(http.MethodPost, &ocm.AuthPayload{Username: "jdoe", Organization: "org1", Role: ocm.UserRole}))).To(Succeed())
+		Expect(authorize(newRequest(http.MethodPost, &ocm.AuthPayload{Username: "jdoe", Organization: "org3", Role: ocm.UserRole}))).To(Succeed())
+	})
+
+	It("applies the overrides of the organization", func() {
+		user := &ocm.AuthPayload{Username: "jdoe", Organization: "org2", Role: ocm.UserRole}
+		for i := 0; i < 5; i++ {
+			Expect(authorize(newRequest(http.MethodPost, user))).To(Succeed())
+		}
+	})
+
+	It("does not limit the admins", func() {
+		for i := 0; i < 5; i++ {
+			Expect(authorize(newRequest(http.MethodPost, ocm.AdminPayload()))).To(Succeed())
+		}
+	})
+
+	It("limits the ISO generations of the owner of the infra-env", func() {
+		// The image service downloads the ignition with the image token of the infra-env
+		ctx := context.Background()
+		infraEnv := &common.InfraEnv{InfraEnv: models.InfraEnv{UserName: "jdoe", OrgID: "org1"}}
+		Expect(manager.CheckISOGeneration(ctx, infraEnv)).To(Succeed())
+		Expect(manager.CheckISOGeneration(ctx, infraEnv)).To(Succeed())
+
+		mockMetric.EXPECT().LimitHit(LimitISOGenerations, "").Times(2)
+		err := manager.CheckISOGeneration(ctx, infraEnv)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusTooManyRequests)))
+
+		By("counting the infra-envs of the same organization together")
+		err = manager.CheckISOGeneration(ctx, &common.InfraEnv{InfraEnv: models.InfraEnv{UserName: "other", OrgID: "org1"}})
+		Expect(err).To(HaveOccurred())
+
+		By("not counting the infra-envs of other owners")
+		Expect(manager.CheckISOGeneration(ctx, &common.InfraEnv{InfraEnv: models.InfraEnv{UserName: "jdoe"}})).To(Succeed())
+
+		By("not limiting the infra-envs without an owner")
+		Expect(manager.CheckISOGeneration(ctx, &common.InfraEnv{})).To(Succeed())
+	})
+
+	It("does not limit anything when disabled", func() {
+		manager.Enabled = false
+		authorize = manager.Authorizer(nil)
+		user := &ocm.AuthPayload{Username: "jdoe", Organization: "org1", Role: ocm.UserRole}
+		for i := 0; i < 5; i++ {
+			Expect(authorize(newRequest(http.MethodPost, user))).To(Succeed())
+			Expect(manager.CheckISOGeneration(context.Background(), &common.InfraEnv{InfraEnv: models.InfraEnv{OrgID: "org1"}})).To(Succeed())
+		}
+	})
+})
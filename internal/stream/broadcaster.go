@@ -0,0 +1,225 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/transaction"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const DefaultSubscriptionBufferSize = 256
+
+// Message is a notification as delivered to the subscribers of a Broadcaster.
+// The payload is encoded when the notification is sent, so later changes to the
+// notified object are not visible to subscribers.
+type Message struct {
+	Name    string
+	HostID  *strfmt.UUID
+	Payload json.RawMessage
+
+	// UpdatedAt is the update time of the cluster or host the payload describes
+	UpdatedAt time.Time
+
+	// EventID is the database ID of the event the payload describes, zero if it was not stored
+	EventID uint
+}
+
+// Subscriber is implemented by notifiers that deliver notifications to in-process subscribers
+type Subscriber interface {
+	Subscribe(clusterID strfmt.UUID) *Subscription
+}
+
+// Subscription receives the cluster, host and event notifications of a single cluster.
+// Messages are dropped when the subscriber does not keep up, in which case Lagged is
+// signaled so that the subscriber can reload the state it missed.
+type Subscription struct {
+	broadcaster *Broadcaster
+	clusterID   strfmt.UUID
+	messages    chan *Message
+	lagged      chan struct{}
+	closeOnce   sync.Once
+}
+
+func (s *Subscription) Messages() <-chan *Message {
+	return s.messages
+}
+
+func (s *Subscription) Lagged() <-chan struct{} {
+	return s.lagged
+}
+
+func (s *Subscription) Close() {
+	s.broadcaster.unsubscribe(s)
+}
+
+func (s *Subscription) deliver(msg *Message) {
+	select {
+	case s.messages <- msg:
+	default:
+		select {
+		case s.lagged <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Broadcaster forwards notifications to the wrapped notifier and fans out the cluster, host
+// and event notifications to the subscribers of the cluster they belong to. Subscribers only
+// see the notifications sent by this process. Notifications sent as part of a transaction
+// are fanned out once it commits, and not at all when it rolls back.
+type Broadcaster struct {
+	notifier   Notifier
+	bufferSize int
+	log        logrus.FieldLogger
+
+	lock          sync.RWMutex
+	subscriptions map[strfmt.UUID]map[*Subscription]struct{}
+}
+
+var (
+	_ TransactionalNotifier = &Broadcaster{}
+	_ Subscriber            = &Broadcaster{}
+)
+
+func NewBroadcaster(notifier Notifier, bufferSize int, log logrus.FieldLogger) *Broadcaster {
+	if bufferSize <= 0 {
+		bufferSize = DefaultSubscriptionBufferSize
+	}
+	return &Broadcaster{
+		notifier:      notifier,
+		bufferSize:    bufferSize,
+		log:           log,
+		subscriptions: make(map[strfmt.UUID]map[*Subscription]struct{}),
+	}
+}
+
+func (b *Broadcaster) Notify(ctx context.Context, notifiable common.Notifiable) error {
+	err := b.notifier.Notify(ctx, notifiable)
+	if clusterID, msg := b.message(notifiable); msg != nil {
+		b.broadcast(clusterID, msg)
+	}
+	return err
+}
+
+func (b *Broadcaster) NotifyWithDB(ctx context.Context, db *gorm.DB, notifiable common.Notifiable) error {
+	if err := NotifyWithDB(ctx, b.notifier, db, notifiable); err != nil {
+		return err
+	}
+	// The message is encoded now, the notifiable may change before the transaction commits
+	if clusterID, msg := b.message(notifiable); msg != nil {
+		transaction.AfterCommit(db, func() {
+			b.broadcast(clusterID, msg)
+		})
+	}
+	return nil
+}
+
+func (b *Broadcaster) Subscribe(clusterID strfmt.UUID) *Subscription {
+	subscription := &Subscription{
+		broadcaster: b,
+		clusterID:   clusterID,
+		messages:    make(chan *Message, b.bufferSize),
+		lagged:      make(chan struct{}, 1),
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.subscriptions[clusterID] == nil {
+		b.subscriptions[clusterID] = make(map[*Subscription]struct{})
+	}
+	b.subscriptions[clusterID][subscription] = struct{}{}
+	return subscription
+}
+
+func (b *Broadcaster) unsubscribe(subscription *Subscription) {
+	subscription.closeOnce.Do(func() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		if subscriptions, ok := b.subscriptions[subscription.clusterID]; ok {
+			delete(subscriptions, subscription)
+			if len(subscriptions) == 0 {
+				delete(b.subscriptions, subscription.clusterID)
+			}
+		}
+		close(subscription.messages)
+	})
+}
+
+// message returns the message to deliver to the subscribers of the cluster the notifiable
+// belongs to, or nil when the cluster has no subscribers
+func (b *Broadcaster) message(notifiable common.Notifiable) (strfmt.UUID, *Message) {
+	if notifiable == nil || reflect.ValueOf(notifiable).IsNil() {
+		return "", nil
+	}
+	clusterID := notifiable.GetClusterID()
+	if clusterID == nil {
+		return "", nil
+	}
+	b.lock.RLock()
+	subscribed := len(b.subscriptions[*clusterID]) > 0
+	b.lock.RUnlock()
+	if !subscribed {
+		return "", nil
+	}
+	msg, err := NewMessage(notifiable)
+	if err != nil {
+		b.log.WithError(err).Warnf("failed to encode %s notification for cluster %s subscribers",
+			notifiable.NotificationType(), clusterID)
+		return "", nil
+	}
+	return *clusterID, msg
+}
+
+func (b *Broadcaster) broadcast(clusterID strfmt.UUID, msg *Message) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	for subscription := range b.subscriptions[clusterID] {
+		subscription.deliver(msg)
+	}
+}
+
+func (b *Broadcaster) Close() {
+	b.lock.Lock()
+	subscriptions := make([]*Subscription, 0)
+	for _, clusterSubscriptions := range b.subscriptions {
+		for subscription := range clusterSubscriptions {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	b.lock.Unlock()
+	for _, subscription := range subscriptions {
+		subscription.Close()
+	}
+	b.notifier.Close()
+}
+
+// NewMessage encodes the notifiable the way it is delivered to subscribers.
+// It returns nil for notification types that are not delivered to subscribers.
+func NewMessage(notifiable common.Notifiable) (*Message, error) {
+	msg := &Message{
+		Name:   notifiable.NotificationType(),
+		HostID: notifiable.GetHostID(),
+	}
+	switch n := notifiable.(type) {
+	case *common.Cluster:
+		msg.UpdatedAt = n.UpdatedAt
+	case *common.Host:
+		msg.UpdatedAt = n.UpdatedAt
+	case *common.Event:
+		msg.EventID = n.ID
+	default:
+		return nil, nil
+	}
+	payload, err := json.Marshal(notifiable.Payload())
+	if err != nil {
+		return nil, err
+	}
+	msg.Payload = payload
+	return msg, nil
+}
@@ -0,0 +1,198 @@
+package stream_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Broadcaster", func() {
+	var (
+		ctx          = context.Background()
+		ctrl         *gomock.Controller
+		notifier     *stream.MockNotifier
+		broadcaster  *stream.Broadcaster
+		clusterID    strfmt.UUID
+		subscription *stream.Subscription
+	)
+
+	BeforeEach(func() {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		ctrl = gomock.NewController(GinkgoT())
+		notifier = stream.NewMockNotifier(ctrl)
+		broadcaster = stream.NewBroadcaster(notifier, 2, logger)
+		clusterID = strfmt.UUID(uuid.New().String())
+		subscription = broadcaster.Subscribe(clusterID)
+	})
+
+	AfterEach(func() {
+		subscription.Close()
+		ctrl.Finish()
+	})
+
+	receive := func() *stream.Message {
+		var msg *stream.Message
+		Eventually(subscription.Messages()).Should(Receive(&msg))
+		return msg
+	}
+
+	It("forwards notifications to the wrapped notifier and the cluster subscribers", func() {
+		updatedAt := time.Now().Truncate(time.Second)
+		cluster := &common.Cluster{Cluster: models.Cluster{
+			ID:        &clusterID,
+			Status:    swag.String(models.ClusterStatusInstalling),
+			UpdatedAt: updatedAt,
+		}}
+		notifier.EXPECT().Notify(ctx, cluster).Return(nil).Times(1)
+		Expect(broadcaster.Notify(ctx, cluster)).To(Succeed())
+
+		msg := receive()
+		Expect(msg.Name).To(Equal(common.NotificationTypeCluster))
+		Expect(msg.UpdatedAt.Equal(updatedAt)).To(BeTrue())
+		var payload models.Cluster
+		Expect(json.Unmarshal(msg.Payload, &payload)).To(Succeed())
+		Expect(payload.ID).To(Equal(&clusterID))
+		Expect(payload.Status).To(Equal(swag.String(models.ClusterStatusInstalling)))
+	})
+
+	It("encodes the payload when notified", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		host := &common.Host{Host: models.Host{ID: &hostID, ClusterID: &clusterID, Status: swag.String(models.HostStatusKnown)}}
+		notifier.EXPECT().Notify(ctx, host).Return(nil).Times(1)
+		Expect(broadcaster.Notify(ctx, host)).To(Succeed())
+		host.Status = swag.String(models.HostStatusInstalling)
+
+		msg := receive()
+		Expect(msg.Name).To(Equal(common.NotificationTypeHost))
+		Expect(msg.HostID).To(Equal(&hostID))
+		var payload models.Host
+		Expect(json.Unmarshal(msg.Payload, &payload)).To(Succeed())
+		Expect(payload.Status).To(Equal(swag.String(models.HostStatusKnown)))
+	})
+
+	It("delivers events with their database ID", func() {
+		event := &common.Event{Model: gorm.Model{ID: 42}, Event: models.Event{ClusterID: &clusterID, Name: "some_event"}}
+		notifier.EXPECT().Notify(ctx, event).Return(nil).Times(1)
+		Expect(broadcaster.Notify(ctx, event)).To(Succeed())
+
+		msg := receive()
+		Expect(msg.Name).To(Equal(common.NotificationTypeEvent))
+		Expect(msg.EventID).To(BeEquivalentTo(42))
+	})
+
+	It("delivers notifications even when the wrapped notifier fails", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		notifier.EXPECT().Notify(ctx, cluster).Return(errors.New("failed")).Times(1)
+		Expect(broadcaster.Notify(ctx, cluster)).ToNot(Succeed())
+		Expect(receive().Name).To(Equal(common.NotificationTypeCluster))
+	})
+
+	It("records transactional notifications through the wrapped notifier", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		notifier.EXPECT().Notify(ctx, cluster).Return(nil).Times(1)
+		Expect(broadcaster.NotifyWithDB(ctx, &gorm.DB{}, cluster)).To(Succeed())
+		Expect(receive().Name).To(Equal(common.NotificationTypeCluster))
+	})
+
+	It("does not deliver transactional notifications that could not be recorded", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		transactional := stream.NewMockTransactionalNotifier(ctrl)
+		transactional.EXPECT().NotifyWithDB(ctx, gomock.Any(), cluster).Return(errors.New("failed")).Times(1)
+		transactionalBroadcaster := stream.NewBroadcaster(transactional, 2, common.GetTestLog())
+		transactionalSubscription := transactionalBroadcaster.Subscribe(clusterID)
+		defer transactionalSubscription.Close()
+		Expect(transactionalBroadcaster.NotifyWithDB(ctx, &gorm.DB{}, cluster)).ToNot(Succeed())
+		Consistently(transactionalSubscription.Messages(), 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	Context("within a transaction", func() {
+		var (
+			db     *gorm.DB
+			dbName string
+		)
+
+		BeforeEach(func() {
+			db, dbName = prepareTestDB()
+		})
+
+		AfterEach(func() {
+			common.DeleteTestDB(db, dbName)
+		})
+
+		It("delivers notifications once the transaction commits", func() {
+			cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+			notifier.EXPECT().Notify(ctx, cluster).Return(nil).Times(1)
+			Expect(db.Transaction(func(tx *gorm.DB) error {
+				Expect(broadcaster.NotifyWithDB(ctx, tx, cluster)).To(Succeed())
+				Expect(subscription.Messages()).To(BeEmpty())
+				return nil
+			})).To(Succeed())
+			Expect(receive().Name).To(Equal(common.NotificationTypeCluster))
+		})
+
+		It("does not deliver notifications when the transaction rolls back", func() {
+			cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+			notifier.EXPECT().Notify(ctx, cluster).Return(nil).Times(1)
+			Expect(db.Transaction(func(tx *gorm.DB) error {
+				Expect(broadcaster.NotifyWithDB(ctx, tx, cluster)).To(Succeed())
+				return errors.New("rollback")
+			})).ToNot(Succeed())
+			Consistently(subscription.Messages(), 100*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+
+	It("does not deliver notifications of other clusters", func() {
+		otherClusterID := strfmt.UUID(uuid.New().String())
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &otherClusterID}}
+		notifier.EXPECT().Notify(ctx, cluster).Return(nil).Times(1)
+		Expect(broadcaster.Notify(ctx, cluster)).To(Succeed())
+		Consistently(subscription.Messages(), 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("does not deliver infra-env notifications", func() {
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		infraEnv := &common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID, ClusterID: clusterID}}
+		notifier.EXPECT().Notify(ctx, infraEnv).Return(nil).Times(1)
+		Expect(broadcaster.Notify(ctx, infraEnv)).To(Succeed())
+		Consistently(subscription.Messages(), 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("signals lagging subscribers instead of blocking", func() {
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		notifier.EXPECT().Notify(ctx, cluster).Return(nil).Times(4)
+		for i := 0; i < 4; i++ {
+			Expect(broadcaster.Notify(ctx, cluster)).To(Succeed())
+		}
+		Expect(subscription.Lagged()).To(Receive())
+		Expect(subscription.Messages()).To(HaveLen(2))
+	})
+
+	It("stops delivering to closed subscriptions", func() {
+		subscription.Close()
+		Expect(subscription.Messages()).To(BeClosed())
+		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterID}}
+		notifier.EXPECT().Notify(ctx, cluster).Return(nil).Times(1)
+		Expect(broadcaster.Notify(ctx, cluster)).To(Succeed())
+	})
+
+	It("closes the subscriptions and the wrapped notifier when closed", func() {
+		notifier.EXPECT().Close().Times(1)
+		broadcaster.Close()
+		Expect(subscription.Messages()).To(BeClosed())
+	})
+})
@@ -0,0 +1,62 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/openshift/assisted-service/pkg/nats"
+)
+
+//go:generate mockgen -source=bus_writer.go -package=stream -destination=mock_bus_writer.go
+type MessagePublisher interface {
+	Publish(ctx context.Context, subject string, data []byte) error
+	Close() error
+}
+
+var invalidSubjectChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// BusWriter publishes envelopes to a subject based message bus. Each envelope
+// is published under "<subject>.<envelope name>" so that consumers can
+// subscribe to a subset of the notification types.
+type BusWriter struct {
+	publisher MessagePublisher
+	subject   string
+}
+
+func NewBusWriter(publisher MessagePublisher, subject string) *BusWriter {
+	return &BusWriter{
+		publisher: publisher,
+		subject:   subject,
+	}
+}
+
+func NewNATSBusWriter() (*BusWriter, error) {
+	publisher, err := nats.NewPublisher()
+	if err != nil {
+		return nil, err
+	}
+	return NewBusWriter(publisher, publisher.Subject()), nil
+}
+
+func (w *BusWriter) subjectFor(value interface{}) string {
+	envelope, ok := value.(*Envelope)
+	if !ok || envelope.Name == "" {
+		return w.subject
+	}
+	return fmt.Sprintf("%s.%s", w.subject, strings.ToLower(invalidSubjectChars.ReplaceAllString(envelope.Name, "_")))
+}
+
+func (w *BusWriter) Write(ctx context.Context, key []byte, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return w.publisher.Publish(ctx, w.subjectFor(value), data)
+}
+
+func (w *BusWriter) Close() {
+	w.publisher.Close()
+}
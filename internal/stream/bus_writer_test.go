@@ -0,0 +1,60 @@
+package stream_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+)
+
+var _ = Describe("BusWriter", func() {
+	var (
+		ctx       = context.Background()
+		ctrl      *gomock.Controller
+		publisher *stream.MockMessagePublisher
+		writer    *stream.BusWriter
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		publisher = stream.NewMockMessagePublisher(ctrl)
+		writer = stream.NewBusWriter(publisher, "assisted")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("publishes envelopes under a per notification type subject", func() {
+		envelope := &stream.Envelope{Name: common.NotificationTypeHost, Payload: map[string]string{"status": "known"}}
+		expected, err := json.Marshal(envelope)
+		Expect(err).ToNot(HaveOccurred())
+		publisher.EXPECT().Publish(ctx, "assisted.hoststate", expected).Return(nil).Times(1)
+		Expect(writer.Write(ctx, []byte("key"), envelope)).To(Succeed())
+	})
+
+	It("sanitizes subject tokens", func() {
+		publisher.EXPECT().Publish(ctx, "assisted.my_event_name", gomock.Any()).Return(nil).Times(1)
+		Expect(writer.Write(ctx, nil, &stream.Envelope{Name: "My Event.Name"})).To(Succeed())
+	})
+
+	It("publishes arbitrary values under the base subject", func() {
+		publisher.EXPECT().Publish(ctx, "assisted", []byte(`{"foo":"bar"}`)).Return(nil).Times(1)
+		Expect(writer.Write(ctx, nil, map[string]string{"foo": "bar"})).To(Succeed())
+	})
+
+	It("returns publisher errors", func() {
+		publisher.EXPECT().Publish(ctx, gomock.Any(), gomock.Any()).Return(errors.New("bus is down")).Times(1)
+		Expect(writer.Write(ctx, nil, &stream.Envelope{Name: "Event"})).To(MatchError("bus is down"))
+	})
+
+	It("closes the publisher", func() {
+		publisher.EXPECT().Close().Return(nil).Times(1)
+		writer.Close()
+	})
+})
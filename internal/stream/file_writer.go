@@ -0,0 +1,74 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type FileWriterConfig struct {
+	Path string `envconfig:"EVENT_STREAM_FILE_PATH" required:"true"`
+	Sync bool   `envconfig:"EVENT_STREAM_FILE_SYNC" default:"false"`
+}
+
+// FileWriter appends every envelope as a single JSON line to a local file
+type FileWriter struct {
+	config *FileWriterConfig
+	lock   sync.Mutex
+	file   *os.File
+}
+
+func NewFileWriter() (*FileWriter, error) {
+	config := &FileWriterConfig{}
+	if err := envconfig.Process("", config); err != nil {
+		return nil, err
+	}
+	return NewFileWriterWithConfig(config)
+}
+
+func NewFileWriterWithConfig(config *FileWriterConfig) (*FileWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(config.Path), 0700); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileWriter{
+		config: config,
+		file:   file,
+	}, nil
+}
+
+func (w *FileWriter) Write(ctx context.Context, key []byte, value interface{}) error {
+	line, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.file == nil {
+		return os.ErrClosed
+	}
+	if _, err = w.file.Write(line); err != nil {
+		return err
+	}
+	if w.config.Sync {
+		return w.file.Sync()
+	}
+	return nil
+}
+
+func (w *FileWriter) Close() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.file != nil {
+		w.file.Close()
+		w.file = nil
+	}
+}
@@ -0,0 +1,81 @@
+package stream_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/stream"
+)
+
+var _ = Describe("FileWriter", func() {
+	var (
+		ctx     = context.Background()
+		tempDir string
+		path    string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "stream_file_writer_test")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(tempDir, "nested", "events.jsonl")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	readLines := func() []map[string]interface{} {
+		f, err := os.Open(path)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		var lines []map[string]interface{}
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var line map[string]interface{}
+			Expect(json.Unmarshal(scanner.Bytes(), &line)).To(Succeed())
+			lines = append(lines, line)
+		}
+		return lines
+	}
+
+	It("appends one envelope per line", func() {
+		writer, err := stream.NewFileWriterWithConfig(&stream.FileWriterConfig{Path: path, Sync: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Write(ctx, []byte("key"), &stream.Envelope{Name: "Event", Payload: map[string]string{"message": "first"}})).To(Succeed())
+		Expect(writer.Write(ctx, []byte("key"), &stream.Envelope{Name: "HostState", Payload: map[string]string{"status": "known"}})).To(Succeed())
+		writer.Close()
+
+		lines := readLines()
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]["Name"]).To(Equal("Event"))
+		Expect(lines[1]["Name"]).To(Equal("HostState"))
+		Expect(lines[1]["Payload"]).To(Equal(map[string]interface{}{"status": "known"}))
+	})
+
+	It("keeps existing content when reopened", func() {
+		writer, err := stream.NewFileWriterWithConfig(&stream.FileWriterConfig{Path: path})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Write(ctx, nil, &stream.Envelope{Name: "Event"})).To(Succeed())
+		writer.Close()
+
+		writer, err = stream.NewFileWriterWithConfig(&stream.FileWriterConfig{Path: path})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Write(ctx, nil, &stream.Envelope{Name: "ClusterState"})).To(Succeed())
+		writer.Close()
+
+		Expect(readLines()).To(HaveLen(2))
+	})
+
+	It("fails to write after close", func() {
+		writer, err := stream.NewFileWriterWithConfig(&stream.FileWriterConfig{Path: path})
+		Expect(err).ToNot(HaveOccurred())
+		writer.Close()
+		Expect(writer.Write(ctx, nil, &stream.Envelope{Name: "Event"})).To(MatchError(os.ErrClosed))
+	})
+})
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	gorm "gorm.io/gorm"
 )
 
 // MockNotifier is a mock of Notifier interface.
@@ -60,3 +61,66 @@ func (mr *MockNotifierMockRecorder) Notify(ctx, notifiable interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, notifiable)
 }
+
+// MockTransactionalNotifier is a mock of TransactionalNotifier interface.
+type MockTransactionalNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionalNotifierMockRecorder
+}
+
+// MockTransactionalNotifierMockRecorder is the mock recorder for MockTransactionalNotifier.
+type MockTransactionalNotifierMockRecorder struct {
+	mock *MockTransactionalNotifier
+}
+
+// NewMockTransactionalNotifier creates a new mock instance.
+func NewMockTransactionalNotifier(ctrl *gomock.Controller) *MockTransactionalNotifier {
+	mock := &MockTransactionalNotifier{ctrl: ctrl}
+	mock.recorder = &MockTransactionalNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionalNotifier) EXPECT() *MockTransactionalNotifierMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockTransactionalNotifier) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockTransactionalNotifierMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTransactionalNotifier)(nil).Close))
+}
+
+// Notify mocks base method.
+func (m *MockTransactionalNotifier) Notify(ctx context.Context, notifiable common.Notifiable) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, notifiable)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockTransactionalNotifierMockRecorder) Notify(ctx, notifiable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockTransactionalNotifier)(nil).Notify), ctx, notifiable)
+}
+
+// NotifyWithDB mocks base method.
+func (m *MockTransactionalNotifier) NotifyWithDB(ctx context.Context, db *gorm.DB, notifiable common.Notifiable) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyWithDB", ctx, db, notifiable)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyWithDB indicates an expected call of NotifyWithDB.
+func (mr *MockTransactionalNotifierMockRecorder) NotifyWithDB(ctx, db, notifiable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyWithDB", reflect.TypeOf((*MockTransactionalNotifier)(nil).NotifyWithDB), ctx, db, notifiable)
+}
@@ -2,20 +2,51 @@ package stream
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 //go:generate mockgen -source=notification_stream.go -package=stream -destination=mock_notification_stream.go
-
 type Notifier interface {
 	Notify(ctx context.Context, notifiable common.Notifiable) error
 	Close()
 }
 
+// TransactionalNotifier is implemented by notifiers that persist notifications in the database
+// and can therefore record them as part of the caller's transaction
+type TransactionalNotifier interface {
+	Notifier
+	NotifyWithDB(ctx context.Context, db *gorm.DB, notifiable common.Notifiable) error
+}
+
+// NotifyWithDB records the notification using the given database handle when the notifier
+// supports it, so that it is committed or rolled back together with the resource change.
+// Other notifiers are called once the transaction of the handle commits, and not at all if it
+// is rolled back; they log their own failures. An error means that the notification could not
+// be recorded and the caller must fail the change, so that its transaction is rolled back.
+func NotifyWithDB(ctx context.Context, notifier Notifier, db *gorm.DB, notifiable common.Notifiable) error {
+	if db == nil {
+		return notifier.Notify(ctx, notifiable)
+	}
+	if transactional, ok := notifier.(TransactionalNotifier); ok {
+		return transactional.NotifyWithDB(ctx, db, notifiable)
+	}
+	transaction.AfterCommit(db, func() {
+		_ = notifier.Notify(ctx, notifiable)
+	})
+	return nil
+}
+
+// outboxLockPrefix namespaces the advisory locks taken on the outbox keys
+const outboxLockPrefix = "notification_outbox:"
+
 type Envelope struct {
 	Name     string
 	Payload  interface{}
@@ -25,49 +56,136 @@ type Envelope struct {
 type NotificationStream struct {
 	metadata interface{}
 	writer   StreamWriter
+	outbox   *gorm.DB
 	log      logrus.FieldLogger
 }
 
+var _ TransactionalNotifier = &NotificationStream{}
+
 func NewNotificationStream(writer StreamWriter, logger logrus.FieldLogger, metadata interface{}) *NotificationStream {
 	return &NotificationStream{
 		writer:   writer,
 		metadata: metadata,
 		log:      logger,
 	}
-
 }
 
-func (s *NotificationStream) Notify(ctx context.Context, notifiable common.Notifiable) error {
-	if s.writer == nil {
-		return nil
+// NewOutboxNotificationStream returns a stream that stores envelopes in the notification outbox
+// table instead of writing them directly. The OutboxRelay delivers them to the writer.
+func NewOutboxNotificationStream(db *gorm.DB, writer StreamWriter, logger logrus.FieldLogger, metadata interface{}) *NotificationStream {
+	return &NotificationStream{
+		writer:   writer,
+		outbox:   db,
+		metadata: metadata,
+		log:      logger,
 	}
+}
+
+func (s *NotificationStream) envelope(notifiable common.Notifiable) (string, *Envelope, error) {
 	if notifiable == nil || reflect.ValueOf(notifiable).IsNil() {
-		return fmt.Errorf("trying to notify on nil notifiable")
+		return "", nil, fmt.Errorf("trying to notify on nil notifiable")
 	}
 	key := ""
 	clusterID := notifiable.GetClusterID()
 	if clusterID != nil {
 		key = clusterID.String()
 	}
-
-	envelope := &Envelope{
+	return key, &Envelope{
 		Name:     notifiable.NotificationType(),
 		Payload:  notifiable.Payload(),
 		Metadata: s.metadata,
+	}, nil
+}
+
+func (s *NotificationStream) Notify(ctx context.Context, notifiable common.Notifiable) error {
+	if s.outbox != nil {
+		return s.outbox.Transaction(func(tx *gorm.DB) error {
+			return s.NotifyWithDB(ctx, tx, notifiable)
+		})
 	}
+	if s.writer == nil {
+		return nil
+	}
+	key, envelope, err := s.envelope(notifiable)
+	if err != nil {
+		return err
+	}
+	return s.write(ctx, key, envelope, notifiable)
+}
 
+func (s *NotificationStream) write(ctx context.Context, key string, envelope *Envelope, notifiable common.Notifiable) error {
 	if err := s.writer.Write(ctx, []byte(key), envelope); err != nil {
-		s.log.WithError(err).WithFields(logrus.Fields{
-			"type":         notifiable.NotificationType(),
-			"cluster_id":   clusterID,
-			"infra_env_id": notifiable.GetInfraEnvID(),
-			"host_id":      notifiable.GetHostID(),
-		}).Warn("failed to stream notification for resource")
+		s.logFailure(err, notifiable)
 		return err
 	}
 	return nil
 }
 
+func (s *NotificationStream) NotifyWithDB(ctx context.Context, db *gorm.DB, notifiable common.Notifiable) error {
+	if s.outbox == nil {
+		return s.notifyAfterCommit(ctx, db, notifiable)
+	}
+	key, envelope, err := s.envelope(notifiable)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	// Envelopes sharing a key are delivered in ID order, which must be the order their
+	// transactions commit in. Holding a lock on the key until the transaction ends keeps a
+	// concurrent transaction from taking a later ID and committing first.
+	if err = db.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", outboxLockPrefix+key).Error; err != nil {
+		s.logFailure(err, notifiable)
+		return err
+	}
+	entry := &common.NotificationOutboxEntry{
+		Key:           key,
+		Name:          envelope.Name,
+		Envelope:      string(encoded),
+		Status:        common.NotificationOutboxStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+	if err = db.Create(entry).Error; err != nil {
+		s.logFailure(err, notifiable)
+		return err
+	}
+	return nil
+}
+
+// notifyAfterCommit writes the envelope directly once the transaction of db commits, and drops it
+// if the transaction is rolled back. Direct writes, and their retries, must not keep the
+// transaction open. They are best effort, so their failures are only logged.
+func (s *NotificationStream) notifyAfterCommit(ctx context.Context, db *gorm.DB, notifiable common.Notifiable) error {
+	if s.writer == nil {
+		return nil
+	}
+	key, envelope, err := s.envelope(notifiable)
+	if err != nil {
+		return err
+	}
+	// The payload is encoded now, the notifiable may change before the transaction commits
+	payload, err := json.Marshal(envelope.Payload)
+	if err != nil {
+		return err
+	}
+	envelope.Payload = json.RawMessage(payload)
+	transaction.AfterCommit(db, func() {
+		_ = s.write(ctx, key, envelope, notifiable)
+	})
+	return nil
+}
+
+func (s *NotificationStream) logFailure(err error, notifiable common.Notifiable) {
+	s.log.WithError(err).WithFields(logrus.Fields{
+		"type":         notifiable.NotificationType(),
+		"cluster_id":   notifiable.GetClusterID(),
+		"infra_env_id": notifiable.GetInfraEnvID(),
+		"host_id":      notifiable.GetHostID(),
+	}).Warn("failed to stream notification for resource")
+}
+
 func (s *NotificationStream) Close() {
 	if s.writer != nil {
 		s.writer.Close()
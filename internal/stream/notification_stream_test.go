@@ -15,6 +15,7 @@ import (
 	"github.com/openshift/assisted-service/internal/stream"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 var _ = Describe("Close", func() {
@@ -215,7 +216,24 @@ var _ = Describe("Notify", func() {
 	})
 })
 
+var dbInitialized bool
+
+// prepareTestDB starts the test database on first use, so that the specs that
+// don't store anything run without one
+func prepareTestDB() (*gorm.DB, string) {
+	if !dbInitialized {
+		common.InitializeDBTest()
+		dbInitialized = true
+	}
+	return common.PrepareTestDB()
+}
+
 func TestNotificationStream(t *testing.T) {
 	RegisterFailHandler(Fail)
+	defer func() {
+		if dbInitialized {
+			common.TerminateDBTest()
+		}
+	}()
 	RunSpecs(t, "Notification stream")
 }
@@ -0,0 +1,188 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type OutboxConfig struct {
+	Enabled        bool          `envconfig:"EVENT_STREAM_OUTBOX_ENABLED" default:"false"`
+	RelayInterval  time.Duration `envconfig:"EVENT_STREAM_OUTBOX_RELAY_INTERVAL" default:"2s"`
+	BatchSize      int           `envconfig:"EVENT_STREAM_OUTBOX_BATCH_SIZE" default:"500"`
+	MaxAttempts    int           `envconfig:"EVENT_STREAM_OUTBOX_MAX_ATTEMPTS" default:"10"`
+	InitialBackoff time.Duration `envconfig:"EVENT_STREAM_OUTBOX_INITIAL_BACKOFF" default:"5s"`
+	MaxBackoff     time.Duration `envconfig:"EVENT_STREAM_OUTBOX_MAX_BACKOFF" default:"5m"`
+}
+
+// outboxEnvelope is the stored form of an Envelope. Keeping the payload and metadata
+// as raw JSON lets the relay hand writers an *Envelope that encodes exactly as the original.
+type outboxEnvelope struct {
+	Name     string
+	Payload  json.RawMessage
+	Metadata json.RawMessage
+}
+
+// OutboxRelay delivers the envelopes stored in the notification outbox to the stream writer.
+// Only the leader relays. Envelopes sharing a key are delivered in the order their transactions
+// committed: when one of them fails, the ones behind it wait until it is delivered or
+// dead-lettered. Each key is relayed separately, so a failing key doesn't hold back the others.
+type OutboxRelay struct {
+	db            *gorm.DB
+	writer        StreamWriter
+	leaderElector leader.Leader
+	metricsAPI    metrics.API
+	config        OutboxConfig
+	log           logrus.FieldLogger
+}
+
+func NewOutboxRelay(db *gorm.DB, writer StreamWriter, leaderElector leader.Leader, metricsAPI metrics.API,
+	config OutboxConfig, log logrus.FieldLogger) *OutboxRelay {
+	return &OutboxRelay{
+		db:            db,
+		writer:        writer,
+		leaderElector: leaderElector,
+		metricsAPI:    metricsAPI,
+		config:        config,
+		log:           log,
+	}
+}
+
+func (r *OutboxRelay) Relay() {
+	if !r.leaderElector.IsLeader() {
+		r.log.Debugf("Not a leader, exiting notification outbox relay")
+		return
+	}
+	var (
+		requestID = requestid.NewID()
+		ctx       = requestid.ToContext(context.Background(), requestID)
+		log       = requestid.RequestIDLogger(r.log, requestID)
+		now       = time.Now()
+		heads     []*common.NotificationOutboxEntry
+	)
+
+	// The first pending entry of each key, when it is due. The keys whose first entry waits
+	// for its backoff are blocked, the entries behind it must not be delivered before it.
+	firstEntries := r.db.Model(&common.NotificationOutboxEntry{}).
+		Select("DISTINCT ON (key) *").
+		Where("status = ?", common.NotificationOutboxStatusPending).
+		Order("key, id")
+	err := r.db.Table("(?) AS heads", firstEntries).
+		Where("next_attempt_at <= ?", now).
+		Order("id").Limit(r.config.BatchSize).Find(&heads).Error
+	if err != nil {
+		log.WithError(err).Error("failed to read the notification outbox")
+		return
+	}
+
+	for _, head := range heads {
+		if !r.leaderElector.IsLeader() {
+			log.Debugf("Not a leader, exiting notification outbox relay")
+			return
+		}
+		r.relayKey(ctx, log, head, now)
+	}
+	r.reportPending(log)
+}
+
+// relayKey delivers the entries of the key of head, starting with head, until one of them fails
+// or waits for its backoff. At most a batch of entries behind head is delivered per key.
+func (r *OutboxRelay) relayKey(ctx context.Context, log logrus.FieldLogger, head *common.NotificationOutboxEntry, now time.Time) {
+	if !r.deliver(ctx, log, head) {
+		return
+	}
+	var entries []*common.NotificationOutboxEntry
+	err := r.db.Where("status = ? AND key = ? AND id > ?", common.NotificationOutboxStatusPending, head.Key, head.ID).
+		Order("id").Limit(r.config.BatchSize).Find(&entries).Error
+	if err != nil {
+		log.WithError(err).Errorf("failed to read the notification outbox entries of key %s", head.Key)
+		return
+	}
+	for _, entry := range entries {
+		if !r.leaderElector.IsLeader() || entry.NextAttemptAt.After(now) || !r.deliver(ctx, log, entry) {
+			return
+		}
+	}
+}
+
+// deliver sends a single entry and returns whether the entries behind it may be delivered
+func (r *OutboxRelay) deliver(ctx context.Context, log logrus.FieldLogger, entry *common.NotificationOutboxEntry) bool {
+	var stored outboxEnvelope
+	err := json.Unmarshal([]byte(entry.Envelope), &stored)
+	if err == nil {
+		err = r.writer.Write(ctx, []byte(entry.Key), &Envelope{
+			Name:     stored.Name,
+			Payload:  stored.Payload,
+			Metadata: stored.Metadata,
+		})
+	}
+	if err == nil {
+		if err = r.db.Delete(entry).Error; err != nil {
+			// The envelope will be delivered again, consumers already have to tolerate duplicates
+			log.WithError(err).Errorf("failed to remove delivered notification %d from the outbox", entry.ID)
+			return false
+		}
+		r.metricsAPI.NotificationOutboxDelivered(time.Since(entry.CreatedAt), true)
+		return true
+	}
+
+	entry.Attempts++
+	entry.LastError = err.Error()
+	logger := log.WithError(err).WithFields(logrus.Fields{
+		"outbox_id": entry.ID,
+		"key":       entry.Key,
+		"type":      entry.Name,
+		"attempts":  entry.Attempts,
+	})
+	updates := map[string]interface{}{
+		"attempts":   entry.Attempts,
+		"last_error": entry.LastError,
+	}
+	unblocked := false
+	if entry.Attempts >= r.config.MaxAttempts {
+		logger.Error("notification could not be delivered, moving it to the dead letter queue")
+		updates["status"] = common.NotificationOutboxStatusDeadLetter
+		r.metricsAPI.NotificationOutboxDelivered(time.Since(entry.CreatedAt), false)
+		unblocked = true
+	} else {
+		logger.Warn("failed to deliver notification, will retry")
+		updates["next_attempt_at"] = time.Now().Add(r.backoff(entry.Attempts))
+	}
+	if dbErr := r.db.Model(entry).Updates(updates).Error; dbErr != nil {
+		log.WithError(dbErr).Errorf("failed to update notification %d in the outbox", entry.ID)
+		return false
+	}
+	return unblocked
+}
+
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	backoff := r.config.InitialBackoff
+	for i := 1; i < attempts && backoff < r.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > r.config.MaxBackoff {
+		backoff = r.config.MaxBackoff
+	}
+	return backoff
+}
+
+func (r *OutboxRelay) reportPending(log logrus.FieldLogger) {
+	var oldest common.NotificationOutboxEntry
+	err := r.db.Where("status = ?", common.NotificationOutboxStatusPending).Order("created_at").Limit(1).Find(&oldest).Error
+	if err != nil {
+		log.WithError(err).Warn("failed to compute the notification outbox lag")
+		return
+	}
+	var lag time.Duration
+	if oldest.ID != 0 {
+		lag = time.Since(oldest.CreatedAt)
+	}
+	r.metricsAPI.NotificationOutboxLag(lag)
+}
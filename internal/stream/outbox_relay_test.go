@@ -0,0 +1,222 @@
+package stream_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Notification outbox", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		writer        *stream.MockStreamWriter
+		mockMetrics   *metrics.MockAPI
+		mockLeader    *leader.MockLeader
+		logger        *logrus.Logger
+		notifier      *stream.NotificationStream
+		relay         *stream.OutboxRelay
+		firstCluster  strfmt.UUID
+		secondCluster strfmt.UUID
+		metadata      = map[string]string{"foo": "bar"}
+	)
+
+	clusterNotifiable := func(id strfmt.UUID, status string) *common.Cluster {
+		return &common.Cluster{Cluster: models.Cluster{ID: &id, Status: &status}}
+	}
+
+	pendingEntries := func() []*common.NotificationOutboxEntry {
+		var entries []*common.NotificationOutboxEntry
+		Expect(db.Order("id").Find(&entries).Error).ToNot(HaveOccurred())
+		return entries
+	}
+
+	statusOf := func(payload interface{}) string {
+		encoded, err := json.Marshal(payload)
+		Expect(err).ToNot(HaveOccurred())
+		var cluster models.Cluster
+		Expect(json.Unmarshal(encoded, &cluster)).To(Succeed())
+		return *cluster.Status
+	}
+
+	BeforeEach(func() {
+		db, dbName = prepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		writer = stream.NewMockStreamWriter(ctrl)
+		mockMetrics = metrics.NewMockAPI(ctrl)
+		mockLeader = leader.NewMockLeader(ctrl)
+		mockLeader.EXPECT().IsLeader().Return(true).AnyTimes()
+		logger = logrus.New()
+		logger.Out = io.Discard
+		notifier = stream.NewOutboxNotificationStream(db, writer, logger, metadata)
+		relay = stream.NewOutboxRelay(db, writer, mockLeader, mockMetrics, stream.OutboxConfig{
+			BatchSize:      100,
+			MaxAttempts:    2,
+			InitialBackoff: time.Hour,
+			MaxBackoff:     time.Hour,
+		}, logger)
+		firstCluster = strfmt.UUID(uuid.New().String())
+		secondCluster = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("stores notifications instead of writing them", func() {
+		writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		Expect(notifier.Notify(ctx, clusterNotifiable(firstCluster, models.ClusterStatusReady))).To(Succeed())
+		entries := pendingEntries()
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Key).To(Equal(firstCluster.String()))
+		Expect(entries[0].Name).To(Equal(common.NotificationTypeCluster))
+		Expect(entries[0].Status).To(Equal(common.NotificationOutboxStatusPending))
+	})
+
+	It("discards notifications of a rolled back transaction", func() {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			Expect(stream.NotifyWithDB(ctx, notifier, tx, clusterNotifiable(firstCluster, models.ClusterStatusReady))).To(Succeed())
+			return errors.New("rollback")
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(pendingEntries()).To(BeEmpty())
+	})
+
+	It("delivers stored envelopes in order and removes them", func() {
+		Expect(notifier.Notify(ctx, clusterNotifiable(firstCluster, models.ClusterStatusReady))).To(Succeed())
+		Expect(notifier.Notify(ctx, clusterNotifiable(firstCluster, models.ClusterStatusInstalling))).To(Succeed())
+
+		var delivered []string
+		writer.EXPECT().Write(gomock.Any(), []byte(firstCluster.String()), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ []byte, value interface{}) error {
+				envelope, ok := value.(*stream.Envelope)
+				Expect(ok).To(BeTrue())
+				Expect(envelope.Name).To(Equal(common.NotificationTypeCluster))
+				encodedMetadata, err := json.Marshal(envelope.Metadata)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(encodedMetadata).To(MatchJSON(`{"foo":"bar"}`))
+				delivered = append(delivered, statusOf(envelope.Payload))
+				return nil
+			}).Times(2)
+		mockMetrics.EXPECT().NotificationOutboxDelivered(gomock.Any(), true).Times(2)
+		mockMetrics.EXPECT().NotificationOutboxLag(time.Duration(0)).Times(1)
+
+		relay.Relay()
+		Expect(delivered).To(Equal([]string{models.ClusterStatusReady, models.ClusterStatusInstalling}))
+		Expect(pendingEntries()).To(BeEmpty())
+	})
+
+	It("holds back envelopes of the same key after a failure but not of other keys", func() {
+		Expect(notifier.Notify(ctx, clusterNotifiable(firstCluster, models.ClusterStatusReady))).To(Succeed())
+		Expect(notifier.Notify(ctx, clusterNotifiable(firstCluster, models.ClusterStatusInstalling))).To(Succeed())
+		Expect(notifier.Notify(ctx, clusterNotifiable(secondCluster, models.ClusterStatusReady))).To(Succeed())
+
+		writer.EXPECT().Write(gomock.Any(), []byte(firstCluster.String()), gomock.Any()).Return(errors.New("unavailable")).Times(1)
+		writer.EXPECT().Write(gomock.Any(), []byte(secondCluster.String()), gomock.Any()).Return(nil).Times(1)
+		mockMetrics.EXPECT().NotificationOutboxDelivered(gomock.Any(), true).Times(1)
+		mockMetrics.EXPECT().NotificationOutboxLag(gomock.Any()).Times(1)
+
+		relay.Relay()
+		entries := pendingEntries()
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Attempts).To(Equal(1))
+		Expect(entries[0].LastError).To(Equal("unavailable"))
+		Expect(entries[0].NextAttemptAt).To(BeTemporally(">", time.Now().Add(30*time.Minute)))
+		Expect(entries[1].Attempts).To(Equal(0))
+
+		By("not retrying before the backoff expires")
+		mockMetrics.EXPECT().NotificationOutboxLag(gomock.Any()).Times(1)
+		relay.Relay()
+	})
+
+	It("dead-letters envelopes that exhausted their attempts and moves on", func() {
+		Expect(notifier.Notify(ctx, clusterNotifiable(firstCluster, models.ClusterStatusReady))).To(Succeed())
+		Expect(notifier.Notify(ctx, clusterNotifiable(firstCluster, models.ClusterStatusInstalling))).To(Succeed())
+		Expect(db.Model(&common.NotificationOutboxEntry{}).Where("1 = 1").Update("attempts", 1).Error).ToNot(HaveOccurred())
+
+		gomock.InOrder(
+			writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("unavailable")),
+			writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+		)
+		mockMetrics.EXPECT().NotificationOutboxDelivered(gomock.Any(), false).Times(1)
+		mockMetrics.EXPECT().NotificationOutboxDelivered(gomock.Any(), true).Times(1)
+		mockMetrics.EXPECT().NotificationOutboxLag(time.Duration(0)).Times(1)
+
+		relay.Relay()
+		entries := pendingEntries()
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Status).To(Equal(common.NotificationOutboxStatusDeadLetter))
+		Expect(entries[0].Attempts).To(Equal(2))
+	})
+
+	It("doesn't let a failing key hold back the keys behind its batch", func() {
+		for i := 0; i < 3; i++ {
+			Expect(notifier.Notify(ctx, clusterNotifiable(firstCluster, models.ClusterStatusReady))).To(Succeed())
+		}
+		Expect(notifier.Notify(ctx, clusterNotifiable(secondCluster, models.ClusterStatusReady))).To(Succeed())
+		relay = stream.NewOutboxRelay(db, writer, mockLeader, mockMetrics, stream.OutboxConfig{
+			BatchSize:      2,
+			MaxAttempts:    2,
+			InitialBackoff: time.Hour,
+			MaxBackoff:     time.Hour,
+		}, logger)
+
+		writer.EXPECT().Write(gomock.Any(), []byte(firstCluster.String()), gomock.Any()).Return(errors.New("unavailable")).Times(1)
+		writer.EXPECT().Write(gomock.Any(), []byte(secondCluster.String()), gomock.Any()).Return(nil).Times(1)
+		mockMetrics.EXPECT().NotificationOutboxDelivered(gomock.Any(), true).Times(1)
+		mockMetrics.EXPECT().NotificationOutboxLag(gomock.Any()).Times(1)
+
+		relay.Relay()
+		Expect(pendingEntries()).To(HaveLen(3))
+	})
+
+	It("writes direct notifications only once their transaction commits", func() {
+		direct := stream.NewNotificationStream(writer, logger, metadata)
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			Expect(stream.NotifyWithDB(ctx, direct, tx, clusterNotifiable(firstCluster, models.ClusterStatusReady))).To(Succeed())
+			return errors.New("rollback")
+		})
+		Expect(err).To(HaveOccurred())
+
+		err = db.Transaction(func(tx *gorm.DB) error {
+			Expect(stream.NotifyWithDB(ctx, direct, tx, clusterNotifiable(firstCluster, models.ClusterStatusInstalling))).To(Succeed())
+			writer.EXPECT().Write(gomock.Any(), []byte(firstCluster.String()), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ []byte, value interface{}) error {
+					envelope, ok := value.(*stream.Envelope)
+					Expect(ok).To(BeTrue())
+					Expect(statusOf(envelope.Payload)).To(Equal(models.ClusterStatusInstalling))
+					return nil
+				}).Times(1)
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("does nothing when not the leader", func() {
+		Expect(notifier.Notify(ctx, clusterNotifiable(firstCluster, models.ClusterStatusReady))).To(Succeed())
+		notLeader := leader.NewMockLeader(ctrl)
+		notLeader.EXPECT().IsLeader().Return(false).AnyTimes()
+		relay = stream.NewOutboxRelay(db, writer, notLeader, mockMetrics, stream.OutboxConfig{BatchSize: 100, MaxAttempts: 1}, logger)
+		relay.Relay()
+		Expect(pendingEntries()).To(HaveLen(1))
+	})
+})
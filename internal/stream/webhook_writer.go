@@ -0,0 +1,152 @@
+package stream
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	WebhookSignatureHeader = "X-Assisted-Signature"
+	WebhookTimestampHeader = "X-Assisted-Timestamp"
+	WebhookKeyHeader       = "X-Assisted-Stream-Key"
+	WebhookEventHeader     = "X-Assisted-Event"
+)
+
+type WebhookConfig struct {
+	URL            string        `envconfig:"EVENT_STREAM_WEBHOOK_URL" required:"true"`
+	Secret         string        `envconfig:"EVENT_STREAM_WEBHOOK_SECRET" default:""`
+	Timeout        time.Duration `envconfig:"EVENT_STREAM_WEBHOOK_TIMEOUT" default:"5s"`
+	MaxRetries     int           `envconfig:"EVENT_STREAM_WEBHOOK_MAX_RETRIES" default:"3"`
+	InitialBackoff time.Duration `envconfig:"EVENT_STREAM_WEBHOOK_INITIAL_BACKOFF" default:"500ms"`
+	MaxBackoff     time.Duration `envconfig:"EVENT_STREAM_WEBHOOK_MAX_BACKOFF" default:"5s"`
+}
+
+// WebhookWriter POSTs every envelope as JSON to a single endpoint. When a secret
+// is configured the request carries an HMAC-SHA256 signature of
+// "<timestamp>.<body>" so that the receiver can authenticate it and reject replays.
+type WebhookWriter struct {
+	config *WebhookConfig
+	client *http.Client
+	log    logrus.FieldLogger
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+func NewWebhookWriter(logger logrus.FieldLogger) (*WebhookWriter, error) {
+	config := &WebhookConfig{}
+	if err := envconfig.Process("", config); err != nil {
+		return nil, err
+	}
+	return NewWebhookWriterWithConfig(config, logger), nil
+}
+
+func NewWebhookWriterWithConfig(config *WebhookConfig, logger logrus.FieldLogger) *WebhookWriter {
+	return &WebhookWriter{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		log:    logger,
+		sleep:  sleepWithContext,
+	}
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>"
+func SignWebhookPayload(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *WebhookWriter) Write(ctx context.Context, key []byte, value interface{}) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var eventName string
+	if envelope, ok := value.(*Envelope); ok {
+		eventName = envelope.Name
+	}
+	backoff := w.config.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		retryable, err := w.post(ctx, key, eventName, body)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt >= w.config.MaxRetries {
+			return err
+		}
+		w.log.WithError(err).Debugf("webhook delivery attempt %d failed, retrying in %s", attempt+1, backoff)
+		if err = w.sleep(ctx, jitter(backoff)); err != nil {
+			return err
+		}
+		backoff *= 2
+		if backoff > w.config.MaxBackoff {
+			backoff = w.config.MaxBackoff
+		}
+	}
+}
+
+func (w *WebhookWriter) post(ctx context.Context, key []byte, eventName string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookKeyHeader, string(key))
+	if eventName != "" {
+		req.Header.Set(WebhookEventHeader, eventName)
+	}
+	if w.config.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, timestamp)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(w.config.Secret, timestamp, body))
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+	return retryable, fmt.Errorf("webhook %s responded with status %d", w.config.URL, resp.StatusCode)
+}
+
+func (w *WebhookWriter) Close() {
+	w.client.CloseIdleConnections()
+}
+
+// jitter spreads retries of concurrent writers over [d/2, d)
+func jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half))) // nolint: gosec
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,147 @@
+package stream_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("WebhookWriter", func() {
+	var (
+		ctx      = context.Background()
+		logger   *logrus.Logger
+		server   *httptest.Server
+		handler  http.HandlerFunc
+		requests int32
+		envelope *stream.Envelope
+	)
+
+	newWriter := func(secret string, maxRetries int) *stream.WebhookWriter {
+		return stream.NewWebhookWriterWithConfig(&stream.WebhookConfig{
+			URL:            server.URL,
+			Secret:         secret,
+			Timeout:        time.Second,
+			MaxRetries:     maxRetries,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     2 * time.Millisecond,
+		}, logger)
+	}
+
+	BeforeEach(func() {
+		logger = logrus.New()
+		logger.Out = io.Discard
+		atomic.StoreInt32(&requests, 0)
+		handler = func(w http.ResponseWriter, r *http.Request) {}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			handler(w, r)
+		}))
+		envelope = &stream.Envelope{
+			Name:     common.NotificationTypeCluster,
+			Payload:  map[string]string{"status": "installing"},
+			Metadata: map[string]string{"foo": "bar"},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("posts a signed envelope", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			body, err := io.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(r.Header.Get(stream.WebhookKeyHeader)).To(Equal("cluster-id"))
+			Expect(r.Header.Get(stream.WebhookEventHeader)).To(Equal(common.NotificationTypeCluster))
+			timestamp := r.Header.Get(stream.WebhookTimestampHeader)
+			Expect(timestamp).ToNot(BeEmpty())
+			Expect(r.Header.Get(stream.WebhookSignatureHeader)).To(Equal("sha256=" + stream.SignWebhookPayload("secret", timestamp, body)))
+			var received stream.Envelope
+			Expect(json.Unmarshal(body, &received)).To(Succeed())
+			Expect(received.Name).To(Equal(common.NotificationTypeCluster))
+		}
+		writer := newWriter("secret", 0)
+		defer writer.Close()
+		Expect(writer.Write(ctx, []byte("cluster-id"), envelope)).To(Succeed())
+		Expect(atomic.LoadInt32(&requests)).To(BeEquivalentTo(1))
+	})
+
+	It("does not sign when no secret is configured", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Header.Get(stream.WebhookSignatureHeader)).To(BeEmpty())
+			Expect(r.Header.Get(stream.WebhookTimestampHeader)).To(BeEmpty())
+		}
+		Expect(newWriter("", 0).Write(ctx, nil, envelope)).To(Succeed())
+	})
+
+	It("retries server errors until the delivery succeeds", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			if atomic.LoadInt32(&requests) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}
+		Expect(newWriter("secret", 5).Write(ctx, nil, envelope)).To(Succeed())
+		Expect(atomic.LoadInt32(&requests)).To(BeEquivalentTo(3))
+	})
+
+	It("retries throttled deliveries", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			if atomic.LoadInt32(&requests) < 2 {
+				w.WriteHeader(http.StatusTooManyRequests)
+			}
+		}
+		Expect(newWriter("secret", 5).Write(ctx, nil, envelope)).To(Succeed())
+		Expect(atomic.LoadInt32(&requests)).To(BeEquivalentTo(2))
+	})
+
+	It("gives up after the configured number of retries", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		err := newWriter("secret", 2).Write(ctx, nil, envelope)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("status 500"))
+		Expect(atomic.LoadInt32(&requests)).To(BeEquivalentTo(3))
+	})
+
+	It("does not retry client errors", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		Expect(newWriter("secret", 5).Write(ctx, nil, envelope)).ToNot(Succeed())
+		Expect(atomic.LoadInt32(&requests)).To(BeEquivalentTo(1))
+	})
+
+	It("stops retrying when the context is cancelled", func() {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			cancel()
+			w.WriteHeader(http.StatusBadGateway)
+		}
+		err := newWriter("secret", 5).Write(cancelCtx, nil, envelope)
+		Expect(err).To(HaveOccurred())
+		Expect(atomic.LoadInt32(&requests)).To(BeEquivalentTo(1))
+	})
+
+	It("fails on non-encodable values", func() {
+		err := newWriter("secret", 0).Write(ctx, nil, map[string]interface{}{"invalid": make(chan int)})
+		Expect(err).To(HaveOccurred())
+		Expect(strings.Contains(err.Error(), "unsupported type")).To(BeTrue())
+		Expect(atomic.LoadInt32(&requests)).To(BeZero())
+	})
+})
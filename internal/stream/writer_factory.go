@@ -2,6 +2,10 @@ package stream
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/openshift/assisted-service/pkg/kafka"
 	"github.com/sirupsen/logrus"
@@ -14,6 +18,61 @@ type StreamWriter interface {
 	Close()
 }
 
+const (
+	WriterTypeKafka   = "kafka"
+	WriterTypeWebhook = "webhook"
+	WriterTypeFile    = "file"
+	WriterTypeBus     = "bus"
+)
+
+type Config struct {
+	WriterType             string `envconfig:"EVENT_STREAM_WRITER" default:"kafka"`
+	SubscriptionBufferSize int    `envconfig:"EVENT_STREAM_SUBSCRIPTION_BUFFER_SIZE" default:"256"`
+	Outbox                 OutboxConfig
+}
+
+// WriterBuilder creates a StreamWriter. Each builder reads its own backend
+// specific configuration from the environment.
+type WriterBuilder func(logger logrus.FieldLogger) (StreamWriter, error)
+
+var (
+	writerBuildersLock sync.RWMutex
+	writerBuilders     = map[string]WriterBuilder{
+		WriterTypeKafka: func(logrus.FieldLogger) (StreamWriter, error) {
+			return kafka.NewWriter()
+		},
+		WriterTypeWebhook: func(logger logrus.FieldLogger) (StreamWriter, error) {
+			return NewWebhookWriter(logger)
+		},
+		WriterTypeFile: func(logrus.FieldLogger) (StreamWriter, error) {
+			return NewFileWriter()
+		},
+		WriterTypeBus: func(logrus.FieldLogger) (StreamWriter, error) {
+			return NewNATSBusWriter()
+		},
+	}
+)
+
+// RegisterWriter makes a StreamWriter backend available under the given name,
+// replacing any backend previously registered under it
+func RegisterWriter(name string, builder WriterBuilder) {
+	writerBuildersLock.Lock()
+	defer writerBuildersLock.Unlock()
+	writerBuilders[strings.ToLower(name)] = builder
+}
+
+// RegisteredWriters returns the sorted names of all registered backends
+func RegisteredWriters() []string {
+	writerBuildersLock.RLock()
+	defer writerBuildersLock.RUnlock()
+	names := make([]string, 0, len(writerBuilders))
+	for name := range writerBuilders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 type DummyWriter struct{}
 
 func (w *DummyWriter) Write(ctx context.Context, key []byte, value interface{}) error {
@@ -24,14 +83,25 @@ func (w *DummyWriter) Close() {
 
 }
 
-// if streaming disabled this will return a dummy writer. Otherwise will try to return kafka writer
-// and fail if any error is encountered
-func NewWriter(logger *logrus.Logger, enableNotificationStreaming bool) (StreamWriter, error) {
+// if streaming disabled this will return a dummy writer. Otherwise will try to return the writer
+// registered under the configured type and fail if any error is encountered
+func NewWriter(logger *logrus.Logger, enableNotificationStreaming bool, config Config) (StreamWriter, error) {
 	writer := &DummyWriter{}
 	if !enableNotificationStreaming {
 		logger.Info("Initializing event stream dummy writer")
 		return writer, nil
 	}
-	logger.Info("Initializing event stream kafka writer")
-	return kafka.NewWriter()
+	writerType := strings.ToLower(config.WriterType)
+	if writerType == "" {
+		writerType = WriterTypeKafka
+	}
+	writerBuildersLock.RLock()
+	builder, ok := writerBuilders[writerType]
+	writerBuildersLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown event stream writer %s, supported writers are %s",
+			config.WriterType, strings.Join(RegisteredWriters(), ", "))
+	}
+	logger.Infof("Initializing event stream %s writer", writerType)
+	return builder(logger.WithField("pkg", "stream-writer"))
 }
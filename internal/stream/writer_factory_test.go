@@ -0,0 +1,68 @@
+package stream_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/stream"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("NewWriter", func() {
+	var logger *logrus.Logger
+
+	BeforeEach(func() {
+		logger = logrus.New()
+		logger.Out = io.Discard
+	})
+
+	It("returns a dummy writer when streaming is disabled", func() {
+		writer, err := stream.NewWriter(logger, false, stream.Config{WriterType: "does-not-exist"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer).To(BeAssignableToTypeOf(&stream.DummyWriter{}))
+	})
+
+	It("fails for an unknown writer type", func() {
+		_, err := stream.NewWriter(logger, true, stream.Config{WriterType: "does-not-exist"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unknown event stream writer does-not-exist"))
+	})
+
+	It("lists the builtin writers", func() {
+		Expect(stream.RegisteredWriters()).To(ContainElements(
+			stream.WriterTypeKafka, stream.WriterTypeWebhook, stream.WriterTypeFile, stream.WriterTypeBus))
+	})
+
+	It("creates a file writer from the environment", func() {
+		tempDir, err := os.MkdirTemp("", "stream_writer_factory_test")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(tempDir)
+		path := filepath.Join(tempDir, "events.jsonl")
+		Expect(os.Setenv("EVENT_STREAM_FILE_PATH", path)).To(Succeed())
+		defer os.Unsetenv("EVENT_STREAM_FILE_PATH")
+		writer, err := stream.NewWriter(logger, true, stream.Config{WriterType: "FILE"})
+		Expect(err).ToNot(HaveOccurred())
+		defer writer.Close()
+		Expect(writer).To(BeAssignableToTypeOf(&stream.FileWriter{}))
+	})
+
+	It("fails when the webhook writer is not configured", func() {
+		_, err := stream.NewWriter(logger, true, stream.Config{WriterType: stream.WriterTypeWebhook})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("uses writers registered at runtime", func() {
+		custom := &stream.DummyWriter{}
+		stream.RegisterWriter("Custom", func(logrus.FieldLogger) (stream.StreamWriter, error) {
+			return custom, nil
+		})
+		writer, err := stream.NewWriter(logger, true, stream.Config{WriterType: "custom"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer).To(BeIdenticalTo(custom))
+		Expect(writer.Write(context.Background(), nil, nil)).To(Succeed())
+	})
+})
@@ -53,6 +53,8 @@ const (
 	LVM string = "LVM"
 	// Nutanix integration
 	NutanixIntegration string = "Nutanix integration"
+	// OpenStack integration
+	OpenstackIntegration string = "OpenStack integration"
 	// Usage of hyperthreading
 	HyperthreadingUsage string = "Hyperthreading"
 	// Usage of discovery kernel arguments
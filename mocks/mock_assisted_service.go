@@ -302,6 +302,76 @@ func (mr *MockInstallerAPIMockRecorder) V2CompleteInstallation(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CompleteInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).V2CompleteInstallation), arg0, arg1)
 }
 
+// V2CreateClusterRoleBinding mocks base method.
+func (m *MockInstallerAPI) V2CreateClusterRoleBinding(arg0 context.Context, arg1 installer.V2CreateClusterRoleBindingParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2CreateClusterRoleBinding", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2CreateClusterRoleBinding indicates an expected call of V2CreateClusterRoleBinding.
+func (mr *MockInstallerAPIMockRecorder) V2CreateClusterRoleBinding(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CreateClusterRoleBinding", reflect.TypeOf((*MockInstallerAPI)(nil).V2CreateClusterRoleBinding), arg0, arg1)
+}
+
+// V2CreateHostDiagnostic mocks base method.
+func (m *MockInstallerAPI) V2CreateHostDiagnostic(arg0 context.Context, arg1 installer.V2CreateHostDiagnosticParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2CreateHostDiagnostic", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2CreateHostDiagnostic indicates an expected call of V2CreateHostDiagnostic.
+func (mr *MockInstallerAPIMockRecorder) V2CreateHostDiagnostic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CreateHostDiagnostic", reflect.TypeOf((*MockInstallerAPI)(nil).V2CreateHostDiagnostic), arg0, arg1)
+}
+
+// V2CreateInfraEnvRoleBinding mocks base method.
+func (m *MockInstallerAPI) V2CreateInfraEnvRoleBinding(arg0 context.Context, arg1 installer.V2CreateInfraEnvRoleBindingParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2CreateInfraEnvRoleBinding", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2CreateInfraEnvRoleBinding indicates an expected call of V2CreateInfraEnvRoleBinding.
+func (mr *MockInstallerAPIMockRecorder) V2CreateInfraEnvRoleBinding(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CreateInfraEnvRoleBinding", reflect.TypeOf((*MockInstallerAPI)(nil).V2CreateInfraEnvRoleBinding), arg0, arg1)
+}
+
+// V2DeleteClusterRoleBinding mocks base method.
+func (m *MockInstallerAPI) V2DeleteClusterRoleBinding(arg0 context.Context, arg1 installer.V2DeleteClusterRoleBindingParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DeleteClusterRoleBinding", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DeleteClusterRoleBinding indicates an expected call of V2DeleteClusterRoleBinding.
+func (mr *MockInstallerAPIMockRecorder) V2DeleteClusterRoleBinding(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DeleteClusterRoleBinding", reflect.TypeOf((*MockInstallerAPI)(nil).V2DeleteClusterRoleBinding), arg0, arg1)
+}
+
+// V2DeleteInfraEnvRoleBinding mocks base method.
+func (m *MockInstallerAPI) V2DeleteInfraEnvRoleBinding(arg0 context.Context, arg1 installer.V2DeleteInfraEnvRoleBindingParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DeleteInfraEnvRoleBinding", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DeleteInfraEnvRoleBinding indicates an expected call of V2DeleteInfraEnvRoleBinding.
+func (mr *MockInstallerAPIMockRecorder) V2DeleteInfraEnvRoleBinding(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DeleteInfraEnvRoleBinding", reflect.TypeOf((*MockInstallerAPI)(nil).V2DeleteInfraEnvRoleBinding), arg0, arg1)
+}
+
 // V2DeregisterCluster mocks base method.
 func (m *MockInstallerAPI) V2DeregisterCluster(arg0 context.Context, arg1 installer.V2DeregisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -442,6 +512,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallConfig(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallConfig), arg0, arg1)
 }
 
+// V2GetClusterInstallationTimeline mocks base method.
+func (m *MockInstallerAPI) V2GetClusterInstallationTimeline(arg0 context.Context, arg1 installer.V2GetClusterInstallationTimelineParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterInstallationTimeline", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterInstallationTimeline indicates an expected call of V2GetClusterInstallationTimeline.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallationTimeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallationTimeline", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallationTimeline), arg0, arg1)
+}
+
 // V2GetClusterUISettings mocks base method.
 func (m *MockInstallerAPI) V2GetClusterUISettings(arg0 context.Context, arg1 installer.V2GetClusterUISettingsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -610,6 +694,20 @@ func (mr *MockInstallerAPIMockRecorder) V2InstallHost(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2InstallHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2InstallHost), arg0, arg1)
 }
 
+// V2ListClusterRoleBindings mocks base method.
+func (m *MockInstallerAPI) V2ListClusterRoleBindings(arg0 context.Context, arg1 installer.V2ListClusterRoleBindingsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListClusterRoleBindings", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListClusterRoleBindings indicates an expected call of V2ListClusterRoleBindings.
+func (mr *MockInstallerAPIMockRecorder) V2ListClusterRoleBindings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusterRoleBindings", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusterRoleBindings), arg0, arg1)
+}
+
 // V2ListClusters mocks base method.
 func (m *MockInstallerAPI) V2ListClusters(arg0 context.Context, arg1 installer.V2ListClustersParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -624,6 +722,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ListClusters(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusters", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusters), arg0, arg1)
 }
 
+// V2ListHostDiagnostics mocks base method.
+func (m *MockInstallerAPI) V2ListHostDiagnostics(arg0 context.Context, arg1 installer.V2ListHostDiagnosticsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListHostDiagnostics", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListHostDiagnostics indicates an expected call of V2ListHostDiagnostics.
+func (mr *MockInstallerAPIMockRecorder) V2ListHostDiagnostics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListHostDiagnostics", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListHostDiagnostics), arg0, arg1)
+}
+
 // V2ListHosts mocks base method.
 func (m *MockInstallerAPI) V2ListHosts(arg0 context.Context, arg1 installer.V2ListHostsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -638,6 +750,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ListHosts(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListHosts", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListHosts), arg0, arg1)
 }
 
+// V2ListInfraEnvRoleBindings mocks base method.
+func (m *MockInstallerAPI) V2ListInfraEnvRoleBindings(arg0 context.Context, arg1 installer.V2ListInfraEnvRoleBindingsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListInfraEnvRoleBindings", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListInfraEnvRoleBindings indicates an expected call of V2ListInfraEnvRoleBindings.
+func (mr *MockInstallerAPIMockRecorder) V2ListInfraEnvRoleBindings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListInfraEnvRoleBindings", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListInfraEnvRoleBindings), arg0, arg1)
+}
+
 // V2PostStepReply mocks base method.
 func (m *MockInstallerAPI) V2PostStepReply(arg0 context.Context, arg1 installer.V2PostStepReplyParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -903,3 +1029,17 @@ func (mr *MockInstallerAPIMockRecorder) V2UploadLogs(arg0, arg1 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UploadLogs", reflect.TypeOf((*MockInstallerAPI)(nil).V2UploadLogs), arg0, arg1)
 }
+
+// V2WatchCluster mocks base method.
+func (m *MockInstallerAPI) V2WatchCluster(arg0 context.Context, arg1 installer.V2WatchClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2WatchCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2WatchCluster indicates an expected call of V2WatchCluster.
+func (mr *MockInstallerAPIMockRecorder) V2WatchCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2WatchCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2WatchCluster), arg0, arg1)
+}
@@ -157,10 +157,16 @@ type Cluster struct {
 	// Example: {\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}
 	InstallConfigOverrides string `json:"install_config_overrides,omitempty" gorm:"type:text"`
 
+	// Time window in which the installation is started automatically once the cluster is ready.
+	InstallSchedule *InstallSchedule `json:"install_schedule,omitempty" gorm:"embedded;embeddedPrefix:install_schedule_"`
+
 	// The time that this cluster started installation.
 	// Format: date-time
 	InstallStartedAt strfmt.DateTime `json:"install_started_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// JSON-formatted string containing per-cluster overrides of the installation timeouts of host stages and finalizing stages, for example {"host_stages": {"Rebooting": "2h"}, "finalizing_stages": {"Waiting for cluster operators": "12h"}}. The timeouts use the Go duration format and stages that are not listed keep the timeouts of the service.
+	InstallTimeouts string `json:"install_timeouts,omitempty" gorm:"type:text"`
+
 	// Json formatted string containing ip collisions detected in the cluster.
 	IPCollisions string `json:"ip_collisions,omitempty" gorm:"type:text"`
 
@@ -236,6 +242,10 @@ type Cluster struct {
 	//
 	SchedulableMastersForcedTrue *bool `json:"schedulable_masters_forced_true,omitempty"`
 
+	// The secure boot state required from the hosts of the cluster, 'any' or empty to accept both states.
+	// Enum: [any enabled disabled]
+	SecureBootMode string `json:"secure_boot_mode,omitempty"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
@@ -366,6 +376,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateInstallSchedule(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInstallStartedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -414,6 +428,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSecureBootMode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -901,6 +919,25 @@ func (m *Cluster) validateInstallCompletedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateInstallSchedule(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallSchedule) { // not required
+		return nil
+	}
+
+	if m.InstallSchedule != nil {
+		if err := m.InstallSchedule.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("install_schedule")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("install_schedule")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateInstallStartedAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.InstallStartedAt) { // not required
 		return nil
@@ -1165,6 +1202,51 @@ func (m *Cluster) validateProgress(formats strfmt.Registry) error {
 	return nil
 }
 
+var clusterTypeSecureBootModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["any","enabled","disabled"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTypeSecureBootModePropEnum = append(clusterTypeSecureBootModePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterSecureBootModeAny captures enum value "any"
+	ClusterSecureBootModeAny string = "any"
+
+	// ClusterSecureBootModeEnabled captures enum value "enabled"
+	ClusterSecureBootModeEnabled string = "enabled"
+
+	// ClusterSecureBootModeDisabled captures enum value "disabled"
+	ClusterSecureBootModeDisabled string = "disabled"
+)
+
+// prop value enum
+func (m *Cluster) validateSecureBootModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTypeSecureBootModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Cluster) validateSecureBootMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.SecureBootMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSecureBootModeEnum("secure_boot_mode", "body", m.SecureBootMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
@@ -1342,6 +1424,10 @@ func (m *Cluster) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateInstallSchedule(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateLastInstallationPreparation(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -1528,6 +1614,22 @@ func (m *Cluster) contextValidateIngressVips(ctx context.Context, formats strfmt
 	return nil
 }
 
+func (m *Cluster) contextValidateInstallSchedule(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.InstallSchedule != nil {
+		if err := m.InstallSchedule.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("install_schedule")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("install_schedule")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) contextValidateLastInstallationPreparation(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.LastInstallationPreparation.ContextValidate(ctx, formats); err != nil {
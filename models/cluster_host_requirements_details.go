@@ -26,6 +26,9 @@ type ClusterHostRequirementsDetails struct {
 	// Required installation disk speed in ms
 	InstallationDiskSpeedThresholdMs int64 `json:"installation_disk_speed_threshold_ms,omitempty"`
 
+	// Minimum network throughput at L3 for role, in Mbps.
+	NetworkBandwidthMbps *float64 `json:"network_bandwidth_mbps,omitempty"`
+
 	// Maximum network average latency (RTT) at L3 for role.
 	NetworkLatencyThresholdMs *float64 `json:"network_latency_threshold_ms,omitempty"`
 
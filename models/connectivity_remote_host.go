@@ -32,6 +32,9 @@ type ConnectivityRemoteHost struct {
 
 	// mtu report
 	MtuReport []*MtuReport `json:"mtu_report"`
+
+	// throughput report
+	ThroughputReport []*ThroughputReport `json:"throughput_report"`
 }
 
 // Validate validates this connectivity remote host
@@ -54,6 +57,10 @@ func (m *ConnectivityRemoteHost) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateThroughputReport(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -150,6 +157,32 @@ func (m *ConnectivityRemoteHost) validateMtuReport(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *ConnectivityRemoteHost) validateThroughputReport(formats strfmt.Registry) error {
+	if swag.IsZero(m.ThroughputReport) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ThroughputReport); i++ {
+		if swag.IsZero(m.ThroughputReport[i]) { // not required
+			continue
+		}
+
+		if m.ThroughputReport[i] != nil {
+			if err := m.ThroughputReport[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("throughput_report" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("throughput_report" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this connectivity remote host based on the context it is used
 func (m *ConnectivityRemoteHost) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -166,6 +199,10 @@ func (m *ConnectivityRemoteHost) ContextValidate(ctx context.Context, formats st
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateThroughputReport(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -232,6 +269,26 @@ func (m *ConnectivityRemoteHost) contextValidateMtuReport(ctx context.Context, f
 	return nil
 }
 
+func (m *ConnectivityRemoteHost) contextValidateThroughputReport(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ThroughputReport); i++ {
+
+		if m.ThroughputReport[i] != nil {
+			if err := m.ThroughputReport[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("throughput_report" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("throughput_report" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ConnectivityRemoteHost) MarshalBinary() ([]byte, error) {
 	if m == nil {
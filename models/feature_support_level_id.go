@@ -161,6 +161,15 @@ const (
 
 	// FeatureSupportLevelIDKUBEDESCHEDULER captures enum value "KUBE_DESCHEDULER"
 	FeatureSupportLevelIDKUBEDESCHEDULER FeatureSupportLevelID = "KUBE_DESCHEDULER"
+
+	// FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX captures enum value "EXTERNAL_PLATFORM_PROXMOX"
+	FeatureSupportLevelIDEXTERNALPLATFORMPROXMOX FeatureSupportLevelID = "EXTERNAL_PLATFORM_PROXMOX"
+
+	// FeatureSupportLevelIDOPENSTACKINTEGRATION captures enum value "OPENSTACK_INTEGRATION"
+	FeatureSupportLevelIDOPENSTACKINTEGRATION FeatureSupportLevelID = "OPENSTACK_INTEGRATION"
+
+	// FeatureSupportLevelIDOPERATORVERSIONPINNING captures enum value "OPERATOR_VERSION_PINNING"
+	FeatureSupportLevelIDOPERATORVERSIONPINNING FeatureSupportLevelID = "OPERATOR_VERSION_PINNING"
 )
 
 // for schema
@@ -168,7 +177,7 @@ var featureSupportLevelIdEnum []interface{}
 
 func init() {
 	var res []FeatureSupportLevelID
-	if err := json.Unmarshal([]byte(`["SNO","TNA","VIP_AUTO_ALLOC","CUSTOM_MANIFEST","SINGLE_NODE_EXPANSION","LVM","ODF","LSO","CNV","MCE","MTV","OSC","NUTANIX_INTEGRATION","BAREMETAL_PLATFORM","NONE_PLATFORM","VSPHERE_INTEGRATION","DUAL_STACK_VIPS","CLUSTER_MANAGED_NETWORKING","USER_MANAGED_NETWORKING","MINIMAL_ISO","FULL_ISO","EXTERNAL_PLATFORM_OCI","DUAL_STACK","PLATFORM_MANAGED_NETWORKING","EXTERNAL_PLATFORM","OVN_NETWORK_TYPE","SDN_NETWORK_TYPE","NODE_FEATURE_DISCOVERY","NVIDIA_GPU","PIPELINES","SERVICEMESH","SERVERLESS","OPENSHIFT_AI","NON_STANDARD_HA_CONTROL_PLANE","AUTHORINO","USER_MANAGED_LOAD_BALANCER","NMSTATE","AMD_GPU","KMM","NODE_HEALTHCHECK","SELF_NODE_REMEDIATION","FENCE_AGENTS_REMEDIATION","NODE_MAINTENANCE","KUBE_DESCHEDULER"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["SNO","TNA","VIP_AUTO_ALLOC","CUSTOM_MANIFEST","SINGLE_NODE_EXPANSION","LVM","ODF","LSO","CNV","MCE","MTV","OSC","NUTANIX_INTEGRATION","BAREMETAL_PLATFORM","NONE_PLATFORM","VSPHERE_INTEGRATION","DUAL_STACK_VIPS","CLUSTER_MANAGED_NETWORKING","USER_MANAGED_NETWORKING","MINIMAL_ISO","FULL_ISO","EXTERNAL_PLATFORM_OCI","DUAL_STACK","PLATFORM_MANAGED_NETWORKING","EXTERNAL_PLATFORM","OVN_NETWORK_TYPE","SDN_NETWORK_TYPE","NODE_FEATURE_DISCOVERY","NVIDIA_GPU","PIPELINES","SERVICEMESH","SERVERLESS","OPENSHIFT_AI","NON_STANDARD_HA_CONTROL_PLANE","AUTHORINO","USER_MANAGED_LOAD_BALANCER","NMSTATE","AMD_GPU","KMM","NODE_HEALTHCHECK","SELF_NODE_REMEDIATION","FENCE_AGENTS_REMEDIATION","NODE_MAINTENANCE","KUBE_DESCHEDULER","EXTERNAL_PLATFORM_PROXMOX","OPENSTACK_INTEGRATION","OPERATOR_VERSION_PINNING"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -50,6 +50,9 @@ type Host struct {
 	// swagger:ignore
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamp with time zone;index"`
 
+	// Contains a serialized host-diagnostic-list, the on-demand diagnostics requested for the host.
+	Diagnostics string `json:"diagnostics,omitempty" gorm:"type:text"`
+
 	// discovery agent version
 	DiscoveryAgentVersion string `json:"discovery_agent_version,omitempty"`
 
@@ -66,9 +69,15 @@ type Host struct {
 	// The domain name resolution result.
 	DomainNameResolutions string `json:"domain_name_resolutions,omitempty" gorm:"type:text"`
 
+	// Contains a serialized firmware_inspection_response
+	Firmware string `json:"firmware,omitempty" gorm:"type:text"`
+
 	// free addresses
 	FreeAddresses string `json:"free_addresses,omitempty" gorm:"type:text"`
 
+	// Contains a serialized host_fingerprint, the hypervisor or cloud that the host runs on.
+	HardwareFingerprint string `json:"hardware_fingerprint,omitempty" gorm:"type:text"`
+
 	// Self link.
 	// Required: true
 	Href *string `json:"href"`
@@ -117,6 +117,9 @@ const (
 	// HostValidationIDSufficientPacketLossRequirementForRole captures enum value "sufficient-packet-loss-requirement-for-role"
 	HostValidationIDSufficientPacketLossRequirementForRole HostValidationID = "sufficient-packet-loss-requirement-for-role"
 
+	// HostValidationIDSufficientNetworkBandwidthRequirementForRole captures enum value "sufficient-network-bandwidth-requirement-for-role"
+	HostValidationIDSufficientNetworkBandwidthRequirementForRole HostValidationID = "sufficient-network-bandwidth-requirement-for-role"
+
 	// HostValidationIDHasDefaultRoute captures enum value "has-default-route"
 	HostValidationIDHasDefaultRoute HostValidationID = "has-default-route"
 
@@ -156,6 +159,12 @@ const (
 	// HostValidationIDNoSkipMissingDisk captures enum value "no-skip-missing-disk"
 	HostValidationIDNoSkipMissingDisk HostValidationID = "no-skip-missing-disk"
 
+	// HostValidationIDNoUnhealthyDisks captures enum value "no-unhealthy-disks"
+	HostValidationIDNoUnhealthyDisks HostValidationID = "no-unhealthy-disks"
+
+	// HostValidationIDCompatibleSecureBootState captures enum value "compatible-secure-boot-state"
+	HostValidationIDCompatibleSecureBootState HostValidationID = "compatible-secure-boot-state"
+
 	// HostValidationIDNoIPCollisionsInNetwork captures enum value "no-ip-collisions-in-network"
 	HostValidationIDNoIPCollisionsInNetwork HostValidationID = "no-ip-collisions-in-network"
 
@@ -209,6 +218,9 @@ const (
 
 	// HostValidationIDKubeDeschedulerRequirementsSatisfied captures enum value "kube-descheduler-requirements-satisfied"
 	HostValidationIDKubeDeschedulerRequirementsSatisfied HostValidationID = "kube-descheduler-requirements-satisfied"
+
+	// HostValidationIDPluginOperatorsRequirementsSatisfied captures enum value "plugin-operators-requirements-satisfied"
+	HostValidationIDPluginOperatorsRequirementsSatisfied HostValidationID = "plugin-operators-requirements-satisfied"
 )
 
 // for schema
@@ -216,7 +228,7 @@ var hostValidationIdEnum []interface{}
 
 func init() {
 	var res []HostValidationID
-	if err := json.Unmarshal([]byte(`["connected","media-connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","ignition-downloadable","belongs-to-majority-group","valid-platform-network-settings","ntp-synced","time-synced-between-host-and-service","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","mtv-requirements-satisfied","osc-requirements-satisfied","sufficient-installation-disk-speed","cnv-requirements-satisfied","sufficient-network-latency-requirement-for-role","sufficient-packet-loss-requirement-for-role","has-default-route","api-domain-name-resolved-correctly","api-int-domain-name-resolved-correctly","apps-domain-name-resolved-correctly","release-domain-name-resolved-correctly","compatible-with-cluster-platform","dns-wildcard-not-configured","disk-encryption-requirements-satisfied","non-overlapping-subnets","vsphere-disk-uuid-enabled","compatible-agent","no-skip-installation-disk","no-skip-missing-disk","no-ip-collisions-in-network","no-iscsi-nic-belongs-to-machine-cidr","node-feature-discovery-requirements-satisfied","nvidia-gpu-requirements-satisfied","pipelines-requirements-satisfied","servicemesh-requirements-satisfied","serverless-requirements-satisfied","openshift-ai-requirements-satisfied","authorino-requirements-satisfied","mtu-valid","nmstate-requirements-satisfied","amd-gpu-requirements-satisfied","kmm-requirements-satisfied","node-healthcheck-requirements-satisfied","self-node-remediation-requirements-satisfied","fence-agents-remediation-requirements-satisfied","node-maintenance-requirements-satisfied","kube-descheduler-requirements-satisfied"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connected","media-connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","ignition-downloadable","belongs-to-majority-group","valid-platform-network-settings","ntp-synced","time-synced-between-host-and-service","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","mtv-requirements-satisfied","osc-requirements-satisfied","sufficient-installation-disk-speed","cnv-requirements-satisfied","sufficient-network-latency-requirement-for-role","sufficient-packet-loss-requirement-for-role","sufficient-network-bandwidth-requirement-for-role","has-default-route","api-domain-name-resolved-correctly","api-int-domain-name-resolved-correctly","apps-domain-name-resolved-correctly","release-domain-name-resolved-correctly","compatible-with-cluster-platform","dns-wildcard-not-configured","disk-encryption-requirements-satisfied","non-overlapping-subnets","vsphere-disk-uuid-enabled","compatible-agent","no-skip-installation-disk","no-skip-missing-disk","no-unhealthy-disks","compatible-secure-boot-state","no-ip-collisions-in-network","no-iscsi-nic-belongs-to-machine-cidr","node-feature-discovery-requirements-satisfied","nvidia-gpu-requirements-satisfied","pipelines-requirements-satisfied","servicemesh-requirements-satisfied","serverless-requirements-satisfied","openshift-ai-requirements-satisfied","authorino-requirements-satisfied","mtu-valid","nmstate-requirements-satisfied","amd-gpu-requirements-satisfied","kmm-requirements-satisfied","node-healthcheck-requirements-satisfied","self-node-remediation-requirements-satisfied","fence-agents-remediation-requirements-satisfied","node-maintenance-requirements-satisfied","kube-descheduler-requirements-satisfied","plugin-operators-requirements-satisfied"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
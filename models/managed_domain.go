@@ -24,7 +24,7 @@ type ManagedDomain struct {
 	Domain string `json:"domain,omitempty"`
 
 	// provider
-	// Enum: [route53]
+	// Enum: [route53 rfc2136 powerdns]
 	Provider string `json:"provider,omitempty"`
 }
 
@@ -46,7 +46,7 @@ var managedDomainTypeProviderPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["route53"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["route53","rfc2136","powerdns"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -58,6 +58,12 @@ const (
 
 	// ManagedDomainProviderRoute53 captures enum value "route53"
 	ManagedDomainProviderRoute53 string = "route53"
+
+	// ManagedDomainProviderRfc2136 captures enum value "rfc2136"
+	ManagedDomainProviderRfc2136 string = "rfc2136"
+
+	// ManagedDomainProviderPowerdns captures enum value "powerdns"
+	ManagedDomainProviderPowerdns string = "powerdns"
 )
 
 // prop value enum
@@ -23,6 +23,22 @@ type MonitoredOperator struct {
 	// List of identifier of the bundles associated with the operator. Can be empty.
 	Bundles pq.StringArray `json:"bundles" gorm:"type:text[]"`
 
+	// Name of the catalog source of the subscription. When empty the default catalog source of the operator is
+	// used. Must be one of the catalog sources returned by the install options of the operator, unless
+	// catalog_source_image is also set.
+	//
+	CatalogSource string `json:"catalog_source,omitempty"`
+
+	// Index image of a catalog source that will be created in the 'openshift-marketplace' namespace, for example
+	// a mirrored catalog in a disconnected environment.
+	//
+	CatalogSourceImage string `json:"catalog_source_image,omitempty"`
+
+	// Channel of the subscription of the operator. When empty the default channel for the OpenShift version of the
+	// cluster is used.
+	//
+	Channel string `json:"channel,omitempty"`
+
 	// The cluster that this operator is associated with.
 	// Format: uuid
 	ClusterID strfmt.UUID `json:"cluster_id,omitempty" gorm:"primaryKey"`
@@ -30,6 +46,9 @@ type MonitoredOperator struct {
 	// Whether the operator can't be installed without being required by another operator.
 	DependencyOnly bool `json:"dependency_only,omitempty"`
 
+	// install plan approval
+	InstallPlanApproval OperatorInstallPlanApproval `json:"install_plan_approval,omitempty"`
+
 	// Unique name of the operator.
 	Name string `json:"name,omitempty" gorm:"primaryKey"`
 
@@ -42,6 +61,9 @@ type MonitoredOperator struct {
 	// Blob of operator-dependent parameters that are required for installation.
 	Properties string `json:"properties,omitempty" gorm:"type:text"`
 
+	// Name of the cluster service version that will be installed, for example 'odf-operator.v4.16.3'.
+	StartingCsv string `json:"starting_csv,omitempty"`
+
 	// status
 	Status OperatorStatus `json:"status,omitempty"`
 
@@ -70,6 +92,10 @@ func (m *MonitoredOperator) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateInstallPlanApproval(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOperatorType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -100,6 +126,23 @@ func (m *MonitoredOperator) validateClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *MonitoredOperator) validateInstallPlanApproval(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallPlanApproval) { // not required
+		return nil
+	}
+
+	if err := m.InstallPlanApproval.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("install_plan_approval")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("install_plan_approval")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *MonitoredOperator) validateOperatorType(formats strfmt.Registry) error {
 	if swag.IsZero(m.OperatorType) { // not required
 		return nil
@@ -150,6 +193,10 @@ func (m *MonitoredOperator) validateStatusUpdatedAt(formats strfmt.Registry) err
 func (m *MonitoredOperator) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateInstallPlanApproval(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateOperatorType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -164,6 +211,20 @@ func (m *MonitoredOperator) ContextValidate(ctx context.Context, formats strfmt.
 	return nil
 }
 
+func (m *MonitoredOperator) contextValidateInstallPlanApproval(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.InstallPlanApproval.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("install_plan_approval")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("install_plan_approval")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *MonitoredOperator) contextValidateOperatorType(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.OperatorType.ContextValidate(ctx, formats); err != nil {
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -17,20 +18,91 @@ import (
 // swagger:model operator-create-params
 type OperatorCreateParams struct {
 
+	// Name of the catalog source of the subscription. When empty the default catalog source of the operator is
+	// used. Must be one of the catalog sources returned by the install options of the operator, unless
+	// catalog_source_image is also set.
+	//
+	CatalogSource string `json:"catalog_source,omitempty"`
+
+	// Index image of a catalog source that will be created in the 'openshift-marketplace' namespace, for example
+	// a mirrored catalog in a disconnected environment.
+	//
+	CatalogSourceImage string `json:"catalog_source_image,omitempty"`
+
+	// Channel of the subscription of the operator. When empty the default channel for the OpenShift version of the
+	// cluster is used.
+	//
+	Channel string `json:"channel,omitempty"`
+
+	// install plan approval
+	InstallPlanApproval OperatorInstallPlanApproval `json:"install_plan_approval,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
 	// Blob of operator-dependent parameters that are required for installation.
 	Properties string `json:"properties,omitempty" gorm:"type:text"`
+
+	// Name of the cluster service version that will be installed, for example 'odf-operator.v4.16.3'.
+	StartingCsv string `json:"starting_csv,omitempty"`
 }
 
 // Validate validates this operator create params
 func (m *OperatorCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateInstallPlanApproval(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OperatorCreateParams) validateInstallPlanApproval(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallPlanApproval) { // not required
+		return nil
+	}
+
+	if err := m.InstallPlanApproval.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("install_plan_approval")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("install_plan_approval")
+		}
+		return err
+	}
+
 	return nil
 }
 
-// ContextValidate validates this operator create params based on context it is used
+// ContextValidate validate this operator create params based on the context it is used
 func (m *OperatorCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateInstallPlanApproval(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OperatorCreateParams) contextValidateInstallPlanApproval(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.InstallPlanApproval.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("install_plan_approval")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("install_plan_approval")
+		}
+		return err
+	}
+
 	return nil
 }
 
@@ -22,6 +22,9 @@ type Platform struct {
 	// external
 	External *PlatformExternal `json:"external,omitempty" gorm:"embedded;embeddedPrefix:external_"`
 
+	// openstack
+	Openstack *PlatformOpenstack `json:"openstack,omitempty" gorm:"embedded;embeddedPrefix:openstack_"`
+
 	// type
 	// Required: true
 	Type *PlatformType `json:"type"`
@@ -35,6 +38,10 @@ func (m *Platform) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOpenstack(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -64,6 +71,25 @@ func (m *Platform) validateExternal(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Platform) validateOpenstack(formats strfmt.Registry) error {
+	if swag.IsZero(m.Openstack) { // not required
+		return nil
+	}
+
+	if m.Openstack != nil {
+		if err := m.Openstack.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("openstack")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("openstack")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Platform) validateType(formats strfmt.Registry) error {
 
 	if err := validate.Required("type", "body", m.Type); err != nil {
@@ -96,6 +122,10 @@ func (m *Platform) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateOpenstack(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -122,6 +152,22 @@ func (m *Platform) contextValidateExternal(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *Platform) contextValidateOpenstack(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Openstack != nil {
+		if err := m.Openstack.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("openstack")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("openstack")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Platform) contextValidateType(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Type != nil {
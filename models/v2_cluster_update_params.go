@@ -71,6 +71,12 @@ type V2ClusterUpdateParams struct {
 	// The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	IngressVips []*IngressVip `json:"ingress_vips"`
 
+	// Time window in which the installation is started automatically once the cluster is ready. Set an empty object to remove the schedule.
+	InstallSchedule *InstallSchedule `json:"install_schedule,omitempty" gorm:"embedded;embeddedPrefix:install_schedule_"`
+
+	// JSON-formatted string containing per-cluster overrides of the installation timeouts of host stages and finalizing stages, for example {"host_stages": {"Rebooting": "2h"}, "finalizing_stages": {"Waiting for cluster operators": "12h"}}. The timeouts use the Go duration format and stages that are not listed keep the timeouts of the service.
+	InstallTimeouts *string `json:"install_timeouts,omitempty"`
+
 	// load balancer
 	LoadBalancer *LoadBalancer `json:"load_balancer,omitempty" gorm:"embedded;embeddedPrefix:load_balancer_"`
 
@@ -107,6 +113,10 @@ type V2ClusterUpdateParams struct {
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
 
+	// The secure boot state required from the hosts of the cluster, 'any' to accept both states.
+	// Enum: [any enabled disabled]
+	SecureBootMode *string `json:"secure_boot_mode,omitempty"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr *string `json:"service_network_cidr,omitempty"`
@@ -163,6 +173,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateInstallSchedule(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateLoadBalancer(formats); err != nil {
 		res = append(res, err)
 	}
@@ -191,6 +205,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSecureBootMode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -412,6 +430,25 @@ func (m *V2ClusterUpdateParams) validateIngressVips(formats strfmt.Registry) err
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateInstallSchedule(formats strfmt.Registry) error {
+	if swag.IsZero(m.InstallSchedule) { // not required
+		return nil
+	}
+
+	if m.InstallSchedule != nil {
+		if err := m.InstallSchedule.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("install_schedule")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("install_schedule")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validateLoadBalancer(formats strfmt.Registry) error {
 	if swag.IsZero(m.LoadBalancer) { // not required
 		return nil
@@ -572,6 +609,51 @@ func (m *V2ClusterUpdateParams) validatePlatform(formats strfmt.Registry) error
 	return nil
 }
 
+var v2ClusterUpdateParamsTypeSecureBootModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["any","enabled","disabled"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		v2ClusterUpdateParamsTypeSecureBootModePropEnum = append(v2ClusterUpdateParamsTypeSecureBootModePropEnum, v)
+	}
+}
+
+const (
+
+	// V2ClusterUpdateParamsSecureBootModeAny captures enum value "any"
+	V2ClusterUpdateParamsSecureBootModeAny string = "any"
+
+	// V2ClusterUpdateParamsSecureBootModeEnabled captures enum value "enabled"
+	V2ClusterUpdateParamsSecureBootModeEnabled string = "enabled"
+
+	// V2ClusterUpdateParamsSecureBootModeDisabled captures enum value "disabled"
+	V2ClusterUpdateParamsSecureBootModeDisabled string = "disabled"
+)
+
+// prop value enum
+func (m *V2ClusterUpdateParams) validateSecureBootModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, v2ClusterUpdateParamsTypeSecureBootModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *V2ClusterUpdateParams) validateSecureBootMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.SecureBootMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSecureBootModeEnum("secure_boot_mode", "body", *m.SecureBootMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
@@ -634,6 +716,10 @@ func (m *V2ClusterUpdateParams) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateInstallSchedule(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateLoadBalancer(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -752,6 +838,22 @@ func (m *V2ClusterUpdateParams) contextValidateIngressVips(ctx context.Context,
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateInstallSchedule(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.InstallSchedule != nil {
+		if err := m.InstallSchedule.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("install_schedule")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("install_schedule")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidateLoadBalancer(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.LoadBalancer != nil {
@@ -3,8 +3,10 @@ package app
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -121,12 +123,23 @@ func WithIPXEScriptMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// retryAfterError is implemented by the errors of the requests that can be retried later, such as
+// the ones rejected by a rate limit
+type retryAfterError interface {
+	error
+	RetryAfter() time.Duration
+}
+
 func WrapServeError() func(http.ResponseWriter, *http.Request, error) {
 	unsupportedHTTPCodes := map[int32]struct{}{
 		http.StatusUnprocessableEntity: {},
 	}
 
 	return func(rw http.ResponseWriter, r *http.Request, err error) {
+		var retryable retryAfterError
+		if errors.As(err, &retryable) && retryable.RetryAfter() > 0 {
+			rw.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryable.RetryAfter().Seconds()))))
+		}
 		if shouldModifyError(err, unsupportedHTTPCodes) {
 			err = unwrapCompositeError(err)
 			err = errormiddleware.New(http.StatusBadRequest, err.Error())
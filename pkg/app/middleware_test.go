@@ -159,3 +159,35 @@ var _ = Describe("WithInitrdViaHTTPMiddleware", func() {
 		Expect(respStatus).To(Equal(200))
 	})
 })
+
+type retryLaterError struct {
+	retryAfter time.Duration
+}
+
+func (e *retryLaterError) Error() string {
+	return "too many requests"
+}
+
+func (e *retryLaterError) Code() int32 {
+	return http.StatusTooManyRequests
+}
+
+func (e *retryLaterError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+var _ = Describe("WrapServeError", func() {
+	It("sets the Retry-After header of the retryable errors", func() {
+		rw := httptest.NewRecorder()
+		WrapServeError()(rw, httptest.NewRequest(http.MethodGet, "/", nil), &retryLaterError{retryAfter: 1500 * time.Millisecond})
+		Expect(rw.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(rw.Header().Get("Retry-After")).To(Equal("2"))
+	})
+
+	It("does not set the Retry-After header of other errors", func() {
+		rw := httptest.NewRecorder()
+		WrapServeError()(rw, httptest.NewRequest(http.MethodGet, "/", nil), fmt.Errorf("failed"))
+		Expect(rw.Code).To(Equal(http.StatusInternalServerError))
+		Expect(rw.Header().Get("Retry-After")).To(BeEmpty())
+	})
+})
@@ -35,6 +35,10 @@ func (a *AgentLocalAuthzHandler) OwnedByUser(ctx context.Context, db *gorm.DB, u
 	return db
 }
 
+func (a *AgentLocalAuthzHandler) OwnedByUserOrBound(ctx context.Context, db *gorm.DB, username string, obj interface{}) *gorm.DB {
+	return db
+}
+
 func (a *AgentLocalAuthzHandler) HasAccessTo(ctx context.Context, obj interface{}, action Action) (bool, error) {
 	return true, nil
 }
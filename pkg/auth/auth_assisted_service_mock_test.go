@@ -8,11 +8,13 @@ import (
 	"os"
 	"strings"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	"github.com/openshift/assisted-service/restapi"
+	auditapi "github.com/openshift/assisted-service/restapi/operations/audit"
 	eventsapi "github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	managed_domains_api "github.com/openshift/assisted-service/restapi/operations/managed_domains"
@@ -113,6 +115,12 @@ func (f fakeInventory) V2UploadClusterIngressCert(ctx context.Context, params in
 	return installer.NewV2UploadClusterIngressCertCreated()
 }
 
+func (f fakeInventory) V2WatchCluster(ctx context.Context, params installer.V2WatchClusterParams) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.WriteHeader(http.StatusOK)
+	})
+}
+
 func (f fakeInventory) V2UpdateClusterLogsProgress(ctx context.Context, params installer.V2UpdateClusterLogsProgressParams) middleware.Responder {
 	return installer.NewV2UpdateClusterLogsProgressNoContent()
 }
@@ -197,6 +205,14 @@ func (f fakeInventory) V2GetHostIgnition(ctx context.Context, params installer.V
 	return installer.NewV2GetHostIgnitionOK()
 }
 
+func (f fakeInventory) V2CreateHostDiagnostic(ctx context.Context, params installer.V2CreateHostDiagnosticParams) middleware.Responder {
+	return installer.NewV2CreateHostDiagnosticCreated()
+}
+
+func (f fakeInventory) V2ListHostDiagnostics(ctx context.Context, params installer.V2ListHostDiagnosticsParams) middleware.Responder {
+	return installer.NewV2ListHostDiagnosticsOK()
+}
+
 func (f fakeInventory) V2ResetHostValidation(ctx context.Context, params installer.V2ResetHostValidationParams) middleware.Responder {
 	return installer.NewV2ResetHostValidationOK()
 }
@@ -226,6 +242,34 @@ func (f fakeInventory) V2GetPresignedForClusterFiles(ctx context.Context, params
 	return installer.NewV2GetPresignedForClusterFilesOK()
 }
 
+func (f fakeInventory) V2GetClusterInstallationTimeline(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) middleware.Responder {
+	return installer.NewV2GetClusterInstallationTimelineOK()
+}
+
+func (f fakeInventory) V2ListClusterRoleBindings(ctx context.Context, params installer.V2ListClusterRoleBindingsParams) middleware.Responder {
+	return installer.NewV2ListClusterRoleBindingsOK()
+}
+
+func (f fakeInventory) V2CreateClusterRoleBinding(ctx context.Context, params installer.V2CreateClusterRoleBindingParams) middleware.Responder {
+	return installer.NewV2CreateClusterRoleBindingCreated()
+}
+
+func (f fakeInventory) V2DeleteClusterRoleBinding(ctx context.Context, params installer.V2DeleteClusterRoleBindingParams) middleware.Responder {
+	return installer.NewV2DeleteClusterRoleBindingNoContent()
+}
+
+func (f fakeInventory) V2ListInfraEnvRoleBindings(ctx context.Context, params installer.V2ListInfraEnvRoleBindingsParams) middleware.Responder {
+	return installer.NewV2ListInfraEnvRoleBindingsOK()
+}
+
+func (f fakeInventory) V2CreateInfraEnvRoleBinding(ctx context.Context, params installer.V2CreateInfraEnvRoleBindingParams) middleware.Responder {
+	return installer.NewV2CreateInfraEnvRoleBindingCreated()
+}
+
+func (f fakeInventory) V2DeleteInfraEnvRoleBinding(ctx context.Context, params installer.V2DeleteInfraEnvRoleBindingParams) middleware.Responder {
+	return installer.NewV2DeleteInfraEnvRoleBindingNoContent()
+}
+
 func (f fakeInventory) V2GetClusterDefaultConfig(ctx context.Context, params installer.V2GetClusterDefaultConfigParams) middleware.Responder {
 	return installer.NewV2GetClusterDefaultConfigOK()
 }
@@ -280,6 +324,12 @@ func (f fakeInventory) ListClusterHosts(ctx context.Context, params installer.Li
 
 var _ restapi.InstallerAPI = fakeInventory{}
 
+type fakeAuditAPI struct{}
+
+func (f fakeAuditAPI) V2ListAuditRecords(ctx context.Context, params auditapi.V2ListAuditRecordsParams) middleware.Responder {
+	return auditapi.NewV2ListAuditRecordsOK()
+}
+
 type fakeEventsAPI struct{}
 
 func (f fakeEventsAPI) V2ListEvents(ctx context.Context, params eventsapi.V2ListEventsParams) middleware.Responder {
@@ -290,6 +340,26 @@ func (f fakeEventsAPI) V2TriggerEvent(ctx context.Context, params eventsapi.V2Tr
 	return eventsapi.NewV2TriggerEventCreated()
 }
 
+func (f fakeEventsAPI) V2RegisterEventSubscription(ctx context.Context, params eventsapi.V2RegisterEventSubscriptionParams) middleware.Responder {
+	return eventsapi.NewV2RegisterEventSubscriptionCreated()
+}
+
+func (f fakeEventsAPI) V2ListEventSubscriptions(ctx context.Context, params eventsapi.V2ListEventSubscriptionsParams) middleware.Responder {
+	return eventsapi.NewV2ListEventSubscriptionsOK()
+}
+
+func (f fakeEventsAPI) V2GetEventSubscription(ctx context.Context, params eventsapi.V2GetEventSubscriptionParams) middleware.Responder {
+	return eventsapi.NewV2GetEventSubscriptionOK()
+}
+
+func (f fakeEventsAPI) V2DeregisterEventSubscription(ctx context.Context, params eventsapi.V2DeregisterEventSubscriptionParams) middleware.Responder {
+	return eventsapi.NewV2DeregisterEventSubscriptionNoContent()
+}
+
+func (f fakeEventsAPI) V2ListEventSubscriptionDeliveries(ctx context.Context, params eventsapi.V2ListEventSubscriptionDeliveriesParams) middleware.Responder {
+	return eventsapi.NewV2ListEventSubscriptionDeliveriesOK()
+}
+
 type fakeVersionsAPI struct{}
 
 func (f fakeVersionsAPI) V2ListComponentVersions(
@@ -18,6 +18,7 @@ const (
 	TypeRHSSO      AuthType = "rhsso"
 	TypeLocal      AuthType = "local"
 	TypeAgentLocal AuthType = "agent-installer-local"
+	TypeOIDC       AuthType = "oidc"
 )
 
 type Authenticator interface {
@@ -42,6 +43,16 @@ type Config struct {
 	AdminUsers                 []string `envconfig:"ADMIN_USERS" default:""`
 	EnableOrgTenancy           bool     `envconfig:"ENABLE_ORG_TENANCY" default:"false"`
 	EnableOrgBasedFeatureGates bool     `envconfig:"ENABLE_ORG_BASED_FEATURE_GATES" default:"false"`
+	OIDCIssuerURL              string   `envconfig:"OIDC_ISSUER_URL" default:""`
+	// Optional, when set the tokens must include it in their audience
+	OIDCAudience   string `envconfig:"OIDC_AUDIENCE" default:""`
+	OIDCCACertFile string `envconfig:"OIDC_CA_CERT_FILE" default:""`
+	// Nested claims are separated by dots, for example realm_access.roles
+	OIDCUsernameClaim       string   `envconfig:"OIDC_USERNAME_CLAIM" default:"preferred_username"`
+	OIDCOrgClaim            string   `envconfig:"OIDC_ORG_CLAIM" default:""`
+	OIDCGroupsClaim         string   `envconfig:"OIDC_GROUPS_CLAIM" default:"groups"`
+	OIDCAdminGroups         []string `envconfig:"OIDC_ADMIN_GROUPS" default:""`
+	OIDCReadOnlyAdminGroups []string `envconfig:"OIDC_READ_ONLY_ADMIN_GROUPS" default:""`
 }
 
 func NewAuthenticator(cfg *Config, ocmClient *ocm.Client, log logrus.FieldLogger, db *gorm.DB) (a Authenticator, err error) {
@@ -54,6 +65,8 @@ func NewAuthenticator(cfg *Config, ocmClient *ocm.Client, log logrus.FieldLogger
 		a, err = NewLocalAuthenticator(cfg, log, db)
 	case TypeAgentLocal:
 		a, err = NewAgentLocalAuthenticator(cfg, log)
+	case TypeOIDC:
+		a, err = NewOIDCAuthenticator(cfg, log, db)
 	default:
 		err = fmt.Errorf("invalid authenticator type %v", cfg.AuthType)
 	}
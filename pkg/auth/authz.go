@@ -29,6 +29,12 @@ type Authorizer interface {
 	 */
 	OwnedByUser(ctx context.Context, db *gorm.DB, username string) *gorm.DB
 
+	/* Limits the database query of clusters or infra-envs, depending on the input
+	 * object type, like OwnedByUser does, and adds the records that the current
+	 * user was granted a role on through role bindings when no user is given.
+	 */
+	OwnedByUserOrBound(ctx context.Context, db *gorm.DB, username string, obj interface{}) *gorm.DB
+
 	/* verify that the current user has access rights (depending on the requested)
 	 * action) to the input resource
 	 */
@@ -57,6 +63,8 @@ func NewAuthzHandler(cfg *Config, ocmCLient *ocm.Client, log logrus.FieldLogger,
 
 	case TypeAgentLocal:
 		authzr = &AgentLocalAuthzHandler{}
+	case TypeOIDC:
+		authzr = NewOIDCAuthzHandler(cfg, log, db)
 	default:
 		authzr = &NoneHandler{}
 	}
@@ -31,6 +31,10 @@ func (*NoneHandler) OwnedByUser(ctx context.Context, db *gorm.DB, username strin
 	return db
 }
 
+func (*NoneHandler) OwnedByUserOrBound(ctx context.Context, db *gorm.DB, username string, obj interface{}) *gorm.DB {
+	return db
+}
+
 func (*NoneHandler) HasAccessTo(ctx context.Context, obj interface{}, action Action) (bool, error) {
 	return true, nil
 }
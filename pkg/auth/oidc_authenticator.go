@@ -0,0 +1,337 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultOIDCUsernameClaim = "preferred_username"
+	defaultOIDCGroupsClaim   = "groups"
+)
+
+// The keys of the identity provider are refreshed when a token is signed with an unknown key,
+// but not more often than this interval
+const oidcKeysRefreshInterval = time.Minute
+
+/* OIDCAuthenticator authenticates users with JWTs issued by any OpenID Connect provider,
+ * such as Keycloak or Dex. Agents keep authenticating with the tokens signed by the service,
+ * as they do with local authentication.
+ */
+type OIDCAuthenticator struct {
+	*LocalAuthenticator
+	IssuerURL           string
+	Audience            string
+	UsernameClaim       string
+	OrgClaim            string
+	GroupsClaim         string
+	AdminUsers          []string
+	AdminGroups         []string
+	ReadOnlyAdminGroups []string
+	OrgTenancyEnabled   bool
+	client              *http.Client
+	keysLock            sync.RWMutex
+	keyMap              map[string]*rsa.PublicKey
+	keysRefreshedAt     time.Time
+	jwksURL             string
+}
+
+func NewOIDCAuthenticator(cfg *Config, log logrus.FieldLogger, db *gorm.DB) (*OIDCAuthenticator, error) {
+	if cfg.OIDCIssuerURL == "" {
+		return nil, errors.Errorf("oidc authentication requires an issuer URL")
+	}
+	if cfg.EnableOrgTenancy && cfg.OIDCOrgClaim == "" {
+		return nil, errors.Errorf("oidc authentication requires an org claim when org tenancy is enabled")
+	}
+
+	localAuthenticator, err := NewLocalAuthenticator(cfg, log, db)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newOIDCHTTPClient(cfg.OIDCCACertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &OIDCAuthenticator{
+		LocalAuthenticator:  localAuthenticator,
+		IssuerURL:           cfg.OIDCIssuerURL,
+		Audience:            cfg.OIDCAudience,
+		UsernameClaim:       cfg.OIDCUsernameClaim,
+		OrgClaim:            cfg.OIDCOrgClaim,
+		GroupsClaim:         cfg.OIDCGroupsClaim,
+		AdminUsers:          cfg.AdminUsers,
+		AdminGroups:         cfg.OIDCAdminGroups,
+		ReadOnlyAdminGroups: cfg.OIDCReadOnlyAdminGroups,
+		OrgTenancyEnabled:   cfg.EnableOrgTenancy,
+		client:              client,
+	}
+
+	if a.UsernameClaim == "" {
+		a.UsernameClaim = defaultOIDCUsernameClaim
+	}
+	if a.GroupsClaim == "" {
+		a.GroupsClaim = defaultOIDCGroupsClaim
+	}
+
+	if err = a.discover(); err != nil {
+		return nil, err
+	}
+	if err = a.refreshKeys(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+var _ Authenticator = &OIDCAuthenticator{}
+
+func (a *OIDCAuthenticator) AuthType() AuthType {
+	return TypeOIDC
+}
+
+func (a *OIDCAuthenticator) EnableOrgTenancy() bool {
+	return a.OrgTenancyEnabled
+}
+
+func (a *OIDCAuthenticator) EnableOrgBasedFeatureGates() bool {
+	return false
+}
+
+func newOIDCHTTPClient(caCertFile string) (*http.Client, error) {
+	trustedCAs, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, errors.Errorf("can't load system trusted CAs: %v", err)
+	}
+	if caCertFile != "" {
+		var caCert []byte
+		caCert, err = os.ReadFile(caCertFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the oidc CA certificate file %s", caCertFile)
+		}
+		if !trustedCAs.AppendCertsFromPEM(caCert) {
+			return nil, errors.Errorf("no certificates found in the oidc CA certificate file %s", caCertFile)
+		}
+	}
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: trustedCAs,
+			},
+		},
+	}, nil
+}
+
+func (a *OIDCAuthenticator) getJSON(url string, target interface{}) error {
+	res, err := a.client.Get(url)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %s", url)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to get %s, unexpected status code %d", url, res.StatusCode)
+	}
+	if err = json.NewDecoder(res.Body).Decode(target); err != nil {
+		return errors.Wrapf(err, "failed to decode the response of %s", url)
+	}
+	return nil
+}
+
+// discover reads the JWKS URL of the identity provider from its discovery document
+func (a *OIDCAuthenticator) discover() error {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JwksURI string `json:"jwks_uri"`
+	}
+	if err := a.getJSON(strings.TrimSuffix(a.IssuerURL, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+		return err
+	}
+	if discovery.Issuer != a.IssuerURL {
+		return errors.Errorf("oidc discovery issuer %s does not match the configured issuer %s", discovery.Issuer, a.IssuerURL)
+	}
+	if discovery.JwksURI == "" {
+		return errors.Errorf("oidc discovery of %s is missing jwks_uri", a.IssuerURL)
+	}
+	a.jwksURL = discovery.JwksURI
+	return nil
+}
+
+func (a *OIDCAuthenticator) refreshKeys() error {
+	var certs jwtKeys
+	if err := a.getJSON(a.jwksURL, &certs); err != nil {
+		return err
+	}
+
+	utils := &aUtils{}
+	keyMap := map[string]*rsa.PublicKey{}
+	for _, c := range certs.Keys {
+		// Identity providers may publish keys that are not used to sign tokens
+		if c.Kty != "RSA" || (c.Use != "" && c.Use != "sig") {
+			continue
+		}
+		pemStr, err := utils.certToPEM(c)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert oidc key %s", c.KID)
+		}
+		pubKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(pemStr))
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse oidc key %s", c.KID)
+		}
+		keyMap[c.KID] = pubKey
+	}
+
+	a.keysLock.Lock()
+	defer a.keysLock.Unlock()
+	a.keyMap = keyMap
+	a.keysRefreshedAt = time.Now()
+	return nil
+}
+
+func (a *OIDCAuthenticator) getKey(kid string) (*rsa.PublicKey, bool) {
+	a.keysLock.RLock()
+	defer a.keysLock.RUnlock()
+	key, ok := a.keyMap[kid]
+	return key, ok
+}
+
+func (a *OIDCAuthenticator) getValidationKey(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return nil, errors.Errorf("no kid found in jwt token")
+	}
+
+	if key, found := a.getKey(kid); found {
+		return key, nil
+	}
+
+	// The identity provider may have rotated its keys
+	a.keysLock.RLock()
+	canRefresh := time.Since(a.keysRefreshedAt) > oidcKeysRefreshInterval
+	a.keysLock.RUnlock()
+	if canRefresh {
+		if err := a.refreshKeys(); err != nil {
+			a.log.WithError(err).Warn("Failed to refresh the oidc keys")
+		} else if key, found := a.getKey(kid); found {
+			return key, nil
+		}
+	}
+	return nil, errors.Errorf("No matching key in auth keymap for key id [%v]", kid)
+}
+
+// claimValue returns the value of a claim, nested claims are separated by dots, for example realm_access.roles
+func claimValue(claims jwt.MapClaims, name string) interface{} {
+	var value interface{} = map[string]interface{}(claims)
+	for _, part := range strings.Split(name, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[part]
+	}
+	return value
+}
+
+func claimStrings(claims jwt.MapClaims, name string) []string {
+	switch value := claimValue(claims, name).(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var ret []string
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				ret = append(ret, s)
+			}
+		}
+		return ret
+	}
+	return nil
+}
+
+func (a *OIDCAuthenticator) parsePayload(claims jwt.MapClaims) *ocm.AuthPayload {
+	payload := &ocm.AuthPayload{}
+	payload.Username, _ = claimValue(claims, a.UsernameClaim).(string)
+	if a.OrgClaim != "" {
+		payload.Organization, _ = claimValue(claims, a.OrgClaim).(string)
+	}
+	payload.Email, _ = claims["email"].(string)
+	payload.FirstName, _ = claims["given_name"].(string)
+	payload.LastName, _ = claims["family_name"].(string)
+	payload.ClientID, _ = claims["azp"].(string)
+	payload.Groups = claimStrings(claims, a.GroupsClaim)
+	payload.Role = a.getRole(payload.Username, payload.Groups)
+	// Access reviews require OCM, users authenticated by the identity provider are authorized by their role alone
+	payload.IsAuthorized = true
+	return payload
+}
+
+func (a *OIDCAuthenticator) getRole(username string, groups []string) ocm.RoleType {
+	if funk.ContainsString(a.AdminUsers, username) {
+		return ocm.AdminRole
+	}
+	for _, group := range groups {
+		if funk.ContainsString(a.AdminGroups, group) {
+			return ocm.AdminRole
+		}
+	}
+	for _, group := range groups {
+		if funk.ContainsString(a.ReadOnlyAdminGroups, group) {
+			return ocm.ReadOnlyAdminRole
+		}
+	}
+	return ocm.UserRole
+}
+
+func (a *OIDCAuthenticator) AuthUserAuth(token string) (interface{}, error) {
+	authHeaderParts := strings.Fields(token)
+	if len(authHeaderParts) != 2 || strings.ToLower(authHeaderParts[0]) != "bearer" {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Authorization header format must be Bearer {token}"))
+	}
+
+	parser := &jwt.Parser{ValidMethods: []string{jwt.SigningMethodRS256.Alg()}}
+	parsedToken, err := parser.Parse(authHeaderParts[1], a.getValidationKey)
+	if err != nil || !parsedToken.Valid {
+		// Don't report error "Token used before issued", clocks of the identity provider and the service may differ
+		if !isValidationErrorIssuedAt(err) {
+			a.log.WithError(err).Error("Error parsing oidc token or token is invalid")
+			return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Error parsing token or token is invalid"))
+		}
+	}
+
+	claims, ok := parsedToken.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Unable to parse JWT token claims"))
+	}
+	if !claims.VerifyIssuer(a.IssuerURL, true) {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Token was not issued by %s", a.IssuerURL))
+	}
+	if a.Audience != "" && !claims.VerifyAudience(a.Audience, true) {
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Token audience does not include %s", a.Audience))
+	}
+
+	payload := a.parsePayload(claims)
+	if payload.Username == "" {
+		a.log.Errorf("Missing %s claim in token", a.UsernameClaim)
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Missing username in token"))
+	}
+	if a.OrgTenancyEnabled && payload.Organization == "" {
+		a.log.Errorf("Missing %s claim in token of user %s", a.OrgClaim, payload.Username)
+		return nil, common.NewInfraError(http.StatusUnauthorized, errors.Errorf("Missing organization in token"))
+	}
+	return payload, nil
+}
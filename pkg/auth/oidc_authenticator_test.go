@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"crypto"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("OIDC authenticator", func() {
+	var (
+		server     *ghttp.Server
+		cfg        *Config
+		privateKey crypto.PrivateKey
+		kid        string
+		jwks       []byte
+	)
+
+	genKey := func() {
+		var pub crypto.PublicKey
+		pub, privateKey, _ = GenKeys(2048)
+		jwks, _, kid, _ = GenJSJWKS(privateKey, pub)
+	}
+
+	serveDiscovery := func(issuer string) {
+		server.RouteToHandler(http.MethodGet, "/.well-known/openid-configuration",
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{
+				"issuer":   issuer,
+				"jwks_uri": server.URL() + "/keys",
+			}))
+	}
+
+	serveKeys := func() {
+		server.RouteToHandler(http.MethodGet, "/keys", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(jwks)
+		})
+	}
+
+	signToken := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		tokenString, err := token.SignedString(privateKey)
+		Expect(err).ToNot(HaveOccurred())
+		return "Bearer " + tokenString
+	}
+
+	claims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":                server.URL(),
+			"aud":                "assisted-service",
+			"exp":                time.Now().Add(time.Hour).Unix(),
+			"preferred_username": "jdoe",
+			"email":              "jdoe@example.com",
+			"tenant":             "edge",
+			"groups":             []string{"developers"},
+		}
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.SetAllowUnhandledRequests(false)
+		genKey()
+		serveDiscovery(server.URL())
+		serveKeys()
+
+		pubKey, _, err := gencrypto.ECDSAKeyPairPEM()
+		Expect(err).ToNot(HaveOccurred())
+		cfg = &Config{
+			AuthType:                TypeOIDC,
+			ECPublicKeyPEM:          pubKey,
+			OIDCIssuerURL:           server.URL(),
+			OIDCAudience:            "assisted-service",
+			OIDCOrgClaim:            "tenant",
+			OIDCAdminGroups:         []string{"admins"},
+			OIDCReadOnlyAdminGroups: []string{"auditors"},
+			EnableOrgTenancy:        true,
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newAuthenticator := func() *OIDCAuthenticator {
+		a, err := NewAuthenticator(cfg, nil, logrus.New(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		oidcAuthenticator, ok := a.(*OIDCAuthenticator)
+		Expect(ok).To(BeTrue())
+		return oidcAuthenticator
+	}
+
+	authUser := func(a *OIDCAuthenticator, token string) *ocm.AuthPayload {
+		payload, err := a.AuthUserAuth(token)
+		Expect(err).ToNot(HaveOccurred())
+		return payload.(*ocm.AuthPayload)
+	}
+
+	It("maps the claims to the payload", func() {
+		payload := authUser(newAuthenticator(), signToken(claims()))
+		Expect(payload.Username).To(Equal("jdoe"))
+		Expect(payload.Organization).To(Equal("edge"))
+		Expect(payload.Email).To(Equal("jdoe@example.com"))
+		Expect(payload.Groups).To(ConsistOf("developers"))
+		Expect(payload.Role).To(Equal(ocm.UserRole))
+		Expect(payload.IsAuthorized).To(BeTrue())
+	})
+
+	It("maps the groups to roles", func() {
+		a := newAuthenticator()
+
+		c := claims()
+		c["groups"] = []string{"developers", "auditors"}
+		Expect(authUser(a, signToken(c)).Role).To(Equal(ocm.ReadOnlyAdminRole))
+
+		c["groups"] = []string{"auditors", "admins"}
+		Expect(authUser(a, signToken(c)).Role).To(Equal(ocm.AdminRole))
+	})
+
+	It("reads nested claims", func() {
+		cfg.OIDCGroupsClaim = "realm_access.roles"
+		cfg.OIDCUsernameClaim = "sub"
+		c := claims()
+		c["sub"] = "a7f3"
+		c["realm_access"] = map[string]interface{}{"roles": []string{"admins"}}
+
+		payload := authUser(newAuthenticator(), signToken(c))
+		Expect(payload.Username).To(Equal("a7f3"))
+		Expect(payload.Role).To(Equal(ocm.AdminRole))
+	})
+
+	It("accepts tokens signed with rotated keys", func() {
+		a := newAuthenticator()
+		a.keysRefreshedAt = time.Now().Add(-2 * oidcKeysRefreshInterval)
+		genKey()
+		Expect(authUser(a, signToken(claims())).Username).To(Equal("jdoe"))
+	})
+
+	DescribeTable("rejects invalid tokens",
+		func(modify func(jwt.MapClaims)) {
+			c := claims()
+			modify(c)
+			_, err := newAuthenticator().AuthUserAuth(signToken(c))
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }),
+		Entry("other issuer", func(c jwt.MapClaims) { c["iss"] = "https://sso.example.com" }),
+		Entry("other audience", func(c jwt.MapClaims) { c["aud"] = "console" }),
+		Entry("missing username", func(c jwt.MapClaims) { delete(c, "preferred_username") }),
+		Entry("missing organization", func(c jwt.MapClaims) { delete(c, "tenant") }),
+	)
+
+	It("rejects tokens signed with unknown keys", func() {
+		a := newAuthenticator()
+		genKey()
+		_, err := a.AuthUserAuth(signToken(claims()))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when the discovered issuer does not match", func() {
+		serveDiscovery("https://sso.example.com")
+		_, err := NewAuthenticator(cfg, nil, logrus.New(), nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails when tenancy is enabled without an org claim", func() {
+		cfg.OIDCOrgClaim = ""
+		_, err := NewAuthenticator(cfg, nil, logrus.New(), nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -155,6 +155,15 @@ func (a *AuthzHandler) hasSubscriptionAccess(clusterId string, action string, pa
 }
 
 func (a *AuthzHandler) HasAccessTo(ctx context.Context, obj interface{}, action Action) (bool, error) {
+	isAllowed, err := a.hasResourceAccessTo(ctx, obj, action)
+	if isAllowed || err != nil {
+		return isAllowed, err
+	}
+	return a.roleBindingAllowsAccessTo(ctx, obj, action)
+}
+
+// hasResourceAccessTo checks the access of the user to the object regardless of their role bindings
+func (a *AuthzHandler) hasResourceAccessTo(ctx context.Context, obj interface{}, action Action) (bool, error) {
 	if a.isReadOnlyAdmin(ctx) {
 		if action == ReadAction {
 			return true, nil
@@ -346,6 +355,9 @@ func (a *AuthzHandler) ocmAuthorizer(request *http.Request) error {
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 		if !isAllowed {
+			if isAllowed, err = a.checkRoleBindingAccess(request, payload); isAllowed || err != nil {
+				return err
+			}
 			obj := a.getObjFromRequest(request)
 			if obj != nil && toAction(request) != http.MethodGet {
 				// Check if user has read access (needed for returning an appropriate http status)
@@ -13,6 +13,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-openapi/runtime"
+	rtclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/golang-jwt/jwt/v4"
@@ -21,6 +23,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/client"
+	"github.com/openshift/assisted-service/client/audit"
 	"github.com/openshift/assisted-service/client/events"
 	"github.com/openshift/assisted-service/client/installer"
 	"github.com/openshift/assisted-service/client/managed_domains"
@@ -707,6 +710,7 @@ var _ = Describe("authz", func() {
 				},
 				log.WithField("pkg", "auth"), nil).CreateAuthorizer(),
 			InstallerAPI:      fakeInventory{},
+			AuditAPI:          fakeAuditAPI{},
 			EventsAPI:         &fakeEventsAPI{},
 			Logger:            logrus.Printf,
 			VersionsAPI:       fakeVersionsAPI{},
@@ -859,6 +863,18 @@ var _ = Describe("authz", func() {
 			apiCall:                updateClusterInstallConfig,
 			expectUnauthorizedCode: http.StatusForbidden,
 		},
+		{
+			name:                   "get cluster installation timeline",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:                getClusterInstallationTimeline,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "watch cluster",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:                watchCluster,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
 		{
 			name:                   "upload cluster ingress cert",
 			apiCall:                uploadClusterIngressCert,
@@ -914,6 +930,18 @@ var _ = Describe("authz", func() {
 			apiCall:                deregisterHost,
 			expectUnauthorizedCode: http.StatusForbidden,
 		},
+		{
+			name:                   "list host diagnostics",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:                listHostDiagnostics,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "create host diagnostic",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:                createHostDiagnostic,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
 		{
 			name:                   "update host install progress",
 			apiCall:                updateHostInstallProgress,
@@ -962,6 +990,60 @@ var _ = Describe("authz", func() {
 			apiCall:                listEvents,
 			expectUnauthorizedCode: http.StatusForbidden,
 		},
+		{
+			name:                   "list audit records",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole},
+			apiCall:                listAuditRecords,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "list event subscriptions",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:                listEventSubscriptions,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "register event subscription",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:                registerEventSubscription,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "list cluster role bindings",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:                listClusterRoleBindings,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "create cluster role binding",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:                createClusterRoleBinding,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "delete cluster role binding",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:                deleteClusterRoleBinding,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "list infra-env role bindings",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
+			apiCall:                listInfraEnvRoleBindings,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "create infra-env role binding",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:                createInfraEnvRoleBinding,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
+		{
+			name:                   "delete infra-env role binding",
+			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.UserRole},
+			apiCall:                deleteInfraEnvRoleBinding,
+			expectUnauthorizedCode: http.StatusForbidden,
+		},
 		{
 			name:                   "list managed domains",
 			allowedRoles:           []ocm.RoleType{ocm.AdminRole, ocm.ReadOnlyAdminRole, ocm.UserRole},
@@ -1209,6 +1291,29 @@ func updateClusterInstallConfig(ctx context.Context, cli *client.AssistedInstall
 	return err
 }
 
+func getClusterInstallationTimeline(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.V2GetClusterInstallationTimeline(
+		ctx,
+		&installer.V2GetClusterInstallationTimelineParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func watchCluster(ctx context.Context, cli *client.AssistedInstall) error {
+	// The generated client has no consumer for server-sent events
+	if transport, ok := cli.Transport.(*rtclient.Runtime); ok {
+		transport.Consumers["text/event-stream"] = runtime.ByteStreamConsumer()
+	}
+	_, err := cli.Installer.V2WatchCluster(
+		ctx,
+		&installer.V2WatchClusterParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		},
+		io.Discard)
+	return err
+}
+
 func uploadClusterIngressCert(ctx context.Context, cli *client.AssistedInstall) error {
 	_, err := cli.Installer.V2UploadClusterIngressCert(
 		ctx,
@@ -1300,6 +1405,28 @@ func deregisterHost(ctx context.Context, cli *client.AssistedInstall) error {
 	return err
 }
 
+func listHostDiagnostics(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.V2ListHostDiagnostics(
+		ctx,
+		&installer.V2ListHostDiagnosticsParams{
+			InfraEnvID: strfmt.UUID(uuid.New().String()),
+			HostID:     strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func createHostDiagnostic(ctx context.Context, cli *client.AssistedInstall) error {
+	diagnosticType := models.HostDiagnosticTypeDiskPerformance
+	_, err := cli.Installer.V2CreateHostDiagnostic(
+		ctx,
+		&installer.V2CreateHostDiagnosticParams{
+			InfraEnvID:             strfmt.UUID(uuid.New().String()),
+			HostID:                 strfmt.UUID(uuid.New().String()),
+			DiagnosticCreateParams: &models.HostDiagnosticCreateParams{Type: &diagnosticType},
+		})
+	return err
+}
+
 func updateHostInstallProgress(ctx context.Context, cli *client.AssistedInstall) error {
 	_, err := cli.Installer.V2UpdateHostInstallProgress(
 		ctx,
@@ -1425,6 +1552,103 @@ func listEvents(ctx context.Context, cli *client.AssistedInstall) error {
 	return err
 }
 
+func listAuditRecords(ctx context.Context, cli *client.AssistedInstall) error {
+	clusterId := strfmt.UUID(uuid.New().String())
+	_, err := cli.Audit.V2ListAuditRecords(
+		ctx,
+		&audit.V2ListAuditRecordsParams{
+			ClusterID: &clusterId,
+		})
+	return err
+}
+
+func listEventSubscriptions(ctx context.Context, cli *client.AssistedInstall) error {
+	clusterId := strfmt.UUID(uuid.New().String())
+	_, err := cli.Events.V2ListEventSubscriptions(
+		ctx,
+		&events.V2ListEventSubscriptionsParams{ClusterID: &clusterId})
+	return err
+}
+
+func registerEventSubscription(ctx context.Context, cli *client.AssistedInstall) error {
+	clusterId := strfmt.UUID(uuid.New().String())
+	_, err := cli.Events.V2RegisterEventSubscription(
+		ctx,
+		&events.V2RegisterEventSubscriptionParams{
+			NewEventSubscriptionParams: &models.EventSubscriptionCreateParams{
+				ClusterID: &clusterId,
+				URL:       "https://example.com/hook",
+			},
+		})
+	return err
+}
+
+func listClusterRoleBindings(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.V2ListClusterRoleBindings(
+		ctx,
+		&installer.V2ListClusterRoleBindingsParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func createClusterRoleBinding(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.V2CreateClusterRoleBinding(
+		ctx,
+		&installer.V2CreateClusterRoleBindingParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+			RoleBindingCreateParams: &models.RoleBindingCreateParams{
+				Role:        swag.String(models.RoleBindingCreateParamsRoleOperator),
+				Subject:     swag.String("technicians"),
+				SubjectKind: swag.String(models.RoleBindingCreateParamsSubjectKindGroup),
+			},
+		})
+	return err
+}
+
+func deleteClusterRoleBinding(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.V2DeleteClusterRoleBinding(
+		ctx,
+		&installer.V2DeleteClusterRoleBindingParams{
+			ClusterID:     strfmt.UUID(uuid.New().String()),
+			RoleBindingID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func listInfraEnvRoleBindings(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.V2ListInfraEnvRoleBindings(
+		ctx,
+		&installer.V2ListInfraEnvRoleBindingsParams{
+			InfraEnvID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
+func createInfraEnvRoleBinding(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.V2CreateInfraEnvRoleBinding(
+		ctx,
+		&installer.V2CreateInfraEnvRoleBindingParams{
+			InfraEnvID: strfmt.UUID(uuid.New().String()),
+			RoleBindingCreateParams: &models.RoleBindingCreateParams{
+				Role:        swag.String(models.RoleBindingCreateParamsRoleOperator),
+				Subject:     swag.String("technicians"),
+				SubjectKind: swag.String(models.RoleBindingCreateParamsSubjectKindGroup),
+			},
+		})
+	return err
+}
+
+func deleteInfraEnvRoleBinding(ctx context.Context, cli *client.AssistedInstall) error {
+	_, err := cli.Installer.V2DeleteInfraEnvRoleBinding(
+		ctx,
+		&installer.V2DeleteInfraEnvRoleBindingParams{
+			InfraEnvID:    strfmt.UUID(uuid.New().String()),
+			RoleBindingID: strfmt.UUID(uuid.New().String()),
+		})
+	return err
+}
+
 func listManagedDomains(ctx context.Context, cli *client.AssistedInstall) error {
 	_, err := cli.ManagedDomains.V2ListManagedDomains(
 		ctx,
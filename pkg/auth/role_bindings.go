@@ -0,0 +1,237 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	params "github.com/openshift/assisted-service/pkg/context"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+// Operations, besides reading, that the operator role allows
+var operatorOperations = []string{
+	"v2InstallCluster",
+	"V2CancelInstallation",
+	"v2ResetCluster",
+	"v2InstallHost",
+	"v2ResetHost",
+}
+
+// Operations that only the owners of the resource and the admins may perform, whatever the role
+// bindings of the user
+var ownerOnlyOperations = []string{
+	"v2DeregisterCluster",
+	"DeregisterInfraEnv",
+	"v2CreateClusterRoleBinding",
+	"v2DeleteClusterRoleBinding",
+	"v2ListClusterRoleBindings",
+	"v2CreateInfraEnvRoleBinding",
+	"v2DeleteInfraEnvRoleBinding",
+	"v2ListInfraEnvRoleBindings",
+}
+
+// roleAllows returns true if the role of a role binding allows the action of the operation
+func roleAllows(role string, operationID string, action Action) bool {
+	if funk.ContainsString(ownerOnlyOperations, operationID) {
+		return false
+	}
+	switch role {
+	case models.RoleBindingRoleViewer:
+		return action == ReadAction
+	case models.RoleBindingRoleOperator:
+		return action == ReadAction || funk.ContainsString(operatorOperations, operationID)
+	case models.RoleBindingRoleEditor:
+		return action != NoneAction
+	default:
+		return false
+	}
+}
+
+// roleAllowsAction returns true if the role of a role binding allows the action when the handlers
+// check the access to a resource themselves, outside of the operation the user requested. Only the
+// editor role allows updating, the operator role only allows the operations it was granted above.
+func roleAllowsAction(role string, action Action) bool {
+	switch role {
+	case models.RoleBindingRoleViewer, models.RoleBindingRoleOperator:
+		return action == ReadAction
+	case models.RoleBindingRoleEditor:
+		return action == ReadAction || action == UpdateAction
+	default:
+		return false
+	}
+}
+
+// roleBindingRoles returns the roles granted to the user, or to one of their groups, on the
+// cluster, the infra-env or the host of the request
+func (a *AuthzHandler) roleBindingRoles(request *http.Request, payload *ocm.AuthPayload) ([]string, error) {
+	clusterID := params.GetParam(request.Context(), params.ClusterId)
+	infraEnvID := params.GetParam(request.Context(), params.InfraEnvId)
+	// The hosts of late binding infra-envs are bound to a cluster of their own
+	if hostID := params.GetParam(request.Context(), params.HostId); hostID != "" && clusterID == "" && a.db != nil {
+		var host common.Host
+		query := a.db.Select("cluster_id").Where("id = ?", hostID)
+		if infraEnvID != "" {
+			query = query.Where("infra_env_id = ?", infraEnvID)
+		}
+		if err := query.First(&host).Error; err != nil {
+			if _, err = handleOwnershipQueryError(err); err != nil {
+				return nil, err
+			}
+		} else if host.ClusterID != nil {
+			clusterID = host.ClusterID.String()
+		}
+	}
+	return a.roleBindingRolesOn(clusterID, infraEnvID, payload)
+}
+
+// roleBindingSubjects returns the conditions that match the role bindings of the user and of
+// their groups
+func (a *AuthzHandler) roleBindingSubjects(payload *ocm.AuthPayload) *gorm.DB {
+	subjects := a.db.Where("subject_kind = ? and subject = ?", models.RoleBindingSubjectKindUser, payload.Username)
+	if len(payload.Groups) > 0 {
+		subjects = subjects.Or("subject_kind = ? and subject in ?", models.RoleBindingSubjectKindGroup, payload.Groups)
+	}
+	return subjects
+}
+
+// roleBindingRolesOn returns the roles granted to the user, or to one of their groups, on the
+// cluster or on the infra-env. The roles granted on a cluster apply to the infra-envs bound to it
+// as well. Every role allows reading the resource.
+func (a *AuthzHandler) roleBindingRolesOn(clusterID, infraEnvID string, payload *ocm.AuthPayload) ([]string, error) {
+	if a.db == nil {
+		return nil, nil
+	}
+
+	var clusterIDs []string
+	if clusterID != "" {
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+	if infraEnvID != "" {
+		var infraEnv common.InfraEnv
+		if err := a.db.Select("cluster_id").First(&infraEnv, "id = ?", infraEnvID).Error; err != nil {
+			if _, err = handleOwnershipQueryError(err); err != nil {
+				return nil, err
+			}
+			infraEnvID = ""
+		} else if infraEnv.ClusterID != "" && infraEnv.ClusterID.String() != clusterID {
+			clusterIDs = append(clusterIDs, infraEnv.ClusterID.String())
+		}
+	}
+
+	var targets *gorm.DB
+	switch {
+	case len(clusterIDs) > 0 && infraEnvID != "":
+		targets = a.db.Where("cluster_id in ? or infra_env_id = ?", clusterIDs, infraEnvID)
+	case len(clusterIDs) > 0:
+		targets = a.db.Where("cluster_id in ?", clusterIDs)
+	case infraEnvID != "":
+		targets = a.db.Where("infra_env_id = ?", infraEnvID)
+	default:
+		return nil, nil
+	}
+
+	var roles []string
+	if err := a.db.Model(&common.RoleBinding{}).Where(targets).Where(a.roleBindingSubjects(payload)).
+		Distinct().Pluck("role", &roles).Error; err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// roleBindingAllowsAccessTo returns true if the roles granted to the user on the cluster or the
+// infra-env of the object allow the action. It is used by HasAccessTo once the ownership checks
+// failed, so that the handlers and the event queries that check the access themselves honor the
+// role bindings as the middleware does.
+func (a *AuthzHandler) roleBindingAllowsAccessTo(ctx context.Context, obj interface{}, action Action) (bool, error) {
+	payload := ocm.PayloadFromContext(ctx)
+	if payload.Role != ocm.UserRole {
+		return false, nil
+	}
+	var clusterID, infraEnvID string
+	switch o := obj.(type) {
+	case *common.Cluster:
+		clusterID = o.ID.String()
+	case *common.InfraEnv:
+		infraEnvID = o.ID.String()
+	case *common.Host:
+		// The roles granted on the cluster of the host and on its infra-env both apply, the
+		// cluster of a late binding host differs from the one of its infra-env
+		if o.ClusterID != nil {
+			clusterID = o.ClusterID.String()
+		}
+		infraEnvID = o.InfraEnvID.String()
+	default:
+		return false, nil
+	}
+	roles, err := a.roleBindingRolesOn(clusterID, infraEnvID, payload)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		if roleAllowsAction(role, action) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// OwnedByUserOrBound limits the query of clusters or infra-envs like OwnedByUser, and adds the
+// ones that the user, or one of their groups, was granted a role on when no owner is requested.
+// The infra-envs bound to a cluster that the user has a role on are added as well.
+func (a *AuthzHandler) OwnedByUserOrBound(ctx context.Context, db *gorm.DB, username string, obj interface{}) *gorm.DB {
+	payload := ocm.PayloadFromContext(ctx)
+	if username != "" || a.db == nil || payload == nil || payload.Role != ocm.UserRole {
+		return a.OwnedByUser(ctx, db, username)
+	}
+	bindings := func(column string) *gorm.DB {
+		return a.db.Model(&common.RoleBinding{}).Select(column).
+			Where(column + " is not null").Where(a.roleBindingSubjects(payload))
+	}
+	owned := a.OwnedBy(ctx, a.db.Session(&gorm.Session{NewDB: true}))
+	switch obj.(type) {
+	case *common.Cluster:
+		return db.Where(owned.Or("id in (?)", bindings("cluster_id")))
+	case *common.InfraEnv:
+		return db.Where(owned.Or("id in (?)", bindings("infra_env_id")).Or("cluster_id in (?)", bindings("cluster_id")))
+	default:
+		return a.OwnedByUser(ctx, db, username)
+	}
+}
+
+// rolesAllowRequest returns true if one of the roles allows the operation of the request
+func rolesAllowRequest(roles []string, request *http.Request) bool {
+	var operationID string
+	if route := middleware.MatchedRouteFrom(request); route != nil && route.Operation != nil {
+		operationID = route.Operation.ID
+	}
+	for _, role := range roles {
+		if roleAllows(role, operationID, toAction(request)) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRoleBindingAccess authorizes the requests of users that neither own the resource nor belong
+// to the organization of its owner. It returns an error when the user has roles on the resource
+// that do not allow the request, and false when the user has no role on it.
+func (a *AuthzHandler) checkRoleBindingAccess(request *http.Request, payload *ocm.AuthPayload) (bool, error) {
+	roles, err := a.roleBindingRoles(request, payload)
+	if err != nil {
+		a.log.Errorf("Failed to retrieve role bindings. Error %v", err)
+		return false, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if rolesAllowRequest(roles, request) {
+		return true, nil
+	}
+	if len(roles) > 0 {
+		return false, common.NewInfraError(http.StatusForbidden, fmt.Errorf("%s: Unauthorized to manipulate object (insufficient role binding)", payload.Username))
+	}
+	return false, nil
+}
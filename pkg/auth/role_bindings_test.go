@@ -0,0 +1,302 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	params "github.com/openshift/assisted-service/pkg/context"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/restapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = DescribeTable("roleAllows",
+	func(role string, operationID string, action Action, expected bool) {
+		Expect(roleAllows(role, operationID, action)).To(Equal(expected))
+	},
+	Entry("viewer reads", models.RoleBindingRoleViewer, "v2GetCluster", ReadAction, true),
+	Entry("viewer installs", models.RoleBindingRoleViewer, "v2InstallCluster", UpdateAction, false),
+	Entry("operator reads", models.RoleBindingRoleOperator, "v2ListHosts", ReadAction, true),
+	Entry("operator installs the cluster", models.RoleBindingRoleOperator, "v2InstallCluster", UpdateAction, true),
+	Entry("operator cancels the installation", models.RoleBindingRoleOperator, "V2CancelInstallation", UpdateAction, true),
+	Entry("operator resets the cluster", models.RoleBindingRoleOperator, "v2ResetCluster", UpdateAction, true),
+	Entry("operator installs a host", models.RoleBindingRoleOperator, "v2InstallHost", UpdateAction, true),
+	Entry("operator resets a host", models.RoleBindingRoleOperator, "v2ResetHost", UpdateAction, true),
+	Entry("operator updates the cluster", models.RoleBindingRoleOperator, "v2UpdateCluster", UpdateAction, false),
+	Entry("operator deregisters a host", models.RoleBindingRoleOperator, "v2DeregisterHost", DeleteAction, false),
+	Entry("editor updates the cluster", models.RoleBindingRoleEditor, "v2UpdateCluster", UpdateAction, true),
+	Entry("editor deregisters a host", models.RoleBindingRoleEditor, "v2DeregisterHost", DeleteAction, true),
+	Entry("editor deregisters the cluster", models.RoleBindingRoleEditor, "v2DeregisterCluster", DeleteAction, false),
+	Entry("editor deregisters the infra-env", models.RoleBindingRoleEditor, "DeregisterInfraEnv", DeleteAction, false),
+	Entry("editor grants a role", models.RoleBindingRoleEditor, "v2CreateClusterRoleBinding", UpdateAction, false),
+	Entry("editor lists the role bindings", models.RoleBindingRoleEditor, "v2ListInfraEnvRoleBindings", ReadAction, false),
+	Entry("unknown role", "owner", "v2GetCluster", ReadAction, false),
+)
+
+var _ = DescribeTable("roleAllowsAction",
+	func(role string, action Action, expected bool) {
+		Expect(roleAllowsAction(role, action)).To(Equal(expected))
+	},
+	Entry("viewer reads", models.RoleBindingRoleViewer, ReadAction, true),
+	Entry("viewer updates", models.RoleBindingRoleViewer, UpdateAction, false),
+	Entry("operator reads", models.RoleBindingRoleOperator, ReadAction, true),
+	Entry("operator updates", models.RoleBindingRoleOperator, UpdateAction, false),
+	Entry("editor reads", models.RoleBindingRoleEditor, ReadAction, true),
+	Entry("editor updates", models.RoleBindingRoleEditor, UpdateAction, true),
+	Entry("editor deletes", models.RoleBindingRoleEditor, DeleteAction, false),
+	Entry("unknown role", "owner", ReadAction, false),
+)
+
+var _ = Describe("checkRoleBindingAccess", func() {
+	var (
+		db                                     *gorm.DB
+		dbName                                 string
+		authzHandler                           *AuthzHandler
+		clusterID, boundInfraEnvID, infraEnvID strfmt.UUID
+		technician                             *ocm.AuthPayload
+	)
+
+	createRoleBinding := func(clusterID, infraEnvID *strfmt.UUID, role, subjectKind, subject string) {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.RoleBinding{RoleBinding: models.RoleBinding{
+			ID:          &id,
+			ClusterID:   clusterID,
+			InfraEnvID:  infraEnvID,
+			Role:        swag.String(role),
+			SubjectKind: swag.String(subjectKind),
+			Subject:     swag.String(subject),
+		}}).Error).ToNot(HaveOccurred())
+	}
+
+	newRequest := func(method, key string, id strfmt.UUID) *http.Request {
+		request := httptest.NewRequest(method, "/", nil)
+		return request.WithContext(params.SetParam(request.Context(), key, id.String()))
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		boundInfraEnvID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		db.Model(&common.Cluster{}).Create([]map[string]interface{}{
+			{"ID": clusterID, "Name": "A", "UserName": "owner", "OrgID": "org1"},
+		})
+		db.Model(&common.InfraEnv{}).Create([]map[string]interface{}{
+			{"ID": boundInfraEnvID, "Name": "A", "UserName": "owner", "OrgID": "org1", "ClusterID": clusterID},
+			{"ID": infraEnvID, "Name": "B", "UserName": "owner", "OrgID": "org1"},
+		})
+		authzHandler = &AuthzHandler{
+			cfg: &Config{AuthType: TypeOIDC},
+			log: logrus.New(),
+			db:  db,
+		}
+		technician = &ocm.AuthPayload{Username: "technician", Role: ocm.UserRole, Groups: []string{"field"}}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("denies users without role bindings", func() {
+		createRoleBinding(&clusterID, nil, models.RoleBindingRoleEditor, models.RoleBindingSubjectKindUser, "someone")
+		isAllowed, err := authzHandler.checkRoleBindingAccess(newRequest(http.MethodGet, params.ClusterId, clusterID), technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeFalse())
+	})
+
+	It("allows the roles granted to the user", func() {
+		createRoleBinding(&clusterID, nil, models.RoleBindingRoleViewer, models.RoleBindingSubjectKindUser, "technician")
+		isAllowed, err := authzHandler.checkRoleBindingAccess(newRequest(http.MethodGet, params.ClusterId, clusterID), technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeTrue())
+
+		isAllowed, err = authzHandler.checkRoleBindingAccess(newRequest(http.MethodPatch, params.ClusterId, clusterID), technician)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.InfraErrorResponse).StatusCode()).To(Equal(int32(http.StatusForbidden)))
+		Expect(isAllowed).To(BeFalse())
+	})
+
+	It("allows the roles granted to the groups of the user", func() {
+		createRoleBinding(&clusterID, nil, models.RoleBindingRoleEditor, models.RoleBindingSubjectKindGroup, "field")
+		isAllowed, err := authzHandler.checkRoleBindingAccess(newRequest(http.MethodPatch, params.ClusterId, clusterID), technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeTrue())
+	})
+
+	It("does not confuse users and groups", func() {
+		createRoleBinding(&clusterID, nil, models.RoleBindingRoleEditor, models.RoleBindingSubjectKindUser, "field")
+		isAllowed, err := authzHandler.checkRoleBindingAccess(newRequest(http.MethodGet, params.ClusterId, clusterID), technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeFalse())
+	})
+
+	It("applies the roles granted on a cluster to its infra-envs", func() {
+		createRoleBinding(&clusterID, nil, models.RoleBindingRoleViewer, models.RoleBindingSubjectKindUser, "technician")
+		isAllowed, err := authzHandler.checkRoleBindingAccess(newRequest(http.MethodGet, params.InfraEnvId, boundInfraEnvID), technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeTrue())
+
+		isAllowed, err = authzHandler.checkRoleBindingAccess(newRequest(http.MethodGet, params.InfraEnvId, infraEnvID), technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeFalse())
+	})
+
+	It("applies the roles granted on a cluster to the hosts bound to it from a late binding infra-env", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Host{Host: models.Host{ID: &hostID, InfraEnvID: infraEnvID, ClusterID: &clusterID}}).Error).ToNot(HaveOccurred())
+		createRoleBinding(&clusterID, nil, models.RoleBindingRoleOperator, models.RoleBindingSubjectKindUser, "technician")
+		request := newRequest(http.MethodGet, params.InfraEnvId, infraEnvID)
+		request = request.WithContext(params.SetParam(request.Context(), params.HostId, hostID.String()))
+		isAllowed, err := authzHandler.checkRoleBindingAccess(request, technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeTrue())
+
+		isAllowed, err = authzHandler.checkRoleBindingAccess(newRequest(http.MethodGet, params.InfraEnvId, infraEnvID), technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeFalse())
+	})
+
+	It("allows the roles granted on an infra-env", func() {
+		createRoleBinding(nil, &infraEnvID, models.RoleBindingRoleViewer, models.RoleBindingSubjectKindGroup, "field")
+		isAllowed, err := authzHandler.checkRoleBindingAccess(newRequest(http.MethodGet, params.InfraEnvId, infraEnvID), technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeTrue())
+
+		isAllowed, err = authzHandler.checkRoleBindingAccess(newRequest(http.MethodGet, params.ClusterId, clusterID), technician)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(isAllowed).To(BeFalse())
+	})
+})
+
+var _ = Describe("HasAccessTo with role bindings", func() {
+	var (
+		db                    *gorm.DB
+		dbName                string
+		clusterID, infraEnvID strfmt.UUID
+		hostID                strfmt.UUID
+		ctx                   context.Context
+	)
+
+	grant := func(clusterID, infraEnvID *strfmt.UUID, role string) {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.RoleBinding{RoleBinding: models.RoleBinding{
+			ID:          &id,
+			ClusterID:   clusterID,
+			InfraEnvID:  infraEnvID,
+			Role:        swag.String(role),
+			SubjectKind: swag.String(models.RoleBindingSubjectKindUser),
+			Subject:     swag.String("technician"),
+		}}).Error).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		db.Model(&common.Cluster{}).Create([]map[string]interface{}{
+			{"ID": clusterID, "Name": "A", "UserName": "owner", "OrgID": "org1"},
+		})
+		db.Model(&common.InfraEnv{}).Create([]map[string]interface{}{
+			{"ID": infraEnvID, "Name": "B", "UserName": "owner", "OrgID": "org1"},
+		})
+		ctx = context.WithValue(context.Background(), restapi.AuthKey,
+			&ocm.AuthPayload{Username: "technician", Organization: "org2", Role: ocm.UserRole})
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	for _, authType := range []AuthType{TypeRHSSO, TypeOIDC} {
+		authType := authType
+
+		Context(string(authType), func() {
+			var authzHandler Authorizer
+
+			BeforeEach(func() {
+				authzHandler = NewAuthzHandler(&Config{AuthType: authType}, nil, logrus.New(), db)
+			})
+
+			hasAccessTo := func(obj interface{}, action Action) bool {
+				isAllowed, err := authzHandler.HasAccessTo(ctx, obj, action)
+				Expect(err).ToNot(HaveOccurred())
+				return isAllowed
+			}
+
+			It("denies users without role bindings", func() {
+				Expect(hasAccessTo(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, ReadAction)).To(BeFalse())
+			})
+
+			It("allows the viewers of a cluster to read it and its hosts", func() {
+				grant(&clusterID, nil, models.RoleBindingRoleViewer)
+				Expect(hasAccessTo(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, ReadAction)).To(BeTrue())
+				Expect(hasAccessTo(&common.Host{Host: models.Host{ID: &hostID, ClusterID: &clusterID}}, ReadAction)).To(BeTrue())
+				Expect(hasAccessTo(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, UpdateAction)).To(BeFalse())
+			})
+
+			It("allows the editors of a cluster to update it but not to delete it", func() {
+				grant(&clusterID, nil, models.RoleBindingRoleEditor)
+				Expect(hasAccessTo(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, UpdateAction)).To(BeTrue())
+				Expect(hasAccessTo(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, DeleteAction)).To(BeFalse())
+			})
+
+			It("allows the viewers of a cluster to read its hosts from a late binding infra-env", func() {
+				grant(&clusterID, nil, models.RoleBindingRoleViewer)
+				Expect(hasAccessTo(&common.Host{Host: models.Host{ID: &hostID, InfraEnvID: infraEnvID, ClusterID: &clusterID}}, ReadAction)).To(BeTrue())
+				Expect(hasAccessTo(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID}}, ReadAction)).To(BeFalse())
+			})
+
+			It("allows the editors of an infra-env to update its hosts, bound to a cluster or not", func() {
+				grant(nil, &infraEnvID, models.RoleBindingRoleEditor)
+				Expect(hasAccessTo(&common.Host{Host: models.Host{ID: &hostID, InfraEnvID: infraEnvID, ClusterID: &clusterID}}, UpdateAction)).To(BeTrue())
+			})
+
+			It("lists the clusters and the infra-envs the user was granted a role on", func() {
+				otherClusterID := strfmt.UUID(uuid.New().String())
+				boundInfraEnvID := strfmt.UUID(uuid.New().String())
+				db.Model(&common.Cluster{}).Create([]map[string]interface{}{
+					{"ID": otherClusterID, "Name": "C", "UserName": "owner", "OrgID": "org1"},
+				})
+				db.Model(&common.InfraEnv{}).Create([]map[string]interface{}{
+					{"ID": boundInfraEnvID, "Name": "D", "UserName": "owner", "OrgID": "org1", "ClusterID": clusterID},
+				})
+				grant(&clusterID, nil, models.RoleBindingRoleViewer)
+				grant(nil, &infraEnvID, models.RoleBindingRoleViewer)
+
+				var clusters []*common.Cluster
+				Expect(authzHandler.OwnedByUserOrBound(ctx, db, "", &common.Cluster{}).Find(&clusters).Error).ToNot(HaveOccurred())
+				Expect(clusters).To(HaveLen(1))
+				Expect(*clusters[0].ID).To(Equal(clusterID))
+
+				var infraEnvs []*common.InfraEnv
+				Expect(authzHandler.OwnedByUserOrBound(ctx, db, "", &common.InfraEnv{}).Order("name").Find(&infraEnvs).Error).ToNot(HaveOccurred())
+				Expect(infraEnvs).To(HaveLen(2))
+				Expect(*infraEnvs[0].ID).To(Equal(infraEnvID))
+				Expect(*infraEnvs[1].ID).To(Equal(boundInfraEnvID))
+
+				// Listing the records of an owner does not add the bound ones
+				var ownedClusters []*common.Cluster
+				Expect(authzHandler.OwnedByUserOrBound(ctx, db, "owner", &common.Cluster{}).Find(&ownedClusters).Error).ToNot(HaveOccurred())
+				Expect(ownedClusters).To(BeEmpty())
+			})
+
+			It("allows the editors of an infra-env to update its unbound hosts", func() {
+				grant(nil, &infraEnvID, models.RoleBindingRoleEditor)
+				Expect(hasAccessTo(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID}}, UpdateAction)).To(BeTrue())
+				Expect(hasAccessTo(&common.Host{Host: models.Host{ID: &hostID, InfraEnvID: infraEnvID}}, UpdateAction)).To(BeTrue())
+				Expect(hasAccessTo(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}, ReadAction)).To(BeFalse())
+			})
+		})
+	}
+})
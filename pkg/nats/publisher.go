@@ -0,0 +1,134 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	natsgo "github.com/nats-io/nats.go"
+)
+
+const (
+	DialTimeout time.Duration = 5 * time.Second
+)
+
+type Config struct {
+	URL        string `envconfig:"NATS_URL" required:"true"`
+	User       string `envconfig:"NATS_USER" default:""`
+	Password   string `envconfig:"NATS_PASSWORD" default:""`
+	Token      string `envconfig:"NATS_TOKEN" default:""`
+	Subject    string `envconfig:"NATS_EVENT_STREAM_SUBJECT" default:"assisted-service.events"`
+	ClientName string `envconfig:"NATS_CLIENT_NAME" default:"assisted-service"`
+}
+
+// Publisher publishes messages to a NATS server. The connection is
+// established lazily on the first publish so that the service can start
+// while the server is unavailable; once connected, the NATS client takes
+// care of reconnecting.
+type Publisher struct {
+	config  *Config
+	options []natsgo.Option
+
+	mu   sync.Mutex
+	conn *natsgo.Conn
+}
+
+func NewPublisher() (*Publisher, error) {
+	config := &Config{}
+	if err := envconfig.Process("", config); err != nil {
+		return nil, err
+	}
+	return NewPublisherWithConfig(config)
+}
+
+func NewPublisherWithConfig(config *Config) (*Publisher, error) {
+	if err := validateURL(config.URL); err != nil {
+		return nil, err
+	}
+	options := []natsgo.Option{
+		natsgo.Name(config.ClientName),
+		natsgo.Timeout(DialTimeout),
+		natsgo.MaxReconnects(-1),
+	}
+	if config.User != "" {
+		options = append(options, natsgo.UserInfo(config.User, config.Password))
+	}
+	if config.Token != "" {
+		options = append(options, natsgo.Token(config.Token))
+	}
+	return &Publisher{
+		config:  config,
+		options: options,
+	}, nil
+}
+
+// Subject returns the configured base subject messages are published under
+func (p *Publisher) Subject() string {
+	return p.config.Subject
+}
+
+// validateURL checks the scheme of every server in the comma separated list
+// up front, so that a misconfiguration is reported when the service starts
+func validateURL(rawURLs string) error {
+	for _, rawURL := range strings.Split(rawURLs, ",") {
+		rawURL = strings.TrimSpace(rawURL)
+		if !strings.Contains(rawURL, "://") {
+			continue
+		}
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("invalid NATS URL %s: %w", rawURL, err)
+		}
+		if u.Scheme != "nats" && u.Scheme != "tls" {
+			return fmt.Errorf("unsupported NATS URL scheme %s", u.Scheme)
+		}
+	}
+	return nil
+}
+
+func (p *Publisher) connection() (*natsgo.Conn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conn != nil && !p.conn.IsClosed() {
+		return p.conn, nil
+	}
+	conn, err := natsgo.Connect(p.config.URL, p.options...)
+	if err != nil {
+		return nil, err
+	}
+	p.conn = conn
+	return conn, nil
+}
+
+func (p *Publisher) Publish(ctx context.Context, subject string, data []byte) error {
+	if subject == "" || strings.ContainsAny(subject, " \t\r\n") {
+		return fmt.Errorf("invalid NATS subject %q", subject)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	conn, err := p.connection()
+	if err != nil {
+		return err
+	}
+	return conn.Publish(subject, data)
+}
+
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.FlushTimeout(DialTimeout)
+	p.conn.Close()
+	p.conn = nil
+	if err == natsgo.ErrConnectionClosed {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,161 @@
+package nats
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	natsgo "github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type published struct {
+	subject string
+	payload string
+}
+
+// fakeServer implements just enough of the NATS server protocol to accept publishes
+type fakeServer struct {
+	listener  net.Listener
+	connects  chan string
+	published chan published
+	reject    bool
+}
+
+func newFakeServer(reject bool) *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	s := &fakeServer{
+		listener:  listener,
+		connects:  make(chan string, 10),
+		published: make(chan published, 10),
+		reject:    reject,
+	}
+	go s.serve()
+	return s
+}
+
+func (s *fakeServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeServer) handle(conn net.Conn) {
+	defer conn.Close()
+	fmt.Fprint(conn, "INFO {\"server_id\":\"fake\",\"max_payload\":1048576}\r\n")
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.HasPrefix(line, "CONNECT "):
+			s.connects <- strings.TrimPrefix(line, "CONNECT ")
+		case line == "PING":
+			if s.reject {
+				fmt.Fprint(conn, "-ERR 'Authorization Violation'\r\n")
+				return
+			}
+			fmt.Fprint(conn, "PONG\r\n")
+		case strings.HasPrefix(line, "PUB "):
+			fields := strings.Fields(line)
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			payload := make([]byte, size+2)
+			if _, err = io.ReadFull(reader, payload); err != nil {
+				return
+			}
+			s.published <- published{subject: fields[1], payload: string(payload[:size])}
+		}
+	}
+}
+
+func (s *fakeServer) Close() {
+	s.listener.Close()
+}
+
+var _ = Describe("Publisher", func() {
+	var (
+		ctx    = context.Background()
+		server *fakeServer
+	)
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("connects with credentials and publishes messages", func() {
+		server = newFakeServer(false)
+		publisher, err := NewPublisherWithConfig(&Config{
+			URL:        "nats://" + server.listener.Addr().String(),
+			User:       "user",
+			Password:   "pass",
+			ClientName: "assisted-service",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer publisher.Close()
+
+		Expect(publisher.Publish(ctx, "assisted.event", []byte(`{"Name":"Event"}`))).To(Succeed())
+		Expect(publisher.Publish(ctx, "assisted.hoststate", []byte("second"))).To(Succeed())
+
+		var connect string
+		Eventually(server.connects).Should(Receive(&connect))
+		Expect(connect).To(ContainSubstring(`"user":"user"`))
+		Expect(connect).To(ContainSubstring(`"pass":"pass"`))
+		Eventually(server.published).Should(Receive(Equal(published{subject: "assisted.event", payload: `{"Name":"Event"}`})))
+		Eventually(server.published).Should(Receive(Equal(published{subject: "assisted.hoststate", payload: "second"})))
+		Consistently(server.connects).ShouldNot(Receive())
+	})
+
+	It("reconnects after the connection is closed", func() {
+		server = newFakeServer(false)
+		publisher, err := NewPublisherWithConfig(&Config{URL: server.listener.Addr().String()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(publisher.Publish(ctx, "subject", []byte("first"))).To(Succeed())
+		Expect(publisher.Close()).To(Succeed())
+		Expect(publisher.Publish(ctx, "subject", []byte("second"))).To(Succeed())
+		Eventually(server.connects).Should(Receive())
+		Eventually(server.connects).Should(Receive())
+		Eventually(server.published).Should(Receive())
+		Eventually(server.published).Should(Receive())
+	})
+
+	It("fails when the server rejects the connection", func() {
+		server = newFakeServer(true)
+		publisher, err := NewPublisherWithConfig(&Config{URL: server.listener.Addr().String()})
+		Expect(err).ToNot(HaveOccurred())
+		err = publisher.Publish(ctx, "subject", []byte("data"))
+		Expect(err).To(MatchError(natsgo.ErrAuthorization))
+	})
+
+	It("rejects invalid subjects", func() {
+		server = newFakeServer(false)
+		publisher, err := NewPublisherWithConfig(&Config{URL: server.listener.Addr().String()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(publisher.Publish(ctx, "with space", []byte("data"))).ToNot(Succeed())
+		Expect(publisher.Publish(ctx, "", []byte("data"))).ToNot(Succeed())
+	})
+
+	It("rejects unsupported URL schemes", func() {
+		server = newFakeServer(false)
+		_, err := NewPublisherWithConfig(&Config{URL: "http://localhost:4222"})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+func TestPublisher(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "NATS publisher suite")
+}
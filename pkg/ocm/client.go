@@ -175,5 +175,6 @@ type AuthPayload struct {
 	Issuer       string   `json:"iss"`
 	ClientID     string   `json:"clientId"`
 	Role         RoleType `json:"scope"`
+	Groups       []string `json:"groups,omitempty"`
 	IsAuthorized bool     `json:"is_authorized"`
 }
@@ -0,0 +1,661 @@
+package s3wrapper
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	azureBlobAPIVersion       = "2021-08-06"
+	azureBlobDefaultBlockSize = 8 * 1024 * 1024
+	azureMetadataHeaderPrefix = "x-ms-meta-"
+	azureSASTimeFormat        = "2006-01-02T15:04:05Z"
+)
+
+type AzureBlobConfig struct {
+	AccountName string `envconfig:"AZURE_STORAGE_ACCOUNT"`
+	AccountKey  string `envconfig:"AZURE_STORAGE_KEY"`
+	Container   string `envconfig:"AZURE_STORAGE_CONTAINER"`
+	// Defaults to https://<account>.blob.core.windows.net, set to http://127.0.0.1:10000/devstoreaccount1 for Azurite
+	EndpointURL string `envconfig:"AZURE_STORAGE_BLOB_ENDPOINT"`
+}
+
+// AzureBlobClient stores the objects as block blobs of an Azure Blob Storage container, using the
+// REST API authorized with the storage account shared key
+type AzureBlobClient struct {
+	log        logrus.FieldLogger
+	cfg        *AzureBlobConfig
+	key        []byte
+	endpoint   string
+	httpClient *http.Client
+	blockSize  int
+}
+
+var _ API = &AzureBlobClient{}
+
+func NewAzureBlobClient(cfg *AzureBlobConfig, logger logrus.FieldLogger) (*AzureBlobClient, error) {
+	if cfg.AccountName == "" || cfg.Container == "" {
+		return nil, errors.New("Azure storage account and container are required")
+	}
+	key, err := base64.StdEncoding.DecodeString(cfg.AccountKey)
+	if err != nil || len(key) == 0 {
+		return nil, errors.New("Azure storage account key must be a non-empty base64 string")
+	}
+	endpoint := cfg.EndpointURL
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", cfg.AccountName)
+	}
+	if _, err = url.ParseRequestURI(endpoint); err != nil {
+		return nil, errors.Wrapf(err, "invalid Azure blob endpoint %s", endpoint)
+	}
+	return &AzureBlobClient{
+		log:        logger,
+		cfg:        cfg,
+		key:        key,
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		httpClient: &http.Client{Transport: newHTTPTransport()},
+		blockSize:  azureBlobDefaultBlockSize,
+	}, nil
+}
+
+func (c *AzureBlobClient) IsAwsS3() bool {
+	return false
+}
+
+func (c *AzureBlobClient) CreateBucket() error {
+	query := url.Values{"restype": {"container"}}
+	resp, err := c.do(context.Background(), http.MethodPut, "", query, nil, nil, 0)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create Azure container %s", c.cfg.Container)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	if err = azureBlobError(resp); err != nil {
+		return errors.Wrapf(err, "Failed to create Azure container %s", c.cfg.Container)
+	}
+	return nil
+}
+
+func (c *AzureBlobClient) uploadStream(ctx context.Context, reader io.Reader, objectName string, metadata map[string]string) error {
+	log := logutil.FromContext(ctx, c.log)
+	if reader == nil {
+		err := errors.Errorf("Upload reader may not be nil. Cannot upload %s to container %s", objectName, c.cfg.Container)
+		log.Error(err)
+		return err
+	}
+
+	headers := http.Header{}
+	headers.Set("x-ms-blob-cache-control", "no-cache")
+	for name, value := range metadata {
+		headers.Set(azureMetadataHeaderPrefix+azureMetadataName(name), value)
+	}
+
+	// Blobs that fit in a single block are uploaded with one request, larger ones are staged block
+	// by block and committed together
+	bufReader := bufio.NewReader(reader)
+	var blockIDs []string
+	for {
+		block := make([]byte, c.blockSize)
+		n, err := io.ReadFull(bufReader, block)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			err = errors.Wrapf(err, "Unable to read %s for upload", objectName)
+			log.Error(err)
+			return err
+		}
+		if len(blockIDs) == 0 && n < c.blockSize {
+			return c.putBlob(ctx, objectName, block[:n], headers)
+		}
+		if n > 0 {
+			blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%010d", len(blockIDs))))
+			if err = c.putBlock(ctx, objectName, blockID, block[:n]); err != nil {
+				log.Error(err)
+				return err
+			}
+			blockIDs = append(blockIDs, blockID)
+		}
+		if n < c.blockSize {
+			break
+		}
+		if _, err = bufReader.Peek(1); err == io.EOF {
+			break
+		}
+	}
+
+	if err := c.putBlockList(ctx, objectName, blockIDs, headers); err != nil {
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully uploaded %s to container %s", objectName, c.cfg.Container)
+	return nil
+}
+
+func (c *AzureBlobClient) putBlob(ctx context.Context, objectName string, data []byte, headers http.Header) error {
+	log := logutil.FromContext(ctx, c.log)
+	headers = headers.Clone()
+	headers.Set("x-ms-blob-type", "BlockBlob")
+	resp, err := c.do(ctx, http.MethodPut, objectName, nil, headers, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to container %s", objectName, c.cfg.Container)
+		log.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+	if err = azureBlobError(resp); err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to container %s", objectName, c.cfg.Container)
+		log.Error(err)
+		return err
+	}
+	log.Infof("Successfully uploaded %s to container %s", objectName, c.cfg.Container)
+	return nil
+}
+
+func (c *AzureBlobClient) putBlock(ctx context.Context, objectName, blockID string, data []byte) error {
+	query := url.Values{"comp": {"block"}, "blockid": {blockID}}
+	resp, err := c.do(ctx, http.MethodPut, objectName, query, nil, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return errors.Wrapf(err, "Unable to upload block of %s to container %s", objectName, c.cfg.Container)
+	}
+	defer resp.Body.Close()
+	return errors.Wrapf(azureBlobError(resp), "Unable to upload block of %s to container %s", objectName, c.cfg.Container)
+}
+
+func (c *AzureBlobClient) putBlockList(ctx context.Context, objectName string, blockIDs []string, headers http.Header) error {
+	blockList := struct {
+		XMLName xml.Name `xml:"BlockList"`
+		Latest  []string `xml:"Latest"`
+	}{Latest: blockIDs}
+	body, err := xml.Marshal(blockList)
+	if err != nil {
+		return err
+	}
+	body = append([]byte(xml.Header), body...)
+	query := url.Values{"comp": {"blocklist"}}
+	resp, err := c.do(ctx, http.MethodPut, objectName, query, headers, bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return errors.Wrapf(err, "Unable to commit blocks of %s to container %s", objectName, c.cfg.Container)
+	}
+	defer resp.Body.Close()
+	return errors.Wrapf(azureBlobError(resp), "Unable to commit blocks of %s to container %s", objectName, c.cfg.Container)
+}
+
+func (c *AzureBlobClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	return c.uploadStream(ctx, reader, objectName, nil)
+}
+
+func (c *AzureBlobClient) UploadStreamWithMetadata(ctx context.Context, reader io.Reader, objectName string, metadata map[string]string) error {
+	return c.uploadStream(ctx, reader, objectName, metadata)
+}
+
+func (c *AzureBlobClient) uploadFile(ctx context.Context, filePath, objectName string, metadata map[string]string) error {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Uploading file %s as object %s to container %s", filePath, objectName, c.cfg.Container)
+	file, err := os.Open(filePath)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+		log.Error(err)
+		return err
+	}
+	defer file.Close()
+	return c.uploadStream(ctx, file, objectName, metadata)
+}
+
+func (c *AzureBlobClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	return c.uploadFile(ctx, filePath, objectName, nil)
+}
+
+func (c *AzureBlobClient) UploadFileWithMetadata(ctx context.Context, filePath, objectName string, metadata map[string]string) error {
+	return c.uploadFile(ctx, filePath, objectName, metadata)
+}
+
+func (c *AzureBlobClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return c.uploadStream(ctx, bytes.NewReader(data), objectName, nil)
+}
+
+func (c *AzureBlobClient) UploadWithMetadata(ctx context.Context, data []byte, objectName string, metadata map[string]string) error {
+	return c.uploadStream(ctx, bytes.NewReader(data), objectName, metadata)
+}
+
+func (c *AzureBlobClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Downloading %s from container %s", objectName, c.cfg.Container)
+	resp, err := c.do(ctx, http.MethodGet, objectName, nil, nil, nil, 0)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get %s object from container %s", objectName, c.cfg.Container)
+		return nil, 0, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, 0, common.NotFound(objectName)
+	}
+	if err = azureBlobError(resp); err != nil {
+		resp.Body.Close()
+		log.WithError(err).Errorf("Failed to get %s object from container %s", objectName, c.cfg.Container)
+		return nil, 0, err
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// getProperties returns the headers of the blob, or nil when it does not exist
+func (c *AzureBlobClient) getProperties(ctx context.Context, objectName string) (http.Header, error) {
+	resp, err := c.do(ctx, http.MethodHead, objectName, nil, nil, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = azureBlobError(resp); err != nil {
+		return nil, err
+	}
+	return resp.Header, nil
+}
+
+func (c *AzureBlobClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Debugf("Verifying if %s exists in %s", objectName, c.cfg.Container)
+	properties, err := c.getProperties(ctx, objectName)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get %s from container %s", objectName, c.cfg.Container)
+	}
+	return properties != nil, nil
+}
+
+func (c *AzureBlobClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Deleting object %s from %s", objectName, c.cfg.Container)
+	resp, err := c.do(ctx, http.MethodDelete, objectName, nil, nil, nil, 0)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to delete object %s from container %s", objectName, c.cfg.Container)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		log.Infof("Object %s does not exist in container %s", objectName, c.cfg.Container)
+		return false, nil
+	}
+	if err = azureBlobError(resp); err != nil {
+		return false, errors.Wrapf(err, "Failed to delete object %s from container %s", objectName, c.cfg.Container)
+	}
+	log.Infof("Deleted object %s from container %s", objectName, c.cfg.Container)
+	return true, nil
+}
+
+// UpdateObjectTimestamp rewrites the metadata of the blob, which moves its last modified time
+// without touching its content
+func (c *AzureBlobClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Updating timestamp of object %s", objectName)
+	properties, err := c.getProperties(ctx, objectName)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to get metadata of object %s from container %s", objectName, c.cfg.Container)
+	}
+	if properties == nil {
+		return false, nil
+	}
+	headers := http.Header{}
+	for name, values := range properties {
+		if strings.HasPrefix(strings.ToLower(name), azureMetadataHeaderPrefix) {
+			headers[name] = values
+		}
+	}
+	resp, err := c.do(ctx, http.MethodPut, objectName, url.Values{"comp": {"metadata"}}, headers, nil, 0)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to update metadata of object %s from container %s", objectName, c.cfg.Container)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err = azureBlobError(resp); err != nil {
+		return false, errors.Wrapf(err, "Failed to update metadata of object %s from container %s", objectName, c.cfg.Container)
+	}
+	return true, nil
+}
+
+func (c *AzureBlobClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	properties, err := c.getProperties(ctx, objectName)
+	if err == nil && properties == nil {
+		err = common.NotFound(objectName)
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch metadata for object %s in container %s", objectName, c.cfg.Container)
+		log.Error(err)
+		return 0, err
+	}
+	return strconv.ParseInt(properties.Get("Content-Length"), 10, 64)
+}
+
+// GeneratePresignedDownloadURL returns the URL of the blob with a read-only service SAS, see
+// https://learn.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+func (c *AzureBlobClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	const (
+		permissions = "r"
+		resource    = "b"
+	)
+	expiry := time.Now().UTC().Add(duration).Format(azureSASTimeFormat)
+	contentDisposition := fmt.Sprintf("attachment;filename=%s", downloadFilename)
+	canonicalizedResource := fmt.Sprintf("/blob/%s/%s/%s", c.cfg.AccountName, c.cfg.Container, objectName)
+	stringToSign := strings.Join([]string{
+		permissions,
+		"", // start
+		expiry,
+		canonicalizedResource,
+		"", // identifier
+		"", // IP range
+		"", // protocol
+		azureBlobAPIVersion,
+		resource,
+		"", // snapshot time
+		"", // encryption scope
+		"", // cache control
+		contentDisposition,
+		"", // content encoding
+		"", // content language
+		"", // content type
+	}, "\n")
+
+	query := url.Values{}
+	query.Set("sv", azureBlobAPIVersion)
+	query.Set("sr", resource)
+	query.Set("sp", permissions)
+	query.Set("se", expiry)
+	query.Set("rscd", contentDisposition)
+	query.Set("sig", c.sign(stringToSign))
+	return c.blobURL(objectName) + "?" + query.Encode(), nil
+}
+
+type azureBlobMetadata map[string]string
+
+func (m *azureBlobMetadata) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*m = azureBlobMetadata{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			var value string
+			if err = d.DecodeElement(&value, &element); err != nil {
+				return err
+			}
+			(*m)[parseAzureMetadataName(element.Name.Local)] = value
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+type azureBlobItem struct {
+	Name       string `xml:"Name"`
+	Properties struct {
+		LastModified string `xml:"Last-Modified"`
+	} `xml:"Properties"`
+	Metadata azureBlobMetadata `xml:"Metadata"`
+}
+
+type azureBlobListResult struct {
+	Blobs      []azureBlobItem `xml:"Blobs>Blob"`
+	NextMarker string          `xml:"NextMarker"`
+}
+
+func (c *AzureBlobClient) listBlobs(ctx context.Context, prefix string, includeMetadata bool, handle func(blob *azureBlobItem)) error {
+	marker := ""
+	for {
+		query := url.Values{"restype": {"container"}, "comp": {"list"}, "prefix": {prefix}}
+		if includeMetadata {
+			query.Set("include", "metadata")
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := c.do(ctx, http.MethodGet, "", query, nil, nil, 0)
+		if err != nil {
+			return err
+		}
+		var result azureBlobListResult
+		err = azureBlobError(resp)
+		if err == nil {
+			err = xml.NewDecoder(resp.Body).Decode(&result)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		for i := range result.Blobs {
+			handle(&result.Blobs[i])
+		}
+		if result.NextMarker == "" {
+			return nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+func (c *AzureBlobClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	log := logutil.FromContext(ctx, c.log)
+	now := time.Now()
+
+	log.Info("Checking for expired objects...")
+	var expired []string
+	err := c.listBlobs(ctx, prefix, false, func(blob *azureBlobItem) {
+		lastModified, err := http.ParseTime(blob.Properties.LastModified)
+		if err != nil {
+			log.WithError(err).Errorf("Error parsing last modified time of object %s", blob.Name)
+			return
+		}
+		if now.After(lastModified.Add(deleteTime)) {
+			expired = append(expired, blob.Name)
+		}
+	})
+	if err != nil {
+		log.WithError(err).Error("Error listing objects")
+		return
+	}
+
+	for _, objectName := range expired {
+		if _, err = c.DeleteObject(ctx, objectName); err != nil {
+			log.WithError(err).Errorf("Error deleting expired object %s", objectName)
+			continue
+		}
+		log.Infof("Deleted expired object %s", objectName)
+		callback(ctx, log, objectName)
+	}
+}
+
+func (c *AzureBlobClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	log := logutil.FromContext(ctx, c.log)
+	var objects []string
+	log.Infof("Listing objects by with prefix %s", prefix)
+	err := c.listBlobs(ctx, prefix, false, func(blob *azureBlobItem) {
+		objects = append(objects, blob.Name)
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (c *AzureBlobClient) ListObjectsByPrefixWithMetadata(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	log := logutil.FromContext(ctx, c.log)
+	objects := []ObjectInfo{}
+	log.Infof("Listing objects by with prefix %s", prefix)
+	err := c.listBlobs(ctx, prefix, true, func(blob *azureBlobItem) {
+		metadata := map[string]string(blob.Metadata)
+		if metadata == nil {
+			metadata = map[string]string{}
+		}
+		objects = append(objects, ObjectInfo{Path: blob.Name, Metadata: metadata})
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (c *AzureBlobClient) blobURL(objectName string) string {
+	u := c.endpoint + "/" + url.PathEscape(c.cfg.Container)
+	if objectName == "" {
+		return u
+	}
+	segments := strings.Split(objectName, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	return u + "/" + strings.Join(segments, "/")
+}
+
+func (c *AzureBlobClient) do(ctx context.Context, method, objectName string, query url.Values, headers http.Header,
+	body io.Reader, contentLength int64) (*http.Response, error) {
+	reqURL := c.blobURL(objectName)
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range headers {
+		req.Header[http.CanonicalHeaderKey(name)] = values
+	}
+	req.ContentLength = contentLength
+	if body == nil {
+		req.Body = http.NoBody
+	}
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureBlobAPIVersion)
+	req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", c.cfg.AccountName, c.sign(c.stringToSign(req))))
+	return c.httpClient.Do(req)
+}
+
+// stringToSign builds the shared key signature input of the request, see
+// https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func (c *AzureBlobClient) stringToSign(req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	var msHeaders []string
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-ms-") {
+			msHeaders = append(msHeaders, name+":"+strings.TrimSpace(strings.Join(values, ",")))
+		}
+	}
+	sort.Strings(msHeaders)
+
+	resource := "/" + c.cfg.AccountName + req.URL.EscapedPath()
+	query := map[string][]string{}
+	for name, values := range req.URL.Query() {
+		query[strings.ToLower(name)] = append(query[strings.ToLower(name)], values...)
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		values := query[name]
+		sort.Strings(values)
+		resource += "\n" + name + ":" + strings.Join(values, ",")
+	}
+
+	return strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // date, x-ms-date is used instead
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		strings.Join(msHeaders, "\n"),
+		resource,
+	}, "\n")
+}
+
+func (c *AzureBlobClient) sign(stringToSign string) string {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func azureBlobError(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	var apiError struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if xml.Unmarshal(body, &apiError) == nil && apiError.Code != "" {
+		return errors.Errorf("Azure Blob Storage responded with status %d (code %s): %s", resp.StatusCode, apiError.Code,
+			strings.TrimSpace(strings.SplitN(apiError.Message, "\n", 2)[0]))
+	}
+	if code := resp.Header.Get("x-ms-error-code"); code != "" {
+		return errors.Errorf("Azure Blob Storage responded with status %d (code %s)", resp.StatusCode, code)
+	}
+	return errors.Errorf("Azure Blob Storage responded with status %d", resp.StatusCode)
+}
+
+// azureMetadataName encodes a metadata name as a C# identifier, as required by Azure. Lower case
+// letters are kept, as well as digits that do not start the name, everything else is escaped as _XX.
+func azureMetadataName(name string) string {
+	var sb strings.Builder
+	for i, b := range []byte(strings.ToLower(name)) {
+		if (b >= 'a' && b <= 'z') || (i > 0 && b >= '0' && b <= '9') {
+			sb.WriteByte(b)
+		} else {
+			fmt.Fprintf(&sb, "_%02x", b)
+		}
+	}
+	return sb.String()
+}
+
+func parseAzureMetadataName(name string) string {
+	var sb strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] == '_' && i+2 < len(name) {
+			if b, err := strconv.ParseUint(name[i+1:i+3], 16, 8); err == nil {
+				sb.WriteByte(byte(b))
+				i += 2
+				continue
+			}
+		}
+		sb.WriteByte(name[i])
+	}
+	return strings.ToLower(sb.String())
+}
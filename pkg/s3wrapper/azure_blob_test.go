@@ -0,0 +1,126 @@
+package s3wrapper
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+// Well-known credentials of the Azurite emulator
+const (
+	azuriteAccountName = "devstoreaccount1"
+	azuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+var _ = Describe("AzureBlobClient", func() {
+	var log = logrus.New()
+
+	It("requires valid configuration", func() {
+		_, err := NewAzureBlobClient(&AzureBlobConfig{AccountName: azuriteAccountName, AccountKey: azuriteAccountKey}, log)
+		Expect(err).To(HaveOccurred())
+		_, err = NewAzureBlobClient(&AzureBlobConfig{AccountName: azuriteAccountName, AccountKey: "not base64!", Container: "c"}, log)
+		Expect(err).To(HaveOccurred())
+
+		client, err := NewAzureBlobClient(&AzureBlobConfig{AccountName: "account", AccountKey: azuriteAccountKey, Container: "c"}, log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.endpoint).To(Equal("https://account.blob.core.windows.net"))
+		Expect(client.IsAwsS3()).To(BeFalse())
+	})
+
+	It("encodes metadata names as identifiers", func() {
+		for _, name := range []string{"source", "assisted-installer-manifest-source", "9lives", "snake_case", "a1.b2"} {
+			encoded := azureMetadataName(name)
+			Expect(encoded).To(MatchRegexp(`^[a-z_][a-z0-9_]*$`))
+			Expect(parseAzureMetadataName(encoded)).To(Equal(name))
+		}
+		Expect(azureMetadataName("Source")).To(Equal("source"))
+	})
+
+	It("signs requests with the shared key", func() {
+		var authorization, date string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+			date = r.Header.Get("x-ms-date")
+			w.Header().Set("x-ms-error-code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, err := NewAzureBlobClient(&AzureBlobConfig{AccountName: azuriteAccountName, AccountKey: azuriteAccountKey,
+			Container: "images", EndpointURL: server.URL + "/" + azuriteAccountName}, log)
+		Expect(err).ToNot(HaveOccurred())
+		exists, err := client.DoesObjectExist(context.Background(), "dir/file name.iso")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		stringToSign := "HEAD\n\n\n\n\n\n\n\n\n\n\n\n" +
+			"x-ms-date:" + date + "\nx-ms-version:" + azureBlobAPIVersion + "\n" +
+			"/devstoreaccount1/devstoreaccount1/images/dir/file%20name.iso"
+		Expect(authorization).To(Equal("SharedKey devstoreaccount1:" + client.sign(stringToSign)))
+	})
+
+	It("reports the error code of failed requests", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?><Error><Code>AuthenticationFailed</Code>` +
+				"<Message>Server failed to authenticate the request.\nRequestId:1</Message></Error>"))
+		}))
+		defer server.Close()
+
+		client, err := NewAzureBlobClient(&AzureBlobConfig{AccountName: azuriteAccountName, AccountKey: azuriteAccountKey,
+			Container: "images", EndpointURL: server.URL}, log)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.DeleteObject(context.Background(), "file")
+		Expect(err).To(MatchError(ContainSubstring("status 403 (code AuthenticationFailed): Server failed to authenticate the request.")))
+	})
+
+	It("generates read-only SAS URLs", func() {
+		client, err := NewAzureBlobClient(&AzureBlobConfig{AccountName: "account", AccountKey: azuriteAccountKey, Container: "images"}, log)
+		Expect(err).ToNot(HaveOccurred())
+		presignedURL, err := client.GeneratePresignedDownloadURL(context.Background(), "dir/discovery.iso", "cluster.iso", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		u, err := url.Parse(presignedURL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Host).To(Equal("account.blob.core.windows.net"))
+		Expect(u.Path).To(Equal("/images/dir/discovery.iso"))
+		query := u.Query()
+		Expect(query.Get("sp")).To(Equal("r"))
+		Expect(query.Get("sr")).To(Equal("b"))
+		Expect(query.Get("rscd")).To(Equal("attachment;filename=cluster.iso"))
+		expiry, err := time.Parse(azureSASTimeFormat, query.Get("se"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expiry).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		Expect(query.Get("sig")).ToNot(BeEmpty())
+	})
+
+	Context("against Azurite", func() {
+		// Set AZURITE_BLOB_ENDPOINT to e.g. http://127.0.0.1:10000/devstoreaccount1 after starting
+		// the emulator with: podman run -p 10000:10000 mcr.microsoft.com/azure-storage/azurite azurite-blob --blobHost 0.0.0.0
+		objectStoreSpecs(func() API {
+			endpoint := os.Getenv("AZURITE_BLOB_ENDPOINT")
+			if endpoint == "" {
+				Skip("AZURITE_BLOB_ENDPOINT is not set")
+			}
+			client, err := NewAzureBlobClient(&AzureBlobConfig{
+				AccountName: azuriteAccountName,
+				AccountKey:  azuriteAccountKey,
+				Container:   "assisted-" + uuid.New().String()[:8],
+				EndpointURL: endpoint,
+			}, log)
+			Expect(err).ToNot(HaveOccurred())
+			client.blockSize = 1024
+			Expect(client.CreateBucket()).To(Succeed())
+			Expect(client.CreateBucket()).To(Succeed())
+			return client
+		})
+	})
+})
@@ -97,8 +97,10 @@ func NewS3Client(cfg *Config, logger logrus.FieldLogger) *S3Client {
 	return &S3Client{client: client, session: awsSession, uploader: uploader, cfg: cfg, log: logger}
 }
 
-func newS3Session(accessKeyID, secretAccessKey, region, endpointURL string) (*session.Session, error) {
-	HTTPTransport := &http.Transport{
+// newHTTPTransport returns the transport, with its connection timeouts, that the object storage
+// clients use
+func newHTTPTransport() *http.Transport {
+	return &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		Dial: (&net.Dialer{
 			Timeout:   30 * time.Second,
@@ -109,8 +111,12 @@ func newS3Session(accessKeyID, secretAccessKey, region, endpointURL string) (*se
 		MaxIdleConnsPerHost:   4096,
 		MaxIdleConns:          0,
 		IdleConnTimeout:       time.Minute,
-		TLSClientConfig:       &tls.Config{InsecureSkipVerify: true}, // true to enable use s3 with ip address (minio)
 	}
+}
+
+func newS3Session(accessKeyID, secretAccessKey, region, endpointURL string) (*session.Session, error) {
+	HTTPTransport := newHTTPTransport()
+	HTTPTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // true to enable use s3 with ip address (minio)
 	creds := credentials.NewStaticCredentials(accessKeyID, secretAccessKey, "")
 
 	awsConfig := &aws.Config{
@@ -0,0 +1,461 @@
+package s3wrapper
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	// contentStoreDirName is the directory of the content store, relative to the base directory of
+	// the filesystem client
+	contentStoreDirName = ".content-store"
+
+	objectReferenceMagic = "assisted-service-object-reference/v1\n"
+	encryptedBlobMagic   = "asenc001"
+
+	encryptionKeySize  = 32
+	encryptionKeyIDLen = 8
+	// Plaintext size of the segments that blobs are encrypted in, each sealed with its own nonce
+	encryptionSegmentSize = 64 * 1024
+	// Nonces of segments are the random prefix of the blob, the segment counter and the last segment flag
+	encryptionNoncePrefixSize = 7
+	wrappedKeyAdditionalData  = "assisted-service data encryption key"
+	contentDigestKeyLabel     = "assisted-service content digest"
+)
+
+type ContentStoreConfig struct {
+	// Store the objects with identical content once
+	Deduplicate bool `envconfig:"FS_DEDUPLICATION" default:"false"`
+	// File holding the base64 encoded 256 bit key encryption key, usually mounted from a secret.
+	// Objects are encrypted at rest when set.
+	EncryptionKeyFile string `envconfig:"FS_ENCRYPTION_KEY_FILE" default:""`
+}
+
+// ContentStore keeps the content of the objects of the filesystem client as blobs, so that identical
+// objects are stored once and their content can be encrypted. The file of each object only holds a
+// reference to its blob, so its metadata and timestamp remain its own.
+//
+// Encrypted blobs use envelope encryption: the content is sealed with AES-256-GCM under a random
+// data key, which is stored in the blob header wrapped with the key encryption key.
+type ContentStore struct {
+	dir         string
+	deduplicate bool
+	keyID       []byte
+	kek         cipher.AEAD
+	digestKey   []byte
+	// Serializes the reference counting of the blobs
+	mutex sync.Mutex
+}
+
+type objectReference struct {
+	Blob string `json:"blob"`
+	Size int64  `json:"size"`
+}
+
+// NewContentStore returns the content store of the filesystem client rooted at basedir, or nil when
+// neither deduplication nor encryption are enabled
+func NewContentStore(basedir string, cfg *ContentStoreConfig) (*ContentStore, error) {
+	if !cfg.Deduplicate && cfg.EncryptionKeyFile == "" {
+		return nil, nil
+	}
+	store := &ContentStore{
+		dir:         filepath.Join(basedir, contentStoreDirName),
+		deduplicate: cfg.Deduplicate,
+	}
+	if cfg.EncryptionKeyFile != "" {
+		data, err := os.ReadFile(cfg.EncryptionKeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read encryption key file %s", cfg.EncryptionKeyFile)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil || len(key) != encryptionKeySize {
+			return nil, errors.Errorf("encryption key file %s must hold a base64 encoded %d bytes key", cfg.EncryptionKeyFile, encryptionKeySize)
+		}
+		if store.kek, err = newGCM(key); err != nil {
+			return nil, err
+		}
+		keyHash := sha256.Sum256(key)
+		store.keyID = keyHash[:encryptionKeyIDLen]
+		// Digests of plaintext content would tell which objects are identical, so they are keyed
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(contentDigestKeyLabel))
+		store.digestKey = mac.Sum(nil)
+	}
+	for _, dir := range []string{store.blobsDir(), store.refsDir(), store.tmpDir()} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, errors.Wrapf(err, "failed to create content store directory %s", dir)
+		}
+	}
+	return store, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (s *ContentStore) blobsDir() string {
+	return filepath.Join(s.dir, "blobs")
+}
+
+func (s *ContentStore) refsDir() string {
+	return filepath.Join(s.dir, "refs")
+}
+
+func (s *ContentStore) tmpDir() string {
+	return filepath.Join(s.dir, "tmp")
+}
+
+func (s *ContentStore) blobPath(blob string) string {
+	return filepath.Join(s.blobsDir(), blob[:2], blob)
+}
+
+func (s *ContentStore) blobRefsDir(blob string) string {
+	return filepath.Join(s.refsDir(), blob)
+}
+
+func (s *ContentStore) blobRefPath(blob, objectName string) string {
+	objectHash := sha256.Sum256([]byte(objectName))
+	return filepath.Join(s.blobRefsDir(blob), hex.EncodeToString(objectHash[:]))
+}
+
+// isStoreDir tells whether the path is the directory of the content store, which the walks over the
+// objects skip
+func (s *ContentStore) isStoreDir(path string) bool {
+	return s != nil && filepath.Clean(path) == s.dir
+}
+
+// put stores the content of the reader and references its blob from the object
+func (s *ContentStore) put(reader io.Reader, objectName string) (*objectReference, error) {
+	tmp, err := os.CreateTemp(s.tmpDir(), "blob")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temp blob")
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	bufWriter := bufio.NewWriter(tmp)
+	var writer io.Writer = bufWriter
+	var encrypter *segmentWriter
+	if s.kek != nil {
+		if encrypter, err = s.newEncrypter(bufWriter); err != nil {
+			return nil, err
+		}
+		writer = encrypter
+	}
+	var digest hash.Hash
+	if s.deduplicate {
+		if s.digestKey != nil {
+			digest = hmac.New(sha256.New, s.digestKey)
+		} else {
+			digest = sha256.New()
+		}
+		writer = io.MultiWriter(writer, digest)
+	}
+
+	size, err := io.Copy(writer, reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write blob")
+	}
+	if encrypter != nil {
+		if err = encrypter.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to encrypt blob")
+		}
+	}
+	if err = bufWriter.Flush(); err != nil {
+		return nil, errors.Wrap(err, "failed to write blob")
+	}
+	if err = tmp.Sync(); err != nil {
+		return nil, errors.Wrap(err, "failed to sync blob")
+	}
+	if err = tmp.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close blob")
+	}
+
+	ref := &objectReference{Blob: strings.ReplaceAll(uuid.New().String(), "-", ""), Size: size}
+	if digest != nil {
+		ref.Blob = hex.EncodeToString(digest.Sum(nil))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	blobPath := s.blobPath(ref.Blob)
+	if _, err = os.Stat(blobPath); os.IsNotExist(err) {
+		if err = os.MkdirAll(filepath.Dir(blobPath), 0700); err != nil {
+			return nil, errors.Wrapf(err, "failed to create directory of blob %s", ref.Blob)
+		}
+		if err = os.Rename(tmp.Name(), blobPath); err != nil {
+			return nil, errors.Wrapf(err, "failed to store blob %s", ref.Blob)
+		}
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to stat blob %s", ref.Blob)
+	}
+	if err = os.MkdirAll(s.blobRefsDir(ref.Blob), 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create references directory of blob %s", ref.Blob)
+	}
+	if err = os.WriteFile(s.blobRefPath(ref.Blob, objectName), []byte(objectName), 0600); err != nil {
+		return nil, errors.Wrapf(err, "failed to reference blob %s", ref.Blob)
+	}
+	return ref, nil
+}
+
+// release drops the reference of the object to the blob, and deletes the blob once it is not
+// referenced anymore
+func (s *ContentStore) release(blob, objectName string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := os.Remove(s.blobRefPath(blob, objectName)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to dereference blob %s", blob)
+	}
+	refs, err := os.ReadDir(s.blobRefsDir(blob))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to list references of blob %s", blob)
+	}
+	if len(refs) > 0 {
+		return nil
+	}
+	if err = os.Remove(s.blobPath(blob)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to delete blob %s", blob)
+	}
+	if err = os.Remove(s.blobRefsDir(blob)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to delete references directory of blob %s", blob)
+	}
+	return nil
+}
+
+func (ref *objectReference) marshal() ([]byte, error) {
+	data, err := json.Marshal(ref)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(objectReferenceMagic), data...), nil
+}
+
+// readReference returns the blob reference held by the object file, or nil when the file holds the
+// content itself, as files written before the content store was enabled do
+func readReference(file io.Reader) (*objectReference, error) {
+	magic := make([]byte, len(objectReferenceMagic))
+	if _, err := io.ReadFull(file, magic); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if string(magic) != objectReferenceMagic {
+		return nil, nil
+	}
+	var ref objectReference
+	if err := json.NewDecoder(io.LimitReader(file, 4096)).Decode(&ref); err != nil {
+		return nil, errors.Wrap(err, "invalid object reference")
+	}
+	if len(ref.Blob) < 2 || strings.ContainsAny(ref.Blob, `/\.`) {
+		return nil, errors.Errorf("invalid blob %q in object reference", ref.Blob)
+	}
+	return &ref, nil
+}
+
+func readReferenceFile(filePath string) (*objectReference, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return readReference(file)
+}
+
+// open returns a reader of the plaintext content of the blob
+func (s *ContentStore) open(blob string) (io.ReadCloser, error) {
+	file, err := os.Open(s.blobPath(blob))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open blob %s", blob)
+	}
+	if s.kek == nil {
+		return file, nil
+	}
+	reader, err := s.newDecrypter(file)
+	if err != nil {
+		file.Close()
+		return nil, errors.Wrapf(err, "failed to decrypt blob %s", blob)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{reader, file}, nil
+}
+
+// newEncrypter writes the header of an encrypted blob, made of the magic, the ID of the key
+// encryption key, the wrapped data key and the nonce prefix, and returns the writer of its segments
+func (s *ContentStore) newEncrypter(w io.Writer) (*segmentWriter, error) {
+	dataKey := make([]byte, encryptionKeySize)
+	keyNonce := make([]byte, s.kek.NonceSize())
+	noncePrefix := make([]byte, encryptionNoncePrefixSize)
+	for _, b := range [][]byte{dataKey, keyNonce, noncePrefix} {
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	header := bytes.NewBufferString(encryptedBlobMagic)
+	header.Write(s.keyID)
+	header.Write(keyNonce)
+	header.Write(s.kek.Seal(nil, keyNonce, dataKey, []byte(wrappedKeyAdditionalData)))
+	header.Write(noncePrefix)
+	if _, err = w.Write(header.Bytes()); err != nil {
+		return nil, err
+	}
+	return &segmentWriter{w: w, aead: aead, noncePrefix: noncePrefix, buf: make([]byte, 0, encryptionSegmentSize)}, nil
+}
+
+func (s *ContentStore) newDecrypter(r io.Reader) (io.Reader, error) {
+	header := make([]byte, len(encryptedBlobMagic)+encryptionKeyIDLen+s.kek.NonceSize()+encryptionKeySize+s.kek.Overhead()+encryptionNoncePrefixSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.Wrap(err, "failed to read header")
+	}
+	if string(header[:len(encryptedBlobMagic)]) != encryptedBlobMagic {
+		return nil, errors.New("not an encrypted blob")
+	}
+	header = header[len(encryptedBlobMagic):]
+	if !bytes.Equal(header[:encryptionKeyIDLen], s.keyID) {
+		return nil, errors.Errorf("blob is encrypted with key %x, not with the configured key %x", header[:encryptionKeyIDLen], s.keyID)
+	}
+	header = header[encryptionKeyIDLen:]
+	keyNonce := header[:s.kek.NonceSize()]
+	wrappedKey := header[s.kek.NonceSize() : s.kek.NonceSize()+encryptionKeySize+s.kek.Overhead()]
+	noncePrefix := header[len(header)-encryptionNoncePrefixSize:]
+	dataKey, err := s.kek.Open(nil, keyNonce, wrappedKey, []byte(wrappedKeyAdditionalData))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unwrap data key")
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &segmentReader{r: bufio.NewReader(r), aead: aead, noncePrefix: noncePrefix}, nil
+}
+
+func segmentNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, 0, encryptionNoncePrefixSize+5)
+	nonce = append(nonce, prefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, counter)
+	if last {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
+
+// segmentWriter seals the content in segments. The last segment is flagged in its nonce, so that
+// truncated blobs fail to decrypt.
+type segmentWriter struct {
+	w           io.Writer
+	aead        cipher.AEAD
+	noncePrefix []byte
+	counter     uint32
+	buf         []byte
+}
+
+func (s *segmentWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		// A full segment is only sealed once more content follows, as it may be the last one
+		if len(s.buf) == encryptionSegmentSize {
+			if err := s.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(s.buf[len(s.buf):encryptionSegmentSize], p)
+		s.buf = s.buf[:len(s.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (s *segmentWriter) seal(last bool) error {
+	if s.counter == ^uint32(0) {
+		return errors.New("too many segments")
+	}
+	if _, err := s.w.Write(s.aead.Seal(nil, segmentNonce(s.noncePrefix, s.counter, last), s.buf, nil)); err != nil {
+		return err
+	}
+	s.counter++
+	s.buf = s.buf[:0]
+	return nil
+}
+
+func (s *segmentWriter) Close() error {
+	return s.seal(true)
+}
+
+type segmentReader struct {
+	r           *bufio.Reader
+	aead        cipher.AEAD
+	noncePrefix []byte
+	counter     uint32
+	plaintext   []byte
+	done        bool
+}
+
+func (s *segmentReader) Read(p []byte) (int, error) {
+	for len(s.plaintext) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		if err := s.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, s.plaintext)
+	s.plaintext = s.plaintext[n:]
+	return n, nil
+}
+
+func (s *segmentReader) open() error {
+	segment := make([]byte, encryptionSegmentSize+s.aead.Overhead())
+	n, err := io.ReadFull(s.r, segment)
+	last := false
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		last = true
+	case err != nil:
+		return err
+	default:
+		if _, err = s.r.Peek(1); err == io.EOF {
+			last = true
+		}
+	}
+	plaintext, err := s.aead.Open(segment[:0], segmentNonce(s.noncePrefix, s.counter, last), segment[:n], nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt segment")
+	}
+	s.counter++
+	s.plaintext = plaintext
+	s.done = last
+	return nil
+}
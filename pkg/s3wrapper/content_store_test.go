@@ -0,0 +1,264 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Content store", func() {
+	var (
+		ctx     = context.Background()
+		log     = logrus.New()
+		baseDir string
+		keyFile string
+	)
+
+	BeforeEach(func() {
+		log.SetOutput(io.Discard)
+		var err error
+		baseDir, err = os.MkdirTemp("", "content-store")
+		Expect(err).ToNot(HaveOccurred())
+		key := make([]byte, encryptionKeySize)
+		_, err = rand.Read(key)
+		Expect(err).ToNot(HaveOccurred())
+		keyFile = filepath.Join(baseDir, "encryption-key")
+		Expect(os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(baseDir)).To(Succeed())
+	})
+
+	newClient := func(cfg *ContentStoreConfig) *FSClient {
+		workDir := filepath.Join(baseDir, "data")
+		store, err := NewContentStore(workDir, cfg)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store).ToNot(BeNil())
+		return &FSClient{basedir: workDir, log: log, xattrClient: NewFilesystemBasedXattrClient(log, workDir), contentStore: store}
+	}
+
+	download := func(client *FSClient, objectName string) []byte {
+		reader, size, err := client.Download(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(BeEquivalentTo(len(data)))
+		return data
+	}
+
+	countBlobs := func(client *FSClient) int {
+		count := 0
+		Expect(filepath.Walk(client.contentStore.blobsDir(), func(_ string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() {
+				count++
+			}
+			return err
+		})).To(Succeed())
+		return count
+	}
+
+	It("is disabled by default", func() {
+		store, err := NewContentStore(baseDir, &ContentStoreConfig{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store).To(BeNil())
+	})
+
+	It("rejects invalid encryption keys", func() {
+		Expect(os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString([]byte("short"))), 0600)).To(Succeed())
+		_, err := NewContentStore(baseDir, &ContentStoreConfig{EncryptionKeyFile: keyFile})
+		Expect(err).To(HaveOccurred())
+		_, err = NewContentStore(baseDir, &ContentStoreConfig{EncryptionKeyFile: filepath.Join(baseDir, "missing")})
+		Expect(err).To(HaveOccurred())
+	})
+
+	configs := map[string]func() *ContentStoreConfig{
+		"deduplication": func() *ContentStoreConfig { return &ContentStoreConfig{Deduplicate: true} },
+		"encryption":    func() *ContentStoreConfig { return &ContentStoreConfig{EncryptionKeyFile: keyFile} },
+		"deduplication and encryption": func() *ContentStoreConfig {
+			return &ContentStoreConfig{Deduplicate: true, EncryptionKeyFile: keyFile}
+		},
+	}
+	for name, config := range configs {
+		config := config
+		Context(fmt.Sprintf("with %s", name), func() {
+			var client *FSClient
+
+			BeforeEach(func() {
+				client = newClient(config())
+			})
+
+			for _, size := range []int{0, 11, encryptionSegmentSize, 2*encryptionSegmentSize + 100} {
+				size := size
+				It(fmt.Sprintf("uploads and downloads %d bytes", size), func() {
+					data := bytes.Repeat([]byte("0123456789"), size/10+1)[:size]
+					Expect(client.UploadStream(ctx, bytes.NewReader(data), "dir/object")).To(Succeed())
+					Expect(download(client, "dir/object")).To(Equal(data))
+					objectSize, err := client.GetObjectSizeBytes(ctx, "dir/object")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(objectSize).To(BeEquivalentTo(size))
+				})
+			}
+
+			It("keeps the metadata and timestamp of each object", func() {
+				Expect(client.UploadWithMetadata(ctx, []byte("content"), "manifests/a.yaml", map[string]string{"source": "user"})).To(Succeed())
+				Expect(client.UploadWithMetadata(ctx, []byte("content"), "manifests/b.yaml", map[string]string{"source": "system"})).To(Succeed())
+
+				objects, err := client.ListObjectsByPrefixWithMetadata(ctx, "manifests/")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(objects).To(ConsistOf(
+					ObjectInfo{Path: "manifests/a.yaml", Metadata: map[string]string{"source": "user"}},
+					ObjectInfo{Path: "manifests/b.yaml", Metadata: map[string]string{"source": "system"}},
+				))
+				names, err := client.ListObjectsByPrefix(ctx, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(names).To(ContainElements("manifests/a.yaml", "manifests/b.yaml"))
+				for _, name := range names {
+					Expect(name).ToNot(HavePrefix(contentStoreDirName))
+				}
+
+				old := time.Now().Add(-time.Hour)
+				Expect(os.Chtimes(filepath.Join(client.basedir, "manifests/a.yaml"), old, old)).To(Succeed())
+				updated, err := client.UpdateObjectTimestamp(ctx, "manifests/b.yaml")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updated).To(BeTrue())
+				info, err := os.Stat(filepath.Join(client.basedir, "manifests/a.yaml"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.ModTime()).To(BeTemporally("~", old, time.Second))
+			})
+
+			It("releases the content of deleted and replaced objects", func() {
+				Expect(client.Upload(ctx, []byte("first"), "object")).To(Succeed())
+				Expect(client.Upload(ctx, []byte("second"), "object")).To(Succeed())
+				Expect(countBlobs(client)).To(Equal(1))
+				Expect(download(client, "object")).To(Equal([]byte("second")))
+
+				deleted, err := client.DeleteObject(ctx, "object")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+				Expect(countBlobs(client)).To(Equal(0))
+			})
+
+			It("releases the content of expired objects", func() {
+				Expect(client.Upload(ctx, []byte("content"), "discovery-image-1.iso")).To(Succeed())
+				old := time.Now().Add(-2 * time.Hour)
+				Expect(os.Chtimes(filepath.Join(client.basedir, "discovery-image-1.iso"), old, old)).To(Succeed())
+
+				var expired []string
+				client.ExpireObjects(ctx, "discovery-image-", time.Hour, func(_ context.Context, _ logrus.FieldLogger, objectName string) {
+					expired = append(expired, objectName)
+				})
+				Expect(expired).To(Equal([]string{filepath.Join(client.basedir, "discovery-image-1.iso")}))
+				Expect(countBlobs(client)).To(Equal(0))
+			})
+
+			It("downloads objects written before it was enabled", func() {
+				Expect(os.WriteFile(filepath.Join(client.basedir, "legacy"), []byte("plaintext"), 0600)).To(Succeed())
+				Expect(download(client, "legacy")).To(Equal([]byte("plaintext")))
+				size, err := client.GetObjectSizeBytes(ctx, "legacy")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(size).To(BeEquivalentTo(9))
+				deleted, err := client.DeleteObject(ctx, "legacy")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+			})
+		})
+	}
+
+	Context("with deduplication", func() {
+		It("stores identical objects once", func() {
+			client := newClient(&ContentStoreConfig{Deduplicate: true, EncryptionKeyFile: keyFile})
+			Expect(client.Upload(ctx, []byte("kubeconfig"), "cluster-1/kubeconfig")).To(Succeed())
+			Expect(client.Upload(ctx, []byte("kubeconfig"), "cluster-2/kubeconfig")).To(Succeed())
+			Expect(client.Upload(ctx, []byte("other"), "cluster-3/kubeconfig")).To(Succeed())
+			Expect(countBlobs(client)).To(Equal(2))
+
+			_, err := client.DeleteObject(ctx, "cluster-1/kubeconfig")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(countBlobs(client)).To(Equal(2))
+			Expect(download(client, "cluster-2/kubeconfig")).To(Equal([]byte("kubeconfig")))
+
+			_, err = client.DeleteObject(ctx, "cluster-2/kubeconfig")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(countBlobs(client)).To(Equal(1))
+		})
+	})
+
+	Context("with encryption", func() {
+		var client *FSClient
+
+		BeforeEach(func() {
+			client = newClient(&ContentStoreConfig{EncryptionKeyFile: keyFile})
+		})
+
+		It("does not store the plaintext", func() {
+			secret := strings.Repeat("client-certificate-data: secret\n", 100)
+			Expect(client.Upload(ctx, []byte(secret), "auth/kubeconfig")).To(Succeed())
+			Expect(filepath.Walk(baseDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				data, err := os.ReadFile(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(data)).ToNot(ContainSubstring("secret"), path)
+				return nil
+			})).To(Succeed())
+		})
+
+		It("detects tampered content", func() {
+			Expect(client.Upload(ctx, bytes.Repeat([]byte("a"), 1000), "object")).To(Succeed())
+			ref, err := readReferenceFile(filepath.Join(client.basedir, "object"))
+			Expect(err).ToNot(HaveOccurred())
+			blobPath := client.contentStore.blobPath(ref.Blob)
+			data, err := os.ReadFile(blobPath)
+			Expect(err).ToNot(HaveOccurred())
+			data[len(data)-20] ^= 0xff
+			Expect(os.WriteFile(blobPath, data, 0600)).To(Succeed())
+
+			reader, _, err := client.Download(ctx, "object")
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+			_, err = io.ReadAll(reader)
+			Expect(err).To(MatchError(ContainSubstring("failed to decrypt segment")))
+		})
+
+		It("detects truncated content", func() {
+			Expect(client.Upload(ctx, bytes.Repeat([]byte("a"), 2*encryptionSegmentSize+1), "object")).To(Succeed())
+			ref, err := readReferenceFile(filepath.Join(client.basedir, "object"))
+			Expect(err).ToNot(HaveOccurred())
+			blobPath := client.contentStore.blobPath(ref.Blob)
+			info, err := os.Stat(blobPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Truncate(blobPath, info.Size()-int64(1+client.contentStore.kek.Overhead()))).To(Succeed())
+
+			reader, _, err := client.Download(ctx, "object")
+			Expect(err).ToNot(HaveOccurred())
+			defer reader.Close()
+			_, err = io.ReadAll(reader)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("refuses content encrypted with another key", func() {
+			Expect(client.Upload(ctx, []byte("content"), "object")).To(Succeed())
+			key := make([]byte, encryptionKeySize)
+			_, err := rand.Read(key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)), 0600)).To(Succeed())
+
+			_, _, err = newClient(&ContentStoreConfig{EncryptionKeyFile: keyFile}).Download(ctx, "object")
+			Expect(err).To(MatchError(ContainSubstring("not with the configured key")))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package s3wrapper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -26,18 +27,22 @@ type FSClient struct {
 	log         logrus.FieldLogger
 	basedir     string
 	xattrClient XattrClient
+	// Holds the content of the objects when deduplication or encryption are enabled
+	contentStore *ContentStore
 }
 
 var _ API = &FSClient{}
 
-func NewFSClient(basedir string, logger logrus.FieldLogger, metricsAPI metrics.API, fsThreshold int, xattrClient XattrClient) *FSClientDecorator {
+func NewFSClient(basedir string, logger logrus.FieldLogger, metricsAPI metrics.API, fsThreshold int, xattrClient XattrClient,
+	contentStore *ContentStore) *FSClientDecorator {
 	return &FSClientDecorator{
 		log:        logger,
 		metricsAPI: metricsAPI,
 		fsClient: FSClient{
-			log:         logger,
-			basedir:     basedir,
-			xattrClient: xattrClient,
+			log:          logger,
+			basedir:      basedir,
+			xattrClient:  xattrClient,
+			contentStore: contentStore,
 		},
 		fsUsageThreshold:              fsThreshold,
 		timeFSUsageLog:                time.Now().Add(-1 * time.Hour),
@@ -89,6 +94,9 @@ func (f *FSClient) UploadWithMetadata(ctx context.Context, data []byte, objectNa
 }
 
 func (f *FSClient) upload(ctx context.Context, data []byte, objectName string, metadata map[string]string) error {
+	if f.contentStore != nil {
+		return f.uploadToContentStore(ctx, bytes.NewReader(data), objectName, metadata)
+	}
 
 	log := logutil.FromContext(ctx, f.log)
 	filePath := filepath.Join(f.basedir, objectName)
@@ -161,6 +169,10 @@ func (f *FSClient) UploadStreamWithMetadata(ctx context.Context, reader io.Reade
 }
 
 func (f *FSClient) uploadStream(ctx context.Context, reader io.Reader, objectName string, metadata map[string]string) error {
+	if f.contentStore != nil {
+		return f.uploadToContentStore(ctx, reader, objectName, metadata)
+	}
+
 	log := logutil.FromContext(ctx, f.log)
 	filePath := filepath.Join(f.basedir, objectName)
 	if err := os.MkdirAll(path.Dir(filePath), 0755); err != nil {
@@ -218,6 +230,98 @@ func (f *FSClient) uploadStream(ctx context.Context, reader io.Reader, objectNam
 	return nil
 }
 
+// uploadToContentStore stores the content in the content store, and writes the reference to its blob
+// as the file of the object
+func (f *FSClient) uploadToContentStore(ctx context.Context, reader io.Reader, objectName string, metadata map[string]string) error {
+	log := logutil.FromContext(ctx, f.log)
+	filePath := filepath.Join(f.basedir, objectName)
+	if err := os.MkdirAll(path.Dir(filePath), 0755); err != nil {
+		err = errors.Wrapf(err, "Unable to create directory for file data %s", filePath)
+		log.Error(err)
+		return err
+	}
+	previous, err := readReferenceFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		err = errors.Wrapf(err, "Unable to read the current content reference of file %s", filePath)
+		log.Error(err)
+		return err
+	}
+
+	ref, err := f.contentStore.put(reader, objectName)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to store content of file %s", filePath)
+		log.Error(err)
+		return err
+	}
+	if err = f.writeReference(filePath, ref, metadata); err != nil {
+		log.Error(err)
+		if previous == nil || previous.Blob != ref.Blob {
+			if releaseErr := f.contentStore.release(ref.Blob, objectName); releaseErr != nil {
+				log.WithError(releaseErr).Errorf("Unable to release content of file %s", filePath)
+			}
+		}
+		return err
+	}
+	if previous != nil && previous.Blob != ref.Blob {
+		if err = f.contentStore.release(previous.Blob, objectName); err != nil {
+			log.WithError(err).Errorf("Unable to release previous content of file %s", filePath)
+		}
+	}
+
+	log.Infof("Successfully uploaded file %s", objectName)
+	return nil
+}
+
+func (f *FSClient) writeReference(filePath string, ref *objectReference, metadata map[string]string) error {
+	data, err := ref.marshal()
+	if err != nil {
+		return err
+	}
+	t, err := renameio.TempFile("", filePath)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create a temp file for %s", filePath)
+	}
+	defer func() {
+		if err := t.Cleanup(); err != nil {
+			f.log.Errorf("Unable to clean up temp file %s", t.Name())
+		}
+	}()
+
+	if _, err = t.Write(data); err != nil {
+		return errors.Wrapf(err, "Unable to write data to file %s", filePath)
+	}
+	if err = f.writeFileMetadata(t.Name(), filePath, metadata); err != nil {
+		return errors.Wrapf(err, "Unable to write file metadata for file %s", filePath)
+	}
+	if err = t.CloseAtomicallyReplace(); err != nil {
+		return errors.Wrapf(err, "Unable to atomically replace %s with temp file %s", filePath, t.Name())
+	}
+	return nil
+}
+
+// releaseContent releases the blob referenced by the file of a deleted object
+func (f *FSClient) releaseContent(log logrus.FieldLogger, filePath string, ref *objectReference) {
+	if ref == nil {
+		return
+	}
+	objectName, err := filepath.Rel(f.basedir, filePath)
+	if err == nil {
+		err = f.contentStore.release(ref.Blob, objectName)
+	}
+	if err != nil {
+		log.WithError(err).Errorf("Unable to release content of file %s", filePath)
+	}
+}
+
+// referenceOf returns the blob reference held by the file, or nil when the content store is disabled
+// or the file holds the content itself
+func (f *FSClient) referenceOf(filePath string) (*objectReference, error) {
+	if f.contentStore == nil {
+		return nil, nil
+	}
+	return readReferenceFile(filePath)
+}
+
 func (f *FSClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
 	log := logutil.FromContext(ctx, f.log)
 	filePath := filepath.Join(f.basedir, objectName)
@@ -230,6 +334,28 @@ func (f *FSClient) Download(ctx context.Context, objectName string) (io.ReadClos
 		log.Error(err)
 		return nil, 0, err
 	}
+	if f.contentStore != nil {
+		ref, err := readReference(fp)
+		if err == nil && ref == nil {
+			_, err = fp.Seek(0, io.SeekStart)
+		}
+		if err != nil {
+			fp.Close()
+			err = errors.Wrapf(err, "Unable to read file %s", filePath)
+			log.Error(err)
+			return nil, 0, err
+		}
+		if ref != nil {
+			fp.Close()
+			reader, err := f.contentStore.open(ref.Blob)
+			if err != nil {
+				err = errors.Wrapf(err, "Unable to open content of file %s", filePath)
+				log.Error(err)
+				return nil, 0, err
+			}
+			return reader, ref.Size, nil
+		}
+	}
 	info, err := fp.Stat()
 	if err != nil {
 		fp.Close()
@@ -258,7 +384,11 @@ func (f *FSClient) DoesObjectExist(ctx context.Context, objectName string) (bool
 func (f *FSClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
 	log := logutil.FromContext(ctx, f.log)
 	filePath := filepath.Join(f.basedir, objectName)
-	err := f.xattrClient.RemoveAll(filePath)
+	ref, err := f.referenceOf(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return false, errors.Wrapf(err, "Failed to read content reference of file %s", filePath)
+	}
+	err = f.xattrClient.RemoveAll(filePath)
 	if err != nil {
 		return false, err
 	}
@@ -269,12 +399,20 @@ func (f *FSClient) DeleteObject(ctx context.Context, objectName string) (bool, e
 		}
 		return false, errors.Wrapf(err, "Failed to delete file %s", filePath)
 	}
+	f.releaseContent(log, filePath, ref)
 	log.Infof("Deleted file %s", filePath)
 	return true, nil
 }
 
 func (f *FSClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
 	filePath := filepath.Join(f.basedir, objectName)
+	ref, err := f.referenceOf(filePath)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get file %s", filePath)
+	}
+	if ref != nil {
+		return ref.Size, nil
+	}
 	info, err := os.Stat(filePath)
 	if err != nil {
 		return 0, errors.Wrapf(err, "failed to get file %s", filePath)
@@ -309,6 +447,9 @@ func (f *FSClient) ExpireObjects(ctx context.Context, prefix string, deleteTime
 		if err != nil {
 			return err
 		}
+		if info.IsDir() && f.contentStore.isStoreDir(path) {
+			return filepath.SkipDir
+		}
 		if strings.HasPrefix(filepath.Base(path), prefix) && !info.IsDir() {
 			f.handleFile(ctx, log, path, info, now, deleteTime, callback)
 		}
@@ -325,7 +466,12 @@ func (f *FSClient) handleFile(ctx context.Context, log logrus.FieldLogger, fileP
 	if now.Before(fileInfo.ModTime().Add(deleteTime)) {
 		return
 	}
-	err := f.xattrClient.RemoveAll(filePath)
+	ref, err := f.referenceOf(filePath)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to read content reference of file %s", filePath)
+		return
+	}
+	err = f.xattrClient.RemoveAll(filePath)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to remove xattr data for file %s", filePath)
 	}
@@ -336,6 +482,7 @@ func (f *FSClient) handleFile(ctx context.Context, log logrus.FieldLogger, fileP
 		}
 		return
 	}
+	f.releaseContent(log, filePath, ref)
 	log.Infof("Deleted expired file %s", filePath)
 	callback(ctx, log, filePath)
 }
@@ -349,6 +496,9 @@ func (f *FSClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]st
 			return err
 		}
 		if info.IsDir() {
+			if f.contentStore.isStoreDir(path) {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 		if strings.HasPrefix(path, prefixWithBase) && !info.IsDir() {
@@ -377,6 +527,9 @@ func (f *FSClient) ListObjectsByPrefixWithMetadata(ctx context.Context, prefix s
 			return err
 		}
 		if info.IsDir() {
+			if f.contentStore.isStoreDir(path) {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 		if strings.HasPrefix(path, prefixWithBase) && !info.IsDir() {
@@ -0,0 +1,643 @@
+package s3wrapper
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+)
+
+const (
+	gcsDefaultEndpoint    = "https://storage.googleapis.com"
+	gcsDefaultTokenURL    = "https://oauth2.googleapis.com/token"
+	gcsReadWriteScope     = "https://www.googleapis.com/auth/devstorage.read_write"
+	gcsSigningAlgorithm   = "GOOG4-RSA-SHA256"
+	gcsMaxSignedURLExpiry = 7 * 24 * time.Hour
+	// Chunks of resumable uploads must be multiples of 256 KiB
+	gcsDefaultChunkSize = 32 * 256 * 1024
+)
+
+type GCSConfig struct {
+	Bucket    string `envconfig:"GCS_BUCKET"`
+	ProjectID string `envconfig:"GCS_PROJECT_ID"`
+	// Service account key file, required for signed URLs. Requests are not authorized when unset,
+	// which is only useful against emulators.
+	CredentialsFile string `envconfig:"GCS_CREDENTIALS_FILE"`
+	// Defaults to https://storage.googleapis.com, set to the URL of fake-gcs-server for tests
+	EndpointURL string `envconfig:"GCS_ENDPOINT_URL"`
+}
+
+type gcsServiceAccountKey struct {
+	Type         string `json:"type"`
+	ClientEmail  string `json:"client_email"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// GCSClient stores the objects in a Google Cloud Storage bucket, using the JSON API authorized with
+// the OAuth tokens of a service account
+type GCSClient struct {
+	log         logrus.FieldLogger
+	cfg         *GCSConfig
+	endpoint    string
+	httpClient  *http.Client
+	email       string
+	privateKey  *rsa.PrivateKey
+	tokenSource oauth2.TokenSource
+	chunkSize   int
+}
+
+var _ API = &GCSClient{}
+
+func NewGCSClient(cfg *GCSConfig, logger logrus.FieldLogger) (*GCSClient, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("GCS bucket is required")
+	}
+	endpoint := cfg.EndpointURL
+	if endpoint == "" {
+		endpoint = gcsDefaultEndpoint
+	}
+	if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return nil, errors.Wrapf(err, "invalid GCS endpoint %s", endpoint)
+	}
+	client := &GCSClient{
+		log:      logger,
+		cfg:      cfg,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		// Resumable uploads answer 308 to every chunk but the last one, which must not be followed
+		httpClient: &http.Client{
+			Transport:     newHTTPTransport(),
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		chunkSize: gcsDefaultChunkSize,
+	}
+	if cfg.CredentialsFile == "" {
+		return client, nil
+	}
+
+	data, err := os.ReadFile(cfg.CredentialsFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read GCS credentials file %s", cfg.CredentialsFile)
+	}
+	var key gcsServiceAccountKey
+	if err = json.Unmarshal(data, &key); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse GCS credentials file %s", cfg.CredentialsFile)
+	}
+	if key.Type != "service_account" || key.ClientEmail == "" {
+		return nil, errors.Errorf("GCS credentials file %s is not a service account key", cfg.CredentialsFile)
+	}
+	if client.privateKey, err = parseRSAPrivateKey([]byte(key.PrivateKey)); err != nil {
+		return nil, errors.Wrapf(err, "invalid private key in GCS credentials file %s", cfg.CredentialsFile)
+	}
+	client.email = key.ClientEmail
+	tokenURL := key.TokenURI
+	if tokenURL == "" {
+		tokenURL = gcsDefaultTokenURL
+	}
+	jwtConfig := &jwt.Config{
+		Email:        key.ClientEmail,
+		PrivateKey:   []byte(key.PrivateKey),
+		PrivateKeyID: key.PrivateKeyID,
+		Scopes:       []string{gcsReadWriteScope},
+		TokenURL:     tokenURL,
+	}
+	// The tokens are requested with the same transport timeouts as the objects
+	tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: newHTTPTransport()})
+	client.tokenSource = jwtConfig.TokenSource(tokenCtx)
+	return client, nil
+}
+
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+func (c *GCSClient) IsAwsS3() bool {
+	return false
+}
+
+func (c *GCSClient) CreateBucket() error {
+	body, err := json.Marshal(map[string]string{"name": c.cfg.Bucket})
+	if err != nil {
+		return err
+	}
+	reqURL := fmt.Sprintf("%s/storage/v1/b?%s", c.endpoint, url.Values{"project": {c.cfg.ProjectID}}.Encode())
+	resp, err := c.do(context.Background(), http.MethodPost, reqURL, "application/json", bytes.NewReader(body), nil)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create GCS bucket %s", c.cfg.Bucket)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	if err = gcsError(resp); err != nil {
+		return errors.Wrapf(err, "Failed to create GCS bucket %s", c.cfg.Bucket)
+	}
+	return nil
+}
+
+// uploadStream uses a resumable upload, so that the size of the stream does not need to be known
+// in advance, see https://cloud.google.com/storage/docs/performing-resumable-uploads
+func (c *GCSClient) uploadStream(ctx context.Context, reader io.Reader, objectName string, metadata map[string]string) error {
+	log := logutil.FromContext(ctx, c.log)
+	if reader == nil {
+		err := errors.Errorf("Upload reader may not be nil. Cannot upload %s to bucket %s", objectName, c.cfg.Bucket)
+		log.Error(err)
+		return err
+	}
+
+	sessionURL, err := c.startResumableUpload(ctx, objectName, metadata)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to upload %s to bucket %s", objectName, c.cfg.Bucket)
+		log.Error(err)
+		return err
+	}
+
+	bufReader := bufio.NewReader(reader)
+	var offset int64
+	for {
+		chunk := make([]byte, c.chunkSize)
+		n, err := io.ReadFull(bufReader, chunk)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			err = errors.Wrapf(err, "Unable to read %s for upload", objectName)
+			log.Error(err)
+			return err
+		}
+		last := n < c.chunkSize
+		if !last {
+			if _, err = bufReader.Peek(1); err == io.EOF {
+				last = true
+			}
+		}
+
+		var contentRange string
+		switch {
+		case n == 0:
+			contentRange = fmt.Sprintf("bytes */%d", offset)
+		case last:
+			contentRange = fmt.Sprintf("bytes %d-%d/%d", offset, offset+int64(n)-1, offset+int64(n))
+		default:
+			contentRange = fmt.Sprintf("bytes %d-%d/*", offset, offset+int64(n)-1)
+		}
+		headers := http.Header{"Content-Range": {contentRange}}
+		resp, err := c.do(ctx, http.MethodPut, sessionURL, "", bytes.NewReader(chunk[:n]), headers)
+		if err != nil {
+			err = errors.Wrapf(err, "Unable to upload %s to bucket %s", objectName, c.cfg.Bucket)
+			log.Error(err)
+			return err
+		}
+		// 308 means that the chunk was persisted and more are expected
+		if resp.StatusCode != http.StatusPermanentRedirect || last {
+			err = gcsError(resp)
+		}
+		resp.Body.Close()
+		if err != nil {
+			err = errors.Wrapf(err, "Unable to upload %s to bucket %s", objectName, c.cfg.Bucket)
+			log.Error(err)
+			return err
+		}
+		offset += int64(n)
+		if last {
+			break
+		}
+	}
+	log.Infof("Successfully uploaded %s to bucket %s", objectName, c.cfg.Bucket)
+	return nil
+}
+
+func (c *GCSClient) startResumableUpload(ctx context.Context, objectName string, metadata map[string]string) (string, error) {
+	body, err := json.Marshal(gcsObject{Name: objectName, CacheControl: "no-cache", Metadata: metadata})
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{"uploadType": {"resumable"}, "name": {objectName}}
+	reqURL := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?%s", c.endpoint, url.PathEscape(c.cfg.Bucket), query.Encode())
+	resp, err := c.do(ctx, http.MethodPost, reqURL, "application/json", bytes.NewReader(body), nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if err = gcsError(resp); err != nil {
+		return "", err
+	}
+	sessionURL := resp.Header.Get("Location")
+	if sessionURL == "" {
+		return "", errors.New("resumable upload session URL is missing")
+	}
+	return sessionURL, nil
+}
+
+func (c *GCSClient) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+	return c.uploadStream(ctx, reader, objectName, nil)
+}
+
+func (c *GCSClient) UploadStreamWithMetadata(ctx context.Context, reader io.Reader, objectName string, metadata map[string]string) error {
+	return c.uploadStream(ctx, reader, objectName, metadata)
+}
+
+func (c *GCSClient) uploadFile(ctx context.Context, filePath, objectName string, metadata map[string]string) error {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Uploading file %s as object %s to bucket %s", filePath, objectName, c.cfg.Bucket)
+	file, err := os.Open(filePath)
+	if err != nil {
+		err = errors.Wrapf(err, "Unable to open file %s for upload", filePath)
+		log.Error(err)
+		return err
+	}
+	defer file.Close()
+	return c.uploadStream(ctx, file, objectName, metadata)
+}
+
+func (c *GCSClient) UploadFile(ctx context.Context, filePath, objectName string) error {
+	return c.uploadFile(ctx, filePath, objectName, nil)
+}
+
+func (c *GCSClient) UploadFileWithMetadata(ctx context.Context, filePath, objectName string, metadata map[string]string) error {
+	return c.uploadFile(ctx, filePath, objectName, metadata)
+}
+
+func (c *GCSClient) Upload(ctx context.Context, data []byte, objectName string) error {
+	return c.uploadStream(ctx, bytes.NewReader(data), objectName, nil)
+}
+
+func (c *GCSClient) UploadWithMetadata(ctx context.Context, data []byte, objectName string, metadata map[string]string) error {
+	return c.uploadStream(ctx, bytes.NewReader(data), objectName, metadata)
+}
+
+func (c *GCSClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Downloading %s from bucket %s", objectName, c.cfg.Bucket)
+	resp, err := c.do(ctx, http.MethodGet, c.objectURL(objectName)+"?alt=media", "", nil, nil)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get %s object from bucket %s", objectName, c.cfg.Bucket)
+		return nil, 0, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, 0, common.NotFound(objectName)
+	}
+	if err = gcsError(resp); err != nil {
+		resp.Body.Close()
+		log.WithError(err).Errorf("Failed to get %s object from bucket %s", objectName, c.cfg.Bucket)
+		return nil, 0, err
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+type gcsObject struct {
+	Name         string            `json:"name,omitempty"`
+	Size         int64             `json:"size,string,omitempty"`
+	CacheControl string            `json:"cacheControl,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	Updated      *time.Time        `json:"updated,omitempty"`
+	CustomTime   *time.Time        `json:"customTime,omitempty"`
+}
+
+// getObject returns the attributes of the object, or nil when it does not exist
+func (c *GCSClient) getObject(ctx context.Context, objectName string) (*gcsObject, error) {
+	resp, err := c.do(ctx, http.MethodGet, c.objectURL(objectName), "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = gcsError(resp); err != nil {
+		return nil, err
+	}
+	var object gcsObject
+	if err = json.NewDecoder(resp.Body).Decode(&object); err != nil {
+		return nil, err
+	}
+	return &object, nil
+}
+
+func (c *GCSClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Debugf("Verifying if %s exists in %s", objectName, c.cfg.Bucket)
+	object, err := c.getObject(ctx, objectName)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get %s from bucket %s", objectName, c.cfg.Bucket)
+	}
+	return object != nil, nil
+}
+
+func (c *GCSClient) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Deleting object %s from %s", objectName, c.cfg.Bucket)
+	resp, err := c.do(ctx, http.MethodDelete, c.objectURL(objectName), "", nil, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to delete object %s from bucket %s", objectName, c.cfg.Bucket)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		log.Infof("Object %s does not exist in bucket %s", objectName, c.cfg.Bucket)
+		return false, nil
+	}
+	if err = gcsError(resp); err != nil {
+		return false, errors.Wrapf(err, "Failed to delete object %s from bucket %s", objectName, c.cfg.Bucket)
+	}
+	log.Infof("Deleted object %s from bucket %s", objectName, c.cfg.Bucket)
+	return true, nil
+}
+
+// UpdateObjectTimestamp sets the custom time of the object, which takes precedence over its
+// update time when expiring objects
+func (c *GCSClient) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+	log := logutil.FromContext(ctx, c.log)
+	log.Infof("Updating timestamp of object %s", objectName)
+	now := time.Now().UTC().Truncate(time.Second)
+	body, err := json.Marshal(gcsObject{CustomTime: &now})
+	if err != nil {
+		return false, err
+	}
+	resp, err := c.do(ctx, http.MethodPatch, c.objectURL(objectName), "application/json", bytes.NewReader(body), nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to update custom time of object %s from bucket %s", objectName, c.cfg.Bucket)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err = gcsError(resp); err != nil {
+		return false, errors.Wrapf(err, "Failed to update custom time of object %s from bucket %s", objectName, c.cfg.Bucket)
+	}
+	return true, nil
+}
+
+func (c *GCSClient) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	object, err := c.getObject(ctx, objectName)
+	if err == nil && object == nil {
+		err = common.NotFound(objectName)
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to fetch metadata for object %s in bucket %s", objectName, c.cfg.Bucket)
+		log.Error(err)
+		return 0, err
+	}
+	return object.Size, nil
+}
+
+// GeneratePresignedDownloadURL returns a V4 signed URL of the object, see
+// https://cloud.google.com/storage/docs/access-control/signing-urls-manually
+func (c *GCSClient) GeneratePresignedDownloadURL(ctx context.Context, objectName string, downloadFilename string, duration time.Duration) (string, error) {
+	log := logutil.FromContext(ctx, c.log)
+	if c.privateKey == nil {
+		err := errors.Errorf("Failed to create presigned download URL for object %s in bucket %s: service account credentials are required",
+			objectName, c.cfg.Bucket)
+		log.Error(err)
+		return "", err
+	}
+	if duration > gcsMaxSignedURLExpiry {
+		duration = gcsMaxSignedURLExpiry
+	}
+	endpoint, err := url.Parse(c.endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	datestamp := now.Format("20060102")
+	scope := datestamp + "/auto/storage/goog4_request"
+	canonicalURI := "/" + gcsURIEncode(c.cfg.Bucket, true) + "/" + gcsURIEncode(objectName, false)
+	query := map[string]string{
+		"X-Goog-Algorithm":             gcsSigningAlgorithm,
+		"X-Goog-Credential":            c.email + "/" + scope,
+		"X-Goog-Date":                  now.Format("20060102T150405Z"),
+		"X-Goog-Expires":               fmt.Sprintf("%d", int64(duration.Seconds())),
+		"X-Goog-SignedHeaders":         "host",
+		"response-content-disposition": fmt.Sprintf("attachment;filename=%s", downloadFilename),
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params := make([]string, 0, len(names))
+	for _, name := range names {
+		params = append(params, gcsURIEncode(name, true)+"="+gcsURIEncode(query[name], true))
+	}
+	canonicalQuery := strings.Join(params, "&")
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		canonicalURI,
+		canonicalQuery,
+		"host:" + endpoint.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		gcsSigningAlgorithm,
+		query["X-Goog-Date"],
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		err = errors.Wrapf(err, "Failed to create presigned download URL for object %s in bucket %s", objectName, c.cfg.Bucket)
+		log.Error(err)
+		return "", err
+	}
+	return fmt.Sprintf("%s://%s%s?%s&X-Goog-Signature=%s", endpoint.Scheme, endpoint.Host, canonicalURI, canonicalQuery,
+		hex.EncodeToString(signature)), nil
+}
+
+func (c *GCSClient) listObjects(ctx context.Context, prefix string, handle func(object *gcsObject)) error {
+	pageToken := ""
+	for {
+		query := url.Values{"prefix": {prefix}}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		reqURL := fmt.Sprintf("%s/storage/v1/b/%s/o?%s", c.endpoint, url.PathEscape(c.cfg.Bucket), query.Encode())
+		resp, err := c.do(ctx, http.MethodGet, reqURL, "", nil, nil)
+		if err != nil {
+			return err
+		}
+		var result struct {
+			Items         []gcsObject `json:"items"`
+			NextPageToken string      `json:"nextPageToken"`
+		}
+		err = gcsError(resp)
+		if err == nil {
+			err = json.NewDecoder(resp.Body).Decode(&result)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		for i := range result.Items {
+			handle(&result.Items[i])
+		}
+		if result.NextPageToken == "" {
+			return nil
+		}
+		pageToken = result.NextPageToken
+	}
+}
+
+func (c *GCSClient) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
+	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	log := logutil.FromContext(ctx, c.log)
+	now := time.Now()
+
+	log.Info("Checking for expired objects...")
+	var expired []string
+	err := c.listObjects(ctx, prefix, func(object *gcsObject) {
+		if object.Updated == nil {
+			return
+		}
+		lastUsed := *object.Updated
+		if object.CustomTime != nil && object.CustomTime.After(lastUsed) {
+			lastUsed = *object.CustomTime
+		}
+		if now.After(lastUsed.Add(deleteTime)) {
+			expired = append(expired, object.Name)
+		}
+	})
+	if err != nil {
+		log.WithError(err).Error("Error listing objects")
+		return
+	}
+
+	for _, objectName := range expired {
+		if _, err = c.DeleteObject(ctx, objectName); err != nil {
+			log.WithError(err).Errorf("Error deleting expired object %s", objectName)
+			continue
+		}
+		log.Infof("Deleted expired object %s", objectName)
+		callback(ctx, log, objectName)
+	}
+}
+
+func (c *GCSClient) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	log := logutil.FromContext(ctx, c.log)
+	var objects []string
+	log.Infof("Listing objects by with prefix %s", prefix)
+	err := c.listObjects(ctx, prefix, func(object *gcsObject) {
+		objects = append(objects, object.Name)
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (c *GCSClient) ListObjectsByPrefixWithMetadata(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	log := logutil.FromContext(ctx, c.log)
+	objects := []ObjectInfo{}
+	log.Infof("Listing objects by with prefix %s", prefix)
+	err := c.listObjects(ctx, prefix, func(object *gcsObject) {
+		metadata := object.Metadata
+		if metadata == nil {
+			metadata = map[string]string{}
+		}
+		objects = append(objects, ObjectInfo{Path: object.Name, Metadata: metadata})
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error listing objects for prefix %s", prefix)
+		log.Error(err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (c *GCSClient) objectURL(objectName string) string {
+	return fmt.Sprintf("%s/storage/v1/b/%s/o/%s", c.endpoint, url.PathEscape(c.cfg.Bucket), url.PathEscape(objectName))
+}
+
+func (c *GCSClient) do(ctx context.Context, method, reqURL, contentType string, body io.Reader, headers http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range headers {
+		req.Header[name] = values
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.tokenSource != nil {
+		token, err := c.tokenSource.Token()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get GCS access token")
+		}
+		token.SetAuthHeader(req)
+	}
+	return c.httpClient.Do(req)
+}
+
+func gcsError(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	var apiError struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if json.Unmarshal(body, &apiError) == nil && apiError.Error.Message != "" {
+		return errors.Errorf("GCS responded with status %d: %s", resp.StatusCode, apiError.Error.Message)
+	}
+	return errors.Errorf("GCS responded with status %d", resp.StatusCode)
+}
+
+// gcsURIEncode percent-encodes everything except the RFC 3986 unreserved characters, and the
+// slashes of object names unless encodeSlash is set
+func gcsURIEncode(s string, encodeSlash bool) string {
+	var sb strings.Builder
+	for _, b := range []byte(s) {
+		if (b >= 'A' && b <= 'Z') || (b >= 'a' && b <= 'z') || (b >= '0' && b <= '9') ||
+			b == '-' || b == '.' || b == '_' || b == '~' || (b == '/' && !encodeSlash) {
+			sb.WriteByte(b)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", b)
+		}
+	}
+	return sb.String()
+}
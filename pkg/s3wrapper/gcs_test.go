@@ -0,0 +1,164 @@
+package s3wrapper
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("GCSClient", func() {
+	var (
+		log             = logrus.New()
+		dir             string
+		privateKey      *rsa.PrivateKey
+		tokenServer     *httptest.Server
+		credentialsFile string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "gcs")
+		Expect(err).ToNot(HaveOccurred())
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		tokenServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.ParseForm()).To(Succeed())
+			Expect(r.Form.Get("grant_type")).To(Equal("urn:ietf:params:oauth:grant-type:jwt-bearer"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token": "test-token", "token_type": "Bearer", "expires_in": 3600}`))
+		}))
+
+		key, err := json.Marshal(gcsServiceAccountKey{
+			Type:        "service_account",
+			ClientEmail: "assisted@project.iam.gserviceaccount.com",
+			PrivateKey: string(pem.EncodeToMemory(&pem.Block{
+				Type:  "RSA PRIVATE KEY",
+				Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+			})),
+			TokenURI: tokenServer.URL,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		credentialsFile = filepath.Join(dir, "credentials.json")
+		Expect(os.WriteFile(credentialsFile, key, 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		tokenServer.Close()
+		os.RemoveAll(dir)
+	})
+
+	It("requires valid configuration", func() {
+		_, err := NewGCSClient(&GCSConfig{}, log)
+		Expect(err).To(HaveOccurred())
+
+		Expect(os.WriteFile(credentialsFile, []byte(`{"type": "authorized_user"}`), 0600)).To(Succeed())
+		_, err = NewGCSClient(&GCSConfig{Bucket: "images", CredentialsFile: credentialsFile}, log)
+		Expect(err).To(MatchError(ContainSubstring("is not a service account key")))
+	})
+
+	It("authorizes requests with the token of the service account", func() {
+		var authorization string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+			Expect(r.URL.EscapedPath()).To(Equal("/storage/v1/b/images/o/dir%2Fdiscovery.iso"))
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, err := NewGCSClient(&GCSConfig{Bucket: "images", CredentialsFile: credentialsFile, EndpointURL: server.URL}, log)
+		Expect(err).ToNot(HaveOccurred())
+		exists, err := client.DoesObjectExist(context.Background(), "dir/discovery.iso")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		Expect(authorization).To(Equal("Bearer test-token"))
+	})
+
+	It("reports the message of failed requests", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": {"code": 403, "message": "access denied"}}`))
+		}))
+		defer server.Close()
+
+		client, err := NewGCSClient(&GCSConfig{Bucket: "images", EndpointURL: server.URL}, log)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.DeleteObject(context.Background(), "file")
+		Expect(err).To(MatchError(ContainSubstring("GCS responded with status 403: access denied")))
+	})
+
+	It("requires credentials to generate signed URLs", func() {
+		client, err := NewGCSClient(&GCSConfig{Bucket: "images"}, log)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.GeneratePresignedDownloadURL(context.Background(), "discovery.iso", "cluster.iso", time.Hour)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("generates V4 signed URLs", func() {
+		client, err := NewGCSClient(&GCSConfig{Bucket: "images", CredentialsFile: credentialsFile}, log)
+		Expect(err).ToNot(HaveOccurred())
+		signedURL, err := client.GeneratePresignedDownloadURL(context.Background(), "dir/discovery image.iso", "cluster.iso", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		u, err := url.Parse(signedURL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Host).To(Equal("storage.googleapis.com"))
+		Expect(u.EscapedPath()).To(Equal("/images/dir/discovery%20image.iso"))
+		query := u.Query()
+		Expect(query.Get("X-Goog-Algorithm")).To(Equal("GOOG4-RSA-SHA256"))
+		Expect(query.Get("X-Goog-Credential")).To(HavePrefix("assisted@project.iam.gserviceaccount.com/"))
+		Expect(query.Get("X-Goog-Expires")).To(Equal("3600"))
+		Expect(query.Get("response-content-disposition")).To(Equal("attachment;filename=cluster.iso"))
+
+		// The signature covers everything but itself
+		canonicalQuery := strings.SplitN(u.RawQuery, "&X-Goog-Signature=", 2)[0]
+		canonicalRequest := "GET\n" + u.EscapedPath() + "\n" + canonicalQuery + "\nhost:storage.googleapis.com\n\nhost\nUNSIGNED-PAYLOAD"
+		requestHash := sha256.Sum256([]byte(canonicalRequest))
+		scope := strings.SplitN(query.Get("X-Goog-Credential"), "/", 2)[1]
+		stringToSign := "GOOG4-RSA-SHA256\n" + query.Get("X-Goog-Date") + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+		digest := sha256.Sum256([]byte(stringToSign))
+		signature, err := hex.DecodeString(query.Get("X-Goog-Signature"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA256, digest[:], signature)).To(Succeed())
+	})
+
+	Context("against fake-gcs-server", func() {
+		// Set FAKE_GCS_ENDPOINT to e.g. http://127.0.0.1:4443 after starting the emulator with:
+		// podman run -p 4443:4443 fsouza/fake-gcs-server -scheme http -public-host 127.0.0.1:4443
+		objectStoreSpecs(func() API {
+			endpoint := os.Getenv("FAKE_GCS_ENDPOINT")
+			if endpoint == "" {
+				Skip("FAKE_GCS_ENDPOINT is not set")
+			}
+			client, err := NewGCSClient(&GCSConfig{
+				Bucket:          "assisted-" + uuid.New().String()[:8],
+				ProjectID:       "test",
+				CredentialsFile: credentialsFile,
+				EndpointURL:     endpoint,
+			}, log)
+			Expect(err).ToNot(HaveOccurred())
+			client.chunkSize = 1024
+			Expect(client.CreateBucket()).To(Succeed())
+			Expect(client.CreateBucket()).To(Succeed())
+			return client
+		})
+	})
+})
@@ -0,0 +1,181 @@
+package s3wrapper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/sirupsen/logrus"
+)
+
+// objectStoreSpecs are run by the clients of the cloud object stores against their emulators. The
+// clients must use chunks of at most 1 KiB, so that multi-part uploads are covered.
+func objectStoreSpecs(newClient func() API) {
+	var (
+		ctx    = context.Background()
+		client API
+		prefix string
+	)
+
+	BeforeEach(func() {
+		client = newClient()
+		prefix = uuid.New().String() + "/"
+	})
+
+	download := func(objectName string) []byte {
+		reader, size, err := client.Download(ctx, objectName)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(BeEquivalentTo(len(data)))
+		return data
+	}
+
+	It("uploads and downloads objects", func() {
+		Expect(client.Upload(ctx, []byte("hello world"), prefix+"small")).To(Succeed())
+		Expect(download(prefix + "small")).To(Equal([]byte("hello world")))
+
+		size, err := client.GetObjectSizeBytes(ctx, prefix+"small")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(size).To(BeEquivalentTo(11))
+	})
+
+	It("uploads empty objects", func() {
+		Expect(client.Upload(ctx, []byte{}, prefix+"empty")).To(Succeed())
+		Expect(download(prefix + "empty")).To(BeEmpty())
+	})
+
+	for _, size := range []int{1024, 2048, 2500} {
+		size := size
+		It(fmt.Sprintf("uploads streams of %d bytes in chunks", size), func() {
+			data := bytes.Repeat([]byte("0123456789"), size/10+1)[:size]
+			Expect(client.UploadStream(ctx, bytes.NewBuffer(data), prefix+"stream")).To(Succeed())
+			Expect(download(prefix + "stream")).To(Equal(data))
+		})
+	}
+
+	It("uploads files with metadata", func() {
+		dir, err := os.MkdirTemp("", "object-store")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		filePath := filepath.Join(dir, "manifest.yaml")
+		Expect(os.WriteFile(filePath, []byte("kind: ConfigMap"), 0600)).To(Succeed())
+
+		metadata := map[string]string{"assisted-installer-manifest-source": "user"}
+		Expect(client.UploadFileWithMetadata(ctx, filePath, prefix+"manifest.yaml", metadata)).To(Succeed())
+		Expect(download(prefix + "manifest.yaml")).To(Equal([]byte("kind: ConfigMap")))
+
+		objects, err := client.ListObjectsByPrefixWithMetadata(ctx, prefix)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(Equal([]ObjectInfo{{Path: prefix + "manifest.yaml", Metadata: metadata}}))
+	})
+
+	It("lists objects by prefix", func() {
+		Expect(client.UploadWithMetadata(ctx, []byte("a"), prefix+"a/1", map[string]string{"source": "system"})).To(Succeed())
+		Expect(client.Upload(ctx, []byte("a"), prefix+"a/2")).To(Succeed())
+		Expect(client.Upload(ctx, []byte("b"), prefix+"b/1")).To(Succeed())
+
+		objects, err := client.ListObjectsByPrefix(ctx, prefix+"a/")
+		Expect(err).ToNot(HaveOccurred())
+		sort.Strings(objects)
+		Expect(objects).To(Equal([]string{prefix + "a/1", prefix + "a/2"}))
+
+		objectInfos, err := client.ListObjectsByPrefixWithMetadata(ctx, prefix+"a/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objectInfos).To(ConsistOf(
+			ObjectInfo{Path: prefix + "a/1", Metadata: map[string]string{"source": "system"}},
+			ObjectInfo{Path: prefix + "a/2", Metadata: map[string]string{}},
+		))
+
+		objects, err = client.ListObjectsByPrefix(ctx, prefix+"c/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+	})
+
+	It("deletes objects", func() {
+		Expect(client.Upload(ctx, []byte("hello world"), prefix+"object")).To(Succeed())
+		exists, err := client.DoesObjectExist(ctx, prefix+"object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		deleted, err := client.DeleteObject(ctx, prefix+"object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+
+		exists, err = client.DoesObjectExist(ctx, prefix+"object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		deleted, err = client.DeleteObject(ctx, prefix+"object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+	})
+
+	It("fails to download missing objects", func() {
+		_, _, err := client.Download(ctx, prefix+"missing")
+		Expect(err).To(Equal(common.NotFound(prefix + "missing")))
+		_, err = client.GetObjectSizeBytes(ctx, prefix+"missing")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("updates the timestamp of objects without losing their metadata", func() {
+		metadata := map[string]string{"source": "user"}
+		Expect(client.UploadWithMetadata(ctx, []byte("hello world"), prefix+"object", metadata)).To(Succeed())
+		updated, err := client.UpdateObjectTimestamp(ctx, prefix+"object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeTrue())
+
+		objects, err := client.ListObjectsByPrefixWithMetadata(ctx, prefix)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(Equal([]ObjectInfo{{Path: prefix + "object", Metadata: metadata}}))
+
+		updated, err = client.UpdateObjectTimestamp(ctx, prefix+"missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(BeFalse())
+	})
+
+	It("expires objects", func() {
+		Expect(client.Upload(ctx, []byte("hello world"), prefix+"object")).To(Succeed())
+		var expired []string
+		callback := func(_ context.Context, _ logrus.FieldLogger, objectName string) {
+			expired = append(expired, objectName)
+		}
+
+		client.ExpireObjects(ctx, prefix, time.Hour, callback)
+		Expect(expired).To(BeEmpty())
+		exists, err := client.DoesObjectExist(ctx, prefix+"object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		client.ExpireObjects(ctx, prefix, -time.Minute, callback)
+		Expect(expired).To(Equal([]string{prefix + "object"}))
+		exists, err = client.DoesObjectExist(ctx, prefix+"object")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("generates presigned download URLs", func() {
+		Expect(client.Upload(ctx, []byte("hello world"), prefix+"discovery.iso")).To(Succeed())
+		presignedURL, err := client.GeneratePresignedDownloadURL(ctx, prefix+"discovery.iso", "cluster-discovery.iso", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err := http.Get(presignedURL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		data, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal([]byte("hello world")))
+	})
+}
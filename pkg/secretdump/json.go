@@ -0,0 +1,83 @@
+package secretdump
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+const redacted = "<SECRET>"
+
+// secretKeyParts are the parts of the JSON keys whose values are considered secrets,
+// e.g. pull_secret, password, api_vip_token, credentials_secret_ref or clouds_yaml. The
+// install-config and ignition overrides are redacted as a whole, as they can embed any
+// secret, e.g. a pull secret or the content of files written to the hosts.
+var secretKeyParts = []string{"secret", "password", "token", "credential", "private_key", "clouds_yaml",
+	"install_config_override", "ignition_config_override"}
+
+// RedactJSON generates a copy of a JSON document with the values of the secret
+// looking keys replaced by <SECRET> and the passwords of the URLs removed.
+// The keys are redacted at any depth, including inside arrays.
+func RedactJSON(data []byte) ([]byte, error) {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	// The redacted documents are read by people, so the markers are not HTML escaped
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(doc)); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if isSecretKey(key) && nested != nil {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(nested)
+			}
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+		return v
+	case string:
+		return redactURL(v)
+	default:
+		return v
+	}
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range secretKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURL removes the password of URLs such as the ones of the proxy settings
+func redactURL(value string) string {
+	if !strings.Contains(value, "://") || !strings.Contains(value, "@") {
+		return value
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.User == nil {
+		return value
+	}
+	if _, hasPassword := u.User.Password(); !hasPassword {
+		return value
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,104 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+// AfterCommitPlugin makes it possible to defer work until the transaction that
+// triggered it is committed, see AfterCommit.
+type AfterCommitPlugin struct{}
+
+func (AfterCommitPlugin) Name() string {
+	return "after_commit"
+}
+
+func (AfterCommitPlugin) Initialize(db *gorm.DB) error {
+	sqlDB, ok := db.ConnPool.(*sql.DB)
+	if !ok {
+		return fmt.Errorf("after commit hooks are not supported with connection pool %T", db.ConnPool)
+	}
+	pool := &afterCommitPool{DB: sqlDB}
+	db.ConnPool = pool
+	db.Statement.ConnPool = pool
+	return nil
+}
+
+// afterCommitPool starts transactions that run their after commit hooks
+type afterCommitPool struct {
+	*sql.DB
+}
+
+func (p *afterCommitPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	tx, err := p.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &afterCommitTx{Tx: tx, db: p.DB}, nil
+}
+
+func (p *afterCommitPool) GetDBConn() (*sql.DB, error) {
+	return p.DB, nil
+}
+
+type afterCommitTx struct {
+	*sql.Tx
+	db *sql.DB
+
+	lock  sync.Mutex
+	hooks []func()
+}
+
+func (t *afterCommitTx) GetDBConn() (*sql.DB, error) {
+	return t.db, nil
+}
+
+func (t *afterCommitTx) Commit() error {
+	err := t.Tx.Commit()
+	hooks := t.takeHooks()
+	if err != nil {
+		return err
+	}
+	for _, hook := range hooks {
+		hook()
+	}
+	return nil
+}
+
+func (t *afterCommitTx) Rollback() error {
+	t.takeHooks()
+	return t.Tx.Rollback()
+}
+
+func (t *afterCommitTx) addHook(hook func()) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.hooks = append(t.hooks, hook)
+}
+
+func (t *afterCommitTx) takeHooks() []func() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	hooks := t.hooks
+	t.hooks = nil
+	return hooks
+}
+
+// AfterCommit runs hook once the transaction db belongs to is committed, and
+// drops it when the transaction is rolled back. The hook runs immediately when
+// db is not in a transaction, or when the database was opened without the
+// AfterCommitPlugin. A savepoint rollback doesn't drop the hooks registered
+// after the savepoint; they run if the outer transaction commits.
+func AfterCommit(db *gorm.DB, hook func()) {
+	if db != nil && db.Statement != nil {
+		if tx, ok := db.Statement.ConnPool.(*afterCommitTx); ok {
+			tx.addHook(hook)
+			return
+		}
+	}
+	hook()
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/go-openapi/runtime/security"
 
 	"github.com/openshift/assisted-service/restapi/operations"
+	"github.com/openshift/assisted-service/restapi/operations/audit"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
@@ -27,13 +28,36 @@ type contextKey string
 
 const AuthKey contextKey = "Auth"
 
+//go:generate mockery -name AuditAPI -inpkg
+
+/* AuditAPI  */
+type AuditAPI interface {
+	/* V2ListAuditRecords Lists the audit records of the changes made through the API and by the controllers, most recent first. */
+	V2ListAuditRecords(ctx context.Context, params audit.V2ListAuditRecordsParams) middleware.Responder
+}
+
 //go:generate mockery -name EventsAPI -inpkg
 
 /* EventsAPI  */
 type EventsAPI interface {
+	/* V2DeregisterEventSubscription Deletes an event subscription and its delivery history. */
+	V2DeregisterEventSubscription(ctx context.Context, params events.V2DeregisterEventSubscriptionParams) middleware.Responder
+
+	/* V2GetEventSubscription Retrieves the details of an event subscription. */
+	V2GetEventSubscription(ctx context.Context, params events.V2GetEventSubscriptionParams) middleware.Responder
+
+	/* V2ListEventSubscriptionDeliveries Lists the deliveries of an event subscription, most recent first. */
+	V2ListEventSubscriptionDeliveries(ctx context.Context, params events.V2ListEventSubscriptionDeliveriesParams) middleware.Responder
+
+	/* V2ListEventSubscriptions Lists the event subscriptions of a cluster or an infra-env. */
+	V2ListEventSubscriptions(ctx context.Context, params events.V2ListEventSubscriptionsParams) middleware.Responder
+
 	/* V2ListEvents Lists events for a cluster. */
 	V2ListEvents(ctx context.Context, params events.V2ListEventsParams) middleware.Responder
 
+	/* V2RegisterEventSubscription Registers a webhook that receives the events of a cluster or an infra-env. */
+	V2RegisterEventSubscription(ctx context.Context, params events.V2RegisterEventSubscriptionParams) middleware.Responder
+
 	/* V2TriggerEvent Add new assisted installer event. */
 	V2TriggerEvent(ctx context.Context, params events.V2TriggerEventParams) middleware.Responder
 }
@@ -52,7 +76,7 @@ type InstallerAPI interface {
 	 */
 	DownloadMinimalInitrd(ctx context.Context, params installer.DownloadMinimalInitrdParams) middleware.Responder
 
-	/* GetClusterSupportedPlatforms A list of platforms that this cluster can support in its current configuration. */
+	/* GetClusterSupportedPlatforms A list of platforms that this cluster can support in its current configuration. The platform suggested by the hardware fingerprints of the hosts is listed first. */
 	GetClusterSupportedPlatforms(ctx context.Context, params installer.GetClusterSupportedPlatformsParams) middleware.Responder
 
 	/* GetInfraEnv Retrieves the details of the infra-env. */
@@ -97,6 +121,18 @@ type InstallerAPI interface {
 	/* V2CancelInstallation Cancels an ongoing installation. */
 	V2CancelInstallation(ctx context.Context, params installer.V2CancelInstallationParams) middleware.Responder
 
+	/* V2CreateClusterRoleBinding Grants a role on the cluster to a user or to a group. */
+	V2CreateClusterRoleBinding(ctx context.Context, params installer.V2CreateClusterRoleBindingParams) middleware.Responder
+
+	/* V2CreateInfraEnvRoleBinding Grants a role on the infra-env to a user or to a group. */
+	V2CreateInfraEnvRoleBinding(ctx context.Context, params installer.V2CreateInfraEnvRoleBindingParams) middleware.Responder
+
+	/* V2DeleteClusterRoleBinding Revokes a role granted on the cluster. */
+	V2DeleteClusterRoleBinding(ctx context.Context, params installer.V2DeleteClusterRoleBindingParams) middleware.Responder
+
+	/* V2DeleteInfraEnvRoleBinding Revokes a role granted on the infra-env. */
+	V2DeleteInfraEnvRoleBinding(ctx context.Context, params installer.V2DeleteInfraEnvRoleBindingParams) middleware.Responder
+
 	/* V2DownloadClusterCredentials Downloads credentials relating to the installed/installing cluster. */
 	V2DownloadClusterCredentials(ctx context.Context, params installer.V2DownloadClusterCredentialsParams) middleware.Responder
 
@@ -109,6 +145,9 @@ type InstallerAPI interface {
 	/* V2GetClusterDefaultConfig Get the default values for various cluster properties. */
 	V2GetClusterDefaultConfig(ctx context.Context, params installer.V2GetClusterDefaultConfigParams) middleware.Responder
 
+	/* V2GetClusterInstallationTimeline Retrieves the timeline of the last installation of the cluster, with the start and end of each stage of the hosts and of the finalizing stages of the cluster. */
+	V2GetClusterInstallationTimeline(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) middleware.Responder
+
 	/* V2GetClusterUISettings Fetch cluster specific UI settings. */
 	V2GetClusterUISettings(ctx context.Context, params installer.V2GetClusterUISettingsParams) middleware.Responder
 
@@ -121,6 +160,12 @@ type InstallerAPI interface {
 	/* V2GetPresignedForClusterFiles Retrieves a pre-signed S3 URL for downloading cluster files. */
 	V2GetPresignedForClusterFiles(ctx context.Context, params installer.V2GetPresignedForClusterFilesParams) middleware.Responder
 
+	/* V2ListClusterRoleBindings Lists the roles granted on the cluster. */
+	V2ListClusterRoleBindings(ctx context.Context, params installer.V2ListClusterRoleBindingsParams) middleware.Responder
+
+	/* V2ListInfraEnvRoleBindings Lists the roles granted on the infra-env. */
+	V2ListInfraEnvRoleBindings(ctx context.Context, params installer.V2ListInfraEnvRoleBindingsParams) middleware.Responder
+
 	/* V2UpdateCluster Updates an OpenShift cluster definition. */
 	V2UpdateCluster(ctx context.Context, params installer.V2UpdateClusterParams) middleware.Responder
 
@@ -133,6 +178,9 @@ type InstallerAPI interface {
 	/* V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%. */
 	V2CompleteInstallation(ctx context.Context, params installer.V2CompleteInstallationParams) middleware.Responder
 
+	/* V2CreateHostDiagnostic Queues a diagnostic that the host runs the next time that it asks for instructions. */
+	V2CreateHostDiagnostic(ctx context.Context, params installer.V2CreateHostDiagnosticParams) middleware.Responder
+
 	/* V2DeregisterCluster Deletes an OpenShift cluster definition. */
 	V2DeregisterCluster(ctx context.Context, params installer.V2DeregisterClusterParams) middleware.Responder
 
@@ -178,6 +226,9 @@ type InstallerAPI interface {
 	/* V2ListClusters Retrieves the list of OpenShift clusters. */
 	V2ListClusters(ctx context.Context, params installer.V2ListClustersParams) middleware.Responder
 
+	/* V2ListHostDiagnostics Lists the on-demand diagnostics requested for the host, with their results. */
+	V2ListHostDiagnostics(ctx context.Context, params installer.V2ListHostDiagnosticsParams) middleware.Responder
+
 	/* V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env. */
 	V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder
 
@@ -228,6 +279,9 @@ type InstallerAPI interface {
 
 	/* V2UploadClusterIngressCert Transfer the ingress certificate for the cluster. */
 	V2UploadClusterIngressCert(ctx context.Context, params installer.V2UploadClusterIngressCertParams) middleware.Responder
+
+	/* V2WatchCluster Streams the live state of a cluster, its hosts and its events as server-sent events. */
+	V2WatchCluster(ctx context.Context, params installer.V2WatchClusterParams) middleware.Responder
 }
 
 //go:generate mockery -name ManagedDomainsAPI -inpkg
@@ -271,6 +325,11 @@ type OperatorsAPI interface {
 	/* V2ListOfClusterOperators Lists operators to be monitored for a cluster. */
 	V2ListOfClusterOperators(ctx context.Context, params operators.V2ListOfClusterOperatorsParams) middleware.Responder
 
+	/* V2ListOperatorInstallOptions Lists the channels, catalog sources and install plan approval modes that can be selected to install an
+	operator on a given OpenShift version.
+	*/
+	V2ListOperatorInstallOptions(ctx context.Context, params operators.V2ListOperatorInstallOptionsParams) middleware.Responder
+
 	/* V2ListOperatorProperties Lists properties for an operator. */
 	V2ListOperatorProperties(ctx context.Context, params operators.V2ListOperatorPropertiesParams) middleware.Responder
 
@@ -297,6 +356,7 @@ type VersionsAPI interface {
 
 // Config is configuration for Handler
 type Config struct {
+	AuditAPI
 	EventsAPI
 	InstallerAPI
 	ManagedDomainsAPI
@@ -522,11 +582,36 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.V2CreateClusterManifest(ctx, params)
 	})
+	api.InstallerV2CreateClusterRoleBindingHandler = installer.V2CreateClusterRoleBindingHandlerFunc(func(params installer.V2CreateClusterRoleBindingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2CreateClusterRoleBinding(ctx, params)
+	})
+	api.InstallerV2CreateInfraEnvRoleBindingHandler = installer.V2CreateInfraEnvRoleBindingHandlerFunc(func(params installer.V2CreateInfraEnvRoleBindingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2CreateInfraEnvRoleBinding(ctx, params)
+	})
 	api.ManifestsV2DeleteClusterManifestHandler = manifests.V2DeleteClusterManifestHandlerFunc(func(params manifests.V2DeleteClusterManifestParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.V2DeleteClusterManifest(ctx, params)
 	})
+	api.InstallerV2DeleteClusterRoleBindingHandler = installer.V2DeleteClusterRoleBindingHandlerFunc(func(params installer.V2DeleteClusterRoleBindingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DeleteClusterRoleBinding(ctx, params)
+	})
+	api.InstallerV2DeleteInfraEnvRoleBindingHandler = installer.V2DeleteInfraEnvRoleBindingHandlerFunc(func(params installer.V2DeleteInfraEnvRoleBindingParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DeleteInfraEnvRoleBinding(ctx, params)
+	})
+	api.EventsV2DeregisterEventSubscriptionHandler = events.V2DeregisterEventSubscriptionHandlerFunc(func(params events.V2DeregisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.V2DeregisterEventSubscription(ctx, params)
+	})
 	api.InstallerV2DownloadClusterCredentialsHandler = installer.V2DownloadClusterCredentialsHandlerFunc(func(params installer.V2DownloadClusterCredentialsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -552,6 +637,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetClusterDefaultConfig(ctx, params)
 	})
+	api.InstallerV2GetClusterInstallationTimelineHandler = installer.V2GetClusterInstallationTimelineHandlerFunc(func(params installer.V2GetClusterInstallationTimelineParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterInstallationTimeline(ctx, params)
+	})
 	api.InstallerV2GetClusterUISettingsHandler = installer.V2GetClusterUISettingsHandlerFunc(func(params installer.V2GetClusterUISettingsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -562,6 +652,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetCredentials(ctx, params)
 	})
+	api.EventsV2GetEventSubscriptionHandler = events.V2GetEventSubscriptionHandlerFunc(func(params events.V2GetEventSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.V2GetEventSubscription(ctx, params)
+	})
 	api.InstallerV2GetPresignedForClusterCredentialsHandler = installer.V2GetPresignedForClusterCredentialsHandlerFunc(func(params installer.V2GetPresignedForClusterCredentialsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -572,6 +667,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetPresignedForClusterFiles(ctx, params)
 	})
+	api.AuditV2ListAuditRecordsHandler = audit.V2ListAuditRecordsHandlerFunc(func(params audit.V2ListAuditRecordsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.AuditAPI.V2ListAuditRecords(ctx, params)
+	})
 	api.OperatorsV2ListBundlesHandler = operators.V2ListBundlesHandlerFunc(func(params operators.V2ListBundlesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -582,16 +682,41 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.V2ListClusterManifests(ctx, params)
 	})
+	api.InstallerV2ListClusterRoleBindingsHandler = installer.V2ListClusterRoleBindingsHandlerFunc(func(params installer.V2ListClusterRoleBindingsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListClusterRoleBindings(ctx, params)
+	})
+	api.InstallerV2ListInfraEnvRoleBindingsHandler = installer.V2ListInfraEnvRoleBindingsHandlerFunc(func(params installer.V2ListInfraEnvRoleBindingsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListInfraEnvRoleBindings(ctx, params)
+	})
 	api.ManagedDomainsV2ListManagedDomainsHandler = managed_domains.V2ListManagedDomainsHandlerFunc(func(params managed_domains.V2ListManagedDomainsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.ManagedDomainsAPI.V2ListManagedDomains(ctx, params)
 	})
+	api.EventsV2ListEventSubscriptionDeliveriesHandler = events.V2ListEventSubscriptionDeliveriesHandlerFunc(func(params events.V2ListEventSubscriptionDeliveriesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.V2ListEventSubscriptionDeliveries(ctx, params)
+	})
+	api.EventsV2ListEventSubscriptionsHandler = events.V2ListEventSubscriptionsHandlerFunc(func(params events.V2ListEventSubscriptionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.V2ListEventSubscriptions(ctx, params)
+	})
 	api.OperatorsV2ListOfClusterOperatorsHandler = operators.V2ListOfClusterOperatorsHandlerFunc(func(params operators.V2ListOfClusterOperatorsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.OperatorsAPI.V2ListOfClusterOperators(ctx, params)
 	})
+	api.OperatorsV2ListOperatorInstallOptionsHandler = operators.V2ListOperatorInstallOptionsHandlerFunc(func(params operators.V2ListOperatorInstallOptionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2ListOperatorInstallOptions(ctx, params)
+	})
 	api.OperatorsV2ListOperatorPropertiesHandler = operators.V2ListOperatorPropertiesHandlerFunc(func(params operators.V2ListOperatorPropertiesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -602,6 +727,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.OperatorsAPI.V2ListSupportedOperators(ctx, params)
 	})
+	api.EventsV2RegisterEventSubscriptionHandler = events.V2RegisterEventSubscriptionHandlerFunc(func(params events.V2RegisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.EventsAPI.V2RegisterEventSubscription(ctx, params)
+	})
 	api.InstallerV2UpdateClusterHandler = installer.V2UpdateClusterHandlerFunc(func(params installer.V2UpdateClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -627,6 +757,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2CompleteInstallation(ctx, params)
 	})
+	api.InstallerV2CreateHostDiagnosticHandler = installer.V2CreateHostDiagnosticHandlerFunc(func(params installer.V2CreateHostDiagnosticParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2CreateHostDiagnostic(ctx, params)
+	})
 	api.InstallerV2DeregisterClusterHandler = installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -717,6 +852,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.EventsAPI.V2ListEvents(ctx, params)
 	})
+	api.InstallerV2ListHostDiagnosticsHandler = installer.V2ListHostDiagnosticsHandlerFunc(func(params installer.V2ListHostDiagnosticsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListHostDiagnostics(ctx, params)
+	})
 	api.InstallerV2ListHostsHandler = installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -822,6 +962,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadClusterIngressCert(ctx, params)
 	})
+	api.InstallerV2WatchClusterHandler = installer.V2WatchClusterHandlerFunc(func(params installer.V2WatchClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2WatchCluster(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
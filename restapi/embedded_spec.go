@@ -41,6 +41,122 @@ func init() {
   "host": "api.openshift.com",
   "basePath": "/api/assisted-install",
   "paths": {
+    "/v2/audit-records": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the audit records of the changes made through the API and by the controllers, most recent first.",
+        "tags": [
+          "audit"
+        ],
+        "operationId": "v2ListAuditRecords",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the changes made to this cluster.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the changes made to this infra-env.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the changes made to this host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the changes made by this user, agent or controller.",
+            "name": "actor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the changes made by this operation.",
+            "name": "operation_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the changes made at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the changes made before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/audit-record-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/clusters": {
       "get": {
         "security": [
@@ -1745,6 +1861,72 @@ func init() {
         }
       }
     },
+    "/v2/clusters/{cluster_id}/installation-timeline": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the timeline of the last installation of the cluster, with the start and end of each stage of the hosts and of the finalizing stages of the cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetClusterInstallationTimeline",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation timeline should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/installation-timeline"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/clusters/{cluster_id}/logs": {
       "get": {
         "security": [
@@ -2722,7 +2904,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
+    "/v2/clusters/{cluster_id}/role-bindings": {
       "get": {
         "security": [
           {
@@ -2733,16 +2915,16 @@ func init() {
             ]
           }
         ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "description": "Lists the roles granted on the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "v2ListClusterRoleBindings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
+            "description": "The cluster whose role bindings should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -2752,10 +2934,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
+              "$ref": "#/definitions/role-binding-list"
             }
           },
           "401": {
@@ -2776,6 +2955,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2783,30 +2968,45 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/ui-settings": {
-      "get": {
-        "description": "Fetch cluster specific UI settings.",
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Grants a role on the cluster to a user or to a group.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterUISettings",
+        "operationId": "v2CreateClusterRoleBinding",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be retrieved.",
+            "description": "The cluster that the role is granted on.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The role binding to be created.",
+            "name": "role-binding-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/role-binding-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/role-binding"
             }
           },
           "400": {
@@ -2833,6 +3033,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2840,44 +3052,44 @@ func init() {
             }
           }
         }
-      },
-      "put": {
-        "description": "Update cluster specific UI settings.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/role-bindings/{role_binding_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Revokes a role granted on the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateClusterUISettings",
+        "operationId": "v2DeleteClusterRoleBinding",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be updated.",
+            "description": "The cluster that the role is granted on.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Settings for the installer UI.",
-            "name": "ui-settings",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The role binding to be deleted.",
+            "name": "role_binding_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -2897,6 +3109,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2906,7 +3124,191 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+    "/v2/clusters/{cluster_id}/supported-platforms": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "A list of platforms that this cluster can support in its current configuration. The platform suggested by the hardware fingerprints of the hosts is listed first.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetClusterSupportedPlatforms",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose platform types should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/ui-settings": {
+      "get": {
+        "description": "Fetch cluster specific UI settings.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterUISettings",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which UI settings should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update cluster specific UI settings.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2UpdateClusterUISettings",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster for which UI settings should be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Settings for the installer UI.",
+            "name": "ui-settings",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
       "post": {
         "security": [
           {
@@ -2992,6 +3394,81 @@ func init() {
         }
       }
     },
+    "/v2/clusters/{cluster_id}/watch": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Streams the live state of a cluster, its hosts and its events as server-sent events.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2WatchCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to watch.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/v2/component-versions": {
       "get": {
         "security": [
@@ -3314,7 +3791,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+    "/v2/events/subscriptions": {
       "get": {
         "security": [
           {
@@ -3323,42 +3800,40 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists the event subscriptions of a cluster or an infra-env.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "v2DownloadHostIgnition",
+        "operationId": "v2ListEventSubscriptions",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "The cluster to return event subscriptions for.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The infra-env to return event subscriptions for.",
+            "name": "infra_env_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/event-subscription-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -3385,120 +3860,36 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
-          },
-          {
-            "watcherAuth": []
-          }
-        ],
-        "description": "Retrieves the list of infra-envs.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "ListInfraEnvs",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If provided, returns only infra-envs which directly reference this cluster.",
-            "name": "cluster_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If provided, returns only infra-envs that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
           }
         ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/infra-env-list"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "description": "Creates a new OpenShift Discovery ISO.",
+        "description": "Registers a webhook that receives the events of a cluster or an infra-env.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "RegisterInfraEnv",
+        "operationId": "v2RegisterEventSubscription",
         "parameters": [
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "infraenv-create-params",
+            "description": "The event subscription to be created.",
+            "name": "new-event-subscription-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-create-params"
+              "$ref": "#/definitions/event-subscription-create-params"
             }
           }
         ],
@@ -3506,7 +3897,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/event-subscription"
             }
           },
           "400": {
@@ -3539,28 +3930,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}": {
+    "/v2/events/subscriptions/{subscription_id}": {
       "get": {
         "security": [
           {
@@ -3569,31 +3948,19 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
           }
         ],
-        "description": "Retrieves the details of the infra-env.",
+        "description": "Retrieves the details of an event subscription.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "v2GetEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
-            "name": "infra_env_id",
+            "description": "The event subscription to be retrieved.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           }
@@ -3602,7 +3969,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/event-subscription"
             }
           },
           "401": {
@@ -3634,33 +4001,29 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "delete": {
-        "description": "Deletes an infra-env.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Deletes an event subscription and its delivery history.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "DeregisterInfraEnv",
+        "operationId": "v2DeregisterEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be deleted.",
-            "name": "infra_env_id",
+            "description": "The event subscription to be deleted.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           }
@@ -3693,62 +4056,63 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an infra-env.",
+      }
+    },
+    "/v2/events/subscriptions/{subscription_id}/deliveries": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the deliveries of an event subscription, most recent first.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "UpdateInfraEnv",
+        "operationId": "v2ListEventSubscriptionDeliveries",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be updated.",
-            "name": "infra_env_id",
+            "description": "The event subscription to return deliveries for.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "infra-env-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/infra-env-update-params"
-            }
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "description": "Only return the deliveries in this status.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/event-subscription-delivery-list"
             }
           },
           "401": {
@@ -3775,28 +4139,16 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -3808,72 +4160,32 @@ func init() {
           },
           {
             "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadInfraEnvFiles",
+        "operationId": "v2DownloadHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose file should be downloaded.",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script",
-              "static-network-config"
-            ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "mac",
-            "description": "Mac address of the host running ipxe script.",
-            "name": "mac",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "full-iso",
-              "minimal-iso"
-            ],
-            "type": "string",
-            "description": "Overrides the ISO type for the disovery ignition, either 'full-iso' or 'minimal-iso'.",
-            "name": "discovery_iso_type",
-            "in": "query"
           }
         ],
         "responses": {
@@ -3883,12 +4195,6 @@ func init() {
               "type": "file"
             }
           },
-          "400": {
-            "description": "Bad Request.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -3925,12 +4231,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -3940,41 +4240,37 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+    "/v2/infra-envs": {
       "get": {
-        "description": "Creates a new pre-signed download URL for the infra-env.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Retrieves the list of infra-envs.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvPresignedFileURL",
+        "operationId": "ListInfraEnvs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The file's infra-env.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "If provided, returns only infra-envs which directly reference this cluster.",
+            "name": "cluster_id",
+            "in": "query"
           },
           {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
             "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
+            "description": "If provided, returns only infra-envs that are owned by the specified user.",
+            "name": "owner",
             "in": "query"
           }
         ],
@@ -3982,7 +4278,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/infra-env-list"
             }
           },
           "401": {
@@ -3997,12 +4293,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -4028,34 +4318,33 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
-      "get": {
-        "description": "Creates a new pre-signed image download URL for the infra-env.",
+      },
+      "post": {
+        "description": "Creates a new OpenShift Discovery ISO.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvDownloadURL",
+        "operationId": "RegisterInfraEnv",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to be retrieved.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "description": "The parameters for the generated ISO.",
+            "name": "infraenv-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/infra-env-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "400": {
-            "description": "Bad Request.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4084,20 +4373,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4105,7 +4394,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
+    "/v2/infra-envs/{infra_env_id}": {
       "get": {
         "security": [
           {
@@ -4115,26 +4404,29 @@ func init() {
               "user"
             ]
           },
+          {
+            "agentAuth": []
+          },
           {
             "urlAuth": []
           },
           {
             "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves the details of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadMinimalInitrd",
+        "operationId": "GetInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env to be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -4144,13 +4436,9 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/infra-env"
             }
           },
-          "204": {
-            "description": "Empty Success."
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4175,56 +4463,45 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Conflict.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
           },
-          {
-            "agentAuth": []
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        ],
-        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
+        }
+      },
+      "delete": {
+        "description": "Deletes an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListHosts",
+        "operationId": "DeregisterInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the hosts are asociated with.",
+            "description": "The infra-env to be deleted.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host-list"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -4238,73 +4515,68 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Registers a new OpenShift agent.",
+      "patch": {
+        "description": "Updates an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterHost",
+        "operationId": "UpdateInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the agent is associated with.",
+            "description": "The infra-env to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the agent being registered.",
-            "name": "new-host-params",
-            "in": "body",
+            "description": "The properties to update.",
+            "name": "infra-env-update-params",
+            "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/infra-env-update-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the agent.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "400": {
@@ -4338,7 +4610,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4354,17 +4626,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+    "/v2/infra-envs/{infra_env_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -4373,36 +4639,88 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift host.",
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHost",
+        "operationId": "v2DownloadInfraEnvFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env whose file should be downloaded.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script",
+              "static-network-config"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that should be retrieved.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "mac",
+            "description": "Mac address of the host running ipxe script.",
+            "name": "mac",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "full-iso",
+              "minimal-iso"
+            ],
+            "type": "string",
+            "description": "Overrides the ISO type for the disovery ignition, either 'full-iso' or 'minimal-iso'.",
+            "name": "discovery_iso_type",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -4429,130 +4747,76 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2DeregisterHost",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that should be deregistered.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that should be deregistered.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "patch": {
-        "description": "Update an Openshift host",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+      "get": {
+        "description": "Creates a new pre-signed download URL for the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHost",
+        "operationId": "GetInfraEnvPresignedFileURL",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env ID of the host to be updated.",
+            "description": "The file's infra-env.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "The host that should be updated.",
-            "name": "host_id",
-            "in": "path",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "host-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-update-params"
-            }
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "401": {
@@ -4579,14 +4843,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4594,49 +4864,32 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
-      "post": {
-        "description": "Bind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
+      "get": {
+        "description": "Creates a new pre-signed image download URL for the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "BindHost",
+        "operationId": "GetInfraEnvDownloadURL",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env to be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being bound.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The parameters for the host binding.",
-            "name": "bind-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bind-host-params"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4686,38 +4939,52 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
-      "post": {
-        "description": "install specific host for day2 cluster.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2InstallHost",
-        "parameters": [
+    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env of the host that is being installed.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
           },
+          {
+            "imageAuth": []
+          }
+        ],
+        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "DownloadMinimalInitrd",
+        "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being installed.",
-            "name": "host_id",
+            "description": "The infra-env of the host that should be retrieved.",
+            "name": "infra_env_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "string",
+              "format": "binary"
             }
           },
+          "204": {
+            "description": "Empty Success."
+          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4736,8 +5003,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
-            "description": "Error.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4751,36 +5024,40 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/v2/infra-envs/{infra_env_id}/hosts": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetHost",
+        "operationId": "v2ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being reset.",
+            "description": "The infra-env that the hosts are asociated with.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -4795,69 +5072,77 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
-      "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift agent.",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
+        "operationId": "v2RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
+            "description": "The infra-env that the agent is associated with.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that its validation is being reset.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "description": "The description of the agent being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
           },
           {
             "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "description": "The software version of the discovery agent that is registering the agent.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host_registration_response"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4880,8 +5165,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new agents due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -4891,22 +5182,43 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
-      "post": {
-        "description": "Unbind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "v2GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env of the host that should be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -4914,7 +5226,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The host that should be retrieved.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -4927,12 +5239,6 @@ func init() {
               "$ref": "#/definitions/host"
             }
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -4957,12 +5263,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Conflict.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -4974,28 +5274,20 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
-      "get": {
-        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHostIgnition",
+        "operationId": "v2DeregisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "description": "The infra-env of the host that should be deregistered.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5003,17 +5295,20 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be obtained.",
+            "description": "The host that should be deregistered.",
             "name": "host_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -5045,32 +5340,20 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       },
       "patch": {
-        "description": "Patch the ignition file for this host",
+        "description": "Update an Openshift host",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "v2UpdateHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
+            "description": "The infra-env ID of the host to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5078,24 +5361,27 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
+            "description": "The host that should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
+            "description": "The properties to update.",
+            "name": "host-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/host-update-params"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
           "400": {
             "description": "Error.",
@@ -5127,14 +5413,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5142,18 +5428,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
+      "post": {
+        "description": "Bind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "BindHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5161,23 +5447,23 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
+            "description": "The host that is being bound.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
+            "description": "The parameters for the host binding.",
+            "name": "bind-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/installer-args-params"
+              "$ref": "#/definitions/bind-host-params"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
@@ -5213,20 +5499,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5234,23 +5520,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
-      "get": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetNextSteps",
+        "operationId": "v2InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is retrieving instructions.",
+            "description": "The infra-env of the host that is being installed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5258,29 +5539,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is retrieving instructions.",
+            "description": "The host that is being installed.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "integer",
-            "description": "The time on the host as seconds since the Unix epoch.",
-            "name": "timestamp",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -5301,8 +5570,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5312,43 +5581,22 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2PostStepReply",
+        "operationId": "v2ResetHost",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is posting results.",
+            "description": "The infra-env of the host that is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5356,28 +5604,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is posting results.",
+            "description": "The host that is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -5398,8 +5635,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5409,39 +5646,23 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update log collection state and progress.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      "patch": {
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostLogsProgress",
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose log progress is being updated.",
+            "description": "The infra-env of the host that its validation is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5449,24 +5670,31 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose log progress is being updated.",
+            "description": "The host that its validation is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5486,12 +5714,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -5503,45 +5725,22 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation progress.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
+      "post": {
+        "description": "Unbind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "UnbindHost",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host being updated.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -5549,24 +5748,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to update.",
+            "description": "The host that is being bound.",
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5592,12 +5791,24 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -5607,26 +5818,37 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
-      "post": {
-        "description": "Regenerate InfraEnv token signing key.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics": {
+      "get": {
+        "description": "Lists the on-demand diagnostics requested for the host, with their results.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegenerateInfraEnvSigningKey",
+        "operationId": "v2ListHostDiagnostics",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The target InfraEnv.",
+            "description": "The infra-env of the host whose diagnostics should be listed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose diagnostics should be listed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -5646,12 +5868,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -5659,86 +5875,79 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/openshift-versions": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the list of OpenShift supported versions.",
+      },
+      "post": {
+        "description": "Queues a diagnostic that the host runs the next time that it asks for instructions.",
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "v2ListSupportedOpenshiftVersions",
+        "operationId": "v2CreateHostDiagnostic",
         "parameters": [
           {
             "type": "string",
-            "description": "Retrieves only the versions that contain the specified substring in their display name.",
-            "name": "version",
-            "in": "query"
+            "format": "uuid",
+            "description": "The infra-env of the host that should run the diagnostic.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "boolean",
-            "description": "If true, returns only the latest version for each minor.",
-            "name": "only_latest",
-            "in": "query"
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should run the diagnostic.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The diagnostic to run.",
+            "name": "diagnostic-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/openshift-versions"
+              "$ref": "#/definitions/host-diagnostic"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "401": {
+            "description": "Unauthorized.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/operators/bundles": {
-      "get": {
-        "description": "Retrieves a list of avaliable bundles.",
-        "tags": [
-          "operators"
-        ],
-        "summary": "Get list of avaliable bundles",
-        "operationId": "V2ListBundles",
-        "responses": {
-          "200": {
-            "description": "Success",
+          },
+          "409": {
+            "description": "Error.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/bundle"
-              }
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -5746,108 +5955,118 @@ func init() {
         }
       }
     },
-    "/v2/operators/bundles/{id}": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
       "get": {
-        "description": "Retrieves an array of operator properties for the specified bundle.",
+        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
         "tags": [
-          "operators"
+          "installer"
         ],
-        "summary": "Get operator properties for a bundle",
-        "operationId": "V2GetBundle",
+        "operationId": "v2GetHostIgnition",
         "parameters": [
           {
             "type": "string",
-            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai-nvidia` + "`" + `.",
-            "name": "id",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be obtained.",
+            "name": "host_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/bundle"
+              "$ref": "#/definitions/host-ignition-params"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
             }
           },
           "404": {
-            "description": "Bundle not found",
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/release-sources": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves openshift release sources configuration.",
-        "tags": [
-          "versions"
-        ],
-        "operationId": "v2ListReleaseSources",
-        "responses": {
-          "200": {
-            "description": "Success.",
+          },
+          "501": {
+            "description": "Not implemented.",
             "schema": {
-              "$ref": "#/definitions/release-sources"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/support-levels/architectures": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the architecture support-levels for each OpenShift version.",
+      },
+      "patch": {
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "operationId": "GetSupportedArchitectures",
+        "operationId": "v2UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster.",
-            "name": "openshift_version",
-            "in": "query",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be updated.",
+            "name": "host_id",
+            "in": "path",
             "required": true
+          },
+          {
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "architectures": {
-                  "description": "Keys will be one of architecture-support-level-id enum.",
-                  "$ref": "#/definitions/support-levels"
-                }
-              }
-            }
+          "201": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -5873,85 +6092,66 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
-    "/v2/support-levels/features": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the features support levels for each OpenShift version.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetSupportedFeatures",
+        "operationId": "v2UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
-            "description": "Version of the OpenShift cluster.",
-            "name": "openshift_version",
-            "in": "query",
+            "format": "uuid",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "name": "infra_env_id",
+            "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x",
-              "multi"
-            ],
-            "type": "string",
-            "default": "x86_64",
-            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-            "name": "cpu_architecture",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "baremetal",
-              "none",
-              "nutanix",
-              "vsphere",
-              "external"
-            ],
             "type": "string",
-            "description": "The provider platform type.",
-            "name": "platform_type",
-            "in": "query"
+            "format": "uuid",
+            "description": "The host whose installer arguments should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
-            "name": "external_platform_name",
-            "in": "query"
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "features": {
-                  "description": "Keys will be one of features-support-level-id enum.",
-                  "$ref": "#/definitions/support-levels"
-                }
-              }
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -5978,70 +6178,26 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      }
-    },
-    "/v2/supported-operators": {
-      "get": {
-        "description": "Retrieves the list of supported operators.",
-        "tags": [
-          "operators"
-        ],
-        "operationId": "V2ListSupportedOperators",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string",
-                "enum": [
-                  "amd-gpu",
-                  "lso",
-                  "mtv",
-                  "openshift-ai",
-                  "osc",
-                  "servicemesh",
-                  "authorino",
-                  "cnv",
-                  "nvidia-gpu",
-                  "pipelines",
-                  "odf",
-                  "lvm",
-                  "mce",
-                  "node-feature-discovery",
-                  "serverless",
-                  "nmstate",
-                  "kmm",
-                  "node-healthcheck",
-                  "self-node-remediation",
-                  "fence-agents-remediation",
-                  "node-maintenance",
-                  "kube-descheduler"
-                ]
-              }
-            }
           },
-          "401": {
-            "description": "Unauthorized.",
+          "409": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "403": {
-            "description": "Forbidden.",
+          "500": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -6049,36 +6205,53 @@ func init() {
         }
       }
     },
-    "/v2/supported-operators/{operator_name}": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Lists properties for an operator.",
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
-          "operators"
+          "installer"
         ],
-        "operationId": "V2ListOperatorProperties",
+        "operationId": "v2GetNextSteps",
         "parameters": [
           {
             "type": "string",
-            "description": "The operator name.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "The infra-env of the host that is retrieving instructions.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is retrieving instructions.",
+            "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The time on the host as seconds since the Unix epoch.",
+            "name": "timestamp",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -6099,3071 +6272,5524 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "api_vip": {
-      "description": "The virtual IP used to reach the OpenShift cluster's API.",
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this VIP is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ip": {
-          "description": "The IP address.",
-          "$ref": "#/definitions/ip"
-        },
-        "verification": {
-          "description": "API VIP verification result.",
-          "$ref": "#/definitions/vip_verification"
-        }
-      }
-    },
-    "api_vip_connectivity_additional_request_header": {
-      "type": "object",
-      "properties": {
-        "key": {
-          "description": "Value of the header's key when making a request",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value corresponding to the header key",
-          "type": "string"
-        }
-      }
-    },
-    "api_vip_connectivity_request": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "ca_certificate": {
-          "description": "A CA certficate to be used when contacting the URL via https.",
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2PostStepReply",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that is posting results.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is posting results.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The results to be posted.",
+            "name": "reply",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/step-reply"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostLogsProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose log progress is being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose log progress is being updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Update cluster install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation progress.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2UpdateHostInstallProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host being updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to update.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
+      "post": {
+        "description": "Regenerate InfraEnv token signing key.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "RegenerateInfraEnvSigningKey",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The target InfraEnv.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/role-bindings": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the roles granted on the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListInfraEnvRoleBindings",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env whose role bindings should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/role-binding-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Grants a role on the infra-env to a user or to a group.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2CreateInfraEnvRoleBinding",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env that the role is granted on.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The role binding to be created.",
+            "name": "role-binding-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/role-binding-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/role-binding"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/role-bindings/{role_binding_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Revokes a role granted on the infra-env.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeleteInfraEnvRoleBinding",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env that the role is granted on.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The role binding to be deleted.",
+            "name": "role_binding_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/openshift-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the list of OpenShift supported versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListSupportedOpenshiftVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Retrieves only the versions that contain the specified substring in their display name.",
+            "name": "version",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, returns only the latest version for each minor.",
+            "name": "only_latest",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/openshift-versions"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/operators/bundles": {
+      "get": {
+        "description": "Retrieves a list of avaliable bundles.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get list of avaliable bundles",
+        "operationId": "V2ListBundles",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/bundle"
+              }
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/operators/bundles/{id}": {
+      "get": {
+        "description": "Retrieves an array of operator properties for the specified bundle.",
+        "tags": [
+          "operators"
+        ],
+        "summary": "Get operator properties for a bundle",
+        "operationId": "V2GetBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Identifier of the bundle, for example, ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai-nvidia` + "`" + `.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/bundle"
+            }
+          },
+          "404": {
+            "description": "Bundle not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/release-sources": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves openshift release sources configuration.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListReleaseSources",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/release-sources"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/architectures": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the architecture support-levels for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetSupportedArchitectures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "architectures": {
+                  "description": "Keys will be one of architecture-support-level-id enum.",
+                  "$ref": "#/definitions/support-levels"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/support-levels/features": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the features support levels for each OpenShift version.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "GetSupportedFeatures",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x",
+              "multi"
+            ],
+            "type": "string",
+            "default": "x86_64",
+            "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+            "name": "cpu_architecture",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "baremetal",
+              "none",
+              "nutanix",
+              "vsphere",
+              "external",
+              "openstack"
+            ],
+            "type": "string",
+            "description": "The provider platform type.",
+            "name": "platform_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "External platform name when platform type is set to external. The value of this parameter will be ignored if platform_type is not external.",
+            "name": "external_platform_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "features": {
+                  "description": "Keys will be one of features-support-level-id enum.",
+                  "$ref": "#/definitions/support-levels"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators": {
+      "get": {
+        "description": "Retrieves the list of supported operators.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListSupportedOperators",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string",
+                "enum": [
+                  "amd-gpu",
+                  "lso",
+                  "mtv",
+                  "openshift-ai",
+                  "osc",
+                  "servicemesh",
+                  "authorino",
+                  "cnv",
+                  "nvidia-gpu",
+                  "pipelines",
+                  "odf",
+                  "lvm",
+                  "mce",
+                  "node-feature-discovery",
+                  "serverless",
+                  "nmstate",
+                  "kmm",
+                  "node-healthcheck",
+                  "self-node-remediation",
+                  "fence-agents-remediation",
+                  "node-maintenance",
+                  "kube-descheduler"
+                ]
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators/{operator_name}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists properties for an operator.",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListOperatorProperties",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators/{operator_name}/install-options": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the channels, catalog sources and install plan approval modes that can be selected to install an\noperator on a given OpenShift version.\n",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListOperatorInstallOptions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-install-options"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "api_vip": {
+      "description": "The virtual IP used to reach the OpenShift cluster's API.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this VIP is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ip": {
+          "description": "The IP address.",
+          "$ref": "#/definitions/ip"
+        },
+        "verification": {
+          "description": "API VIP verification result.",
+          "$ref": "#/definitions/vip_verification"
+        }
+      }
+    },
+    "api_vip_connectivity_additional_request_header": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "description": "Value of the header's key when making a request",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value corresponding to the header key",
+          "type": "string"
+        }
+      }
+    },
+    "api_vip_connectivity_request": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "ca_certificate": {
+          "description": "A CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).",
+          "type": "string",
+          "x-nullable": true
+        },
+        "request_headers": {
+          "description": "Additional request headers to include when fetching the ignition from ignition_endpoint_url.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip_connectivity_additional_request_header"
+          },
+          "x-nullable": true,
+          "x-omitempty": true
+        },
+        "url": {
+          "description": "URL address of the API.",
+          "type": "string"
+        },
+        "verify_cidr": {
+          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
+          "type": "boolean"
+        }
+      }
+    },
+    "api_vip_connectivity_response": {
+      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
+      "type": "object",
+      "properties": {
+        "download_error": {
+          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
+          "type": "string"
+        },
+        "ignition": {
+          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
+          "type": "string"
+        },
+        "is_success": {
+          "description": "Whether the agent was able to download the ignition or not",
+          "type": "boolean"
+        },
+        "url": {
+          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
+          "type": "string"
+        }
+      }
+    },
+    "architecture-support-level-id": {
+      "type": "string",
+      "enum": [
+        "X86_64_ARCHITECTURE",
+        "ARM64_ARCHITECTURE",
+        "PPC64LE_ARCHITECTURE",
+        "S390X_ARCHITECTURE",
+        "MULTIARCH_RELEASE_IMAGE"
+      ]
+    },
+    "audit-record": {
+      "type": "object",
+      "properties": {
+        "actor": {
+          "description": "The user, agent or controller that made the change.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "auth_type": {
+          "description": "The security scheme that authenticated the actor, or controller for changes made by the controllers.",
+          "type": "string"
+        },
+        "changes": {
+          "description": "JSON document of the requested change with its secrets redacted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "cluster_id": {
+          "description": "The cluster that was changed.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "host_id": {
+          "description": "The host that was changed.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the audit record.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env that was changed.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "method": {
+          "description": "HTTP method of the request, empty for changes made by the controllers.",
+          "type": "string"
+        },
+        "operation_id": {
+          "description": "The operation that was called.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "request_id": {
+          "description": "The request identifier, as found in the service logs.",
+          "type": "string"
+        },
+        "status_code": {
+          "description": "HTTP status code of the response, zero for changes made by the controllers.",
+          "type": "integer"
+        }
+      }
+    },
+    "audit-record-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-record"
+      }
+    },
+    "bind-host-params": {
+      "required": [
+        "cluster_id"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "command_line": {
+          "type": "string"
+        },
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "device_type": {
+          "type": "string",
+          "enum": [
+            "persistent",
+            "ephemeral"
+          ]
+        },
+        "pxe_interface": {
+          "type": "string"
+        },
+        "secure_boot_state": {
+          "$ref": "#/definitions/secure-boot-state"
+        }
+      }
+    },
+    "bundle": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "description": "Longer human friendly description for the bundle, usually one or more sentences.\n",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the bundle, for example ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai-nvidia` + "`" + `.",
+          "type": "string"
+        },
+        "operators": {
+          "description": "List of operators associated with the bundle.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "title": {
+          "description": "Short human friendly description for the bundle, usually only a few words, for example ` + "`" + `Virtualization` + "`" + ` or\n` + "`" + `OpenShift AI (NVIDIA)` + "`" + `.\n",
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "ams_subscription_id": {
+          "description": "Unique identifier of the AMS subscription in OCM.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "connectivity_majority_groups": {
+          "description": "Json formatted string containing the majority groups for connectivity checks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer"
+        },
+        "controller_logs_collected_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "controller_logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-nullable": false
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
+          },
+          "x-nullable": false
+        },
+        "disk_encryption": {
+          "description": "Information regarding hosts' installation disks encryption.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "email_domain": {
+          "type": "string"
+        },
+        "enabled_host_count": {
+          "description": "hosts associated to this cluster that are not in 'disabled' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "feature_usage": {
+          "description": "JSON-formatted string containing the usage information by feature name",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "high_availability_mode": {
+          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+          "type": "string",
+          "enum": [
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ]
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ignored_cluster_validations": {
+          "description": "Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ignored_host_validations": {
+          "description": "Json formatted string containing a list of host validations to be ignored. May also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "image_info": {
+          "$ref": "#/definitions/image_info"
+        },
+        "imported": {
+          "description": "Indicates whether this cluster is an imported day-2 cluster or a\nregular cluster. Clusters are considered imported when they are\ncreated via the ../clusters/import endpoint. Day-2 clusters converted\nfrom day-1 clusters by kube-api controllers or the\n../clusters/\u003ccluster_id\u003e/actions/allow-add-hosts endpoint are not\nconsidered imported. Imported clusters usually lack a lot of\ninformation and are filled with default values that don't necessarily\nreflect the actual cluster they represent",
+          "type": "boolean",
+          "default": false
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "install_config_overrides": {
+          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
+        },
+        "install_schedule": {
+          "description": "Time window in which the installation is started automatically once the cluster is ready.",
+          "$ref": "#/definitions/install-schedule"
+        },
+        "install_started_at": {
+          "description": "The time that this cluster started installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "install_timeouts": {
+          "description": "JSON-formatted string containing per-cluster overrides of the installation timeouts of host stages and finalizing stages, for example {\"host_stages\": {\"Rebooting\": \"2h\"}, \"finalizing_stages\": {\"Waiting for cluster operators\": \"12h\"}}. The timeouts use the Go duration format and stages that are not listed keep the timeouts of the service.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ip_collisions": {
+          "description": "Json formatted string containing ip collisions detected in the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+          "type": "string",
+          "enum": [
+            "Cluster",
+            "AddHostsCluster"
+          ]
+        },
+        "last-installation-preparation": {
+          "$ref": "#/definitions/last-installation-preparation"
+        },
+        "load_balancer": {
+          "$ref": "#/definitions/load_balancer"
+        },
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "monitored_operators": {
+          "description": "Operators that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/monitored-operator"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string"
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "openshift_cluster_id": {
+          "description": "Cluster ID on OCP system.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "org_soft_timeouts_enabled": {
+          "description": "Indication if organization soft timeouts is enabled for the cluster.",
+          "type": "boolean"
+        },
+        "platform": {
+          "$ref": "#/definitions/platform"
+        },
+        "progress": {
+          "description": "Installation progress percentages of the cluster.",
+          "$ref": "#/definitions/cluster-progress-info"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "ready_host_count": {
+          "description": "hosts associated to this cluster that are in 'known' state.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "schedulable_masters_forced_true": {
+          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
+          "type": "boolean",
+          "default": true
+        },
+        "secure_boot_mode": {
+          "description": "The secure boot state required from the hosts of the cluster, 'any' or empty to accept both states.",
+          "type": "string",
+          "enum": [
+            "any",
+            "enabled",
+            "disabled"
+          ]
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "pending-for-input",
+            "installing",
+            "finalizing",
+            "installed",
+            "adding-hosts",
+            "cancelled",
+            "installing-pending-user-action"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string"
+        },
+        "total_host_count": {
+          "description": "All hosts associated to this cluster.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "user_name": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version",
+        "pull_secret"
+      ],
+      "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          }
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-nullable": false
+        },
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "$ref": "#/definitions/disk-encryption"
+        },
+        "high_availability_mode": {
+          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+          "type": "string",
+          "default": "Full",
+          "enum": [
+            "Full",
+            "None"
+          ]
+        },
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+          "type": "string",
+          "default": "all",
+          "enum": [
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ]
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          }
+        },
+        "load_balancer": {
+          "$ref": "#/definitions/load_balancer"
+        },
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/machine_network"
+          },
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1
+        },
+        "network_type": {
+          "description": "The desired network type used.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ocp_release_image": {
+          "description": "OpenShift release image URI.",
+          "type": "string"
+        },
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-create-params"
+          }
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
+        },
+        "platform": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-finalizing-progress": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
+        }
+      }
+    },
+    "cluster-host-requirements": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "description": "Unique identifier of the host the requirements relate to.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "ocp": {
+          "description": "Host requirements for the OCP installation",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "operators": {
+          "description": "Host requirements related to requested operators",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-host-requirements"
+          }
+        },
+        "total": {
+          "description": "Total host requirements for the cluster configuration",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        }
+      }
+    },
+    "cluster-host-requirements-details": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Required number of CPU cores",
+          "type": "integer"
+        },
+        "disk_size_gb": {
+          "description": "Required disk size in GB",
+          "type": "integer"
+        },
+        "installation_disk_speed_threshold_ms": {
+          "description": "Required installation disk speed in ms",
+          "type": "integer"
+        },
+        "network_bandwidth_mbps": {
+          "description": "Minimum network throughput at L3 for role, in Mbps.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "network_latency_threshold_ms": {
+          "description": "Maximum network average latency (RTT) at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "packet_loss_percentage": {
+          "description": "Maximum packet loss allowed at L3 for role.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "ram_mib": {
+          "description": "Required number of RAM in MiB",
+          "type": "integer"
+        },
+        "tpm_enabled_in_bios": {
+          "description": "Whether TPM module should be enabled in host's BIOS.",
+          "type": "boolean"
+        }
+      }
+    },
+    "cluster-host-requirements-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster-host-requirements"
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-progress-info": {
+      "type": "object",
+      "properties": {
+        "finalizing_stage": {
+          "$ref": "#/definitions/finalizing-stage"
+        },
+        "finalizing_stage_percentage": {
+          "type": "integer"
+        },
+        "finalizing_stage_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "finalizing_stage_timed_out": {
+          "type": "boolean"
+        },
+        "installing_stage_percentage": {
+          "type": "integer"
+        },
+        "preparing_for_installation_stage_percentage": {
+          "type": "integer"
+        },
+        "total_percentage": {
+          "type": "integer"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "machine-cidr-defined",
+        "cluster-cidr-defined",
+        "service-cidr-defined",
+        "no-cidrs-overlapping",
+        "networks-same-address-families",
+        "network-prefix-valid",
+        "machine-cidr-equals-to-calculated-cidr",
+        "api-vips-defined",
+        "api-vips-valid",
+        "ingress-vips-defined",
+        "ingress-vips-valid",
+        "all-hosts-are-ready-to-install",
+        "sufficient-masters-count",
+        "dns-domain-defined",
+        "pull-secret-set",
+        "ntp-server-configured",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "cnv-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "mce-requirements-satisfied",
+        "mtv-requirements-satisfied",
+        "osc-requirements-satisfied",
+        "network-type-valid",
+        "platform-requirements-satisfied",
+        "node-feature-discovery-requirements-satisfied",
+        "nvidia-gpu-requirements-satisfied",
+        "pipelines-requirements-satisfied",
+        "servicemesh-requirements-satisfied",
+        "serverless-requirements-satisfied",
+        "openshift-ai-requirements-satisfied",
+        "openshift-ai-gpu-requirements-satisfied",
+        "authorino-requirements-satisfied",
+        "nmstate-requirements-satisfied",
+        "amd-gpu-requirements-satisfied",
+        "kmm-requirements-satisfied",
+        "node-healthcheck-requirements-satisfied",
+        "self-node-remediation-requirements-satisfied",
+        "fence-agents-remediation-requirements-satisfied",
+        "node-maintenance-requirements-satisfied",
+        "kube-descheduler-requirements-satisfied",
+        "plugin-operators-requirements-satisfied"
+      ]
+    },
+    "cluster_default_config": {
+      "type": "object",
+      "properties": {
+        "cluster_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "cluster_network_host_prefix": {
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "cluster_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "cluster_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          }
+        },
+        "forbidden_hostnames": {
+          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "inactive_deletion_hours": {
+          "type": "integer"
+        },
+        "ntp_source": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "service_network_cidr": {
+          "type": "string",
+          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+        },
+        "service_networks_dualstack": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        },
+        "service_networks_ipv4": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/service_network"
+          }
+        }
+      }
+    },
+    "cluster_network": {
+      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
+        },
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        }
+      }
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "data": {
+          "description": "additional data from the cluster",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
           "type": "string",
-          "x-nullable": true
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "mac": {
+          "type": "string",
+          "format": "mac"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        },
+        "mtu_report": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/mtu-report"
+          }
+        },
+        "throughput_report": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/throughput-report"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container_image_availability": {
+      "type": "object",
+      "properties": {
+        "download_rate": {
+          "description": "The rate of size/time in seconds MBps.",
+          "type": "number"
+        },
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container_image_availability_result"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes.",
+          "type": "number"
+        },
+        "time": {
+          "description": "Seconds it took to pull the image.",
+          "type": "number"
+        }
+      }
+    },
+    "container_image_availability_request": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of image names to be checked.",
+          "type": "array",
+          "items": {
+            "description": "A fully qualified image name (FQIN).",
+            "type": "string",
+            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+          }
+        },
+        "timeout": {
+          "description": "Positive number represents a timeout in seconds for a pull operation.",
+          "type": "integer"
+        }
+      }
+    },
+    "container_image_availability_response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container_image_availability"
+          }
+        }
+      }
+    },
+    "container_image_availability_result": {
+      "description": "Image availability result.",
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "create-manifest-params": {
+      "type": "object",
+      "required": [
+        "file_name",
+        "content"
+      ],
+      "properties": {
+        "content": {
+          "description": "base64 encoded manifest content.",
+          "type": "string"
+        },
+        "file_name": {
+          "description": "The name of the manifest to customize the installed OCP cluster.",
+          "type": "string",
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$"
+        },
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_request": {
+      "type": "object",
+      "required": [
+        "interface",
+        "api_vip_mac",
+        "ingress_vip_mac"
+      ],
+      "properties": {
+        "api_vip_lease": {
+          "description": "Contents of lease file to be used for API virtual IP.",
+          "type": "string"
+        },
+        "api_vip_mac": {
+          "description": "MAC address for the API virtual IP.",
+          "type": "string",
+          "format": "mac"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of lease file to be used for for Ingress virtual IP.",
+          "type": "string"
         },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url (DEPRECATED use request_headers to pass this token).",
+        "ingress_vip_mac": {
+          "description": "MAC address for the Ingress virtual IP.",
           "type": "string",
-          "x-nullable": true
+          "format": "mac"
         },
-        "request_headers": {
-          "description": "Additional request headers to include when fetching the ignition from ignition_endpoint_url.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip_connectivity_additional_request_header"
-          },
-          "x-nullable": true,
-          "x-omitempty": true
+        "interface": {
+          "description": "The network interface (NIC) to run the DHCP requests on.",
+          "type": "string"
+        }
+      }
+    },
+    "dhcp_allocation_response": {
+      "type": "object",
+      "required": [
+        "api_vip_address",
+        "ingress_vip_address"
+      ],
+      "properties": {
+        "api_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
+          "type": "string",
+          "format": "ipv4"
         },
-        "url": {
-          "description": "URL address of the API.",
+        "api_vip_lease": {
+          "description": "Contents of last acquired lease for API virtual IP.",
           "type": "string"
         },
-        "verify_cidr": {
-          "description": "Whether to verify if the API VIP belongs to one of the interfaces (DEPRECATED).",
-          "type": "boolean"
+        "ingress_vip_address": {
+          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "ingress_vip_lease": {
+          "description": "Contents of last acquired lease for Ingress virtual IP.",
+          "type": "string"
         }
       }
     },
-    "api_vip_connectivity_response": {
-      "description": "The response from the day-2 agent's attempt to download the worker ignition file from the API machine config server of the target cluster.\nNote - the name \"API VIP connectivity\" is old and misleading and is preserved for backwards compatibility.",
+    "disk": {
       "type": "object",
       "properties": {
-        "download_error": {
-          "description": "The error that occurred while downloading the worker ignition file, ignored when is_success is true",
+        "bootable": {
+          "type": "boolean"
+        },
+        "by_id": {
+          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
           "type": "string"
         },
-        "ignition": {
-          "description": "Ignition file fetched from the target cluster's API machine config server.\nThis ignition file may be incomplete as almost all files / systemd units are removed from it by the agent in order to save space.",
+        "by_path": {
+          "description": "by-path is the shortest physical path to the device",
           "type": "string"
         },
-        "is_success": {
-          "description": "Whether the agent was able to download the ignition or not",
+        "drive_type": {
+          "$ref": "#/definitions/drive_type"
+        },
+        "has_uuid": {
           "type": "boolean"
         },
-        "url": {
-          "description": "This parameter mirrors the url parameter of the corresponding api_vip_connectivity_request",
+        "hctl": {
+          "type": "string"
+        },
+        "holders": {
+          "description": "A comma-separated list of disk names that this disk belongs to",
+          "type": "string"
+        },
+        "id": {
+          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
+          "type": "string"
+        },
+        "installation_eligibility": {
+          "type": "object",
+          "properties": {
+            "eligible": {
+              "description": "Whether the disk is eligible for installation or not.",
+              "type": "boolean"
+            },
+            "not_eligible_reasons": {
+              "description": "Reasons for why this disk is not eligible for installation.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": false
+        },
+        "io_perf": {
+          "$ref": "#/definitions/io_perf"
+        },
+        "is_installation_media": {
+          "description": "Whether the disk appears to be an installation media or not",
+          "type": "boolean"
+        },
+        "iscsi": {
+          "$ref": "#/definitions/iscsi"
+        },
+        "model": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "partitionTypes": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "removable": {
+          "type": "boolean"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "smart": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
           "type": "string"
         }
       }
     },
-    "architecture-support-level-id": {
+    "disk-config-params": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/disk-role"
+        }
+      }
+    },
+    "disk-encryption": {
+      "type": "object",
+      "properties": {
+        "enable_on": {
+          "description": "Enable/disable disk encryption on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+          "type": "string",
+          "default": "none",
+          "enum": [
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ]
+        },
+        "mode": {
+          "description": "The disk encryption mode to use.",
+          "type": "string",
+          "default": "tpmv2",
+          "enum": [
+            "tpmv2",
+            "tang"
+          ]
+        },
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
+    },
+    "disk-role": {
       "type": "string",
       "enum": [
-        "X86_64_ARCHITECTURE",
-        "ARM64_ARCHITECTURE",
-        "PPC64LE_ARCHITECTURE",
-        "S390X_ARCHITECTURE",
-        "MULTIARCH_RELEASE_IMAGE"
+        "none",
+        "install"
       ]
     },
-    "bind-host-params": {
+    "disk-skip-formatting-params": {
+      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+      "type": "object",
       "required": [
-        "cluster_id"
+        "disk_id",
+        "skip_formatting"
       ],
       "properties": {
-        "cluster_id": {
-          "type": "string",
-          "format": "uuid"
+        "disk_id": {
+          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
+          "type": "string"
+        },
+        "skip_formatting": {
+          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
+          "type": "boolean"
         }
       }
     },
-    "boot": {
+    "disk_info": {
       "type": "object",
       "properties": {
-        "command_line": {
-          "type": "string"
+        "disk_speed": {
+          "$ref": "#/definitions/disk_speed"
         },
-        "current_boot_mode": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "path": {
           "type": "string"
+        }
+      }
+    },
+    "disk_speed": {
+      "type": "object",
+      "properties": {
+        "exit_code": {
+          "type": "integer"
         },
-        "device_type": {
-          "type": "string",
-          "enum": [
-            "persistent",
-            "ephemeral"
-          ]
+        "speed_ms": {
+          "type": "integer"
         },
-        "pxe_interface": {
+        "tested": {
+          "type": "boolean"
+        }
+      }
+    },
+    "disk_speed_check_request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "--filename argument for fio (expects a file or a block device path).",
           "type": "string"
-        },
-        "secure_boot_state": {
-          "$ref": "#/definitions/secure-boot-state"
         }
       }
     },
-    "bundle": {
+    "disk_speed_check_response": {
       "type": "object",
       "properties": {
-        "description": {
-          "description": "Longer human friendly description for the bundle, usually one or more sentences.\n",
-          "type": "string"
+        "io_sync_duration": {
+          "description": "The 99th percentile of fdatasync durations in milliseconds.",
+          "type": "integer"
         },
-        "id": {
-          "description": "Unique identifier of the bundle, for example ` + "`" + `virtualization` + "`" + ` or ` + "`" + `openshift-ai-nvidia` + "`" + `.",
+        "path": {
+          "description": "The device path.",
           "type": "string"
-        },
-        "operators": {
-          "description": "List of operators associated with the bundle.",
+        }
+      }
+    },
+    "domain_resolution_request": {
+      "type": "object",
+      "required": [
+        "domains"
+      ],
+      "properties": {
+        "domains": {
           "type": "array",
           "items": {
-            "type": "string"
+            "type": "object",
+            "x-go-type": {
+              "import": {
+                "path": "github.com/openshift/assisted-service/models"
+              },
+              "type": "DomainResolutionRequestDomain"
+            }
           }
-        },
-        "title": {
-          "description": "Short human friendly description for the bundle, usually only a few words, for example ` + "`" + `Virtualization` + "`" + ` or\n` + "`" + `OpenShift AI (NVIDIA)` + "`" + `.\n",
-          "type": "string"
         }
       }
     },
-    "cluster": {
+    "domain_resolution_response": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "image_info",
-        "status",
-        "status_info"
+        "resolutions"
       ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "ams_subscription_id": {
-          "description": "Unique identifier of the AMS subscription in OCM.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
+        "resolutions": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/cluster_network"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
-          "x-nullable": true
-        },
-        "connectivity_majority_groups": {
-          "description": "Json formatted string containing the majority groups for connectivity checks.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer"
-        },
-        "controller_logs_collected_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "controller_logs_started_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
-          "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-nullable": false
-        },
-        "created_at": {
-          "description": "The time that this cluster was created.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
+            "type": "object",
+            "required": [
+              "domain_name"
+            ],
+            "properties": {
+              "cnames": {
+                "description": "The cnames that were resolved for the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string"
+                }
+              },
+              "domain_name": {
+                "description": "The domain that was resolved",
+                "type": "string"
+              },
+              "ipv4_addresses": {
+                "description": "The IPv4 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv4"
+                }
+              },
+              "ipv6_addresses": {
+                "description": "The IPv6 addresses of the domain, empty if none",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "format": "ipv6"
+                }
+              }
             },
-            "type": "Time"
+            "x-go-name": "DomainResolutionResponseDomain"
           }
-        },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "gorm.io/gorm"
-            },
-            "type": "DeletedAt"
-          },
-          "x-nullable": false
-        },
-        "disk_encryption": {
-          "description": "Information regarding hosts' installation disks encryption.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "email_domain": {
+        }
+      }
+    },
+    "download_boot_artifacts_request": {
+      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
+      "type": "object",
+      "required": [
+        "kernel_url",
+        "rootfs_url",
+        "initrd_url",
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
           "type": "string"
         },
-        "enabled_host_count": {
-          "description": "hosts associated to this cluster that are not in 'disabled' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
-        },
-        "feature_usage": {
-          "description": "JSON-formatted string containing the usage information by feature name",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "high_availability_mode": {
-          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
-          "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
+        "initrd_url": {
+          "description": "URL address to download the initrd.",
+          "type": "string"
         },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
-          },
-          "x-go-custom-tag": "gorm:\"-\"",
-          "x-nullable": true
+        "kernel_url": {
+          "description": "URL address to download the kernel.",
+          "type": "string"
         },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        "rootfs_url": {
+          "description": "URL address to download the rootfs.",
+          "type": "string"
+        }
+      }
+    },
+    "drive_type": {
+      "type": "string",
+      "enum": [
+        "Unknown",
+        "HDD",
+        "FDD",
+        "ODD",
+        "SSD",
+        "virtual",
+        "Multipath",
+        "iSCSI",
+        "FC",
+        "LVM",
+        "RAID",
+        "ECKD",
+        "ECKD (ESE)",
+        "FBA"
+      ]
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
         },
         "href": {
           "description": "Self link.",
           "type": "string"
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string"
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\""
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
           "type": "string",
           "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
+            "Error"
           ]
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ignored_cluster_validations": {
-          "description": "Json formatted string containing a list of cluster validations to be ignored. May also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+        "reason": {
+          "description": "Human-readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "category": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "enum": [
+            "user",
+            "metrics"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'user'\""
         },
-        "ignored_host_validations": {
-          "description": "Json formatted string containing a list of host validations to be ignored. May also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+        "cluster_id": {
+          "description": "Unique identifier of the cluster this event relates to.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "image_info": {
-          "$ref": "#/definitions/image_info"
-        },
-        "imported": {
-          "description": "Indicates whether this cluster is an imported day-2 cluster or a\nregular cluster. Clusters are considered imported when they are\ncreated via the ../clusters/import endpoint. Day-2 clusters converted\nfrom day-1 clusters by kube-api controllers or the\n../clusters/\u003ccluster_id\u003e/actions/allow-add-hosts endpoint are not\nconsidered imported. Imported clusters usually lack a lot of\ninformation and are filled with default values that don't necessarily\nreflect the actual cluster they represent",
-          "type": "boolean",
-          "default": false
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
           "x-nullable": true
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "install_config_overrides": {
-          "description": "JSON-formatted string containing the user overrides for the install-config.yaml file.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
-        },
-        "install_started_at": {
-          "description": "The time that this cluster started installation.",
+        "event_time": {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ip_collisions": {
-          "description": "Json formatted string containing ip collisions detected in the cluster.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object,\n'AddHostsCluster' for cluster that add hosts to existing OCP cluster,\n",
+        "host_id": {
+          "description": "Unique identifier of the host this event relates to.",
           "type": "string",
-          "enum": [
-            "Cluster",
-            "AddHostsCluster"
-          ]
-        },
-        "last-installation-preparation": {
-          "$ref": "#/definitions/last-installation-preparation"
-        },
-        "load_balancer": {
-          "$ref": "#/definitions/load_balancer"
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+        "infra_env_id": {
+          "description": "Unique identifier of the infra-env this event relates to.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
           "x-nullable": true
         },
-        "monitored_operators": {
-          "description": "Operators that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/monitored-operator"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\""
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
         },
         "name": {
-          "description": "Name of the OpenShift cluster.",
+          "description": "Event Name.",
           "type": "string"
         },
-        "network_type": {
-          "description": "The desired network type used.",
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
           "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "A comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string"
-        },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
-          "type": "string"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "openshift_cluster_id": {
-          "description": "Cluster ID on OCP system.",
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
           "type": "string",
           "format": "uuid"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "event-subscription": {
+      "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster whose events are delivered.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "org_id": {
-          "type": "string"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
         },
-        "org_soft_timeouts_enabled": {
-          "description": "Indication if organization soft timeouts is enabled for the cluster.",
-          "type": "boolean"
+        "filter": {
+          "$ref": "#/definitions/event-subscription-filter"
         },
-        "platform": {
-          "$ref": "#/definitions/platform"
+        "id": {
+          "description": "Unique identifier of the event subscription.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "progress": {
-          "description": "Installation progress percentages of the cluster.",
-          "$ref": "#/definitions/cluster-progress-info"
+        "infra_env_id": {
+          "description": "The infra-env whose events are delivered.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
+        "signed": {
+          "description": "Whether the deliveries are signed with the secret of the subscription.",
           "type": "boolean"
         },
-        "ready_host_count": {
-          "description": "hosts associated to this cluster that are in 'known' state.",
-          "type": "integer",
-          "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+        "url": {
+          "description": "The URL that the matching events are POSTed to.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
+        "user_name": {
+          "description": "The user that created the event subscription.",
+          "type": "string"
+        }
+      }
+    },
+    "event-subscription-create-params": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster whose events are delivered. Exactly one of cluster_id and infra_env_id must be set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
         },
-        "schedulable_masters_forced_true": {
-          "description": "Indicates if schedule workloads on masters will be enabled regardless the value of 'schedulable_masters' property.\nSet to 'true' when not enough hosts are associated with this cluster to disable the scheduling on masters.\n",
-          "type": "boolean",
-          "default": true
+        "filter": {
+          "$ref": "#/definitions/event-subscription-filter"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "infra_env_id": {
+          "description": "The infra-env whose events are delivered. Exactly one of cluster_id and infra_env_id must be set.",
           "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;references:ID\"",
+          "format": "uuid",
           "x-nullable": true
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "secret": {
+          "description": "Secret used to sign the deliveries with HMAC-SHA256. Deliveries are not signed when empty.",
           "type": "string"
         },
-        "status": {
-          "description": "Status of the OpenShift cluster.",
+        "url": {
+          "description": "The http or https URL that the matching events are POSTed to.",
           "type": "string",
-          "enum": [
-            "insufficient",
-            "ready",
-            "error",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "finalizing",
-            "installed",
-            "adding-hosts",
-            "cancelled",
-            "installing-pending-user-action"
-          ]
+          "x-nullable": false
+        }
+      }
+    },
+    "event-subscription-delivery": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "description": "Number of delivery attempts so far.",
+          "type": "integer"
         },
-        "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+        "created_at": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
         },
-        "status_updated_at": {
-          "description": "The last time that the cluster status was updated.",
+        "delivered_at": {
+          "description": "Time at which the event was delivered.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
+        "event_name": {
+          "description": "Name of the delivered event.",
           "type": "string"
         },
-        "total_host_count": {
-          "description": "All hosts associated to this cluster.",
+        "event_time": {
+          "description": "Time of the delivered event.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "id": {
+          "description": "Unique identifier of the delivery.",
           "type": "integer",
           "format": "int64",
-          "x-go-custom-tag": "gorm:\"-\""
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "updated_at": {
-          "description": "The last time that this cluster was updated.",
+        "last_error": {
+          "description": "Error of the last failed attempt.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "response_code": {
+          "description": "HTTP status code of the last attempt, zero if no response was received.",
+          "type": "integer"
+        },
+        "status": {
+          "description": "pending until the event is delivered or all the attempts failed.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "subscription_id": {
+          "description": "The event subscription this delivery belongs to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "event-subscription-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event-subscription-delivery"
+      }
+    },
+    "event-subscription-filter": {
+      "description": "Selects the events delivered to an event subscription. An empty filter field matches every event.",
+      "type": "object",
+      "properties": {
+        "categories": {
+          "description": "Categories of the events to deliver (user, metrics). Only user events are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
           "x-go-type": {
             "hints": {
               "noValidation": true
             },
             "import": {
-              "package": "time"
+              "package": "github.com/lib/pq"
             },
-            "type": "Time"
+            "type": "StringArray"
           }
         },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+        "message": {
+          "description": "Only deliver the events whose message contains this text, ignoring case.",
+          "type": "string"
+        },
+        "names": {
+          "description": "Names of the events to deliver, e.g. host_status_updated.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "severities": {
+          "description": "Severities of the events to deliver (info, warning, error, critical).",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:filter_\""
+    },
+    "event-subscription-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event-subscription"
+      }
+    },
+    "feature-support-level-id": {
+      "type": "string",
+      "enum": [
+        "SNO",
+        "TNA",
+        "VIP_AUTO_ALLOC",
+        "CUSTOM_MANIFEST",
+        "SINGLE_NODE_EXPANSION",
+        "LVM",
+        "ODF",
+        "LSO",
+        "CNV",
+        "MCE",
+        "MTV",
+        "OSC",
+        "NUTANIX_INTEGRATION",
+        "BAREMETAL_PLATFORM",
+        "NONE_PLATFORM",
+        "VSPHERE_INTEGRATION",
+        "DUAL_STACK_VIPS",
+        "CLUSTER_MANAGED_NETWORKING",
+        "USER_MANAGED_NETWORKING",
+        "MINIMAL_ISO",
+        "FULL_ISO",
+        "EXTERNAL_PLATFORM_OCI",
+        "DUAL_STACK",
+        "PLATFORM_MANAGED_NETWORKING",
+        "EXTERNAL_PLATFORM",
+        "OVN_NETWORK_TYPE",
+        "SDN_NETWORK_TYPE",
+        "NODE_FEATURE_DISCOVERY",
+        "NVIDIA_GPU",
+        "PIPELINES",
+        "SERVICEMESH",
+        "SERVERLESS",
+        "OPENSHIFT_AI",
+        "NON_STANDARD_HA_CONTROL_PLANE",
+        "AUTHORINO",
+        "USER_MANAGED_LOAD_BALANCER",
+        "NMSTATE",
+        "AMD_GPU",
+        "KMM",
+        "NODE_HEALTHCHECK",
+        "SELF_NODE_REMEDIATION",
+        "FENCE_AGENTS_REMEDIATION",
+        "NODE_MAINTENANCE",
+        "KUBE_DESCHEDULER",
+        "EXTERNAL_PLATFORM_PROXMOX",
+        "OPENSTACK_INTEGRATION",
+        "OPERATOR_VERSION_PINNING"
+      ]
+    },
+    "finalizing-stage": {
+      "description": "Cluster finalizing stage managed by controller",
+      "type": "string",
+      "enum": [
+        "Waiting for cluster operators",
+        "Adding router ca",
+        "Applying olm manifests",
+        "Waiting for olm operators csv initialization",
+        "Waiting for olm operators csv",
+        "Waiting for OLM operator setup jobs",
+        "Done"
+      ]
+    },
+    "firmware_inspection_response": {
+      "type": "object",
+      "properties": {
+        "iommu_enabled": {
+          "description": "Whether the IOMMU (Intel VT-d or AMD-Vi) is enabled in the firmware and in the kernel. Missing if it couldn't be determined.",
           "type": "boolean",
           "x-nullable": true
         },
-        "user_name": {
-          "type": "string"
-        },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hosts-data, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "sriov_interfaces": {
+          "description": "The network interfaces that expose the SR-IOV capability.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/firmware_sriov_interface"
+          }
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+        "virtualization_enabled": {
+          "description": "Whether the virtualization extensions of the CPU (Intel VT-x or AMD-V) are enabled in the firmware. Missing if it couldn't be determined.",
           "type": "boolean",
           "x-nullable": true
         }
       }
     },
-    "cluster-create-params": {
+    "firmware_sriov_interface": {
       "type": "object",
-      "required": [
-        "name",
-        "openshift_version",
-        "pull_secret"
-      ],
       "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
+        "name": {
+          "description": "The name of the network interface.",
+          "type": "string"
         },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+        "total_vfs": {
+          "description": "The maximal number of virtual functions of the network interface, 0 if SR-IOV is disabled in the firmware.",
+          "type": "integer"
+        }
+      }
+    },
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "ipv4"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+      }
+    },
+    "free_network_addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/api_vip"
+            "type": "string",
+            "format": "ipv4"
           }
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "network": {
           "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        }
+      }
+    },
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "gpu": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Device address (for example \"0000:00:02.0\")",
+          "type": "string"
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 128,
-          "minimum": 1
+        "device_id": {
+          "description": "ID of the device (for example \"3ea0\")",
+          "type": "string"
         },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
-          },
-          "x-nullable": true
+        "name": {
+          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+          "type": "string"
         },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer",
-          "x-nullable": true
+        "vendor": {
+          "description": "The name of the device vendor (for example \"Intel Corporation\")",
+          "type": "string"
         },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+        "vendor_id": {
+          "description": "ID of the vendor (for example \"8086\")",
+          "type": "string"
+        }
+      }
+    },
+    "host": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "api_vip_connectivity": {
+          "description": "Contains a serialized api_vip_connectivity_response",
           "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-nullable": false
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "$ref": "#/definitions/disk-encryption"
+        "bootstrap": {
+          "type": "boolean"
         },
-        "high_availability_mode": {
-          "description": "(DEPRECATED) Please use 'control_plane_count' instead. Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster\nover multiple master nodes whereas 'None' installs a full cluster over one node.\n",
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
           "type": "string",
-          "default": "Full",
-          "enum": [
-            "Full",
-            "None"
-          ]
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
           "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
           "x-nullable": true
         },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
+        "connection_timed_out": {
+          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
+          "type": "boolean"
         },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+        "connectivity": {
           "type": "string",
-          "default": "all",
-          "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ]
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
           }
         },
-        "load_balancer": {
-          "$ref": "#/definitions/load_balancer"
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
+        "deleted_at": {
+          "description": "swagger:ignore",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "gorm.io/gorm"
+            },
+            "type": "DeletedAt"
           },
-          "x-nullable": true
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
+          "x-nullable": false
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "diagnostics": {
+          "description": "Contains a serialized host-diagnostic-list, the on-demand diagnostics requested for the host.",
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "ocp_release_image": {
-          "description": "OpenShift release image URI.",
+        "discovery_agent_version": {
           "type": "string"
         },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
-          }
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "disks_info": {
+          "description": "Additional information about disks, formatted as JSON.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "platform": {
-          "x-nullable": true,
-          "$ref": "#/definitions/platform"
+        "disks_to_be_formatted": {
+          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string"
+        "domain_name_resolutions": {
+          "description": "The domain name resolution result.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
+        "firmware": {
+          "description": "Contains a serialized firmware_inspection_response",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "free_addresses": {
           "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          },
-          "x-nullable": true
+        "hardware_fingerprint": {
+          "description": "Contains a serialized host_fingerprint, the hypervisor or cloud that the host runs on.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "href": {
+          "description": "Self link.",
           "type": "string"
         },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
+        "id": {
+          "description": "Unique identifier of the object.",
           "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "default": false,
-          "x-nullable": true
-        }
-      }
-    },
-    "cluster-finalizing-progress": {
-      "type": "object",
-      "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
-        }
-      }
-    },
-    "cluster-host-requirements": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "description": "Unique identifier of the host the requirements relate to.",
+        "ignition_config_overrides": {
+          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
           "type": "string",
-          "format": "uuid"
-        },
-        "ocp": {
-          "description": "Host requirements for the OCP installation",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
         },
-        "operators": {
-          "description": "Host requirements related to requested operators",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-host-requirements"
-          }
+        "ignition_endpoint_token_set": {
+          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
+          "type": "boolean"
         },
-        "total": {
-          "description": "Total host requirements for the cluster configuration",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        }
-      }
-    },
-    "cluster-host-requirements-details": {
-      "type": "object",
-      "properties": {
-        "cpu_cores": {
-          "description": "Required number of CPU cores",
-          "type": "integer"
+        "images_status": {
+          "description": "Array of image statuses.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "disk_size_gb": {
-          "description": "Required disk size in GB",
-          "type": "integer"
+        "infra_env_id": {
+          "description": "The infra-env that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
         },
-        "installation_disk_speed_threshold_ms": {
-          "description": "Required installation disk speed in ms",
-          "type": "integer"
+        "installation_disk_id": {
+          "description": "Contains the inventory disk id to install on.",
+          "type": "string"
         },
-        "network_latency_threshold_ms": {
-          "description": "Maximum network average latency (RTT) at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "installation_disk_path": {
+          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+          "type": "string",
+          "example": "/dev/sda"
         },
-        "packet_loss_percentage": {
-          "description": "Maximum packet loss allowed at L3 for role.",
-          "type": "number",
-          "format": "double",
-          "x-nullable": true
+        "installer_args": {
+          "type": "string"
         },
-        "ram_mib": {
-          "description": "Required number of RAM in MiB",
-          "type": "integer"
+        "installer_version": {
+          "description": "Installer version.",
+          "type": "string"
         },
-        "tpm_enabled_in_bios": {
-          "description": "Whether TPM module should be enabled in host's BIOS.",
-          "type": "boolean"
-        }
-      }
-    },
-    "cluster-host-requirements-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster-host-requirements"
-      }
-    },
-    "cluster-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster"
-      }
-    },
-    "cluster-progress-info": {
-      "type": "object",
-      "properties": {
-        "finalizing_stage": {
-          "$ref": "#/definitions/finalizing-stage"
+        "inventory": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "finalizing_stage_percentage": {
-          "type": "integer"
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
+          "type": "string",
+          "enum": [
+            "Host",
+            "AddToExistingClusterHost"
+          ]
         },
-        "finalizing_stage_started_at": {
+        "logs_collected_at": {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "finalizing_stage_timed_out": {
-          "type": "boolean"
+        "logs_info": {
+          "description": "The progress of log collection or empty if logs are not applicable",
+          "$ref": "#/definitions/logs_state"
         },
-        "installing_stage_percentage": {
-          "type": "integer"
+        "logs_started_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "preparing_for_installation_stage_percentage": {
-          "type": "integer"
+        "machine_config_pool_name": {
+          "type": "string"
         },
-        "total_percentage": {
-          "type": "integer"
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
-    },
-    "cluster-validation-id": {
-      "type": "string",
-      "enum": [
-        "machine-cidr-defined",
-        "cluster-cidr-defined",
-        "service-cidr-defined",
-        "no-cidrs-overlapping",
-        "networks-same-address-families",
-        "network-prefix-valid",
-        "machine-cidr-equals-to-calculated-cidr",
-        "api-vips-defined",
-        "api-vips-valid",
-        "ingress-vips-defined",
-        "ingress-vips-valid",
-        "all-hosts-are-ready-to-install",
-        "sufficient-masters-count",
-        "dns-domain-defined",
-        "pull-secret-set",
-        "ntp-server-configured",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "cnv-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "mce-requirements-satisfied",
-        "mtv-requirements-satisfied",
-        "osc-requirements-satisfied",
-        "network-type-valid",
-        "platform-requirements-satisfied",
-        "node-feature-discovery-requirements-satisfied",
-        "nvidia-gpu-requirements-satisfied",
-        "pipelines-requirements-satisfied",
-        "servicemesh-requirements-satisfied",
-        "serverless-requirements-satisfied",
-        "openshift-ai-requirements-satisfied",
-        "openshift-ai-gpu-requirements-satisfied",
-        "authorino-requirements-satisfied",
-        "nmstate-requirements-satisfied",
-        "amd-gpu-requirements-satisfied",
-        "kmm-requirements-satisfied",
-        "node-healthcheck-requirements-satisfied",
-        "self-node-remediation-requirements-satisfied",
-        "fence-agents-remediation-requirements-satisfied",
-        "node-maintenance-requirements-satisfied",
-        "kube-descheduler-requirements-satisfied"
-      ]
-    },
-    "cluster_default_config": {
-      "type": "object",
-      "properties": {
-        "cluster_network_cidr": {
+        "media_status": {
+          "type": "string",
+          "default": "connected",
+          "enum": [
+            "connected",
+            "disconnected"
+          ],
+          "x-nullable": true
+        },
+        "node_labels": {
+          "description": "Json containing node's labels.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "ntp_sources": {
+          "description": "The configured NTP sources on the host.",
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "cluster_network_host_prefix": {
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "progress": {
+          "$ref": "#/definitions/host-progress-info"
         },
-        "cluster_networks_dualstack": {
+        "progress_stages": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/cluster_network"
-          }
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
         },
-        "cluster_networks_ipv4": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
-          }
+        "registered_at": {
+          "description": "The last time the host's agent tried to register in the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "forbidden_hostnames": {
-          "description": "This provides a list of forbidden hostnames. If this list is empty or not present, this implies that the UI should fall back to a hard coded list.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "requested_hostname": {
+          "type": "string"
         },
-        "inactive_deletion_hours": {
-          "type": "integer"
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "ntp_source": {
+        "skip_formatting_disks": {
+          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
           "type": "string",
-          "x-omitempty": false
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "service_network_cidr": {
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "pattern": "^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)[\\/]([1-9]|[1-2][0-9]|3[0-2]?)$"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "service_networks_dualstack": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          }
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "service_networks_ipv4": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
-          }
-        }
-      }
-    },
-    "cluster_network": {
-      "description": "A network from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-      "type": "object",
-      "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
+        "status": {
+          "type": "string",
+          "enum": [
+            "discovering",
+            "known",
+            "disconnected",
+            "insufficient",
+            "disabled",
+            "preparing-for-installation",
+            "preparing-failed",
+            "preparing-successful",
+            "pending-for-input",
+            "installing",
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
+            "error",
+            "resetting",
+            "added-to-existing-cluster",
+            "cancelled",
+            "binding",
+            "unbinding",
+            "unbinding-pending-user-action",
+            "known-unbound",
+            "disconnected-unbound",
+            "insufficient-unbound",
+            "disabled-unbound",
+            "discovering-unbound",
+            "reclaiming",
+            "reclaiming-rebooting"
+          ]
         },
-        "cluster_id": {
-          "description": "The cluster that this network is associated with.",
+        "status_info": {
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example if is set to 23, then each node is assigned a /23 subnet out of the given CIDR, which allows for 510 (2^(32 - 23) - 2) pod IPs addresses.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1
-        }
-      }
-    },
-    "completion-params": {
-      "type": "object",
-      "required": [
-        "is_success"
-      ],
-      "properties": {
-        "data": {
-          "description": "additional data from the cluster",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+        "status_updated_at": {
+          "description": "The last time that the host status was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "suggested_role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "tang_connectivity": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "timestamp": {
+          "description": "The time on the host as seconds since the Unix epoch.",
+          "type": "integer"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
           }
         },
-        "error_info": {
+        "user_name": {
           "type": "string"
         },
-        "is_success": {
-          "type": "boolean"
+        "validations_info": {
+          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "connectivity-check-host": {
+    "host-create-params": {
       "type": "object",
+      "required": [
+        "host_id"
+      ],
       "properties": {
+        "discovery_agent_version": {
+          "type": "string"
+        },
         "host_id": {
           "type": "string",
           "format": "uuid"
-        },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
         }
       }
     },
-    "connectivity-check-nic": {
+    "host-diagnostic": {
       "type": "object",
+      "required": [
+        "id",
+        "type",
+        "status"
+      ],
       "properties": {
-        "ip_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
-        },
-        "mac": {
+        "created_at": {
+          "description": "The time when the diagnostic was requested.",
           "type": "string",
-          "format": "mac"
+          "format": "date-time"
         },
-        "name": {
+        "disk_path": {
+          "description": "The disk whose performance is measured.",
           "type": "string"
-        }
-      }
-    },
-    "connectivity-check-params": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/connectivity-check-host"
-      }
-    },
-    "connectivity-remote-host": {
-      "type": "object",
-      "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "l2_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l2-connectivity"
-          }
         },
-        "l3_connectivity": {
+        "domains": {
+          "description": "The domain names that the host resolves.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l3-connectivity"
+            "type": "string"
           }
         },
-        "mtu_report": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/mtu-report"
-          }
-        }
-      }
-    },
-    "connectivity-report": {
-      "type": "object",
-      "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
-        }
-      }
-    },
-    "container_image_availability": {
-      "type": "object",
-      "properties": {
-        "download_rate": {
-          "description": "The rate of size/time in seconds MBps.",
-          "type": "number"
+        "id": {
+          "description": "Unique identifier of the diagnostic.",
+          "type": "string",
+          "format": "uuid"
         },
-        "name": {
-          "description": "A fully qualified image name (FQIN).",
+        "image": {
+          "description": "The container image that the host pulls.",
+          "type": "string"
+        },
+        "ip_address": {
+          "description": "The IP address that the host checks its connectivity to.",
           "type": "string"
         },
         "result": {
-          "$ref": "#/definitions/container_image_availability_result"
+          "description": "The output reported by the host for the diagnostic, formatted as JSON.",
+          "type": "string"
         },
-        "size_bytes": {
-          "description": "Size of the image in bytes.",
-          "type": "number"
+        "status": {
+          "description": "The status of the diagnostic.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "running",
+            "succeeded",
+            "failed"
+          ]
         },
-        "time": {
-          "description": "Seconds it took to pull the image.",
-          "type": "number"
+        "status_info": {
+          "description": "Additional information about the status of the diagnostic.",
+          "type": "string"
+        },
+        "step_id": {
+          "description": "The identifier of the step that the host runs for the diagnostic.",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/host-diagnostic-type"
+        },
+        "updated_at": {
+          "description": "The last time the status of the diagnostic changed.",
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "container_image_availability_request": {
+    "host-diagnostic-create-params": {
       "type": "object",
       "required": [
-        "images"
+        "type"
       ],
       "properties": {
-        "images": {
-          "description": "List of image names to be checked.",
+        "disk_path": {
+          "description": "The disk whose performance is measured. Defaults to the installation disk of the host.",
+          "type": "string"
+        },
+        "domains": {
+          "description": "The domain names that the host resolves. Required for the domain-resolution diagnostic.",
           "type": "array",
           "items": {
-            "description": "A fully qualified image name (FQIN).",
-            "type": "string",
-            "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
+            "type": "string"
           }
         },
-        "timeout": {
-          "description": "Positive number represents a timeout in seconds for a pull operation.",
-          "type": "integer"
+        "image": {
+          "description": "The container image that the host pulls. Required for the container-image-availability diagnostic.",
+          "type": "string"
+        },
+        "ip_address": {
+          "description": "The IP address that the host checks its connectivity to. Required for the connectivity diagnostic.",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/host-diagnostic-type"
         }
       }
     },
-    "container_image_availability_response": {
-      "type": "object",
-      "required": [
-        "images"
-      ],
-      "properties": {
-        "images": {
-          "description": "List of images that were checked.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/container_image_availability"
-          }
-        }
+    "host-diagnostic-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-diagnostic"
       }
     },
-    "container_image_availability_result": {
-      "description": "Image availability result.",
+    "host-diagnostic-type": {
+      "description": "The check that an on-demand diagnostic runs on the host.",
       "type": "string",
       "enum": [
-        "success",
-        "failure"
+        "disk-performance",
+        "container-image-availability",
+        "domain-resolution",
+        "connectivity"
       ]
     },
-    "cpu": {
+    "host-ignition-params": {
+      "properties": {
+        "config": {
+          "type": "string"
+        }
+      }
+    },
+    "host-installation-timeline": {
       "type": "object",
       "properties": {
-        "architecture": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
           "type": "string"
         },
-        "count": {
-          "type": "integer"
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "flags": {
+        "stages": {
+          "description": "The installation stages of the host, in the order in which they started.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/installation-timeline-stage"
           }
-        },
-        "frequency": {
-          "type": "number"
-        },
-        "model_name": {
-          "type": "string"
         }
       }
     },
-    "create-manifest-params": {
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
+      }
+    },
+    "host-progress": {
       "type": "object",
-      "required": [
-        "file_name",
-        "content"
-      ],
       "properties": {
-        "content": {
-          "description": "base64 encoded manifest content.",
-          "type": "string"
-        },
-        "file_name": {
-          "description": "The name of the manifest to customize the installed OCP cluster.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$"
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+        "progress_info": {
           "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ]
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         }
       }
     },
-    "credentials": {
+    "host-progress-info": {
       "type": "object",
       "properties": {
-        "console_url": {
-          "type": "string"
+        "current_stage": {
+          "$ref": "#/definitions/host-stage"
         },
-        "password": {
-          "type": "string"
+        "installation_percentage": {
+          "type": "integer"
         },
-        "username": {
-          "type": "string"
-        }
-      }
-    },
-    "dhcp_allocation_request": {
-      "type": "object",
-      "required": [
-        "interface",
-        "api_vip_mac",
-        "ingress_vip_mac"
-      ],
-      "properties": {
-        "api_vip_lease": {
-          "description": "Contents of lease file to be used for API virtual IP.",
-          "type": "string"
+        "progress_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "api_vip_mac": {
-          "description": "MAC address for the API virtual IP.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started.",
           "type": "string",
-          "format": "mac"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ingress_vip_lease": {
-          "description": "Contents of lease file to be used for for Ingress virtual IP.",
-          "type": "string"
+        "stage_timed_out": {
+          "description": "Indicate of the current stage has been timed out.",
+          "type": "boolean"
         },
-        "ingress_vip_mac": {
-          "description": "MAC address for the Ingress virtual IP.",
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated.",
           "type": "string",
-          "format": "mac"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
+    },
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "arbiter",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "auto-assign",
+        "master",
+        "arbiter",
+        "worker"
+      ]
+    },
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Waiting for bootkube",
+        "Waiting for controller",
+        "Installing",
+        "Writing image to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
+    },
+    "host-type-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "qualitative": {
+          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "interface": {
-          "description": "The network interface (NIC) to run the DHCP requests on.",
-          "type": "string"
+        "quantitative": {
+          "description": "Host requirements that can be quantified",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "dhcp_allocation_response": {
+    "host-type-hardware-requirements-wrapper": {
       "type": "object",
-      "required": [
-        "api_vip_address",
-        "ingress_vip_address"
-      ],
       "properties": {
-        "api_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the API virtual IP.",
-          "type": "string",
-          "format": "ipv4"
-        },
-        "api_vip_lease": {
-          "description": "Contents of last acquired lease for API virtual IP.",
-          "type": "string"
-        },
-        "ingress_vip_address": {
-          "description": "The IPv4 address that was allocated by DHCP for the Ingress virtual IP.",
-          "type": "string",
-          "format": "ipv4"
+        "master": {
+          "description": "Requirements towards a master node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         },
-        "ingress_vip_lease": {
-          "description": "Contents of last acquired lease for Ingress virtual IP.",
-          "type": "string"
+        "worker": {
+          "description": "Requirements towards a worker node",
+          "$ref": "#/definitions/host-type-hardware-requirements"
         }
       }
     },
-    "disk": {
+    "host-update-params": {
       "type": "object",
       "properties": {
-        "bootable": {
-          "type": "boolean"
-        },
-        "by_id": {
-          "description": "by-id is the World Wide Number of the device which guaranteed to be unique for every storage device",
-          "type": "string"
-        },
-        "by_path": {
-          "description": "by-path is the shortest physical path to the device",
-          "type": "string"
-        },
-        "drive_type": {
-          "$ref": "#/definitions/drive_type"
-        },
-        "has_uuid": {
-          "type": "boolean"
-        },
-        "hctl": {
-          "type": "string"
-        },
-        "holders": {
-          "description": "A comma-separated list of disk names that this disk belongs to",
-          "type": "string"
-        },
-        "id": {
-          "description": "Determine the disk's unique identifier which is the by-id field if it exists and fallback to the by-path field otherwise",
-          "type": "string"
-        },
-        "installation_eligibility": {
-          "type": "object",
-          "properties": {
-            "eligible": {
-              "description": "Whether the disk is eligible for installation or not.",
-              "type": "boolean"
-            },
-            "not_eligible_reasons": {
-              "description": "Reasons for why this disk is not eligible for installation.",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+        "disks_selected_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-config-params"
           },
-          "x-nullable": false
-        },
-        "io_perf": {
-          "$ref": "#/definitions/io_perf"
-        },
-        "is_installation_media": {
-          "description": "Whether the disk appears to be an installation media or not",
-          "type": "boolean"
-        },
-        "iscsi": {
-          "$ref": "#/definitions/iscsi"
-        },
-        "model": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "partitionTypes": {
-          "type": "string"
+          "x-nullable": true
         },
-        "path": {
-          "type": "string"
+        "disks_skip_formatting": {
+          "description": "Allows changing the host's skip_formatting_disks parameter",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk-skip-formatting-params"
+          },
+          "x-nullable": true
         },
-        "removable": {
-          "type": "boolean"
+        "host_name": {
+          "type": "string",
+          "x-nullable": true
         },
-        "serial": {
-          "type": "string"
+        "host_role": {
+          "type": "string",
+          "enum": [
+            "auto-assign",
+            "master",
+            "arbiter",
+            "worker"
+          ],
+          "x-nullable": true
         },
-        "size_bytes": {
-          "type": "integer"
+        "ignition_endpoint_http_headers": {
+          "description": "JSON-formatted string of additional HTTP headers when fetching the ignition.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ignition-endpoint-http-headers-params"
+          },
+          "x-nullable": true
         },
-        "smart": {
-          "type": "string"
+        "ignition_endpoint_token": {
+          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
+          "type": "string",
+          "x-nullable": true
         },
-        "vendor": {
-          "type": "string"
+        "machine_config_pool_name": {
+          "type": "string",
+          "x-nullable": true
         },
-        "wwn": {
-          "type": "string"
+        "node_labels": {
+          "description": "Labels to be added to the corresponding node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/node-label-params"
+          },
+          "x-nullable": true
         }
       }
     },
-    "disk-config-params": {
-      "type": "object",
-      "required": [
-        "id"
-      ],
-      "properties": {
-        "id": {
-          "type": "string"
-        },
-        "role": {
-          "$ref": "#/definitions/disk-role"
-        }
-      }
+    "host-validation-id": {
+      "type": "string",
+      "enum": [
+        "connected",
+        "media-connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "ignition-downloadable",
+        "belongs-to-majority-group",
+        "valid-platform-network-settings",
+        "ntp-synced",
+        "time-synced-between-host-and-service",
+        "container-images-available",
+        "lso-requirements-satisfied",
+        "ocs-requirements-satisfied",
+        "odf-requirements-satisfied",
+        "lvm-requirements-satisfied",
+        "mce-requirements-satisfied",
+        "mtv-requirements-satisfied",
+        "osc-requirements-satisfied",
+        "sufficient-installation-disk-speed",
+        "cnv-requirements-satisfied",
+        "sufficient-network-latency-requirement-for-role",
+        "sufficient-packet-loss-requirement-for-role",
+        "sufficient-network-bandwidth-requirement-for-role",
+        "has-default-route",
+        "api-domain-name-resolved-correctly",
+        "api-int-domain-name-resolved-correctly",
+        "apps-domain-name-resolved-correctly",
+        "release-domain-name-resolved-correctly",
+        "compatible-with-cluster-platform",
+        "dns-wildcard-not-configured",
+        "disk-encryption-requirements-satisfied",
+        "non-overlapping-subnets",
+        "vsphere-disk-uuid-enabled",
+        "compatible-agent",
+        "no-skip-installation-disk",
+        "no-skip-missing-disk",
+        "no-unhealthy-disks",
+        "compatible-secure-boot-state",
+        "no-ip-collisions-in-network",
+        "no-iscsi-nic-belongs-to-machine-cidr",
+        "node-feature-discovery-requirements-satisfied",
+        "nvidia-gpu-requirements-satisfied",
+        "pipelines-requirements-satisfied",
+        "servicemesh-requirements-satisfied",
+        "serverless-requirements-satisfied",
+        "openshift-ai-requirements-satisfied",
+        "authorino-requirements-satisfied",
+        "mtu-valid",
+        "nmstate-requirements-satisfied",
+        "amd-gpu-requirements-satisfied",
+        "kmm-requirements-satisfied",
+        "node-healthcheck-requirements-satisfied",
+        "self-node-remediation-requirements-satisfied",
+        "fence-agents-remediation-requirements-satisfied",
+        "node-maintenance-requirements-satisfied",
+        "kube-descheduler-requirements-satisfied",
+        "plugin-operators-requirements-satisfied"
+      ]
     },
-    "disk-encryption": {
+    "host_fingerprint": {
+      "description": "The hypervisor or cloud that a host runs on, as detected from its inventory.",
       "type": "object",
       "properties": {
-        "enable_on": {
-          "description": "Enable/disable disk encryption on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+        "confidence": {
+          "description": "How reliable the detection of the environment is.",
           "type": "string",
-          "default": "none",
           "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
+            "low",
+            "medium",
+            "high"
           ]
         },
-        "mode": {
-          "description": "The disk encryption mode to use.",
+        "environment": {
+          "description": "The hypervisor or cloud that the host runs on.",
           "type": "string",
-          "default": "tpmv2",
           "enum": [
-            "tpmv2",
-            "tang"
+            "unknown",
+            "baremetal",
+            "vsphere",
+            "nutanix",
+            "openstack",
+            "oci",
+            "proxmox",
+            "kvm",
+            "hyperv",
+            "xen",
+            "virtualbox",
+            "aws",
+            "gcp"
           ]
         },
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "[{\"url\":\"http://tang.example.com:7500\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu9\"}, {\"url\":\"http://tang.example.com:7501\",\"thumbprint\":\"PLjNyRdGw03zlRoGjQYMahSZGu8\"}]"
+        "signals": {
+          "description": "The inventory values that identified the environment.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:disk_encryption_\""
-    },
-    "disk-role": {
-      "type": "string",
-      "enum": [
-        "none",
-        "install"
-      ]
+      }
     },
-    "disk-skip-formatting-params": {
-      "description": "Allows an addition or removal of a host disk from the host's skip_formatting_disks list",
+    "host_network": {
       "type": "object",
-      "required": [
-        "disk_id",
-        "skip_formatting"
-      ],
       "properties": {
-        "disk_id": {
-          "description": "The ID of the disk that is being added to or removed from the host's skip_formatting_disks list",
+        "cidr": {
           "type": "string"
         },
-        "skip_formatting": {
-          "description": "True if you wish to add the disk to the skip_formatting_disks list, false if you wish to remove it",
-          "type": "boolean"
+        "host_ids": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         }
       }
     },
-    "disk_info": {
+    "host_registration_response": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/host"
+        },
+        {
+          "properties": {
+            "next_step_runner_command": {
+              "description": "Command for starting the next step runner",
+              "type": "object",
+              "properties": {
+                "args": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "command": {
+                  "type": "string"
+                },
+                "retry_seconds": {
+                  "description": "How long in seconds to wait before retrying registration if the command fails",
+                  "type": "integer"
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "host_static_network_config": {
       "type": "object",
       "properties": {
-        "disk_speed": {
-          "$ref": "#/definitions/disk_speed"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid"
+        "mac_interface_map": {
+          "description": "mapping of host macs to logical interfaces used in the network yaml",
+          "$ref": "#/definitions/mac_interface_map"
         },
-        "path": {
+        "network_yaml": {
+          "description": "yaml string that can be processed by nmstate",
           "type": "string"
         }
       }
     },
-    "disk_speed": {
+    "ignition-endpoint": {
+      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
       "type": "object",
       "properties": {
-        "exit_code": {
-          "type": "integer"
-        },
-        "speed_ms": {
-          "type": "integer"
+        "ca_certificate": {
+          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
+          "type": "string",
+          "x-nullable": true
         },
-        "tested": {
-          "type": "boolean"
+        "url": {
+          "description": "The URL for the ignition endpoint.",
+          "type": "string",
+          "x-nullable": true
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
     },
-    "disk_speed_check_request": {
+    "ignition-endpoint-http-headers-params": {
       "type": "object",
       "required": [
-        "path"
+        "key",
+        "value"
       ],
       "properties": {
-        "path": {
-          "description": "--filename argument for fio (expects a file or a block device path).",
+        "key": {
+          "description": "The key for the http header's key-value pair.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value for the http header's key-value pair.",
           "type": "string"
         }
       }
     },
-    "disk_speed_check_response": {
+    "ignored-validations": {
       "type": "object",
       "properties": {
-        "io_sync_duration": {
-          "description": "The 99th percentile of fdatasync durations in milliseconds.",
-          "type": "integer"
+        "cluster-validation-ids": {
+          "description": "JSON-formatted list of cluster validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+          "type": "string",
+          "format": "string"
         },
-        "path": {
-          "description": "The device path.",
-          "type": "string"
+        "host-validation-ids": {
+          "description": "JSON-formatted list of host validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+          "type": "string",
+          "format": "string"
         }
       }
     },
-    "domain_resolution_request": {
+    "image-create-params": {
       "type": "object",
-      "required": [
-        "domains"
-      ],
       "properties": {
-        "domains": {
+        "image_type": {
+          "description": "Type of image that should be generated.",
+          "$ref": "#/definitions/image_type"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        },
+        "static_network_config": {
           "type": "array",
           "items": {
-            "type": "object",
-            "x-go-type": {
-              "import": {
-                "path": "github.com/openshift/assisted-service/models"
-              },
-              "type": "DomainResolutionRequestDomain"
-            }
+            "$ref": "#/definitions/host_static_network_config"
           }
         }
       }
     },
-    "domain_resolution_response": {
+    "image_info": {
       "type": "object",
-      "required": [
-        "resolutions"
-      ],
       "properties": {
-        "resolutions": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "domain_name"
-            ],
-            "properties": {
-              "cnames": {
-                "description": "The cnames that were resolved for the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string"
-                }
-              },
-              "domain_name": {
-                "description": "The domain that was resolved",
-                "type": "string"
-              },
-              "ipv4_addresses": {
-                "description": "The IPv4 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv4"
-                }
-              },
-              "ipv6_addresses": {
-                "description": "The IPv6 addresses of the domain, empty if none",
-                "type": "array",
-                "items": {
-                  "type": "string",
-                  "format": "ipv6"
-                }
-              }
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
             },
-            "x-go-name": "DomainResolutionResponseDomain"
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
           }
-        }
-      }
-    },
-    "download_boot_artifacts_request": {
-      "description": "Information sent to the agent for downloading artifacts to boot a host into discovery.",
-      "type": "object",
-      "required": [
-        "kernel_url",
-        "rootfs_url",
-        "initrd_url",
-        "host_fs_mount_dir"
-      ],
-      "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host will download boot\nartifacts into a folder in this directory.",
+        },
+        "download_url": {
           "type": "string"
         },
-        "initrd_url": {
-          "description": "URL address to download the initrd.",
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "generator_version": {
+          "description": "Image generator version.",
           "type": "string"
         },
-        "kernel_url": {
-          "description": "URL address to download the kernel.",
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string"
         },
-        "rootfs_url": {
-          "description": "URL address to download the rootfs.",
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation",
           "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/image_type"
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
     },
-    "drive_type": {
+    "image_type": {
       "type": "string",
       "enum": [
-        "Unknown",
-        "HDD",
-        "FDD",
-        "ODD",
-        "SSD",
-        "virtual",
-        "Multipath",
-        "iSCSI",
-        "FC",
-        "LVM",
-        "RAID",
-        "ECKD",
-        "ECKD (ESE)",
-        "FBA"
+        "full-iso",
+        "minimal-iso"
       ]
     },
-    "error": {
+    "import-cluster-params": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
+        "name",
+        "api_vip_dnsname",
+        "openshift_cluster_id"
       ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
-          "type": "string"
-        },
-        "href": {
-          "description": "Self link.",
+        "api_vip_dnsname": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
           "type": "string"
         },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
+        "openshift_cluster_id": {
+          "description": "The id of the OCP cluster, that hosts will be added to",
           "type": "string",
-          "enum": [
-            "Error"
-          ]
+          "format": "uuid"
         },
-        "reason": {
-          "description": "Human-readable description of the error.",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         }
       }
     },
-    "event": {
+    "infra-env": {
       "type": "object",
       "required": [
-        "severity",
-        "message",
-        "event_time"
+        "kind",
+        "id",
+        "href",
+        "name",
+        "type",
+        "updated_at",
+        "created_at"
       ],
       "properties": {
-        "category": {
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        },
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
           "type": "string",
-          "enum": [
-            "user",
-            "metrics"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'user'\""
+          "x-nullable": false
         },
         "cluster_id": {
-          "description": "Unique identifier of the cluster this event relates to.",
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "event_time": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-nullable": false
         },
-        "host_id": {
-          "description": "Unique identifier of the host this event relates to.",
+        "created_at": {
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
         },
-        "infra_env_id": {
-          "description": "Unique identifier of the infra-env this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\"",
-          "x-nullable": true
+        "download_url": {
+          "type": "string"
         },
-        "message": {
+        "email_domain": {
+          "type": "string"
+        },
+        "expires_at": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "name": {
-          "description": "Event Name.",
+        "generator_version": {
+          "description": "Image generator version.",
           "type": "string"
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
+        "ignition_config_override": {
+          "description": "Json formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
+        },
+        "kernel_arguments": {
+          "description": "JSON formatted string array representing the discovery image kernel arguments.",
           "type": "string",
-          "format": "uuid"
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "x-nullable": true
         },
-        "severity": {
+        "kind": {
+          "description": "Indicates the type of this object.",
           "type": "string",
           "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
+            "InfraEnv"
           ]
-        }
-      }
-    },
-    "event-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/event"
-      }
-    },
-    "feature-support-level-id": {
-      "type": "string",
-      "enum": [
-        "SNO",
-        "TNA",
-        "VIP_AUTO_ALLOC",
-        "CUSTOM_MANIFEST",
-        "SINGLE_NODE_EXPANSION",
-        "LVM",
-        "ODF",
-        "LSO",
-        "CNV",
-        "MCE",
-        "MTV",
-        "OSC",
-        "NUTANIX_INTEGRATION",
-        "BAREMETAL_PLATFORM",
-        "NONE_PLATFORM",
-        "VSPHERE_INTEGRATION",
-        "DUAL_STACK_VIPS",
-        "CLUSTER_MANAGED_NETWORKING",
-        "USER_MANAGED_NETWORKING",
-        "MINIMAL_ISO",
-        "FULL_ISO",
-        "EXTERNAL_PLATFORM_OCI",
-        "DUAL_STACK",
-        "PLATFORM_MANAGED_NETWORKING",
-        "EXTERNAL_PLATFORM",
-        "OVN_NETWORK_TYPE",
-        "SDN_NETWORK_TYPE",
-        "NODE_FEATURE_DISCOVERY",
-        "NVIDIA_GPU",
-        "PIPELINES",
-        "SERVICEMESH",
-        "SERVERLESS",
-        "OPENSHIFT_AI",
-        "NON_STANDARD_HA_CONTROL_PLANE",
-        "AUTHORINO",
-        "USER_MANAGED_LOAD_BALANCER",
-        "NMSTATE",
-        "AMD_GPU",
-        "KMM",
-        "NODE_HEALTHCHECK",
-        "SELF_NODE_REMEDIATION",
-        "FENCE_AGENTS_REMEDIATION",
-        "NODE_MAINTENANCE",
-        "KUBE_DESCHEDULER"
-      ]
-    },
-    "finalizing-stage": {
-      "description": "Cluster finalizing stage managed by controller",
-      "type": "string",
-      "enum": [
-        "Waiting for cluster operators",
-        "Adding router ca",
-        "Applying olm manifests",
-        "Waiting for olm operators csv initialization",
-        "Waiting for olm operators csv",
-        "Waiting for OLM operator setup jobs",
-        "Done"
-      ]
-    },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
-      }
-    },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-      }
-    },
-    "free_network_addresses": {
-      "type": "object",
-      "properties": {
-        "free_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string",
-            "format": "ipv4"
-          }
         },
-        "network": {
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        }
-      }
-    },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
-      }
-    },
-    "gpu": {
-      "type": "object",
-      "properties": {
-        "address": {
-          "description": "Device address (for example \"0000:00:02.0\")",
+        "name": {
+          "description": "Name of the infra-env.",
           "type": "string"
         },
-        "device_id": {
-          "description": "ID of the device (for example \"3ea0\")",
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull secret has been added to the cluster.",
+          "type": "boolean"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string"
         },
-        "name": {
-          "description": "Product name of the device (for example \"UHD Graphics 620 (Whiskey Lake)\")",
+        "static_network_config": {
+          "description": "static network configuration string in the format expected by discovery ignition generation.",
           "type": "string"
         },
-        "vendor": {
-          "description": "The name of the device vendor (for example \"Intel Corporation\")",
-          "type": "string"
+        "type": {
+          "$ref": "#/definitions/image_type"
         },
-        "vendor_id": {
-          "description": "ID of the vendor (for example \"8086\")",
+        "updated_at": {
+          "description": "The last time that this infra-env was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "user_name": {
           "type": "string"
         }
       }
     },
-    "host": {
+    "infra-env-create-params": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
+        "name",
+        "pull_secret"
       ],
       "properties": {
-        "api_vip_connectivity": {
-          "description": "Contains a serialized api_vip_connectivity_response",
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "bootstrap": {
-          "type": "boolean"
+          "x-nullable": true
         },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
+        "additional_trust_bundle": {
+          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "maxLength": 65535,
+          "x-nullable": false
         },
         "cluster_id": {
-          "description": "The cluster that this host is associated with.",
+          "description": "If set, all hosts that register will be associated with the specified cluster.",
           "type": "string",
           "format": "uuid",
-          "x-go-custom-tag": "gorm:\"foreignkey:Cluster\"",
           "x-nullable": true
         },
-        "connection_timed_out": {
-          "description": "Indicate that connection to assisted service was timed out when soft timeout is enabled.",
-          "type": "boolean"
-        },
-        "connectivity": {
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
+          ],
+          "x-nullable": false
         },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+          "type": "string"
         },
-        "deleted_at": {
-          "description": "swagger:ignore",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "gorm.io/gorm"
-            },
-            "type": "DeletedAt"
-          },
-          "x-nullable": false
+        "image_type": {
+          "$ref": "#/definitions/image_type"
         },
-        "discovery_agent_version": {
+        "kernel_arguments": {
+          "$ref": "#/definitions/kernel_arguments"
+        },
+        "name": {
+          "description": "Name of the infra-env.",
           "type": "string"
         },
-        "disks_info": {
-          "description": "Additional information about disks, formatted as JSON.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+          "type": "string"
         },
-        "disks_to_be_formatted": {
-          "description": "A comma-separated list of disks that will be formatted once\ninstallation begins, unless otherwise set to be skipped by\nskip_formatting_disks. This means that this list also includes disks\nthat appear in skip_formatting_disks. This property is managed by the\nservice and cannot be modified by the user.",
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+          "type": "string"
+        },
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-nullable": true
         },
-        "domain_name_resolutions": {
-          "description": "The domain name resolution result.",
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "infra-env-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/infra-env"
+      }
+    },
+    "infra-env-update-params": {
+      "type": "object",
+      "properties": {
+        "additional_ntp_sources": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-nullable": true
         },
-        "free_addresses": {
+        "additional_trust_bundle": {
+          "description": "Allows users to change the additional_trust_bundle infra-env field",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "maxLength": 65535,
+          "x-nullable": true
         },
-        "href": {
-          "description": "Self link.",
+        "ignition_config_override": {
+          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
           "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ignition_config_overrides": {
-          "description": "Json formatted string containing the user overrides for the host's pointer ignition",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "example": "{\"ignition\": {\"version\": \"3.1.0\"}, \"storage\": {\"files\": [{\"path\": \"/tmp/example\", \"contents\": {\"source\": \"data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj\"}}]}}"
+        "image_type": {
+          "$ref": "#/definitions/image_type"
         },
-        "ignition_endpoint_token_set": {
-          "description": "True if the token to fetch the ignition from ignition_endpoint_url is set.",
-          "type": "boolean"
+        "kernel_arguments": {
+          "$ref": "#/definitions/kernel_arguments"
         },
-        "images_status": {
-          "description": "Array of image statuses.",
+        "openshift_version": {
+          "description": "Version of the OS image",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "x-nullable": true
         },
-        "infra_env_id": {
-          "description": "The infra-env that this host is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey;foreignkey:InfraEnvID\""
+        "proxy": {
+          "$ref": "#/definitions/proxy"
         },
-        "installation_disk_id": {
-          "description": "Contains the inventory disk id to install on.",
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
           "type": "string"
         },
-        "installation_disk_path": {
-          "description": "Contains the inventory disk path, This field is replaced by installation_disk_id field and used for backward compatability with the old UI.",
+        "ssh_authorized_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string",
-          "example": "/dev/sda"
+          "x-nullable": true
         },
-        "installer_args": {
-          "type": "string"
+        "static_network_config": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_static_network_config"
+          }
+        }
+      }
+    },
+    "infra_error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 403,
+          "minimum": 401
         },
-        "installer_version": {
-          "description": "Installer version.",
+        "message": {
+          "description": "Human-readable description of the error.",
           "type": "string"
-        },
-        "inventory": {
+        }
+      }
+    },
+    "ingress-cert-params": {
+      "type": "string"
+    },
+    "ingress_vip": {
+      "description": "The virtual IP used for cluster ingress traffic.",
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that this VIP is associated with.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link, or\n'AddToExistingClusterHost' for host being added to existing OCP cluster, or\n",
-          "type": "string",
-          "enum": [
-            "Host",
-            "AddToExistingClusterHost"
-          ]
+        "ip": {
+          "description": "The IP address.",
+          "$ref": "#/definitions/ip"
         },
-        "logs_collected_at": {
+        "verification": {
+          "description": "Ingress VIP verification result.",
+          "$ref": "#/definitions/vip_verification"
+        }
+      }
+    },
+    "install-schedule": {
+      "description": "A time window in which the installation of the cluster is started automatically once the cluster is ready.",
+      "type": "object",
+      "properties": {
+        "not_after": {
+          "description": "The installation is not started after this time. If the cluster is not ready by then, the schedule is removed. Optional.",
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "logs_info": {
-          "description": "The progress of log collection or empty if logs are not applicable",
-          "$ref": "#/definitions/logs_state"
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
         },
-        "logs_started_at": {
+        "not_before": {
+          "description": "The installation is not started before this time.",
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "machine_config_pool_name": {
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:install_schedule_\""
+    },
+    "install_cmd_request": {
+      "type": "object",
+      "required": [
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "role",
+        "boot_device",
+        "controller_image",
+        "installer_image"
+      ],
+      "properties": {
+        "boot_device": {
+          "description": "Boot device to write image on",
           "type": "string"
         },
-        "media_status": {
-          "type": "string",
-          "default": "connected",
-          "enum": [
-            "connected",
-            "disconnected"
-          ],
-          "x-nullable": true
+        "check_cvo": {
+          "description": "Check CVO status if needed",
+          "type": "boolean",
+          "default": true
         },
-        "node_labels": {
-          "description": "Json containing node's labels.",
+        "cluster_id": {
+          "description": "Cluster id",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid"
         },
-        "ntp_sources": {
-          "description": "The configured NTP sources on the host.",
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer"
+        },
+        "controller_image": {
+          "description": "Assisted installer controller image",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "progress": {
-          "$ref": "#/definitions/host-progress-info"
+        "coreos_image": {
+          "description": "CoreOS container image to use if installing to the local device",
+          "type": "string"
         },
-        "progress_stages": {
+        "disks_to_format": {
+          "description": "List of disks to format",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\"",
-          "x-nullable": true
-        },
-        "registered_at": {
-          "description": "The last time the host's agent tried to register in the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "requested_hostname": {
-          "type": "string"
+            "description": "Disk to format",
+            "type": "string"
+          }
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
+        "enable_skip_mco_reboot": {
+          "description": "If true, assisted service will attempt to skip MCO reboot",
+          "type": "boolean"
         },
-        "skip_formatting_disks": {
-          "description": "A comma-seperated list of host disks that the service will avoid\nformatting.",
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+          "format": "uuid"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
+        "infra_env_id": {
+          "description": "Infra env id",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid"
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "installer_args": {
+          "description": "Core-os installer addtional args",
+          "type": "string"
         },
-        "status": {
+        "installer_image": {
+          "description": "Assisted installer image",
           "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "preparing-failed",
-            "preparing-successful",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting",
-            "added-to-existing-cluster",
-            "cancelled",
-            "binding",
-            "unbinding",
-            "unbinding-pending-user-action",
-            "known-unbound",
-            "disconnected-unbound",
-            "insufficient-unbound",
-            "disabled-unbound",
-            "discovering-unbound",
-            "reclaiming",
-            "reclaiming-rebooting"
-          ]
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "status_info": {
+        "mco_image": {
+          "description": "Machine config operator image",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
-        "status_updated_at": {
-          "description": "The last time that the host status was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "must_gather_image": {
+          "description": "Must-gather images to use",
+          "type": "string"
         },
-        "suggested_role": {
-          "$ref": "#/definitions/host-role"
+        "notify_num_reboots": {
+          "description": "If true, notify number of reboots by assisted controller",
+          "type": "boolean"
         },
-        "tang_connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string"
         },
-        "timestamp": {
-          "description": "The time on the host as seconds since the Unix epoch.",
-          "type": "integer"
+        "proxy": {
+          "$ref": "#/definitions/proxy"
         },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "user_name": {
-          "type": "string"
+        "service_ips": {
+          "description": "List of service ips",
+          "type": "array",
+          "items": {
+            "description": "Service ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
         },
-        "validations_info": {
-          "description": "JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "skip_installation_disk_cleanup": {
+          "description": "Skip formatting installation disk",
+          "type": "boolean"
         }
       }
     },
-    "host-create-params": {
+    "installation-timeline": {
       "type": "object",
-      "required": [
-        "host_id"
-      ],
       "properties": {
-        "discovery_agent_version": {
-          "type": "string"
-        },
-        "host_id": {
+        "cluster_id": {
+          "description": "The cluster that was installed.",
           "type": "string",
           "format": "uuid"
-        }
-      }
-    },
-    "host-ignition-params": {
-      "properties": {
-        "config": {
-          "type": "string"
-        }
-      }
-    },
-    "host-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/host"
-      }
-    },
-    "host-progress": {
-      "type": "object",
-      "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
         },
-        "progress_info": {
+        "finalizing_stages": {
+          "description": "The finalizing stages of the cluster, in the order in which they started.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-stage"
+          }
+        },
+        "hosts": {
+          "description": "The installation stages of each host of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-installation-timeline"
+          }
+        },
+        "install_completed_at": {
+          "description": "Time at which the installation completed, omitted while it is in progress.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "install_started_at": {
+          "description": "Time at which the installation started.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "format": "date-time"
         }
       }
     },
-    "host-progress-info": {
+    "installation-timeline-stage": {
       "type": "object",
       "properties": {
-        "current_stage": {
-          "$ref": "#/definitions/host-stage"
-        },
-        "installation_percentage": {
-          "type": "integer"
+        "duration_seconds": {
+          "description": "Time spent in the stage so far.",
+          "type": "number"
         },
-        "progress_info": {
+        "ended_at": {
+          "description": "Time at which the stage ended, omitted while the stage is in progress.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "format": "date-time"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "name": {
+          "description": "The host stage or the finalizing stage.",
+          "type": "string"
         },
-        "stage_timed_out": {
-          "description": "Indicate of the current stage has been timed out.",
-          "type": "boolean"
+        "result": {
+          "description": "in-progress until the stage ends, and then whether the stage succeeded or failed.",
+          "type": "string",
+          "enum": [
+            "in-progress",
+            "succeeded",
+            "failed"
+          ]
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated.",
+        "started_at": {
+          "description": "Time at which the stage started.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "date-time"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:progress_\""
-    },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "arbiter",
-        "worker",
-        "bootstrap"
-      ]
-    },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "auto-assign",
-        "master",
-        "arbiter",
-        "worker"
-      ]
-    },
-    "host-stage": {
-      "type": "string",
-      "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Waiting for bootkube",
-        "Waiting for controller",
-        "Installing",
-        "Writing image to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
-      ]
+      }
     },
-    "host-type-hardware-requirements": {
+    "installer-args-params": {
       "type": "object",
       "properties": {
-        "qualitative": {
-          "description": "Host requirements that cannot be quantified at the time of calculation. Descriptions or formulas of requiements",
+        "args": {
+          "description": "List of additional arguments passed to coreos-installer",
           "type": "array",
           "items": {
             "type": "string"
-          }
-        },
-        "quantitative": {
-          "description": "Host requirements that can be quantified",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+          },
+          "example": [
+            "--append-karg",
+            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
+            "--save-partindex",
+            "1",
+            "-n"
+          ]
         }
       }
     },
-    "host-type-hardware-requirements-wrapper": {
+    "interface": {
       "type": "object",
       "properties": {
-        "master": {
-          "description": "Requirements towards a master node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
+        "biosdevname": {
+          "type": "string"
         },
-        "worker": {
-          "description": "Requirements towards a worker node",
-          "$ref": "#/definitions/host-type-hardware-requirements"
-        }
-      }
-    },
-    "host-update-params": {
-      "type": "object",
-      "properties": {
-        "disks_selected_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk-config-params"
-          },
-          "x-nullable": true
+        "client_id": {
+          "type": "string"
         },
-        "disks_skip_formatting": {
-          "description": "Allows changing the host's skip_formatting_disks parameter",
+        "flags": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/disk-skip-formatting-params"
-          },
-          "x-nullable": true
+            "type": "string"
+          }
         },
-        "host_name": {
-          "type": "string",
-          "x-nullable": true
+        "has_carrier": {
+          "type": "boolean"
         },
-        "host_role": {
-          "type": "string",
-          "enum": [
-            "auto-assign",
-            "master",
-            "arbiter",
-            "worker"
-          ],
-          "x-nullable": true
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "ignition_endpoint_http_headers": {
-          "description": "JSON-formatted string of additional HTTP headers when fetching the ignition.",
+        "ipv6_addresses": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ignition-endpoint-http-headers-params"
-          },
-          "x-nullable": true
+            "type": "string"
+          }
         },
-        "ignition_endpoint_token": {
-          "description": "A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.",
-          "type": "string",
-          "x-nullable": true
+        "mac_address": {
+          "type": "string"
         },
-        "machine_config_pool_name": {
-          "type": "string",
-          "x-nullable": true
+        "mtu": {
+          "type": "integer"
         },
-        "node_labels": {
-          "description": "Labels to be added to the corresponding node.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/node-label-params"
-          },
-          "x-nullable": true
+        "name": {
+          "type": "string"
+        },
+        "product": {
+          "type": "string"
+        },
+        "speed_mbps": {
+          "type": "integer"
+        },
+        "type": {
+          "type": "string"
+        },
+        "vendor": {
+          "type": "string"
         }
       }
     },
-    "host-validation-id": {
-      "type": "string",
-      "enum": [
-        "connected",
-        "media-connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr",
-        "ignition-downloadable",
-        "belongs-to-majority-group",
-        "valid-platform-network-settings",
-        "ntp-synced",
-        "time-synced-between-host-and-service",
-        "container-images-available",
-        "lso-requirements-satisfied",
-        "ocs-requirements-satisfied",
-        "odf-requirements-satisfied",
-        "lvm-requirements-satisfied",
-        "mce-requirements-satisfied",
-        "mtv-requirements-satisfied",
-        "osc-requirements-satisfied",
-        "sufficient-installation-disk-speed",
-        "cnv-requirements-satisfied",
-        "sufficient-network-latency-requirement-for-role",
-        "sufficient-packet-loss-requirement-for-role",
-        "has-default-route",
-        "api-domain-name-resolved-correctly",
-        "api-int-domain-name-resolved-correctly",
-        "apps-domain-name-resolved-correctly",
-        "release-domain-name-resolved-correctly",
-        "compatible-with-cluster-platform",
-        "dns-wildcard-not-configured",
-        "disk-encryption-requirements-satisfied",
-        "non-overlapping-subnets",
-        "vsphere-disk-uuid-enabled",
-        "compatible-agent",
-        "no-skip-installation-disk",
-        "no-skip-missing-disk",
-        "no-ip-collisions-in-network",
-        "no-iscsi-nic-belongs-to-machine-cidr",
-        "node-feature-discovery-requirements-satisfied",
-        "nvidia-gpu-requirements-satisfied",
-        "pipelines-requirements-satisfied",
-        "servicemesh-requirements-satisfied",
-        "serverless-requirements-satisfied",
-        "openshift-ai-requirements-satisfied",
-        "authorino-requirements-satisfied",
-        "mtu-valid",
-        "nmstate-requirements-satisfied",
-        "amd-gpu-requirements-satisfied",
-        "kmm-requirements-satisfied",
-        "node-healthcheck-requirements-satisfied",
-        "self-node-remediation-requirements-satisfied",
-        "fence-agents-remediation-requirements-satisfied",
-        "node-maintenance-requirements-satisfied",
-        "kube-descheduler-requirements-satisfied"
-      ]
-    },
-    "host_network": {
+    "inventory": {
       "type": "object",
       "properties": {
-        "cidr": {
+        "bmc_address": {
           "type": "string"
         },
-        "host_ids": {
+        "bmc_v6address": {
+          "type": "string"
+        },
+        "boot": {
+          "$ref": "#/definitions/boot"
+        },
+        "cpu": {
+          "$ref": "#/definitions/cpu"
+        },
+        "disks": {
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "uuid"
+            "$ref": "#/definitions/disk"
           }
-        }
-      }
-    },
-    "host_registration_response": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/host"
         },
-        {
-          "properties": {
-            "next_step_runner_command": {
-              "description": "Command for starting the next step runner",
-              "type": "object",
-              "properties": {
-                "args": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "command": {
-                  "type": "string"
-                },
-                "retry_seconds": {
-                  "description": "How long in seconds to wait before retrying registration if the command fails",
-                  "type": "integer"
-                }
-              }
-            }
+        "gpus": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/gpu"
           }
-        }
-      ]
-    },
-    "host_static_network_config": {
-      "type": "object",
-      "properties": {
-        "mac_interface_map": {
-          "description": "mapping of host macs to logical interfaces used in the network yaml",
-          "$ref": "#/definitions/mac_interface_map"
         },
-        "network_yaml": {
-          "description": "yaml string that can be processed by nmstate",
+        "hostname": {
           "type": "string"
+        },
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
+        },
+        "memory": {
+          "$ref": "#/definitions/memory"
+        },
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/route"
+          }
+        },
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        },
+        "tpm_version": {
+          "type": "string",
+          "enum": [
+            "none",
+            "1.2",
+            "2.0"
+          ]
         }
       }
     },
-    "ignition-endpoint": {
-      "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+    "io_perf": {
       "type": "object",
       "properties": {
-        "ca_certificate": {
-          "description": "base64 encoded CA certficate to be used when contacting the URL via https.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "url": {
-          "description": "The URL for the ignition endpoint.",
-          "type": "string",
-          "x-nullable": true
+        "sync_duration": {
+          "description": "99th percentile of fsync duration in milliseconds",
+          "type": "integer"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:ignition_endpoint_\""
+      }
     },
-    "ignition-endpoint-http-headers-params": {
+    "ip": {
+      "type": "string",
+      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
+      "x-go-custom-tag": "gorm:\"primaryKey\""
+    },
+    "iscsi": {
       "type": "object",
-      "required": [
-        "key",
-        "value"
-      ],
       "properties": {
-        "key": {
-          "description": "The key for the http header's key-value pair.",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value for the http header's key-value pair.",
+        "host_ip_address": {
+          "description": "Host IP address used to reach iSCSI target",
           "type": "string"
         }
       }
     },
-    "ignored-validations": {
+    "kernel_argument": {
+      "description": "pair of [operation, argument] specifying the argument and what operation should be applied on it.",
       "type": "object",
       "properties": {
-        "cluster-validation-ids": {
-          "description": "JSON-formatted list of cluster validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all cluster validations. Some validations cannot be ignored.",
+        "operation": {
+          "description": "The operation to apply on the kernel argument.",
           "type": "string",
-          "format": "string"
+          "enum": [
+            "append",
+            "replace",
+            "delete"
+          ]
         },
-        "host-validation-ids": {
-          "description": "JSON-formatted list of host validation IDs that will be ignored for all hosts that belong to this cluster. It may also contain a list with a single string \"all\" to ignore all host validations. Some validations cannot be ignored.",
+        "value": {
+          "description": "Kernel argument can have the form \u003cparameter\u003e or \u003cparameter\u003e=\u003cvalue\u003e. The following examples should\nbe supported:\nrd.net.timeout.carrier=60\nisolcpus=1,2,10-20,100-2000:2/25\nquiet\nThe parsing by the command line parser in linux kernel is much looser and this pattern follows it.\n",
           "type": "string",
-          "format": "string"
+          "pattern": "^(?:(?:[^ \\t\\n\\r\"]+)|(?:\"[^\"]*\"))+$"
         }
       }
     },
-    "image-create-params": {
+    "kernel_arguments": {
+      "description": "List of kernel arugment objects that define the operations and values to be applied.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/kernel_argument"
+      },
+      "x-omitempty": false
+    },
+    "l2-connectivity": {
       "type": "object",
       "properties": {
-        "image_type": {
-          "description": "Type of image that should be generated.",
-          "$ref": "#/definitions/image_type"
+        "outgoing_ip_address": {
+          "type": "string"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "outgoing_nic": {
           "type": "string"
         },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "image_info": {
+    "l3-connectivity": {
       "type": "object",
       "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
+        "average_rtt_ms": {
+          "description": "Average round trip time in milliseconds.",
+          "type": "number",
+          "format": "double",
+          "x-go-name": "AverageRTTMs"
         },
-        "download_url": {
+        "outgoing_nic": {
           "type": "string"
         },
-        "expires_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "packet_loss_percentage": {
+          "description": "Percentage of packets lost during connectivity check.",
+          "type": "number",
+          "format": "double"
         },
-        "generator_version": {
-          "description": "Image generator version.",
+        "remote_ip_address": {
           "type": "string"
         },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "successful": {
+          "type": "boolean"
+        }
+      }
+    },
+    "last-installation-preparation": {
+      "description": "Gives the status of the last installation preparation (if any)",
+      "type": "object",
+      "properties": {
+        "reason": {
+          "description": "The reason for the preparation status if applicable",
           "type": "string"
         },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation",
+        "status": {
+          "description": "The last installation preparation status",
+          "type": "string",
+          "default": "not_started",
+          "enum": [
+            "not_started",
+            "failed",
+            "success"
+          ],
+          "x-nullable": false
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:last_installation_preparation_\"",
+      "x-nullable": false
+    },
+    "list-managed-domains": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/managed-domain"
+      }
+    },
+    "list-manifests": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/manifest"
+      }
+    },
+    "list-versions": {
+      "type": "object",
+      "properties": {
+        "release_tag": {
           "type": "string"
         },
+        "versions": {
+          "$ref": "#/definitions/versions"
+        }
+      }
+    },
+    "load_balancer": {
+      "type": "object",
+      "properties": {
         "type": {
-          "$ref": "#/definitions/image_type"
+          "description": "Indicates if the load balancer will be managed by the cluster or by the user. This is optional and The\ndefault is ` + "`" + `cluster-managed` + "`" + `.\n\n` + "`" + `cluster-managed` + "`" + ` means that the cluster will start the components that assign the API and ingress VIPs to the\nnodes of the cluster automatically.\n\n` + "`" + `user-managed` + "`" + ` means that the user is responsible for configuring an external load balancer and assign the\nAPI and ingress VIPs to it. Note that this configuration needs to be completed before starting the\ninstallation of the cluster, as it is needed during the installation process.\n",
+          "type": "string",
+          "enum": [
+            "cluster-managed",
+            "user-managed"
+          ],
+          "x-go-custom-tag": "gorm:\"not null;check:load_balancer_type in ('cluster-managed', 'user-managed');default:'cluster-managed'\""
         }
       },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:image_\""
-    },
-    "image_type": {
-      "type": "string",
-      "enum": [
-        "full-iso",
-        "minimal-iso"
-      ]
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:load_balancer_\""
     },
-    "import-cluster-params": {
+    "logs-progress-params": {
       "type": "object",
       "required": [
-        "name",
-        "api_vip_dnsname",
-        "openshift_cluster_id"
+        "logs_state"
       ],
       "properties": {
-        "api_vip_dnsname": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string"
-        },
-        "name": {
-          "description": "OpenShift cluster name.",
-          "type": "string"
-        },
-        "openshift_cluster_id": {
-          "description": "The id of the OCP cluster, that hosts will be added to",
-          "type": "string",
-          "format": "uuid"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "logs_state": {
+          "description": "The state of collecting logs.",
+          "$ref": "#/definitions/logs_state"
         }
       }
     },
-    "infra-env": {
+    "logs_gather_cmd_request": {
       "type": "object",
       "required": [
-        "kind",
-        "id",
-        "href",
-        "name",
-        "type",
-        "updated_at",
-        "created_at"
+        "cluster_id",
+        "infra_env_id",
+        "host_id",
+        "bootstrap",
+        "installer_gather"
       ],
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string"
-        },
-        "additional_trust_bundle": {
-          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
-          "type": "string",
-          "x-nullable": false
+        "bootstrap": {
+          "description": "Host is bootstrap or not",
+          "type": "boolean"
         },
         "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "description": "Cluster id",
           "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-nullable": false
+          "format": "uuid"
         },
-        "created_at": {
+        "host_id": {
+          "description": "Host id",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "time"
-            },
-            "type": "Time"
-          }
-        },
-        "download_url": {
-          "type": "string"
-        },
-        "email_domain": {
-          "type": "string"
+          "format": "uuid"
         },
-        "expires_at": {
+        "infra_env_id": {
+          "description": "Infra env id",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid"
         },
-        "generator_version": {
-          "description": "Image generator version.",
-          "type": "string"
+        "installer_gather": {
+          "description": "Run installer gather logs",
+          "type": "boolean",
+          "default": true
         },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
+        "master_ips": {
+          "description": "List of master ips",
+          "type": "array",
+          "items": {
+            "description": "Master ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        }
+      }
+    },
+    "logs_state": {
+      "type": "string",
+      "enum": [
+        "requested",
+        "collecting",
+        "completed",
+        "timeout",
+        ""
+      ],
+      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+    },
+    "logs_type": {
+      "type": "string",
+      "enum": [
+        "host",
+        "controller",
+        "all",
+        ""
+      ]
+    },
+    "mac_interface_map": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "logical_nic_name": {
+            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
+            "type": "string"
+          },
+          "mac_address": {
+            "description": "mac address present on the host",
+            "type": "string",
+            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+          }
+        }
+      }
+    },
+    "machine_network": {
+      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
+        "cluster_id": {
+          "description": "The cluster that this network is associated with.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ignition_config_override": {
-          "description": "Json formatted string containing the user overrides for the initial ignition config.",
+        }
+      }
+    },
+    "managed-domain": {
+      "type": "object",
+      "properties": {
+        "domain": {
           "type": "string"
         },
-        "kernel_arguments": {
-          "description": "JSON formatted string array representing the discovery image kernel arguments.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\"",
-          "x-nullable": true
-        },
-        "kind": {
-          "description": "Indicates the type of this object.",
+        "provider": {
           "type": "string",
           "enum": [
-            "InfraEnv"
+            "route53",
+            "rfc2136",
+            "powerdns"
           ]
-        },
-        "name": {
-          "description": "Name of the infra-env.",
-          "type": "string"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
-          "type": "string"
-        },
-        "org_id": {
+        }
+      }
+    },
+    "manifest": {
+      "type": "object",
+      "properties": {
+        "file_name": {
+          "description": "The file name prefaced by the folder that contains it.",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
-        },
-        "pull_secret_set": {
-          "description": "True if the pull secret has been added to the cluster.",
-          "type": "boolean"
-        },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+        "folder": {
+          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "enum": [
+            "manifests",
+            "openshift"
+          ]
         },
-        "static_network_config": {
-          "description": "static network configuration string in the format expected by discovery ignition generation.",
-          "type": "string"
+        "manifest_source": {
+          "description": "Describes whether manifest is sourced from a user or created by the system.",
+          "type": "string",
+          "enum": [
+            "user",
+            "system"
+          ]
+        }
+      }
+    },
+    "memory": {
+      "type": "object",
+      "properties": {
+        "physical_bytes": {
+          "type": "integer"
         },
-        "type": {
-          "$ref": "#/definitions/image_type"
+        "physical_bytes_method": {
+          "description": "The method by which the physical memory was set",
+          "$ref": "#/definitions/memory_method"
         },
-        "updated_at": {
-          "description": "The last time that this infra-env was updated.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+        "usable_bytes": {
+          "type": "integer"
+        }
+      }
+    },
+    "memory_method": {
+      "type": "string",
+      "enum": [
+        "dmidecode",
+        "ghw",
+        "meminfo"
+      ]
+    },
+    "monitored-operator": {
+      "type": "object",
+      "properties": {
+        "bundles": {
+          "description": "List of identifier of the bundles associated with the operator. Can be empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
           "x-go-type": {
             "hints": {
               "noValidation": true
             },
             "import": {
-              "package": "time"
+              "package": "github.com/lib/pq"
             },
-            "type": "Time"
+            "type": "StringArray"
           }
         },
-        "user_name": {
+        "catalog_source": {
+          "description": "Name of the catalog source of the subscription. When empty the default catalog source of the operator is\nused. Must be one of the catalog sources returned by the install options of the operator, unless\ncatalog_source_image is also set.\n",
           "type": "string"
-        }
-      }
-    },
-    "infra-env-create-params": {
-      "type": "object",
-      "required": [
-        "name",
-        "pull_secret"
-      ],
-      "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
         },
-        "additional_trust_bundle": {
-          "description": "PEM-encoded X.509 certificate bundle. Hosts discovered by this\ninfra-env will trust the certificates in this bundle. Clusters formed\nfrom the hosts discovered by this infra-env will also trust the\ncertificates in this bundle.",
-          "type": "string",
-          "maxLength": 65535,
-          "x-nullable": false
+        "catalog_source_image": {
+          "description": "Index image of a catalog source that will be created in the 'openshift-marketplace' namespace, for example\na mirrored catalog in a disconnected environment.\n",
+          "type": "string"
+        },
+        "channel": {
+          "description": "Channel of the subscription of the operator. When empty the default channel for the OpenShift version of the\ncluster is used.\n",
+          "type": "string"
         },
         "cluster_id": {
-          "description": "If set, all hosts that register will be associated with the specified cluster.",
+          "description": "The cluster that this operator is associated with.",
           "type": "string",
           "format": "uuid",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+        "dependency_only": {
+          "description": "Whether the operator can't be installed without being required by another operator.",
+          "type": "boolean"
+        },
+        "install_plan_approval": {
+          "$ref": "#/definitions/operator-install-plan-approval"
+        },
+        "name": {
+          "description": "Unique name of the operator.",
           "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x"
-          ],
-          "x-nullable": false
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+        "namespace": {
+          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
           "type": "string"
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
+        "operator_type": {
+          "$ref": "#/definitions/operator-type"
         },
-        "kernel_arguments": {
-          "$ref": "#/definitions/kernel_arguments"
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "name": {
-          "description": "Name of the infra-env.",
+        "starting_csv": {
+          "description": "Name of the cluster service version that will be installed, for example 'odf-operator.v4.16.3'.",
           "type": "string"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster (used to infer the RHCOS version - temporary until generic logic implemented).",
+        "status": {
+          "$ref": "#/definitions/operator-status"
+        },
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "status_updated_at": {
+          "description": "Time at which the operator was last updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "subscription_name": {
+          "description": "The name of the subscription of the operator.",
           "type": "string"
         },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string",
-          "x-nullable": true
+        "timeout_seconds": {
+          "description": "Positive number represents a timeout in seconds for the operator to be available.",
+          "type": "integer"
         },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
+        "version": {
+          "description": "Operator version",
+          "type": "string"
         }
       }
     },
-    "infra-env-list": {
+    "monitored-operators-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/infra-env"
+        "$ref": "#/definitions/monitored-operator"
       }
     },
-    "infra-env-update-params": {
+    "mtu-report": {
       "type": "object",
       "properties": {
-        "additional_ntp_sources": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "additional_trust_bundle": {
-          "description": "Allows users to change the additional_trust_bundle infra-env field",
-          "type": "string",
-          "maxLength": 65535,
-          "x-nullable": true
+        "mtu_successful": {
+          "type": "boolean"
         },
-        "ignition_config_override": {
-          "description": "JSON formatted string containing the user overrides for the initial ignition config.",
+        "outgoing_nic": {
           "type": "string"
         },
-        "image_type": {
-          "$ref": "#/definitions/image_type"
-        },
-        "kernel_arguments": {
-          "$ref": "#/definitions/kernel_arguments"
-        },
-        "openshift_version": {
-          "description": "Version of the OS image",
-          "type": "string",
-          "x-nullable": true
-        },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
+        "remote_ip_address": {
           "type": "string"
-        },
-        "ssh_authorized_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "static_network_config": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_static_network_config"
-          }
         }
       }
     },
-    "infra_error": {
+    "network_throughput_request": {
       "type": "object",
       "required": [
-        "code",
-        "message"
+        "duration_seconds",
+        "remote_hosts"
       ],
       "properties": {
-        "code": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 403,
-          "minimum": 401
-        },
-        "message": {
-          "description": "Human-readable description of the error.",
-          "type": "string"
-        }
-      }
-    },
-    "ingress-cert-params": {
-      "type": "string"
-    },
-    "ingress_vip": {
-      "description": "The virtual IP used for cluster ingress traffic.",
-      "type": "object",
-      "properties": {
-        "cluster_id": {
-          "description": "The cluster that this VIP is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "ip": {
-          "description": "The IP address.",
-          "$ref": "#/definitions/ip"
+        "duration_seconds": {
+          "description": "Duration of the throughput test with each remote host, in seconds.",
+          "type": "integer"
         },
-        "verification": {
-          "description": "Ingress VIP verification result.",
-          "$ref": "#/definitions/vip_verification"
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network_throughput_request_host"
+          }
         }
       }
     },
-    "install_cmd_request": {
+    "network_throughput_request_host": {
       "type": "object",
       "required": [
-        "cluster_id",
-        "infra_env_id",
         "host_id",
-        "role",
-        "boot_device",
-        "controller_image",
-        "installer_image"
+        "ip_address"
       ],
       "properties": {
-        "boot_device": {
-          "description": "Boot device to write image on",
-          "type": "string"
-        },
-        "check_cvo": {
-          "description": "Check CVO status if needed",
-          "type": "boolean",
-          "default": true
-        },
-        "cluster_id": {
-          "description": "Cluster id",
-          "type": "string",
-          "format": "uuid"
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer"
-        },
-        "controller_image": {
-          "description": "Assisted installer controller image",
+        "host_id": {
           "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "coreos_image": {
-          "description": "CoreOS container image to use if installing to the local device",
-          "type": "string"
+          "format": "uuid"
         },
-        "disks_to_format": {
-          "description": "List of disks to format",
+        "ip_address": {
+          "description": "The address of the remote host to measure the throughput to.",
+          "type": "string"
+        }
+      }
+    },
+    "network_throughput_response": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
           "type": "array",
           "items": {
-            "description": "Disk to format",
-            "type": "string"
+            "$ref": "#/definitions/connectivity-remote-host"
           }
-        },
-        "enable_skip_mco_reboot": {
-          "description": "If true, assisted service will attempt to skip MCO reboot",
-          "type": "boolean"
+        }
+      }
+    },
+    "next_step_cmd_request": {
+      "type": "object",
+      "required": [
+        "infra_env_id",
+        "host_id",
+        "agent_version"
+      ],
+      "properties": {
+        "agent_version": {
+          "description": "Agent image version",
+          "type": "string",
+          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
         },
         "host_id": {
           "description": "Host id",
@@ -9174,980 +11800,1198 @@ func init() {
           "description": "Infra env id",
           "type": "string",
           "format": "uuid"
-        },
-        "installer_args": {
-          "description": "Core-os installer addtional args",
+        }
+      }
+    },
+    "node-label-params": {
+      "type": "object",
+      "required": [
+        "key",
+        "value"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key for the label's key-value pair.",
           "type": "string"
         },
-        "installer_image": {
-          "description": "Assisted installer image",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "mco_image": {
-          "description": "Machine config operator image",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "must_gather_image": {
-          "description": "Must-gather images to use",
+        "value": {
+          "description": "The value for the label's key-value pair.",
           "type": "string"
-        },
-        "notify_num_reboots": {
-          "description": "If true, notify number of reboots by assisted controller",
-          "type": "boolean"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        }
+      }
+    },
+    "ntp_source": {
+      "type": "object",
+      "properties": {
+        "source_name": {
+          "description": "NTP source name or IP.",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
-        },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "service_ips": {
-          "description": "List of service ips",
-          "type": "array",
-          "items": {
-            "description": "Service ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
-        },
-        "skip_installation_disk_cleanup": {
-          "description": "Skip formatting installation disk",
-          "type": "boolean"
+        "source_state": {
+          "description": "Indication of state of an NTP source.",
+          "$ref": "#/definitions/source_state"
         }
       }
     },
-    "installer-args-params": {
+    "ntp_synchronization_request": {
       "type": "object",
+      "required": [
+        "ntp_source"
+      ],
       "properties": {
-        "args": {
-          "description": "List of additional arguments passed to coreos-installer",
+        "ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string"
+        }
+      }
+    },
+    "ntp_synchronization_response": {
+      "properties": {
+        "ntp_sources": {
           "type": "array",
           "items": {
-            "type": "string"
-          },
-          "example": [
-            "--append-karg",
-            "ip=192.0.2.2::192.0.2.254:255.255.255.0:core0.example.com:enp1s0:none",
-            "--save-partindex",
-            "1",
-            "-n"
-          ]
+            "$ref": "#/definitions/ntp_source"
+          }
         }
       }
     },
-    "interface": {
+    "openshift-version": {
       "type": "object",
+      "required": [
+        "display_name",
+        "support_level",
+        "cpu_architectures"
+      ],
       "properties": {
-        "biosdevname": {
-          "type": "string"
-        },
-        "client_id": {
-          "type": "string"
-        },
-        "flags": {
+        "cpu_architectures": {
+          "description": "Available CPU architectures.",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "has_carrier": {
+        "default": {
+          "description": "Indication that the version is the recommended one.",
           "type": "boolean"
         },
-        "ipv4_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "ipv6_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "mac_address": {
+        "display_name": {
+          "description": "Name of the version to be presented to the user.",
           "type": "string"
         },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
+        "support_level": {
+          "description": "Level of support of the version.",
+          "type": "string",
+          "enum": [
+            "beta",
+            "production",
+            "maintenance",
+            "end-of-life"
+          ]
+        }
+      }
+    },
+    "openshift-versions": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/openshift-version"
+      }
+    },
+    "operator-catalog-source": {
+      "description": "Catalog source that can be used by the subscriptions of the operators.",
+      "type": "object",
+      "properties": {
+        "display_name": {
+          "description": "Human friendly name of the catalog source.",
           "type": "string"
         },
-        "product": {
+        "image": {
+          "description": "Index image of the catalog source. Empty for the catalog sources that already exist in the cluster.",
           "type": "string"
         },
-        "speed_mbps": {
-          "type": "integer"
-        },
-        "type": {
+        "name": {
+          "description": "Name of the catalog source.",
           "type": "string"
         },
-        "vendor": {
+        "namespace": {
+          "description": "Namespace of the catalog source.",
           "type": "string"
         }
       }
     },
-    "inventory": {
+    "operator-create-params": {
       "type": "object",
       "properties": {
-        "bmc_address": {
+        "catalog_source": {
+          "description": "Name of the catalog source of the subscription. When empty the default catalog source of the operator is\nused. Must be one of the catalog sources returned by the install options of the operator, unless\ncatalog_source_image is also set.\n",
           "type": "string"
         },
-        "bmc_v6address": {
+        "catalog_source_image": {
+          "description": "Index image of a catalog source that will be created in the 'openshift-marketplace' namespace, for example\na mirrored catalog in a disconnected environment.\n",
           "type": "string"
         },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk"
-          }
-        },
-        "gpus": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/gpu"
-          }
-        },
-        "hostname": {
+        "channel": {
+          "description": "Channel of the subscription of the operator. When empty the default channel for the OpenShift version of the\ncluster is used.\n",
           "type": "string"
         },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
-          }
-        },
-        "memory": {
-          "$ref": "#/definitions/memory"
-        },
-        "routes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/route"
-          }
+        "install_plan_approval": {
+          "$ref": "#/definitions/operator-install-plan-approval"
         },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
+        "name": {
+          "type": "string"
         },
-        "tpm_version": {
+        "properties": {
+          "description": "Blob of operator-dependent parameters that are required for installation.",
           "type": "string",
-          "enum": [
-            "none",
-            "1.2",
-            "2.0"
-          ]
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "starting_csv": {
+          "description": "Name of the cluster service version that will be installed, for example 'odf-operator.v4.16.3'.",
+          "type": "string"
         }
       }
     },
-    "io_perf": {
+    "operator-hardware-requirements": {
       "type": "object",
       "properties": {
-        "sync_duration": {
-          "description": "99th percentile of fsync duration in milliseconds",
-          "type": "integer"
+        "dependencies": {
+          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operator_name": {
+          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+          "type": "string"
+        },
+        "requirements": {
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         }
       }
     },
-    "ip": {
-      "type": "string",
-      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))?$",
-      "x-go-custom-tag": "gorm:\"primaryKey\""
-    },
-    "iscsi": {
+    "operator-host-requirements": {
       "type": "object",
       "properties": {
-        "host_ip_address": {
-          "description": "Host IP address used to reach iSCSI target",
+        "operator_name": {
+          "description": "Name of the operator",
           "type": "string"
+        },
+        "requirements": {
+          "description": "Host requirements for the operator",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "kernel_argument": {
-      "description": "pair of [operation, argument] specifying the argument and what operation should be applied on it.",
+    "operator-install-options": {
+      "description": "Choices available to install an operator on a given OpenShift version.",
       "type": "object",
       "properties": {
-        "operation": {
-          "description": "The operation to apply on the kernel argument.",
-          "type": "string",
-          "enum": [
-            "append",
-            "replace",
-            "delete"
-          ]
+        "catalog_sources": {
+          "description": "Catalog sources that can be selected for the operator.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-catalog-source"
+          }
         },
-        "value": {
-          "description": "Kernel argument can have the form \u003cparameter\u003e or \u003cparameter\u003e=\u003cvalue\u003e. The following examples should\nbe supported:\nrd.net.timeout.carrier=60\nisolcpus=1,2,10-20,100-2000:2/25\nquiet\nThe parsing by the command line parser in linux kernel is much looser and this pattern follows it.\n",
-          "type": "string",
-          "pattern": "^(?:(?:[^ \\t\\n\\r\"]+)|(?:\"[^\"]*\"))+$"
+        "channels": {
+          "description": "Channels that can be selected for the operator.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "default_catalog_source": {
+          "description": "Name of the catalog source used when the cluster doesn't specify one.",
+          "type": "string"
+        },
+        "default_channel": {
+          "description": "Channel used when the cluster doesn't specify one.",
+          "type": "string"
+        },
+        "install_plan_approvals": {
+          "description": "Install plan approval modes that can be selected for the operator.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-install-plan-approval"
+          }
+        },
+        "openshift_version": {
+          "description": "OpenShift version that the options apply to.",
+          "type": "string"
+        },
+        "operator_name": {
+          "description": "Name of the operator.",
+          "type": "string"
+        },
+        "support_level": {
+          "$ref": "#/definitions/support-level"
         }
       }
     },
-    "kernel_arguments": {
-      "description": "List of kernel arugment objects that define the operations and values to be applied.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/kernel_argument"
-      },
-      "x-omitempty": false
+    "operator-install-plan-approval": {
+      "description": "Approval mode of the install plans of the subscription. Only 'Automatic' is supported, the installation\nwaits for the operators and nothing else would approve their install plans.\n",
+      "type": "string",
+      "enum": [
+        "Automatic"
+      ]
     },
-    "l2-connectivity": {
+    "operator-monitor-report": {
       "type": "object",
       "properties": {
-        "outgoing_ip_address": {
+        "name": {
+          "description": "Unique name of the operator.",
           "type": "string"
         },
-        "outgoing_nic": {
-          "type": "string"
+        "status": {
+          "$ref": "#/definitions/operator-status"
         },
-        "remote_ip_address": {
+        "status_info": {
+          "description": "Detailed information about the operator state.",
           "type": "string"
         },
-        "remote_mac": {
+        "version": {
+          "description": "operator version.",
           "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
         }
       }
     },
-    "l3-connectivity": {
+    "operator-properties": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/operator-property"
+      }
+    },
+    "operator-property": {
       "type": "object",
       "properties": {
-        "average_rtt_ms": {
-          "description": "Average round trip time in milliseconds.",
-          "type": "number",
-          "format": "double",
-          "x-go-name": "AverageRTTMs"
+        "data_type": {
+          "description": "Type of the property",
+          "type": "string",
+          "enum": [
+            "boolean",
+            "string",
+            "integer",
+            "float"
+          ]
         },
-        "outgoing_nic": {
+        "default_value": {
+          "description": "Default value for the property",
           "type": "string"
         },
-        "packet_loss_percentage": {
-          "description": "Percentage of packets lost during connectivity check.",
-          "type": "number",
-          "format": "double"
-        },
-        "remote_ip_address": {
+        "description": {
+          "description": "Description of a property",
           "type": "string"
         },
-        "successful": {
+        "mandatory": {
+          "description": "Indicates whether the property is reqired",
           "type": "boolean"
+        },
+        "name": {
+          "description": "Name of the property",
+          "type": "string"
+        },
+        "options": {
+          "description": "Values to select from",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "last-installation-preparation": {
-      "description": "Gives the status of the last installation preparation (if any)",
+    "operator-status": {
+      "description": "Represents the operator state.",
+      "type": "string",
+      "enum": [
+        "failed",
+        "progressing",
+        "available"
+      ]
+    },
+    "operator-type": {
+      "description": "Kind of operator. Different types are monitored by the service differently.",
+      "type": "string",
+      "enum": [
+        "builtin",
+        "olm"
+      ]
+    },
+    "os-image": {
       "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
       "properties": {
-        "reason": {
-          "description": "The reason for the preparation status if applicable",
-          "type": "string"
-        },
-        "status": {
-          "description": "The last installation preparation status",
+        "cpu_architecture": {
+          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
           "type": "string",
-          "default": "not_started",
+          "default": "x86_64",
           "enum": [
-            "not_started",
-            "failed",
-            "success"
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x"
           ],
-          "x-nullable": false
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+        },
+        "openshift_version": {
+          "description": "Version of the operating system image",
+          "type": "string",
+          "example": "4.12"
+        },
+        "url": {
+          "description": "The base OS image used for the discovery iso.",
+          "type": "string"
+        },
+        "version": {
+          "description": "Build ID of the OS image.",
+          "type": "string"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:last_installation_preparation_\"",
-      "x-nullable": false
-    },
-    "list-managed-domains": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/managed-domain"
       }
     },
-    "list-manifests": {
+    "os-images": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/manifest"
+        "$ref": "#/definitions/os-image"
       }
     },
-    "list-versions": {
+    "platform": {
+      "description": "The configuration for the specific platform upon which to perform the installation.",
       "type": "object",
+      "required": [
+        "type"
+      ],
       "properties": {
-        "release_tag": {
-          "type": "string"
+        "external": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform_external"
         },
-        "versions": {
-          "$ref": "#/definitions/versions"
+        "openstack": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform_openstack"
+        },
+        "type": {
+          "$ref": "#/definitions/platform_type"
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
     },
-    "load_balancer": {
+    "platform_external": {
+      "description": "Configuration used when installing with an external platform type.",
       "type": "object",
       "properties": {
-        "type": {
-          "description": "Indicates if the load balancer will be managed by the cluster or by the user. This is optional and The\ndefault is ` + "`" + `cluster-managed` + "`" + `.\n\n` + "`" + `cluster-managed` + "`" + ` means that the cluster will start the components that assign the API and ingress VIPs to the\nnodes of the cluster automatically.\n\n` + "`" + `user-managed` + "`" + ` means that the user is responsible for configuring an external load balancer and assign the\nAPI and ingress VIPs to it. Note that this configuration needs to be completed before starting the\ninstallation of the cluster, as it is needed during the installation process.\n",
+        "cloud_controller_manager": {
+          "description": "When set to external, this property will enable an external cloud provider.",
           "type": "string",
+          "default": "",
           "enum": [
-            "cluster-managed",
-            "user-managed"
+            "",
+            "External"
           ],
-          "x-go-custom-tag": "gorm:\"not null;check:load_balancer_type in ('cluster-managed', 'user-managed');default:'cluster-managed'\""
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:load_balancer_\""
-    },
-    "logs-progress-params": {
-      "type": "object",
-      "required": [
-        "logs_state"
-      ],
-      "properties": {
-        "logs_state": {
-          "description": "The state of collecting logs.",
-          "$ref": "#/definitions/logs_state"
+          "x-nullable": true
+        },
+        "platform_name": {
+          "description": "Holds the arbitrary string representing the infrastructure provider name.",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:external_\""
     },
-    "logs_gather_cmd_request": {
+    "platform_openstack": {
+      "description": "Configuration used when installing with the OpenStack platform type.",
       "type": "object",
-      "required": [
-        "cluster_id",
-        "infra_env_id",
-        "host_id",
-        "bootstrap",
-        "installer_gather"
-      ],
       "properties": {
-        "bootstrap": {
-          "description": "Host is bootstrap or not",
-          "type": "boolean"
-        },
-        "cluster_id": {
-          "description": "Cluster id",
+        "api_floating_ip": {
+          "description": "The floating IP address to associate with the API load balancer.",
           "type": "string",
-          "format": "uuid"
+          "x-nullable": true
         },
-        "host_id": {
-          "description": "Host id",
+        "cloud": {
+          "description": "The name of the cloud in the clouds.yaml file used to install and run the cluster.",
           "type": "string",
-          "format": "uuid"
+          "minLength": 1,
+          "x-nullable": true
         },
-        "infra_env_id": {
-          "description": "Infra env id",
+        "clouds_yaml": {
+          "description": "The clouds.yaml file with the credentials of the cloud, used by the installer to generate the manifests of the cluster. It is stored with the secrets of the cluster and is never returned.",
           "type": "string",
-          "format": "uuid"
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
         },
-        "installer_gather": {
-          "description": "Run installer gather logs",
-          "type": "boolean",
-          "default": true
+        "external_network": {
+          "description": "The name of the external network that provides the floating IP addresses.",
+          "type": "string",
+          "x-nullable": true
         },
-        "master_ips": {
-          "description": "List of master ips",
-          "type": "array",
-          "items": {
-            "description": "Master ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
+        "ingress_floating_ip": {
+          "description": "The floating IP address to associate with the ingress load balancer.",
+          "type": "string",
+          "x-nullable": true
         }
-      }
-    },
-    "logs_state": {
-      "type": "string",
-      "enum": [
-        "requested",
-        "collecting",
-        "completed",
-        "timeout",
-        ""
-      ],
-      "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:openstack_\""
     },
-    "logs_type": {
+    "platform_type": {
       "type": "string",
       "enum": [
-        "host",
-        "controller",
-        "all",
-        ""
+        "baremetal",
+        "nutanix",
+        "vsphere",
+        "none",
+        "external",
+        "openstack"
       ]
     },
-    "mac_interface_map": {
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "logical_nic_name": {
-            "description": "nic name used in the yaml, which relates 1:1 to the mac address",
-            "type": "string"
-          },
-          "mac_address": {
-            "description": "mac address present on the host",
-            "type": "string",
-            "pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$"
+    "preflight-hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "ocp": {
+          "description": "Preflight OCP requirements",
+          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
+        },
+        "operators": {
+          "description": "Preflight operators hardware requirements",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-hardware-requirements"
           }
         }
       }
     },
-    "machine_network": {
-      "description": "A network that all hosts belonging to the cluster should have an interface with IP address in. The VIPs (if exist) belong to this network.",
+    "presigned-url": {
       "type": "object",
+      "required": [
+        "url"
+      ],
       "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
-        },
-        "cluster_id": {
-          "description": "The cluster that this network is associated with.",
+        "expires_at": {
+          "description": "Expiration time for the URL token.",
           "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+          "format": "date-time"
+        },
+        "url": {
+          "description": "Pre-signed URL for downloading the infra-env discovery image.",
+          "type": "string"
         }
       }
     },
-    "managed-domain": {
+    "proxy": {
       "type": "object",
       "properties": {
-        "domain": {
-          "type": "string"
+        "http_proxy": {
+          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
+          "type": "string",
+          "x-nullable": true
         },
-        "provider": {
+        "https_proxy": {
+          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
-          "enum": [
-            "route53"
-          ]
+          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
+    },
+    "reboot_for_reclaim_request": {
+      "description": "Information sent to the agent for rebooting a host into discovery.",
+      "type": "object",
+      "required": [
+        "host_fs_mount_dir"
+      ],
+      "properties": {
+        "host_fs_mount_dir": {
+          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
+          "type": "string"
         }
       }
     },
-    "manifest": {
+    "release-channel": {
+      "description": "Release channel.",
+      "type": "string",
+      "enum": [
+        "candidate",
+        "fast",
+        "stable",
+        "eus"
+      ]
+    },
+    "release-image": {
       "type": "object",
+      "required": [
+        "openshift_version",
+        "cpu_architecture",
+        "url",
+        "version"
+      ],
       "properties": {
-        "file_name": {
-          "description": "The file name prefaced by the folder that contains it.",
+        "cpu_architecture": {
+          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
+          "type": "string",
+          "default": "x86_64",
+          "enum": [
+            "x86_64",
+            "aarch64",
+            "arm64",
+            "ppc64le",
+            "s390x",
+            "multi"
+          ],
+          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+        },
+        "cpu_architectures": {
+          "description": "List of CPU architectures provided by the image.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "default": {
+          "description": "Indication that the version is the recommended one.",
+          "type": "boolean"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "folder": {
-          "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+        "support_level": {
+          "description": "Level of support of the version.",
           "type": "string",
           "enum": [
-            "manifests",
-            "openshift"
+            "beta",
+            "production",
+            "maintenance",
+            "end-of-life"
           ]
         },
-        "manifest_source": {
-          "description": "Describes whether manifest is sourced from a user or created by the system.",
+        "url": {
+          "description": "The installation image of the OpenShift cluster.",
           "type": "string",
-          "enum": [
-            "user",
-            "system"
-          ]
+          "x-go-custom-tag": "gorm:\"primarykey\""
+        },
+        "version": {
+          "description": "OCP version from the release metadata.",
+          "type": "string"
         }
       }
     },
-    "memory": {
+    "release-images": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/release-image"
+      }
+    },
+    "release-source": {
       "type": "object",
+      "required": [
+        "openshift_version",
+        "multi_cpu_architectures",
+        "upgrade_channels"
+      ],
       "properties": {
-        "physical_bytes": {
-          "type": "integer"
+        "multi_cpu_architectures": {
+          "type": "array",
+          "items": {
+            "description": "Supported CPU architecture for multi-architecture releases in this OpenShift version..",
+            "type": "string",
+            "enum": [
+              "x86_64",
+              "aarch64",
+              "arm64",
+              "ppc64le",
+              "s390x"
+            ]
+          }
         },
-        "physical_bytes_method": {
-          "description": "The method by which the physical memory was set",
-          "$ref": "#/definitions/memory_method"
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string",
+          "example": "4.14"
         },
-        "usable_bytes": {
-          "type": "integer"
+        "upgrade_channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/upgrade-channel"
+          }
         }
       }
     },
-    "memory_method": {
-      "type": "string",
-      "enum": [
-        "dmidecode",
-        "ghw",
-        "meminfo"
-      ]
+    "release-sources": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/release-source"
+      }
     },
-    "monitored-operator": {
+    "role-binding": {
       "type": "object",
+      "required": [
+        "id",
+        "subject_kind",
+        "subject",
+        "role"
+      ],
       "properties": {
-        "bundles": {
-          "description": "List of identifier of the bundles associated with the operator. Can be empty.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-go-custom-tag": "gorm:\"type:text[]\"",
+        "cluster_id": {
+          "description": "The cluster that the role is granted on.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
           "x-go-type": {
             "hints": {
               "noValidation": true
             },
             "import": {
-              "package": "github.com/lib/pq"
+              "package": "time"
             },
-            "type": "StringArray"
+            "type": "Time"
           }
         },
-        "cluster_id": {
-          "description": "The cluster that this operator is associated with.",
+        "id": {
+          "description": "Unique identifier of the role binding.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primaryKey\""
         },
-        "dependency_only": {
-          "description": "Whether the operator can't be installed without being required by another operator.",
-          "type": "boolean"
-        },
-        "name": {
-          "description": "Unique name of the operator.",
+        "infra_env_id": {
+          "description": "The infra-env that the role is granted on.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
-        },
-        "namespace": {
-          "description": "Namespace where to deploy an operator. Only some operators require a namespace.",
-          "type": "string"
-        },
-        "operator_type": {
-          "$ref": "#/definitions/operator-type"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
         },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
+        "role": {
+          "description": "The role granted to the subject. A viewer can read the resource, an operator can also install, reset and cancel the installation, an editor can also update the resource.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "status": {
-          "$ref": "#/definitions/operator-status"
+          "enum": [
+            "viewer",
+            "operator",
+            "editor"
+          ]
         },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
+        "subject": {
+          "description": "The name of the user or of the group that the role is granted to.",
           "type": "string"
         },
-        "status_updated_at": {
-          "description": "Time at which the operator was last updated.",
+        "subject_kind": {
+          "description": "Whether the subject is a user or a group.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "enum": [
+            "user",
+            "group"
+          ]
         },
-        "subscription_name": {
-          "description": "The name of the subscription of the operator.",
+        "user_name": {
+          "description": "The user that created the role binding.",
           "type": "string"
+        }
+      }
+    },
+    "role-binding-create-params": {
+      "type": "object",
+      "required": [
+        "subject_kind",
+        "subject",
+        "role"
+      ],
+      "properties": {
+        "role": {
+          "description": "The role granted to the subject.",
+          "type": "string",
+          "enum": [
+            "viewer",
+            "operator",
+            "editor"
+          ]
         },
-        "timeout_seconds": {
-          "description": "Positive number represents a timeout in seconds for the operator to be available.",
-          "type": "integer"
+        "subject": {
+          "description": "The name of the user or of the group that the role is granted to.",
+          "type": "string",
+          "minLength": 1
         },
-        "version": {
-          "description": "Operator version",
-          "type": "string"
+        "subject_kind": {
+          "description": "Whether the subject is a user or a group.",
+          "type": "string",
+          "enum": [
+            "user",
+            "group"
+          ]
         }
       }
     },
-    "monitored-operators-list": {
+    "role-binding-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/monitored-operator"
+        "$ref": "#/definitions/role-binding"
       }
     },
-    "mtu-report": {
+    "route": {
       "type": "object",
       "properties": {
-        "mtu_successful": {
-          "type": "boolean"
+        "destination": {
+          "description": "The destination network or destination host",
+          "type": "string"
         },
-        "outgoing_nic": {
+        "family": {
+          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
+          "type": "integer",
+          "format": "int32"
+        },
+        "gateway": {
+          "description": "Gateway address where the packets are sent",
           "type": "string"
         },
-        "remote_ip_address": {
+        "interface": {
+          "description": "Interface to which packets for this route will be sent",
           "type": "string"
+        },
+        "metric": {
+          "description": "Route priority metric",
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "next_step_cmd_request": {
+    "secure-boot-state": {
+      "type": "string",
+      "enum": [
+        "Unknown",
+        "NotSupported",
+        "Enabled",
+        "Disabled"
+      ]
+    },
+    "service_network": {
+      "description": "IP address block for service IP blocks.",
       "type": "object",
-      "required": [
-        "infra_env_id",
-        "host_id",
-        "agent_version"
-      ],
       "properties": {
-        "agent_version": {
-          "description": "Agent image version",
-          "type": "string",
-          "pattern": "^(([a-zA-Z0-9\\-\\.]+)(:[0-9]+)?\\/)?[a-z0-9\\._\\-\\/@]+[?::a-zA-Z0-9_\\-.]+$"
-        },
-        "host_id": {
-          "description": "Host id",
-          "type": "string",
-          "format": "uuid"
+        "cidr": {
+          "description": "The IP block address pool.",
+          "$ref": "#/definitions/subnet"
         },
-        "infra_env_id": {
-          "description": "Infra env id",
+        "cluster_id": {
+          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "format": "uuid"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
         }
       }
     },
-    "node-label-params": {
+    "source_state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not_combined",
+        "error",
+        "variable",
+        "unreachable"
+      ]
+    },
+    "step": {
       "type": "object",
-      "required": [
-        "key",
-        "value"
-      ],
       "properties": {
-        "key": {
-          "description": "The key for the label's key-value pair.",
-          "type": "string"
+        "args": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "value": {
-          "description": "The value for the label's key-value pair.",
+        "step_id": {
           "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "ntp_source": {
+    "step-reply": {
       "type": "object",
       "properties": {
-        "source_name": {
-          "description": "NTP source name or IP.",
+        "error": {
           "type": "string"
         },
-        "source_state": {
-          "description": "Indication of state of an NTP source.",
-          "$ref": "#/definitions/source_state"
-        }
-      }
-    },
-    "ntp_synchronization_request": {
-      "type": "object",
-      "required": [
-        "ntp_source"
-      ],
-      "properties": {
-        "ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+        "exit_code": {
+          "type": "integer"
+        },
+        "output": {
           "type": "string"
+        },
+        "step_id": {
+          "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "ntp_synchronization_response": {
-      "properties": {
-        "ntp_sources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ntp_source"
-          }
-        }
-      }
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "dhcp-lease-allocate",
+        "api-vip-connectivity-check",
+        "tang-connectivity-check",
+        "ntp-synchronizer",
+        "installation-disk-speed-check",
+        "container-image-availability",
+        "domain-resolution",
+        "stop-installation",
+        "logs-gather",
+        "next-step-runner",
+        "upgrade-agent",
+        "download-boot-artifacts",
+        "reboot-for-reclaim",
+        "verify-vips",
+        "network-throughput",
+        "firmware-inspection"
+      ]
     },
-    "openshift-version": {
+    "steps": {
       "type": "object",
-      "required": [
-        "display_name",
-        "support_level",
-        "cpu_architectures"
-      ],
       "properties": {
-        "cpu_architectures": {
-          "description": "Available CPU architectures.",
+        "instructions": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/step"
           }
         },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
-        },
-        "display_name": {
-          "description": "Name of the version to be presented to the user.",
-          "type": "string"
+        "next_instruction_seconds": {
+          "type": "integer"
         },
-        "support_level": {
-          "description": "Level of support of the version.",
+        "post_step_action": {
+          "description": "What to do after finishing to run step instructions",
           "type": "string",
+          "default": "continue",
           "enum": [
-            "beta",
-            "production",
-            "maintenance",
-            "end-of-life"
+            "exit",
+            "continue"
           ]
         }
       }
     },
-    "openshift-versions": {
+    "steps-reply": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step-reply"
+      }
+    },
+    "subnet": {
+      "type": "string",
+      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+      "x-go-custom-tag": "gorm:\"primaryKey\""
+    },
+    "support-level": {
+      "type": "string",
+      "enum": [
+        "supported",
+        "unsupported",
+        "tech-preview",
+        "dev-preview",
+        "unavailable"
+      ]
+    },
+    "support-levels": {
+      "description": "Map of feature ID or CPU architecture alongside their support level",
       "type": "object",
       "additionalProperties": {
-        "$ref": "#/definitions/openshift-version"
+        "$ref": "#/definitions/support-level"
       }
     },
-    "operator-create-params": {
+    "system_vendor": {
       "type": "object",
       "properties": {
-        "name": {
+        "manufacturer": {
           "type": "string"
         },
-        "properties": {
-          "description": "Blob of operator-dependent parameters that are required for installation.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "product_name": {
+          "type": "string"
+        },
+        "serial_number": {
+          "type": "string"
+        },
+        "virtual": {
+          "description": "Whether the machine appears to be a virtual machine or not",
+          "type": "boolean"
         }
       }
     },
-    "operator-hardware-requirements": {
+    "tang_connectivity_request": {
       "type": "object",
+      "required": [
+        "tang_servers"
+      ],
       "properties": {
-        "dependencies": {
-          "description": "List of other operator unique names that are required to be installed. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "operator_name": {
-          "description": "Unique name of the operator. Corresponds to name property of the monitored-operator, i.e. \"lso\", \"cnv\", etc.",
+        "tang_servers": {
+          "description": "JSON-formatted string containing additional information regarding tang's configuration",
           "type": "string"
-        },
-        "requirements": {
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
         }
       }
     },
-    "operator-host-requirements": {
+    "tang_connectivity_response": {
       "type": "object",
       "properties": {
-        "operator_name": {
-          "description": "Name of the operator",
-          "type": "string"
+        "is_success": {
+          "description": "Tang check result.",
+          "type": "boolean"
         },
-        "requirements": {
-          "description": "Host requirements for the operator",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+        "tang_server_response": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "payload": {
+                "description": "Tang response payload.",
+                "type": "string"
+              },
+              "signatures": {
+                "type": "array",
+                "items": {
+                  "type": "object",
+                  "properties": {
+                    "protected": {
+                      "type": "string"
+                    },
+                    "signature": {
+                      "type": "string"
+                    }
+                  },
+                  "x-go-name": "TangServerSignatures"
+                }
+              },
+              "tang_url": {
+                "description": "Tang URL.",
+                "type": "string"
+              }
+            },
+            "x-go-name": "TangServerResponse"
+          }
         }
       }
     },
-    "operator-monitor-report": {
+    "throughput-report": {
       "type": "object",
       "properties": {
-        "name": {
-          "description": "Unique name of the operator.",
+        "remote_ip_address": {
           "type": "string"
         },
-        "status": {
-          "$ref": "#/definitions/operator-status"
-        },
-        "status_info": {
-          "description": "Detailed information about the operator state.",
-          "type": "string"
+        "successful": {
+          "type": "boolean"
         },
-        "version": {
-          "description": "operator version.",
-          "type": "string"
+        "throughput_mbps": {
+          "description": "Throughput measured to the remote address, in Mbps.",
+          "type": "number",
+          "format": "double"
         }
       }
     },
-    "operator-properties": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/operator-property"
-      }
-    },
-    "operator-property": {
+    "update-manifest-params": {
       "type": "object",
+      "required": [
+        "folder",
+        "file_name"
+      ],
       "properties": {
-        "data_type": {
-          "description": "Type of the property",
+        "file_name": {
+          "description": "The file name for the manifest to modify.",
           "type": "string",
-          "enum": [
-            "boolean",
-            "string",
-            "integer",
-            "float"
-          ]
-        },
-        "default_value": {
-          "description": "Default value for the property",
-          "type": "string"
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
+          "x-nullable": false
         },
-        "description": {
-          "description": "Description of a property",
-          "type": "string"
+        "folder": {
+          "description": "The folder for the manifest to modify.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ],
+          "x-nullable": false
         },
-        "mandatory": {
-          "description": "Indicates whether the property is reqired",
-          "type": "boolean"
+        "updated_content": {
+          "description": "The new base64 encoded manifest content.",
+          "type": "string",
+          "x-nullable": true
         },
-        "name": {
-          "description": "Name of the property",
-          "type": "string"
+        "updated_file_name": {
+          "description": "The new file name for the manifest.",
+          "type": "string",
+          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
+          "x-nullable": true
         },
-        "options": {
-          "description": "Values to select from",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "updated_folder": {
+          "description": "The new folder for the manifest. Manifests can be placed in 'manifests' or 'openshift' directories.",
+          "type": "string",
+          "default": "manifests",
+          "enum": [
+            "manifests",
+            "openshift"
+          ],
+          "x-nullable": true
         }
       }
     },
-    "operator-status": {
-      "description": "Represents the operator state.",
-      "type": "string",
-      "enum": [
-        "failed",
-        "progressing",
-        "available"
-      ]
-    },
-    "operator-type": {
-      "description": "Kind of operator. Different types are monitored by the service differently.",
-      "type": "string",
-      "enum": [
-        "builtin",
-        "olm"
-      ]
-    },
-    "os-image": {
+    "upgrade-channel": {
       "type": "object",
       "required": [
-        "openshift_version",
         "cpu_architecture",
-        "url",
-        "version"
+        "channels"
       ],
       "properties": {
+        "channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/release-channel"
+          }
+        },
         "cpu_architecture": {
-          "description": "The CPU architecture of the image (x86_64/arm64/etc).",
+          "description": "The CPU architecture of the image.",
           "type": "string",
-          "default": "x86_64",
           "enum": [
             "x86_64",
             "aarch64",
             "arm64",
             "ppc64le",
-            "s390x"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
-        },
-        "openshift_version": {
-          "description": "Version of the operating system image",
-          "type": "string",
-          "example": "4.12"
-        },
-        "url": {
-          "description": "The base OS image used for the discovery iso.",
-          "type": "string"
-        },
-        "version": {
-          "description": "Build ID of the OS image.",
-          "type": "string"
+            "s390x",
+            "multi"
+          ]
         }
       }
     },
-    "os-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/os-image"
-      }
-    },
-    "platform": {
-      "description": "The configuration for the specific platform upon which to perform the installation.",
+    "upgrade_agent_request": {
       "type": "object",
-      "required": [
-        "type"
-      ],
       "properties": {
-        "external": {
-          "x-nullable": true,
-          "$ref": "#/definitions/platform_external"
-        },
-        "type": {
-          "$ref": "#/definitions/platform_type"
+        "agent_image": {
+          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
+          "type": "string"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:platform_\""
+      }
     },
-    "platform_external": {
-      "description": "Configuration used when installing with an external platform type.",
+    "upgrade_agent_response": {
       "type": "object",
       "properties": {
-        "cloud_controller_manager": {
-          "description": "When set to external, this property will enable an external cloud provider.",
-          "type": "string",
-          "default": "",
-          "enum": [
-            "",
-            "External"
-          ],
-          "x-nullable": true
+        "agent_image": {
+          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
+          "type": "string"
         },
-        "platform_name": {
-          "description": "Holds the arbitrary string representing the infrastructure provider name.",
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": true
+        "result": {
+          "$ref": "#/definitions/upgrade_agent_result"
         }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:external_\""
+      }
     },
-    "platform_type": {
+    "upgrade_agent_result": {
+      "description": "Agent upgrade result.",
       "type": "string",
       "enum": [
-        "baremetal",
-        "nutanix",
-        "vsphere",
-        "none",
-        "external"
+        "success",
+        "failure"
       ]
     },
-    "preflight-hardware-requirements": {
+    "usage": {
       "type": "object",
       "properties": {
-        "ocp": {
-          "description": "Preflight OCP requirements",
-          "$ref": "#/definitions/host-type-hardware-requirements-wrapper"
-        },
-        "operators": {
-          "description": "Preflight operators hardware requirements",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-hardware-requirements"
+        "data": {
+          "description": "additional properties of the feature",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
           }
-        }
-      }
-    },
-    "presigned-url": {
-      "type": "object",
-      "required": [
-        "url"
-      ],
-      "properties": {
-        "expires_at": {
-          "description": "Expiration time for the URL token.",
-          "type": "string",
-          "format": "date-time"
         },
-        "url": {
-          "description": "Pre-signed URL for downloading the infra-env discovery image.",
+        "id": {
+          "description": "Unique idenftifier of the feature",
+          "type": "string"
+        },
+        "name": {
+          "description": "name of the feature to track",
           "type": "string"
         }
       }
     },
-    "proxy": {
+    "v2-cluster-update-params": {
       "type": "object",
       "properties": {
+        "additional_ntp_source": {
+          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip_dns_name": {
+          "description": "The domain name used to reach the OpenShift cluster API.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vips": {
+          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/api_vip"
+          },
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "cluster_networks": {
+          "description": "Cluster networks that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/cluster_network"
+          },
+          "x-nullable": true
+        },
+        "control_plane_count": {
+          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "disk_encryption": {
+          "description": "Installation disks encryption mode and host roles to be applied.",
+          "$ref": "#/definitions/disk-encryption"
+        },
         "http_proxy": {
           "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
@@ -10156,970 +13000,1476 @@ func init() {
         "https_proxy": {
           "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"column:https_proxy\"",
           "x-nullable": true
         },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
+        "hyperthreading": {
+          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
+          "type": "string",
+          "enum": [
+            "none",
+            "masters",
+            "arbiters",
+            "workers",
+            "masters,arbiters",
+            "masters,workers",
+            "arbiters,workers",
+            "masters,arbiters,workers",
+            "all"
+          ],
+          "x-nullable": true
+        },
+        "ignition_endpoint": {
+          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
+          "$ref": "#/definitions/ignition-endpoint"
+        },
+        "ingress_vips": {
+          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ingress_vip"
+          },
+          "x-nullable": true
+        },
+        "install_schedule": {
+          "description": "Time window in which the installation is started automatically once the cluster is ready. Set an empty object to remove the schedule.",
+          "$ref": "#/definitions/install-schedule"
+        },
+        "install_timeouts": {
+          "description": "JSON-formatted string containing per-cluster overrides of the installation timeouts of host stages and finalizing stages, for example {\"host_stages\": {\"Rebooting\": \"2h\"}, \"finalizing_stages\": {\"Waiting for cluster operators\": \"12h\"}}. The timeouts use the Go duration format and stages that are not listed keep the timeouts of the service.",
           "type": "string",
           "x-nullable": true
-        }
-      },
-      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:proxy_\""
-    },
-    "reboot_for_reclaim_request": {
-      "description": "Information sent to the agent for rebooting a host into discovery.",
-      "type": "object",
-      "required": [
-        "host_fs_mount_dir"
-      ],
-      "properties": {
-        "host_fs_mount_dir": {
-          "description": "The base directory on the host that contains the /boot folder. The host needs to\nchroot into this directory in order to properly reboot.",
-          "type": "string"
-        }
-      }
-    },
-    "release-channel": {
-      "description": "Release channel.",
-      "type": "string",
-      "enum": [
-        "candidate",
-        "fast",
-        "stable",
-        "eus"
-      ]
-    },
-    "release-image": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "cpu_architecture",
-        "url",
-        "version"
-      ],
-      "properties": {
-        "cpu_architecture": {
-          "description": "(DEPRECATED) The CPU architecture of the image (x86_64/arm64/etc).",
+        },
+        "load_balancer": {
+          "$ref": "#/definitions/load_balancer"
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
           "type": "string",
-          "default": "x86_64",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ],
-          "x-go-custom-tag": "gorm:\"default:'x86_64'\""
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
         },
-        "cpu_architectures": {
-          "description": "List of CPU architectures provided by the image.",
+        "machine_networks": {
+          "description": "Machine networks that are associated with this cluster.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/machine_network"
           },
-          "x-go-custom-tag": "gorm:\"type:text[]\"",
-          "x-go-type": {
-            "hints": {
-              "noValidation": true
-            },
-            "import": {
-              "package": "github.com/lib/pq"
-            },
-            "type": "StringArray"
-          }
-        },
-        "default": {
-          "description": "Indication that the version is the recommended one.",
-          "type": "boolean"
+          "x-nullable": true
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string"
+        "name": {
+          "description": "OpenShift cluster name.",
+          "type": "string",
+          "maxLength": 54,
+          "minLength": 1,
+          "x-nullable": true
         },
-        "support_level": {
-          "description": "Level of support of the version.",
+        "network_type": {
+          "description": "The desired network type used.",
           "type": "string",
           "enum": [
-            "beta",
-            "production",
-            "maintenance",
-            "end-of-life"
-          ]
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
         },
-        "url": {
-          "description": "The installation image of the OpenShift cluster.",
+        "no_proxy": {
+          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"primarykey\""
+          "x-nullable": true
         },
-        "version": {
-          "description": "OCP version from the release metadata.",
-          "type": "string"
-        }
-      }
-    },
-    "release-images": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/release-image"
-      }
-    },
-    "release-source": {
-      "type": "object",
-      "required": [
-        "openshift_version",
-        "multi_cpu_architectures",
-        "upgrade_channels"
-      ],
-      "properties": {
-        "multi_cpu_architectures": {
+        "olm_operators": {
+          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
           "type": "array",
           "items": {
-            "description": "Supported CPU architecture for multi-architecture releases in this OpenShift version..",
-            "type": "string",
-            "enum": [
-              "x86_64",
-              "aarch64",
-              "arm64",
-              "ppc64le",
-              "s390x"
-            ]
+            "$ref": "#/definitions/operator-create-params"
           }
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "platform": {
+          "$ref": "#/definitions/platform"
+        },
+        "pull_secret": {
+          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
           "type": "string",
-          "example": "4.14"
+          "x-nullable": true
         },
-        "upgrade_channels": {
+        "schedulable_masters": {
+          "description": "Schedule workloads on masters",
+          "type": "boolean",
+          "default": false
+        },
+        "secure_boot_mode": {
+          "description": "The secure boot state required from the hosts of the cluster, 'any' to accept both states.",
+          "type": "string",
+          "enum": [
+            "any",
+            "enabled",
+            "disabled"
+          ],
+          "x-nullable": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
+          "x-nullable": true
+        },
+        "service_networks": {
+          "description": "Service networks that are associated with this cluster.",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/upgrade-channel"
-          }
+            "$ref": "#/definitions/service_network"
+          },
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "tags": {
+          "description": "A comma-separated list of tags that are associated to the cluster.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "user_managed_networking": {
+          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_dhcp_allocation": {
+          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
+          "type": "boolean",
+          "x-nullable": true
         }
       }
     },
-    "release-sources": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/release-source"
-      }
-    },
-    "route": {
+    "verified_vip": {
+      "description": "Single VIP verification result.",
       "type": "object",
       "properties": {
-        "destination": {
-          "description": "The destination network or destination host",
-          "type": "string"
-        },
-        "family": {
-          "description": "Defines whether this is an IPv4 (4) or IPv6 route (6)",
-          "type": "integer",
-          "format": "int32"
-        },
-        "gateway": {
-          "description": "Gateway address where the packets are sent",
-          "type": "string"
+        "verification": {
+          "$ref": "#/definitions/vip_verification"
         },
-        "interface": {
-          "description": "Interface to which packets for this route will be sent",
-          "type": "string"
+        "vip": {
+          "$ref": "#/definitions/ip"
         },
-        "metric": {
-          "description": "Route priority metric",
-          "type": "integer",
-          "format": "int32"
+        "vip_type": {
+          "$ref": "#/definitions/vip_type"
         }
       }
     },
-    "secure-boot-state": {
-      "type": "string",
-      "enum": [
-        "Unknown",
-        "NotSupported",
-        "Enabled",
-        "Disabled"
-      ]
-    },
-    "service_network": {
-      "description": "IP address block for service IP blocks.",
+    "verify_vip": {
+      "description": "Request to verify single vip.",
       "type": "object",
       "properties": {
-        "cidr": {
-          "description": "The IP block address pool.",
-          "$ref": "#/definitions/subnet"
+        "vip": {
+          "$ref": "#/definitions/ip"
         },
-        "cluster_id": {
-          "description": "A network to use for service IP addresses. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primaryKey\""
+        "vip_type": {
+          "$ref": "#/definitions/vip_type"
         }
       }
     },
-    "source_state": {
-      "type": "string",
-      "enum": [
-        "synced",
-        "combined",
-        "not_combined",
-        "error",
-        "variable",
-        "unreachable"
-      ]
+    "verify_vips_request": {
+      "description": "list of vips to be verified.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/verify_vip"
+      }
     },
-    "step": {
+    "verify_vips_response": {
+      "description": "list of verified vips.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/verified_vip"
+      }
+    },
+    "versioned-host-requirements": {
       "type": "object",
       "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "arbiter": {
+          "description": "Arbiter node requirements",
+          "x-go-name": "ArbiterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "edge-worker": {
+          "description": "Edge Worker OpenShift node requirements",
+          "x-go-name": "EdgeWorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "master": {
+          "description": "Master node requirements",
+          "x-go-name": "MasterRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
+        },
+        "sno": {
+          "description": "Single node OpenShift node requirements",
+          "x-go-name": "SNORequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         },
-        "step_id": {
+        "version": {
+          "description": "Version of the component for which requirements are defined",
           "type": "string"
         },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+        "worker": {
+          "description": "Worker node requirements",
+          "x-go-name": "WorkerRequirements",
+          "$ref": "#/definitions/cluster-host-requirements-details"
         }
       }
     },
-    "step-reply": {
+    "versions": {
       "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
-        }
+      "additionalProperties": {
+        "type": "string"
       }
     },
-    "step-type": {
+    "vip_type": {
+      "description": "The vip type.",
       "type": "string",
       "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "dhcp-lease-allocate",
-        "api-vip-connectivity-check",
-        "tang-connectivity-check",
-        "ntp-synchronizer",
-        "installation-disk-speed-check",
-        "container-image-availability",
-        "domain-resolution",
-        "stop-installation",
-        "logs-gather",
-        "next-step-runner",
-        "upgrade-agent",
-        "download-boot-artifacts",
-        "reboot-for-reclaim",
-        "verify-vips"
+        "api",
+        "ingress"
       ]
     },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+    "vip_verification": {
+      "description": "vip verification result.",
+      "type": "string",
+      "default": "unverified",
+      "enum": [
+        "unverified",
+        "failed",
+        "succeeded"
+      ]
+    }
+  },
+  "securityDefinitions": {
+    "agentAuth": {
+      "type": "apiKey",
+      "name": "X-Secret-Key",
+      "in": "header"
+    },
+    "imageAuth": {
+      "type": "apiKey",
+      "name": "Image-Token",
+      "in": "header"
+    },
+    "imageURLAuth": {
+      "type": "apiKey",
+      "name": "image_token",
+      "in": "query"
+    },
+    "urlAuth": {
+      "type": "apiKey",
+      "name": "api_key",
+      "in": "query"
+    },
+    "userAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    },
+    "watcherAuth": {
+      "type": "apiKey",
+      "name": "Watcher-Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": [
+        "admin",
+        "user"
+      ]
+    }
+  ],
+  "tags": [
+    {
+      "description": "Agent-driven installation",
+      "name": "Assisted installation"
+    },
+    {
+      "description": "Audit trail of the changes made to clusters, hosts and infra-envs.",
+      "name": "audit"
+    },
+    {
+      "description": "Events related to a cluster installation.",
+      "name": "events"
+    },
+    {
+      "description": "General OpenShift cluster installation APIs.",
+      "name": "installer"
+    },
+    {
+      "description": "Managed dns domains for a cluster installation.",
+      "name": "managed_domains"
+    },
+    {
+      "description": "Manifests for customizing a cluster installation.",
+      "name": "manifests"
+    },
+    {
+      "description": "Information regarding supported operators.",
+      "name": "operators"
+    },
+    {
+      "description": "Information regarding versions.",
+      "name": "versions"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install",
+  "paths": {
+    "/v2/audit-records": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin"
+            ]
+          }
+        ],
+        "description": "Lists the audit records of the changes made through the API and by the controllers, most recent first.",
+        "tags": [
+          "audit"
+        ],
+        "operationId": "v2ListAuditRecords",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the changes made to this cluster.",
+            "name": "cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the changes made to this infra-env.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Only return the changes made to this host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the changes made by this user, agent or controller.",
+            "name": "actor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return the changes made by this operation.",
+            "name": "operation_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the changes made at or after this time.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return the changes made before this time.",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/audit-record-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
-        },
-        "post_step_action": {
-          "description": "What to do after finishing to run step instructions",
-          "type": "string",
-          "default": "continue",
-          "enum": [
-            "exit",
-            "continue"
-          ]
         }
       }
     },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "subnet": {
-      "type": "string",
-      "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-      "x-go-custom-tag": "gorm:\"primaryKey\""
-    },
-    "support-level": {
-      "type": "string",
-      "enum": [
-        "supported",
-        "unsupported",
-        "tech-preview",
-        "dev-preview",
-        "unavailable"
-      ]
-    },
-    "support-levels": {
-      "description": "Map of feature ID or CPU architecture alongside their support level",
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/support-level"
-      }
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
-        },
-        "virtual": {
-          "description": "Whether the machine appears to be a virtual machine or not",
-          "type": "boolean"
+    "/v2/clusters": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift clusters.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListClusters",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific cluster to retrieve.",
+            "name": "openshift_cluster_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
+            "name": "ams_subscription_ids",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Include hosts in the returned list.",
+            "name": "with_hosts",
+            "in": "query",
+            "allowEmptyValue": true
+          },
+          {
+            "type": "string",
+            "description": "If provided, returns only clusters that are owned by the specified user.",
+            "name": "owner",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Creates a new OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2RegisterCluster",
+        "parameters": [
+          {
+            "description": "The properties describing the new cluster.",
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tang_connectivity_request": {
-      "type": "object",
-      "required": [
-        "tang_servers"
-      ],
-      "properties": {
-        "tang_servers": {
-          "description": "JSON-formatted string containing additional information regarding tang's configuration",
-          "type": "string"
+    "/v2/clusters/default-config": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the default values for various cluster properties.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2GetClusterDefaultConfig",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster_default_config"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tang_connectivity_response": {
-      "type": "object",
-      "properties": {
-        "is_success": {
-          "description": "Tang check result.",
-          "type": "boolean"
-        },
-        "tang_server_response": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "payload": {
-                "description": "Tang response payload.",
-                "type": "string"
-              },
-              "signatures": {
-                "type": "array",
-                "items": {
-                  "type": "object",
-                  "properties": {
-                    "protected": {
-                      "type": "string"
-                    },
-                    "signature": {
-                      "type": "string"
-                    }
-                  },
-                  "x-go-name": "TangServerSignatures"
-                }
-              },
-              "tang_url": {
-                "description": "Tang URL.",
-                "type": "string"
-              }
-            },
-            "x-go-name": "TangServerResponse"
+    "/v2/clusters/import": {
+      "post": {
+        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ImportCluster",
+        "parameters": [
+          {
+            "description": "Parameters for importing a OCP cluster for adding nodes.",
+            "name": "new-import-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/import-cluster-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "update-manifest-params": {
-      "type": "object",
-      "required": [
-        "folder",
-        "file_name"
-      ],
-      "properties": {
-        "file_name": {
-          "description": "The file name for the manifest to modify.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
-          "x-nullable": false
-        },
-        "folder": {
-          "description": "The folder for the manifest to modify.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ],
-          "x-nullable": false
-        },
-        "updated_content": {
-          "description": "The new base64 encoded manifest content.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "updated_file_name": {
-          "description": "The new file name for the manifest.",
-          "type": "string",
-          "pattern": "^[^\\/]*\\.(json|ya?ml(\\.patch_?[a-zA-Z0-9_]*)?)$",
-          "x-nullable": true
-        },
-        "updated_folder": {
-          "description": "The new folder for the manifest. Manifests can be placed in 'manifests' or 'openshift' directories.",
-          "type": "string",
-          "default": "manifests",
-          "enum": [
-            "manifests",
-            "openshift"
-          ],
-          "x-nullable": true
+    "/v2/clusters/{cluster_id}": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "watcherAuth": []
+          }
+        ],
+        "description": "Retrieves the details of the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving the cluster details.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to return clusters that have been unregistered.",
+            "name": "get_unregistered_clusters",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, do not include hosts.",
+            "name": "exclude-hosts",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "upgrade-channel": {
-      "type": "object",
-      "required": [
-        "cpu_architecture",
-        "channels"
-      ],
-      "properties": {
-        "channels": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/release-channel"
+      },
+      "delete": {
+        "description": "Deletes an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be deregistered.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "cpu_architecture": {
-          "description": "The CPU architecture of the image.",
-          "type": "string",
-          "enum": [
-            "x86_64",
-            "aarch64",
-            "arm64",
-            "ppc64le",
-            "s390x",
-            "multi"
-          ]
         }
-      }
-    },
-    "upgrade_agent_request": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent should upgrade to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
+      },
+      "patch": {
+        "description": "Updates an OpenShift cluster definition.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be updated.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2-cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade_agent_response": {
-      "type": "object",
-      "properties": {
-        "agent_image": {
-          "description": "Full image reference of the image that the agent has upgraded to, for example\n` + "`" + `quay.io/registry-proxy.engineering.redhat.com/rh-osbs/openshift4-assisted-installer-agent-rhel8:v1.0.0-142` + "`" + `.\n",
-          "type": "string"
-        },
-        "result": {
-          "$ref": "#/definitions/upgrade_agent_result"
+    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
+      "post": {
+        "description": "Transforms installed cluster to a state which allows adding hosts.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "TransformClusterToAddingHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to transform.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "upgrade_agent_result": {
-      "description": "Agent upgrade result.",
-      "type": "string",
-      "enum": [
-        "success",
-        "failure"
-      ]
-    },
-    "usage": {
-      "type": "object",
-      "properties": {
-        "data": {
-          "description": "additional properties of the feature",
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
+      "post": {
+        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "TransformClusterToDay2",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to transform.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "description": "Unique idenftifier of the feature",
-          "type": "string"
-        },
-        "name": {
-          "description": "name of the feature to track",
-          "type": "string"
         }
       }
     },
-    "v2-cluster-update-params": {
-      "type": "object",
-      "properties": {
-        "additional_ntp_source": {
-          "description": "A comma-separated list of NTP sources (name or IP) going to be added to all the hosts.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vip_dns_name": {
-          "description": "The domain name used to reach the OpenShift cluster API.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "api_vips": {
-          "description": "The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/api_vip"
+    "/v2/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "description": "Cancels an ongoing installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be canceled.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
           },
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 128,
-          "minimum": 1,
-          "x-nullable": true
-        },
-        "cluster_networks": {
-          "description": "Cluster networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cluster_network"
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-nullable": true
-        },
-        "control_plane_count": {
-          "description": "Specifies the required number of control plane nodes that should be part of the cluster.",
-          "type": "integer",
-          "x-nullable": true
-        },
-        "disk_encryption": {
-          "description": "Installation disks encryption mode and host roles to be applied.",
-          "$ref": "#/definitions/disk-encryption"
-        },
-        "http_proxy": {
-          "description": "A proxy URL to use for creating HTTP connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "https_proxy": {
-          "description": "A proxy URL to use for creating HTTPS connections outside the cluster.\nhttp://\\\u003cusername\\\u003e:\\\u003cpswd\\\u003e@\\\u003cip\\\u003e:\\\u003cport\\\u003e\n",
-          "type": "string",
-          "x-nullable": true
-        },
-        "hyperthreading": {
-          "description": "Enable/disable hyperthreading on master nodes, arbiter nodes, worker nodes, or a combination of them.",
-          "type": "string",
-          "enum": [
-            "none",
-            "masters",
-            "arbiters",
-            "workers",
-            "masters,arbiters",
-            "masters,workers",
-            "arbiters,workers",
-            "masters,arbiters,workers",
-            "all"
-          ],
-          "x-nullable": true
-        },
-        "ignition_endpoint": {
-          "description": "Explicit ignition endpoint overrides the default ignition endpoint.",
-          "$ref": "#/definitions/ignition-endpoint"
-        },
-        "ingress_vips": {
-          "description": "The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ingress_vip"
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
           },
-          "x-nullable": true
-        },
-        "load_balancer": {
-          "$ref": "#/definitions/load_balancer"
-        },
-        "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "machine_networks": {
-          "description": "Machine networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/machine_network"
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-nullable": true
-        },
-        "name": {
-          "description": "OpenShift cluster name.",
-          "type": "string",
-          "maxLength": 54,
-          "minLength": 1,
-          "x-nullable": true
-        },
-        "network_type": {
-          "description": "The desired network type used.",
-          "type": "string",
-          "enum": [
-            "OpenShiftSDN",
-            "OVNKubernetes"
-          ],
-          "x-nullable": true
-        },
-        "no_proxy": {
-          "description": "An \"*\" or a comma-separated list of destination domain names, domains, IP addresses, or other network CIDRs to exclude from proxying.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "olm_operators": {
-          "description": "List of OLM operators to be installed.\nFor the full list of supported operators, check the endpoint ` + "`" + `/v2/supported-operators` + "`" + `:\n",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/operator-create-params"
-          }
-        },
-        "platform": {
-          "$ref": "#/definitions/platform"
-        },
-        "pull_secret": {
-          "description": "The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "schedulable_masters": {
-          "description": "Schedule workloads on masters",
-          "type": "boolean",
-          "default": false
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3}\\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$",
-          "x-nullable": true
-        },
-        "service_networks": {
-          "description": "Service networks that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/service_network"
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
-          "x-nullable": true
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "tags": {
-          "description": "A comma-separated list of tags that are associated to the cluster.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "user_managed_networking": {
-          "description": "(DEPRECATED) Indicate if the networking is managed by the user.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "vip_dhcp_allocation": {
-          "description": "Indicate if virtual IP DHCP allocation mode is enabled.",
-          "type": "boolean",
-          "x-nullable": true
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verified_vip": {
-      "description": "Single VIP verification result.",
-      "type": "object",
-      "properties": {
-        "verification": {
-          "$ref": "#/definitions/vip_verification"
-        },
-        "vip": {
-          "$ref": "#/definitions/ip"
-        },
-        "vip_type": {
-          "$ref": "#/definitions/vip_type"
+    "/v2/clusters/{cluster_id}/actions/complete-installation": {
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is being completing.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The final status of the cluster installation.",
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is completing the installation.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verify_vip": {
-      "description": "Request to verify single vip.",
-      "type": "object",
-      "properties": {
-        "vip": {
-          "$ref": "#/definitions/ip"
-        },
-        "vip_type": {
-          "$ref": "#/definitions/vip_type"
+    "/v2/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "description": "Installs the OpenShift cluster.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster to be installed.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "verify_vips_request": {
-      "description": "list of vips to be verified.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/verify_vip"
-      }
-    },
-    "verify_vips_response": {
-      "description": "list of verified vips.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/verified_vip"
-      }
-    },
-    "versioned-host-requirements": {
-      "type": "object",
-      "properties": {
-        "arbiter": {
-          "description": "Arbiter node requirements",
-          "x-go-name": "ArbiterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "edge-worker": {
-          "description": "Edge Worker OpenShift node requirements",
-          "x-go-name": "EdgeWorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "master": {
-          "description": "Master node requirements",
-          "x-go-name": "MasterRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "sno": {
-          "description": "Single node OpenShift node requirements",
-          "x-go-name": "SNORequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
-        },
-        "version": {
-          "description": "Version of the component for which requirements are defined",
-          "type": "string"
-        },
-        "worker": {
-          "description": "Worker node requirements",
-          "x-go-name": "WorkerRequirements",
-          "$ref": "#/definitions/cluster-host-requirements-details"
+    "/v2/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "description": "Resets a failed installation.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster whose installation is to be reset.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    },
-    "vip_type": {
-      "description": "The vip type.",
-      "type": "string",
-      "enum": [
-        "api",
-        "ingress"
-      ]
-    },
-    "vip_verification": {
-      "description": "vip verification result.",
-      "type": "string",
-      "default": "unverified",
-      "enum": [
-        "unverified",
-        "failed",
-        "succeeded"
-      ]
-    }
-  },
-  "securityDefinitions": {
-    "agentAuth": {
-      "type": "apiKey",
-      "name": "X-Secret-Key",
-      "in": "header"
-    },
-    "imageAuth": {
-      "type": "apiKey",
-      "name": "Image-Token",
-      "in": "header"
-    },
-    "imageURLAuth": {
-      "type": "apiKey",
-      "name": "image_token",
-      "in": "query"
-    },
-    "urlAuth": {
-      "type": "apiKey",
-      "name": "api_key",
-      "in": "query"
-    },
-    "userAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    },
-    "watcherAuth": {
-      "type": "apiKey",
-      "name": "Watcher-Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "userAuth": [
-        "admin",
-        "user"
-      ]
-    }
-  ],
-  "tags": [
-    {
-      "description": "Agent-driven installation",
-      "name": "Assisted installation"
-    },
-    {
-      "description": "Events related to a cluster installation.",
-      "name": "events"
-    },
-    {
-      "description": "General OpenShift cluster installation APIs.",
-      "name": "installer"
-    },
-    {
-      "description": "Managed dns domains for a cluster installation.",
-      "name": "managed_domains"
-    },
-    {
-      "description": "Manifests for customizing a cluster installation.",
-      "name": "manifests"
-    },
-    {
-      "description": "Information regarding supported operators.",
-      "name": "operators"
-    },
-    {
-      "description": "Information regarding versions.",
-      "name": "versions"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install",
-  "paths": {
-    "/v2/clusters": {
+    "/v2/clusters/{cluster_id}/credentials": {
       "get": {
         "security": [
           {
             "userAuth": [
-              "admin",
-              "read-only-admin",
               "user"
             ]
-          },
-          {
-            "watcherAuth": []
           }
         ],
-        "description": "Retrieves the list of OpenShift clusters.",
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListClusters",
+        "operationId": "V2GetCredentials",
         "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "A specific cluster to retrieve.",
-            "name": "openshift_cluster_id",
-            "in": "query"
+            "description": "The cluster whose admin credentials should be retrieved.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/credentials"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
           },
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "If non-empty, returned Clusters are filtered to those with matching subscription IDs.",
-            "name": "ams_subscription_ids",
-            "in": "query"
+            "urlAuth": []
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Include hosts in the returned list.",
-            "name": "with_hosts",
-            "in": "query",
-            "allowEmptyValue": true
+            "agentAuth": []
+          }
+        ],
+        "description": "Downloads credentials relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "operationId": "V2DownloadClusterCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the credential file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           },
           {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
             "type": "string",
-            "description": "If provided, returns only clusters that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "description": "The credential file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "type": "file"
             }
           },
           "401": {
@@ -11134,12 +14484,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11153,29 +14515,49 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Creates a new OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "user"
+            ]
+          }
+        ],
+        "description": "Get the cluster admin credentials.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterCluster",
+        "operationId": "V2GetPresignedForClusterCredentials",
         "parameters": [
           {
-            "description": "The properties describing the new cluster.",
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "400": {
@@ -11196,12 +14578,24 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11211,7 +14605,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/default-config": {
+    "/v2/clusters/{cluster_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -11220,18 +14614,60 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
+          },
+          {
+            "urlAuth": []
           }
         ],
-        "description": "Get the default values for various cluster properties.",
+        "description": "Downloads files relating to the installed/installing cluster.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetClusterDefaultConfig",
+        "operationId": "V2DownloadClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "custom_manifests.json",
+              "custom_manifests.yaml",
+              "arbiter.ign"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is downloading the file.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster_default_config"
+              "type": "file"
             }
           },
           "401": {
@@ -11246,38 +14682,110 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/import": {
-      "post": {
-        "description": "Import an AI cluster using minimal data associated with existing OCP cluster, in order to allow adding day2 hosts to that cluster",
+    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ImportCluster",
+        "operationId": "V2GetPresignedForClusterFiles",
         "parameters": [
           {
-            "description": "Parameters for importing a OCP cluster for adding nodes.",
-            "name": "new-import-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/import-cluster-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The cluster that owns the file that should be downloaded.",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "install-config.yaml",
+              "logs",
+              "manifests"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "If downloading logs, the type of logs to download.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "If downloading a file related to a host, the relevant host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
+            "name": "additional_name",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "400": {
@@ -11295,7 +14803,25 @@ func init() {
           "403": {
             "description": "Forbidden.",
             "schema": {
-              "$ref": "#/definitions/infra_error"
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -11307,7 +14833,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}": {
+    "/v2/clusters/{cluster_id}/hosts": {
       "get": {
         "security": [
           {
@@ -11319,42 +14845,50 @@ func init() {
           },
           {
             "agentAuth": []
-          },
-          {
-            "watcherAuth": []
           }
         ],
-        "description": "Retrieves the details of the OpenShift cluster.",
+        "description": "Get a list of cluster hosts according to supplied filters.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetCluster",
+        "operationId": "ListClusterHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be retrieved.",
+            "description": "The cluster whose hosts should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "master",
+              "arbiter",
+              "worker",
+              "auto-assign"
+            ],
             "type": "string",
-            "description": "The software version of the discovery agent that is retrieving the cluster details.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "Role to request.",
+            "name": "role",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Hosts status to request.",
+            "name": "status",
+            "in": "query"
           },
           {
             "type": "boolean",
-            "default": false,
-            "description": "Whether to return clusters that have been unregistered.",
-            "name": "get_unregistered_clusters",
-            "in": "header"
+            "description": "If true return the host's inventory.",
+            "name": "with-inventory",
+            "in": "query"
           },
           {
             "type": "boolean",
-            "description": "If true, do not include hosts.",
-            "name": "exclude-hosts",
+            "description": "If true return the host's connectivity.",
+            "name": "with-connectivity",
             "in": "query"
           }
         ],
@@ -11362,7 +14896,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -11383,77 +14917,46 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an OpenShift cluster definition.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/ignored-validations": {
+      "get": {
+        "description": "Fetch the validations which are to be ignored for this cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterCluster",
+        "operationId": "v2GetIgnoredValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be deregistered.",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/ignored-validations"
             }
           },
-          "409": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -11461,28 +14964,28 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Updates an OpenShift cluster definition.",
+      "put": {
+        "description": "Register the validations which are to be ignored for this cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UpdateCluster",
+        "operationId": "v2SetIgnoredValidations",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be updated.",
+            "description": "The cluster whose failing validations should be ignored according to this list.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "cluster-update-params",
+            "description": "The validations to be ignored.",
+            "name": "ignored_validations",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/v2-cluster-update-params"
+              "$ref": "#/definitions/ignored-validations"
             }
           }
         ],
@@ -11490,7 +14993,7 @@ func init() {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/ignored-validations"
             }
           },
           "400": {
@@ -11517,18 +15020,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11538,92 +15029,37 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/allow-add-hosts": {
-      "post": {
-        "description": "Transforms installed cluster to a state which allows adding hosts.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "TransformClusterToAddingHosts",
-        "parameters": [
+    "/v2/clusters/{cluster_id}/install-config": {
+      "get": {
+        "security": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to transform.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/allow-add-workers": {
-      "post": {
-        "description": "Deprecated, maintained for legacy purposes. Does the same thing as allow-add-hosts. Use allow-add-hosts instead.",
+        "description": "Get the cluster's install config YAML.",
         "tags": [
           "installer"
         ],
-        "operationId": "TransformClusterToDay2",
-        "deprecated": true,
+        "operationId": "v2GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to transform.",
+            "description": "The cluster whose install config is being retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string"
             }
           },
           "401": {
@@ -11650,12 +15086,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11663,30 +15093,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/cancel": {
-      "post": {
-        "description": "Cancels an ongoing installation.",
+      },
+      "patch": {
+        "description": "Override values in the install config.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2CancelInstallation",
+        "operationId": "v2UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be canceled.",
+            "description": "The cluster whose install config is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Install config overrides.",
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -11713,12 +15153,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -11727,49 +15161,38 @@ func init() {
           }
         }
       }
-    },
-    "/v2/clusters/{cluster_id}/actions/complete-installation": {
-      "post": {
+    },
+    "/v2/clusters/{cluster_id}/installation-timeline": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to mark a finalizing installation as complete and progress to 100%.",
+        "description": "Retrieves the timeline of the last installation of the cluster, with the start and end of each stage of the hosts and of the finalizing stages of the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2CompleteInstallation",
+        "operationId": "v2GetClusterInstallationTimeline",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is being completing.",
+            "description": "The cluster whose installation timeline should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The final status of the cluster installation.",
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is completing the installation.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/installation-timeline"
             }
           },
           "401": {
@@ -11796,55 +15219,70 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/actions/install": {
-      "post": {
-        "description": "Installs the OpenShift cluster.",
+    "/v2/clusters/{cluster_id}/logs": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          }
+        ],
+        "description": "Download cluster logs.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallCluster",
+        "operationId": "V2DownloadClusterLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to be installed.",
+            "description": "The cluster whose logs should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "host",
+              "controller",
+              "all"
+            ],
+            "type": "string",
+            "description": "The type of logs to be downloaded.",
+            "name": "logs_type",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "A specific host in the cluster whose logs should be downloaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "type": "file"
             }
           },
           "401": {
@@ -11884,31 +15322,67 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/actions/reset": {
+      },
       "post": {
-        "description": "Resets a failed installation.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Agent API to upload logs.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetCluster",
+        "operationId": "V2UploadLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose installation is to be reset.",
+            "description": "The cluster whose logs should be uploaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "maxLength": 104857600,
+            "type": "file",
+            "x-mimetype": "application/zip",
+            "description": "The log file to be uploaded.",
+            "name": "upfile",
+            "in": "formData"
+          },
+          {
+            "enum": [
+              "host",
+              "controller"
+            ],
+            "type": "string",
+            "description": "The type of log file to be uploaded.",
+            "name": "logs_type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env ID of the host.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose logs should be uploaded.",
+            "name": "host_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -11945,40 +15419,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/credentials": {
-      "get": {
+    "/v2/clusters/{cluster_id}/logs-progress": {
+      "put": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "agentAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2GetCredentials",
+        "operationId": "v2UpdateClusterLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose admin credentials should be retrieved.",
+            "description": "The cluster whose log progress is being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/logs-progress-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/credentials"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12015,60 +15499,50 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/downloads/credentials": {
+    "/v2/clusters/{cluster_id}/manifests": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Downloads credentials relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists manifests for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterCredentials",
+        "operationId": "V2ListClusterManifests",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the credential file that should be downloaded.",
+            "description": "The cluster for which the manifests should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The credential file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "type": "boolean",
+            "default": false,
+            "description": "Include system generated manifests in results? Default is false.",
+            "name": "include_system_generated",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/list-manifests"
             }
           },
           "401": {
@@ -12106,57 +15580,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/credentials-presigned": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "userAuth": [
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Get the cluster admin credentials.",
+        "description": "Creates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetPresignedForClusterCredentials",
+        "operationId": "V2CreateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster for which a new manifest should be created.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "The new manifest to create.",
+            "name": "CreateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/create-manifest-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/manifest"
             }
           },
           "400": {
@@ -12202,72 +15663,44 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
-          },
-          {
-            "urlAuth": []
-          }
-        ],
-        "description": "Downloads files relating to the installed/installing cluster.",
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "delete": {
+        "description": "Deletes a manifest from the cluster.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2DownloadClusterFiles",
+        "operationId": "V2DeleteClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster whose manifest should be deleted.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "custom_manifests.json",
-              "custom_manifests.yaml",
-              "arbiter.ign"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The software version of the discovery agent that is downloading the file.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "The manifest file name to delete from the cluster.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "file"
-            }
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12304,87 +15737,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/downloads/files-presigned": {
-      "get": {
+      },
+      "patch": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "userAuth": []
           }
         ],
-        "description": "Retrieves a pre-signed S3 URL for downloading cluster files.",
+        "description": "Updates a manifest for customizing cluster installation.",
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "V2GetPresignedForClusterFiles",
+        "operationId": "V2UpdateClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster that owns the file that should be downloaded.",
+            "description": "The cluster for which a new manifest should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "install-config.yaml",
-              "logs",
-              "manifests"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "If downloading logs, the type of logs to download.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If downloading a file related to a host, the relevant host.",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "If downloading a manifest, the file name, prefaced with folder name, for example, openshift/99-openshift-xyz.yaml.",
-            "name": "additional_name",
-            "in": "query"
+            "description": "The manifest to be updated.",
+            "name": "UpdateManifestParams",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/update-manifest-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/manifest"
             }
           },
           "400": {
@@ -12432,70 +15822,54 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/hosts": {
+    "/v2/clusters/{cluster_id}/manifests/files": {
       "get": {
         "security": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "agentAuth": []
+            "userAuth": []
           }
         ],
-        "description": "Get a list of cluster hosts according to supplied filters.",
+        "description": "Downloads cluster manifest.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "installer"
+          "manifests"
         ],
-        "operationId": "ListClusterHosts",
+        "operationId": "v2DownloadClusterManifest",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose hosts should be retrieved.",
+            "description": "The cluster whose manifest should be downloaded.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "enum": [
-              "master",
-              "arbiter",
-              "worker",
-              "auto-assign"
+              "manifests",
+              "openshift"
             ],
             "type": "string",
-            "description": "Role to request.",
-            "name": "role",
+            "default": "manifests",
+            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
+            "name": "folder",
             "in": "query"
           },
           {
             "type": "string",
-            "description": "Hosts status to request.",
-            "name": "status",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's inventory.",
-            "name": "with-inventory",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "If true return the host's connectivity.",
-            "name": "with-connectivity",
-            "in": "query"
+            "description": "The manifest file name to download.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "type": "file"
             }
           },
           "401": {
@@ -12516,6 +15890,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12525,37 +15911,67 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/ignored-validations": {
+    "/v2/clusters/{cluster_id}/monitored-operators": {
       "get": {
-        "description": "Fetch the validations which are to be ignored for this cluster.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Lists operators to be monitored for a cluster.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "v2GetIgnoredValidations",
+        "operationId": "V2ListOfClusterOperators",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "description": "The cluster to return operators for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "An operator in the specified cluster to return its data.",
+            "name": "operator_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
+              "$ref": "#/definitions/monitored-operators-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
             }
           },
-          "400": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -12564,39 +15980,42 @@ func init() {
         }
       },
       "put": {
-        "description": "Register the validations which are to be ignored for this cluster.",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Controller API to report of monitored operators.",
         "tags": [
+          "operators",
           "installer"
         ],
-        "operationId": "v2SetIgnoredValidations",
+        "operationId": "v2ReportMonitoredOperatorStatus",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose failing validations should be ignored according to this list.",
+            "description": "The cluster whose operators are being monitored.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The validations to be ignored.",
-            "name": "ignored_validations",
+            "description": "The operators monitor report.",
+            "name": "report-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ignored-validations"
+              "$ref": "#/definitions/operator-monitor-report"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/ignored-validations"
-            }
+          "200": {
+            "description": "Success."
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -12619,16 +16038,34 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/install-config": {
+    "/v2/clusters/{cluster_id}/preflight-requirements": {
       "get": {
         "security": [
           {
@@ -12639,16 +16076,16 @@ func init() {
             ]
           }
         ],
-        "description": "Get the cluster's install config YAML.",
+        "description": "Get preflight requirements for a cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetClusterInstallConfig",
+        "operationId": "v2GetPreflightRequirements",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being retrieved.",
+            "description": "The cluster to return preflight requirements for.",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -12658,7 +16095,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/preflight-hardware-requirements"
             }
           },
           "401": {
@@ -12692,41 +16129,42 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Override values in the install config.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update installation finalizing progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterInstallConfig",
+        "operationId": "v2UpdateClusterFinalizingProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose install config is being updated.",
+            "description": "The cluster being updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Install config overrides.",
-            "name": "install-config-params",
+            "description": "New progress value.",
+            "name": "finalizing-progress",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/cluster-finalizing-progress"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "200": {
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -12757,11 +16195,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs": {
+    "/v2/clusters/{cluster_id}/role-bindings": {
       "get": {
         "security": [
           {
@@ -12770,52 +16214,28 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
           }
         ],
-        "description": "Download cluster logs.",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Lists the roles granted on the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2DownloadClusterLogs",
+        "operationId": "v2ListClusterRoleBindings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be downloaded.",
+            "description": "The cluster whose role bindings should be listed.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "host",
-              "controller",
-              "all"
-            ],
-            "type": "string",
-            "description": "The type of logs to be downloaded.",
-            "name": "logs_type",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "A specific host in the cluster whose logs should be downloaded.",
-            "name": "host_id",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/role-binding-list"
             }
           },
           "401": {
@@ -12842,12 +16262,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -12859,63 +16273,48 @@ func init() {
       "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "user"
+            ]
           }
         ],
-        "description": "Agent API to upload logs.",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Grants a role on the cluster to a user or to a group.",
         "tags": [
           "installer"
         ],
-        "operationId": "V2UploadLogs",
+        "operationId": "v2CreateClusterRoleBinding",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose logs should be uploaded.",
+            "description": "The cluster that the role is granted on.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "maxLength": 104857600,
-            "type": "file",
-            "x-mimetype": "application/zip",
-            "description": "The log file to be uploaded.",
-            "name": "upfile",
-            "in": "formData"
-          },
-          {
-            "enum": [
-              "host",
-              "controller"
-            ],
-            "type": "string",
-            "description": "The type of log file to be uploaded.",
-            "name": "logs_type",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env ID of the host.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose logs should be uploaded.",
-            "name": "host_id",
-            "in": "query"
+            "description": "The role binding to be created.",
+            "name": "role-binding-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/role-binding-create-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/role-binding"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -12952,50 +16351,46 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/logs-progress": {
-      "put": {
+    "/v2/clusters/{cluster_id}/role-bindings/{role_binding_id}": {
+      "delete": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Revokes a role granted on the cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateClusterLogsProgress",
+        "operationId": "v2DeleteClusterRoleBinding",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose log progress is being updated.",
+            "description": "The cluster that the role is granted on.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The role binding to be deleted.",
+            "name": "role_binding_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "204": {
-            "description": "Update cluster install progress."
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -13021,61 +16416,49 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests": {
+    "/v2/clusters/{cluster_id}/supported-platforms": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Lists manifests for customizing cluster installation.",
+        "description": "A list of platforms that this cluster can support in its current configuration. The platform suggested by the hardware fingerprints of the hosts is listed first.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2ListClusterManifests",
+        "operationId": "GetClusterSupportedPlatforms",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which the manifests should be listed.",
+            "description": "The cluster whose platform types should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Include system generated manifests in results? Default is false.",
-            "name": "include_system_generated",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-manifests"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/platform_type"
+              }
             }
           },
           "401": {
@@ -13096,18 +16479,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13115,42 +16486,30 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [
-          {
-            "userAuth": []
-          }
-        ],
-        "description": "Creates a manifest for customizing cluster installation.",
+      }
+    },
+    "/v2/clusters/{cluster_id}/ui-settings": {
+      "get": {
+        "description": "Fetch cluster specific UI settings.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2CreateClusterManifest",
+        "operationId": "V2GetClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be created.",
+            "description": "The cluster for which UI settings should be retrieved.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The new manifest to create.",
-            "name": "CreateManifestParams",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/create-manifest-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/manifest"
+              "type": "string"
             }
           },
           "400": {
@@ -13177,18 +16536,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13197,43 +16544,43 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Deletes a manifest from the cluster.",
+      "put": {
+        "description": "Update cluster specific UI settings.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2DeleteClusterManifest",
+        "operationId": "V2UpdateClusterUISettings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be deleted.",
+            "description": "The cluster for which UI settings should be updated.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to delete from the cluster.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
+            "description": "Settings for the installer UI.",
+            "name": "ui-settings",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -13253,18 +16600,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13272,43 +16607,48 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
+      }
+    },
+    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
         "security": [
           {
-            "userAuth": []
+            "agentAuth": []
           }
         ],
-        "description": "Updates a manifest for customizing cluster installation.",
+        "description": "Transfer the ingress certificate for the cluster.",
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "V2UpdateClusterManifest",
+        "operationId": "v2UploadClusterIngressCert",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which a new manifest should be updated.",
+            "description": "The cluster to associate with the ingress certificate.",
             "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The manifest to be updated.",
-            "name": "UpdateManifestParams",
+            "description": "The ingress certificate.",
+            "name": "ingress-cert-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/update-manifest-params"
+              "$ref": "#/definitions/ingress-cert-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/manifest"
-            }
+          "201": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -13340,14 +16680,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13355,54 +16695,40 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/manifests/files": {
+    "/v2/clusters/{cluster_id}/watch": {
       "get": {
         "security": [
           {
-            "userAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Downloads cluster manifest.",
+        "description": "Streams the live state of a cluster, its hosts and its events as server-sent events.",
         "produces": [
-          "application/octet-stream"
+          "text/event-stream"
         ],
         "tags": [
-          "manifests"
+          "installer"
         ],
-        "operationId": "v2DownloadClusterManifest",
+        "operationId": "v2WatchCluster",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster whose manifest should be downloaded.",
+            "description": "The cluster to watch.",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "manifests",
-              "openshift"
-            ],
-            "type": "string",
-            "default": "manifests",
-            "description": "The folder that contains the files. Manifests can be placed in 'manifests' or 'openshift' directories.",
-            "name": "folder",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The manifest file name to download.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/v2WatchClusterOKBody"
             }
           },
           "401": {
@@ -13429,12 +16755,70 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/component-versions": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of component versions.",
+        "tags": [
+          "versions"
+        ],
+        "operationId": "v2ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/v2/domains": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "List of managed DNS domains.",
+        "tags": [
+          "managed_domains"
+        ],
+        "operationId": "V2ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13444,32 +16828,123 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/monitored-operators": {
+    "/v2/events": {
       "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "watcherAuth": []
           }
         ],
-        "description": "Lists operators to be monitored for a cluster.",
+        "description": "Lists events for a cluster.",
         "tags": [
-          "operators",
-          "installer"
+          "events"
         ],
-        "operationId": "V2ListOfClusterOperators",
+        "operationId": "v2ListEvents",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return operators for.",
+            "description": "The cluster to return events for.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "An operator in the specified cluster to return its data.",
-            "name": "operator_name",
+            "format": "uuid",
+            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string",
+              "format": "uuid"
+            },
+            "description": "Hosts in the specified cluster to return events for.",
+            "name": "host_ids",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to return events for.",
+            "name": "infra_env_id",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Number of records to skip before starting to return the records.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ascending",
+              "descending"
+            ],
+            "type": "string",
+            "default": "ascending",
+            "description": "Order by event_time of events retrieved.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "info",
+                "warning",
+                "error",
+                "critical"
+              ],
+              "type": "string"
+            },
+            "description": "Retrieved events severities.",
+            "name": "severities",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Retrieved events message pattern.",
+            "name": "message",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Deleted hosts flag.",
+            "name": "deleted_hosts",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Cluster level events flag.",
+            "name": "cluster_level",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "A comma-separated list of event categories.",
+            "name": "categories",
             "in": "query"
           }
         ],
@@ -13477,7 +16952,34 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/monitored-operators-list"
+              "$ref": "#/definitions/event-list"
+            },
+            "headers": {
+              "Event-Count": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events retrieved."
+              },
+              "Severity-Count-Critical": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'critical'."
+              },
+              "Severity-Count-Error": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'error'."
+              },
+              "Severity-Count-Info": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'info'."
+              },
+              "Severity-Count-Warning": {
+                "minimum": 0,
+                "type": "integer",
+                "description": "Count of events with severity 'warning'."
+              }
             }
           },
           "401": {
@@ -13512,43 +17014,34 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Controller API to report of monitored operators.",
+        "description": "Add new assisted installer event.",
         "tags": [
-          "operators",
-          "installer"
+          "events"
         ],
-        "operationId": "v2ReportMonitoredOperatorStatus",
+        "operationId": "v2TriggerEvent",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose operators are being monitored.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The operators monitor report.",
-            "name": "report-params",
+            "description": "The event to be created.",
+            "name": "trigger-event-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/operator-monitor-report"
+              "$ref": "#/definitions/event"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success."
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13578,7 +17071,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Cluster cannot accept new agents due to its current state.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -13589,6 +17082,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -13598,7 +17097,7 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/preflight-requirements": {
+    "/v2/events/subscriptions": {
       "get": {
         "security": [
           {
@@ -13609,95 +17108,39 @@ func init() {
             ]
           }
         ],
-        "description": "Get preflight requirements for a cluster.",
-        "tags": [
-          "installer"
-        ],
-        "operationId": "v2GetPreflightRequirements",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster to return preflight requirements for.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/preflight-hardware-requirements"
-            }
-          },
-          "401": {
-            "description": "Unauthorized.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "403": {
-            "description": "Forbidden.",
-            "schema": {
-              "$ref": "#/definitions/infra_error"
-            }
-          },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "500": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/v2/clusters/{cluster_id}/progress": {
-      "put": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Update installation finalizing progress.",
+        "description": "Lists the event subscriptions of a cluster or an infra-env.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "v2UpdateClusterFinalizingProgress",
+        "operationId": "v2ListEventSubscriptions",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster being updated.",
+            "description": "The cluster to return event subscriptions for.",
             "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "in": "query"
           },
           {
-            "description": "New progress value.",
-            "name": "finalizing-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-finalizing-progress"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to return event subscriptions for.",
+            "name": "infra_env_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-subscription-list"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -13728,50 +17171,45 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/clusters/{cluster_id}/supported-platforms": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "userAuth": [
               "admin",
-              "read-only-admin",
               "user"
             ]
           }
         ],
-        "description": "A list of platforms that this cluster can support in its current configuration.",
+        "description": "Registers a webhook that receives the events of a cluster or an infra-env.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "GetClusterSupportedPlatforms",
+        "operationId": "v2RegisterEventSubscription",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The cluster whose platform types should be retrieved.",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
+            "description": "The event subscription to be created.",
+            "name": "new-event-subscription-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/event-subscription-create-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/platform_type"
-              }
+              "$ref": "#/definitions/event-subscription"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -13792,6 +17230,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13801,19 +17245,28 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/ui-settings": {
+    "/v2/events/subscriptions/{subscription_id}": {
       "get": {
-        "description": "Fetch cluster specific UI settings.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Retrieves the details of an event subscription.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "V2GetClusterUISettings",
+        "operationId": "v2GetEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be retrieved.",
-            "name": "cluster_id",
+            "description": "The event subscription to be retrieved.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           }
@@ -13822,13 +17275,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/event-subscription"
             }
           },
           "401": {
@@ -13849,6 +17296,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13857,43 +17310,33 @@ func init() {
           }
         }
       },
-      "put": {
-        "description": "Update cluster specific UI settings.",
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Deletes an event subscription and its delivery history.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "V2UpdateClusterUISettings",
+        "operationId": "v2DeregisterEventSubscription",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster for which UI settings should be updated.",
-            "name": "cluster_id",
+            "description": "The event subscription to be deleted.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Settings for the installer UI.",
-            "name": "ui-settings",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "string"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "type": "string"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -13913,6 +17356,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -13922,51 +17371,54 @@ func init() {
         }
       }
     },
-    "/v2/clusters/{cluster_id}/uploads/ingress-cert": {
-      "post": {
+    "/v2/events/subscriptions/{subscription_id}/deliveries": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Transfer the ingress certificate for the cluster.",
+        "description": "Lists the deliveries of an event subscription, most recent first.",
         "tags": [
-          "installer"
+          "events"
         ],
-        "operationId": "v2UploadClusterIngressCert",
+        "operationId": "v2ListEventSubscriptionDeliveries",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to associate with the ingress certificate.",
-            "name": "cluster_id",
+            "description": "The event subscription to return deliveries for.",
+            "name": "subscription_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The ingress certificate.",
-            "name": "ingress-cert-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
-            }
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "description": "Only return the deliveries in this status.",
+            "name": "status",
+            "in": "query"
           },
           {
-            "type": "string",
-            "description": "The software version of the discovery agent that is uploading the ingress certificate.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "type": "integer",
+            "description": "The maximum number of records to retrieve.",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/event-subscription-delivery-list"
             }
           },
           "401": {
@@ -13998,17 +17450,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/component-versions": {
+    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
       "get": {
         "security": [
           {
@@ -14017,44 +17463,72 @@ func init() {
               "read-only-admin",
               "user"
             ]
+          },
+          {
+            "agentAuth": []
           }
         ],
-        "description": "List of component versions.",
+        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "versions"
+          "installer"
         ],
-        "operationId": "v2ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
-          }
-        }
-      }
-    },
-    "/v2/domains": {
-      "get": {
-        "security": [
+        "operationId": "v2DownloadHostIgnition",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose ignition file should be downloaded.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
-        "description": "List of managed DNS domains.",
-        "tags": [
-          "managed_domains"
-        ],
-        "operationId": "V2ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -14062,11 +17536,17 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/events": {
+    "/v2/infra-envs": {
       "get": {
         "security": [
           {
@@ -14076,113 +17556,27 @@ func init() {
               "user"
             ]
           },
-          {
-            "urlAuth": []
-          },
           {
             "watcherAuth": []
           }
         ],
-        "description": "Lists events for a cluster.",
+        "description": "Retrieves the list of infra-envs.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2ListEvents",
+        "operationId": "ListInfraEnvs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The cluster to return events for.",
+            "description": "If provided, returns only infra-envs which directly reference this cluster.",
             "name": "cluster_id",
             "in": "query"
           },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "A host in the specified cluster to return events for (DEPRECATED. Use ` + "`" + `host_ids` + "`" + ` instead).",
-            "name": "host_id",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string",
-              "format": "uuid"
-            },
-            "description": "Hosts in the specified cluster to return events for.",
-            "name": "host_ids",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env to return events for.",
-            "name": "infra_env_id",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "The maximum number of records to retrieve.",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Number of records to skip before starting to return the records.",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "ascending",
-              "descending"
-            ],
-            "type": "string",
-            "default": "ascending",
-            "description": "Order by event_time of events retrieved.",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "info",
-                "warning",
-                "error",
-                "critical"
-              ],
-              "type": "string"
-            },
-            "description": "Retrieved events severities.",
-            "name": "severities",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Retrieved events message pattern.",
-            "name": "message",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Deleted hosts flag.",
-            "name": "deleted_hosts",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "Cluster level events flag.",
-            "name": "cluster_level",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "description": "A comma-separated list of event categories.",
-            "name": "categories",
+            "description": "If provided, returns only infra-envs that are owned by the specified user.",
+            "name": "owner",
             "in": "query"
           }
         ],
@@ -14190,34 +17584,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/event-list"
-            },
-            "headers": {
-              "Event-Count": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events retrieved."
-              },
-              "Severity-Count-Critical": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'critical'."
-              },
-              "Severity-Count-Error": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'error'."
-              },
-              "Severity-Count-Info": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'info'."
-              },
-              "Severity-Count-Warning": {
-                "minimum": 0,
-                "type": "integer",
-                "description": "Count of events with severity 'warning'."
-              }
+              "$ref": "#/definitions/infra-env-list"
             }
           },
           "401": {
@@ -14232,20 +17599,26 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14253,30 +17626,28 @@ func init() {
         }
       },
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Add new assisted installer event.",
+        "description": "Creates a new OpenShift Discovery ISO.",
         "tags": [
-          "events"
+          "installer"
         ],
-        "operationId": "v2TriggerEvent",
+        "operationId": "RegisterInfraEnv",
         "parameters": [
           {
-            "description": "The event to be created.",
-            "name": "trigger-event-params",
+            "description": "The parameters for the generated ISO.",
+            "name": "infraenv-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/event"
+              "$ref": "#/definitions/infra-env-create-params"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Success."
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/infra-env"
+            }
           },
           "400": {
             "description": "Error.",
@@ -14309,7 +17680,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14325,17 +17696,11 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition": {
+    "/v2/infra-envs/{infra_env_id}": {
       "get": {
         "security": [
           {
@@ -14347,39 +17712,37 @@ func init() {
           },
           {
             "agentAuth": []
+          },
+          {
+            "urlAuth": []
+          },
+          {
+            "imageAuth": []
+          },
+          {
+            "imageURLAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves the details of the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DownloadHostIgnition",
+        "operationId": "GetInfraEnv",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be downloaded.",
+            "description": "The infra-env to be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose ignition file should be downloaded.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/infra-env"
             }
           },
           "401": {
@@ -14406,14 +17769,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14425,48 +17788,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          },
-          {
-            "watcherAuth": []
-          }
-        ],
-        "description": "Retrieves the list of infra-envs.",
+      },
+      "delete": {
+        "description": "Deletes an infra-env.",
         "tags": [
-          "installer"
-        ],
-        "operationId": "ListInfraEnvs",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "If provided, returns only infra-envs which directly reference this cluster.",
-            "name": "cluster_id",
-            "in": "query"
-          },
+          "installer"
+        ],
+        "operationId": "DeregisterInfraEnv",
+        "parameters": [
           {
             "type": "string",
-            "description": "If provided, returns only infra-envs that are owned by the specified user.",
-            "name": "owner",
-            "in": "query"
+            "format": "uuid",
+            "description": "The infra-env to be deleted.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/infra-env-list"
-            }
+          "204": {
+            "description": "Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -14480,46 +17821,60 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
       },
-      "post": {
-        "description": "Creates a new OpenShift Discovery ISO.",
+      "patch": {
+        "description": "Updates an infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegisterInfraEnv",
+        "operationId": "UpdateInfraEnv",
         "parameters": [
           {
-            "description": "The parameters for the generated ISO.",
-            "name": "infraenv-create-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env to be updated.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "infra-env-update-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/infra-env-create-params"
+              "$ref": "#/definitions/infra-env-update-params"
             }
           }
         ],
@@ -14581,7 +17936,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}": {
+    "/v2/infra-envs/{infra_env_id}/downloads/files": {
       "get": {
         "security": [
           {
@@ -14604,26 +17959,74 @@ func init() {
             "imageURLAuth": []
           }
         ],
-        "description": "Retrieves the details of the infra-env.",
+        "description": "Downloads the customized ignition file for this host",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnv",
+        "operationId": "v2DownloadInfraEnvFiles",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
+            "description": "The infra-env whose file should be downloaded.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script",
+              "static-network-config"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "mac",
+            "description": "Mac address of the host running ipxe script.",
+            "name": "mac",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "full-iso",
+              "minimal-iso"
+            ],
+            "type": "string",
+            "description": "Overrides the ISO type for the disovery ignition, either 'full-iso' or 'minimal-iso'.",
+            "name": "discovery_iso_type",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad Request.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -14650,6 +18053,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -14669,26 +18078,52 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Deletes an infra-env.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+      "get": {
+        "description": "Creates a new pre-signed download URL for the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "DeregisterInfraEnv",
+        "operationId": "GetInfraEnvPresignedFileURL",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be deleted.",
+            "description": "The file's infra-env.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "discovery.ign",
+              "ipxe-script"
+            ],
+            "type": "string",
+            "description": "The file to be downloaded.",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "discovery-image-always",
+              "boot-order-control"
+            ],
+            "type": "string",
+            "description": "Specify the script type to be served for iPXE.",
+            "name": "ipxe_script_type",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/presigned-url"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -14714,60 +18149,53 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "patch": {
-        "description": "Updates an infra-env.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
+      "get": {
+        "description": "Creates a new pre-signed image download URL for the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "UpdateInfraEnv",
+        "operationId": "GetInfraEnvDownloadURL",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be updated.",
+            "description": "The infra-env to be retrieved.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The properties to update.",
-            "name": "infra-env-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/infra-env-update-params"
-            }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/infra-env"
+              "$ref": "#/definitions/presigned-url"
             }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14796,20 +18224,20 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "503": {
+            "description": "Unavailable.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14817,7 +18245,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files": {
+    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
       "get": {
         "security": [
           {
@@ -14827,88 +18255,41 @@ func init() {
               "user"
             ]
           },
-          {
-            "agentAuth": []
-          },
           {
             "urlAuth": []
           },
           {
             "imageAuth": []
-          },
-          {
-            "imageURLAuth": []
           }
         ],
-        "description": "Downloads the customized ignition file for this host",
+        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "installer"
-        ],
-        "operationId": "v2DownloadInfraEnvFiles",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The infra-env whose file should be downloaded.",
-            "name": "infra_env_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script",
-              "static-network-config"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "mac",
-            "description": "Mac address of the host running ipxe script.",
-            "name": "mac",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
-          },
+        ],
+        "operationId": "DownloadMinimalInitrd",
+        "parameters": [
           {
-            "enum": [
-              "full-iso",
-              "minimal-iso"
-            ],
             "type": "string",
-            "description": "Overrides the ISO type for the disovery ignition, either 'full-iso' or 'minimal-iso'.",
-            "name": "discovery_iso_type",
-            "in": "query"
+            "format": "uuid",
+            "description": "The infra-env of the host that should be retrieved.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "type": "string",
+              "format": "binary"
             }
           },
-          "400": {
-            "description": "Bad Request.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Empty Success."
           },
           "401": {
             "description": "Unauthorized.",
@@ -14935,7 +18316,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Error.",
+            "description": "Conflict.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -14945,65 +18326,44 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/files-presigned": {
+    "/v2/infra-envs/{infra_env_id}/hosts": {
       "get": {
-        "description": "Creates a new pre-signed download URL for the infra-env.",
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          },
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvPresignedFileURL",
+        "operationId": "v2ListHosts",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The file's infra-env.",
+            "description": "The infra-env that the hosts are asociated with.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "enum": [
-              "discovery.ign",
-              "ipxe-script"
-            ],
-            "type": "string",
-            "description": "The file to be downloaded.",
-            "name": "file_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "discovery-image-always",
-              "boot-order-control"
-            ],
-            "type": "string",
-            "description": "Specify the script type to be served for iPXE.",
-            "name": "ipxe_script_type",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/host-list"
             }
           },
           "401": {
@@ -15018,12 +18378,6 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "404": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "405": {
             "description": "Method Not Allowed.",
             "schema": {
@@ -15049,34 +18403,52 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/downloads/image-url": {
-      "get": {
-        "description": "Creates a new pre-signed image download URL for the infra-env.",
+      },
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Registers a new OpenShift agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "GetInfraEnvDownloadURL",
+        "operationId": "v2RegisterHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env to be retrieved.",
+            "description": "The infra-env that the agent is associated with.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The description of the agent being registered.",
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is registering the agent.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/presigned-url"
+              "$ref": "#/definitions/host_registration_response"
             }
           },
           "400": {
-            "description": "Bad Request.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15105,6 +18477,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Cluster cannot accept new agents due to its current state.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -15126,7 +18504,7 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/downloads/minimal-initrd": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
       "get": {
         "security": [
           {
@@ -15135,22 +18513,13 @@ func init() {
               "read-only-admin",
               "user"
             ]
-          },
-          {
-            "urlAuth": []
-          },
-          {
-            "imageAuth": []
           }
         ],
-        "description": "Get the initial ramdisk for minimal ISO based installations.\n",
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "Retrieves the details of the OpenShift host.",
         "tags": [
           "installer"
         ],
-        "operationId": "DownloadMinimalInitrd",
+        "operationId": "v2GetHost",
         "parameters": [
           {
             "type": "string",
@@ -15159,19 +18528,23 @@ func init() {
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be retrieved.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/host"
             }
           },
-          "204": {
-            "description": "Empty Success."
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -15196,55 +18569,130 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Conflict.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "501": {
+            "description": "Not implemented.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts": {
-      "get": {
-        "security": [
+      },
+      "delete": {
+        "description": "Deregisters an OpenShift host.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2DeregisterHost",
+        "parameters": [
           {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host that should be deregistered.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
           },
           {
-            "agentAuth": []
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be deregistered.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
-        "description": "Retrieves the list of OpenShift hosts that belong the infra-env.",
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "Method Not Allowed.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update an Openshift host",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ListHosts",
+        "operationId": "v2UpdateHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the hosts are asociated with.",
+            "description": "The infra-env ID of the host to be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that should be updated.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The properties to update.",
+            "name": "host-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-update-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -15259,73 +18707,72 @@ func init() {
               "$ref": "#/definitions/infra_error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "404": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Registers a new OpenShift agent.",
+        "description": "Bind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "v2RegisterHost",
+        "operationId": "BindHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env that the agent is associated with.",
+            "description": "The infra-env of the host that is being bound.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The description of the agent being registered.",
-            "name": "new-host-params",
+            "type": "string",
+            "format": "uuid",
+            "description": "The host that is being bound.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The parameters for the host binding.",
+            "name": "bind-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/bind-host-params"
             }
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is registering the agent.",
-            "name": "discovery_agent_version",
-            "in": "header"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host_registration_response"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -15358,12 +18805,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Cluster cannot accept new agents due to its current state.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -15385,27 +18826,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}": {
-      "get": {
-        "security": [
-          {
-            "userAuth": [
-              "admin",
-              "read-only-admin",
-              "user"
-            ]
-          }
-        ],
-        "description": "Retrieves the details of the OpenShift host.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+      "post": {
+        "description": "install specific host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHost",
+        "operationId": "v2InstallHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be retrieved.",
+            "description": "The infra-env of the host that is being installed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15413,14 +18845,14 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be retrieved.",
+            "description": "The host that is being installed.",
             "name": "host_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
@@ -15444,8 +18876,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15455,26 +18887,22 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      },
-      "delete": {
-        "description": "Deregisters an OpenShift host.",
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
+      "post": {
+        "description": "reset a failed host for day2 cluster.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2DeregisterHost",
+        "operationId": "v2ResetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that should be deregistered.",
+            "description": "The infra-env of the host that is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15482,20 +18910,17 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be deregistered.",
+            "description": "The host that is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
-          },
-          "400": {
-            "description": "Error.",
+          "200": {
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/host"
             }
           },
           "401": {
@@ -15516,8 +18941,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
+          "409": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15529,18 +18954,21 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
       "patch": {
-        "description": "Update an Openshift host",
+        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHost",
+        "summary": "Reset failed host validation.",
+        "operationId": "v2ResetHostValidation",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env ID of the host to be updated.",
+            "description": "The infra-env of the host that its validation is being reset.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15548,30 +18976,28 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that should be updated.",
+            "description": "The host that its validation is being reset.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The properties to update.",
-            "name": "host-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-update-params"
-            }
+            "type": "string",
+            "description": "The id of the validation being reset.",
+            "name": "validation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
             }
           },
           "400": {
-            "description": "Error.",
+            "description": "Bad Request",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15594,12 +19020,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "405": {
-            "description": "Method Not Allowed.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "409": {
             "description": "Error.",
             "schema": {
@@ -15615,13 +19035,13 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/bind": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
       "post": {
-        "description": "Bind host to a cluster",
+        "description": "Unbind host to a cluster",
         "tags": [
           "installer"
         ],
-        "operationId": "BindHost",
+        "operationId": "UnbindHost",
         "parameters": [
           {
             "type": "string",
@@ -15638,15 +19058,6 @@ func init() {
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "The parameters for the host binding.",
-            "name": "bind-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bind-host-params"
-            }
           }
         ],
         "responses": {
@@ -15686,6 +19097,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Conflict.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -15707,18 +19124,75 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics": {
+      "get": {
+        "description": "Lists the on-demand diagnostics requested for the host, with their results.",
+        "tags": [
+          "installer"
+        ],
+        "operationId": "v2ListHostDiagnostics",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The infra-env of the host whose diagnostics should be listed.",
+            "name": "infra_env_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The host whose diagnostics should be listed.",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic-list"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
       "post": {
-        "description": "install specific host for day2 cluster.",
+        "description": "Queues a diagnostic that the host runs the next time that it asks for instructions.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2InstallHost",
+        "operationId": "v2CreateHostDiagnostic",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being installed.",
+            "description": "The infra-env of the host that should run the diagnostic.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15726,17 +19200,32 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being installed.",
+            "description": "The host that should run the diagnostic.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The diagnostic to run.",
+            "name": "diagnostic-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-diagnostic-create-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-diagnostic"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -15772,18 +19261,18 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset": {
-      "post": {
-        "description": "reset a failed host for day2 cluster.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
+      "get": {
+        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
         "tags": [
           "installer"
         ],
-        "operationId": "v2ResetHost",
+        "operationId": "v2GetHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being reset.",
+            "description": "The infra-env of the host whose ignition file should be obtained.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15791,7 +19280,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being reset.",
+            "description": "The host whose ignition file should be obtained.",
             "name": "host_id",
             "in": "path",
             "required": true
@@ -15801,7 +19290,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-ignition-params"
             }
           },
           "401": {
@@ -15822,8 +19311,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15833,23 +19322,32 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}": {
+      },
       "patch": {
-        "description": "Reset failed host validation. It may be performed on any host validation with persistent validation result.",
+        "description": "Patch the ignition file for this host",
         "tags": [
           "installer"
         ],
-        "summary": "Reset failed host validation.",
-        "operationId": "v2ResetHostValidation",
+        "operationId": "v2UpdateHostIgnition",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that its validation is being reset.",
+            "description": "The infra-env of the host whose ignition file should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15857,28 +19355,27 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that its validation is being reset.",
+            "description": "The host whose ignition file should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The id of the validation being reset.",
-            "name": "validation_id",
-            "in": "path",
-            "required": true
+            "description": "Ignition config overrides.",
+            "name": "host-ignition-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-ignition-params"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+          "201": {
+            "description": "Success."
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15901,8 +19398,8 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
+          "405": {
+            "description": "Method Not Allowed.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15912,22 +19409,28 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "501": {
+            "description": "Not implemented.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/unbind": {
-      "post": {
-        "description": "Unbind host to a cluster",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
+      "patch": {
+        "description": "Updates a host's installer arguments.",
         "tags": [
           "installer"
         ],
-        "operationId": "UnbindHost",
+        "operationId": "v2UpdateHostInstallerArgs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is being bound.",
+            "description": "The infra-env of the host whose installer arguments should be updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -15935,14 +19438,23 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is being bound.",
+            "description": "The host whose installer arguments should be updated.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The updated installer arguments.",
+            "name": "installer-args-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installer-args-params"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host"
@@ -15979,7 +19491,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Conflict.",
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -15995,28 +19507,27 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/ignition": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
       "get": {
-        "description": "Fetch the ignition file for this host as a string. In case of unbound host produces an error",
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Retrieves the next operations that the host agent needs to perform.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetHostIgnition",
+        "operationId": "v2GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be obtained.",
+            "description": "The infra-env of the host that is retrieving instructions.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16024,17 +19535,29 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be obtained.",
+            "description": "The host that is retrieving instructions.",
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The time on the host as seconds since the Unix epoch.",
+            "name": "timestamp",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is retrieving instructions.",
+            "name": "discovery_agent_version",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/steps"
             }
           },
           "401": {
@@ -16081,17 +19604,28 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Patch the ignition file for this host",
+      "post": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Posts the result of the operations from the host agent.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostIgnition",
+        "operationId": "v2PostStepReply",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is posting results.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose ignition file should be updated.",
+            "description": "The infra-env of the host that is posting results.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16099,23 +19633,22 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose ignition file should be updated.",
+            "description": "The host that is posting results.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Ignition config overrides.",
-            "name": "host-ignition-params",
+            "description": "The results to be posted.",
+            "name": "reply",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/host-ignition-params"
+              "$ref": "#/definitions/step-reply"
             }
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "Success."
           },
           "400": {
@@ -16159,22 +19692,33 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/installer-args": {
-      "patch": {
-        "description": "Updates a host's installer arguments.",
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
+      "put": {
+        "security": [
+          {
+            "agentAuth": []
+          }
+        ],
+        "description": "Update log collection state and progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallerArgs",
+        "operationId": "v2UpdateHostLogsProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host whose installer arguments should be updated.",
+            "description": "The infra-env whose log progress is being updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16182,33 +19726,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host whose installer arguments should be updated.",
+            "description": "The host whose log progress is being updated.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "The updated installer arguments.",
-            "name": "installer-args-params",
+            "description": "Parameters for updating log progress.",
+            "name": "logs-progress-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/installer-args-params"
+              "$ref": "#/definitions/logs-progress-params"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
-          },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "Update cluster install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16251,27 +19786,39 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
+          },
+          "503": {
+            "description": "Unavailable.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions": {
-      "get": {
+    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
+      "put": {
         "security": [
           {
             "agentAuth": []
           }
         ],
-        "description": "Retrieves the next operations that the host agent needs to perform.",
+        "description": "Update installation progress.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2GetNextSteps",
+        "operationId": "v2UpdateHostInstallProgress",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The software version of the discovery agent that is updating progress.",
+            "name": "discovery_agent_version",
+            "in": "header"
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is retrieving instructions.",
+            "description": "The infra-env of the host being updated.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
@@ -16279,30 +19826,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The host that is retrieving instructions.",
+            "description": "The ID of the host to update.",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "integer",
-            "description": "The time on the host as seconds since the Unix epoch.",
-            "name": "timestamp",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is retrieving instructions.",
-            "name": "discovery_agent_version",
-            "in": "header"
+            "description": "New progress value.",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/steps"
-            }
+            "description": "Update install progress."
           },
           "401": {
             "description": "Unauthorized.",
@@ -16334,12 +19875,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "503": {
             "description": "Unavailable.",
             "schema": {
@@ -16347,60 +19882,29 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
       "post": {
-        "security": [
-          {
-            "agentAuth": []
-          }
-        ],
-        "description": "Posts the result of the operations from the host agent.",
+        "description": "Regenerate InfraEnv token signing key.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2PostStepReply",
+        "operationId": "RegenerateInfraEnvSigningKey",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is posting results.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host that is posting results.",
+            "description": "The target InfraEnv.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host that is posting results.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The results to be posted.",
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "401": {
             "description": "Unauthorized.",
             "schema": {
@@ -16430,64 +19934,42 @@ func init() {
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/logs-progress": {
-      "put": {
+    "/v2/infra-envs/{infra_env_id}/role-bindings": {
+      "get": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update log collection state and progress.",
+        "description": "Lists the roles granted on the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostLogsProgress",
+        "operationId": "v2ListInfraEnvRoleBindings",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env whose log progress is being updated.",
+            "description": "The infra-env whose role bindings should be listed.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The host whose log progress is being updated.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Parameters for updating log progress.",
-            "name": "logs-progress-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/logs-progress-params"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Update cluster install progress."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/role-binding-list"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -16513,81 +19995,59 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          },
-          "501": {
-            "description": "Not implemented.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
-          "503": {
-            "description": "Unavailable.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
           }
         }
-      }
-    },
-    "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/progress": {
-      "put": {
+      },
+      "post": {
         "security": [
           {
-            "agentAuth": []
+            "userAuth": [
+              "admin",
+              "user"
+            ]
           }
         ],
-        "description": "Update installation progress.",
+        "description": "Grants a role on the infra-env to a user or to a group.",
         "tags": [
           "installer"
         ],
-        "operationId": "v2UpdateHostInstallProgress",
+        "operationId": "v2CreateInfraEnvRoleBinding",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The software version of the discovery agent that is updating progress.",
-            "name": "discovery_agent_version",
-            "in": "header"
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The infra-env of the host being updated.",
+            "description": "The infra-env that the role is granted on.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to update.",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "New progress value.",
-            "name": "host-progress",
+            "description": "The role binding to be created.",
+            "name": "role-binding-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/host-progress"
+              "$ref": "#/definitions/role-binding-create-params"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/role-binding"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "401": {
             "description": "Unauthorized.",
@@ -16613,14 +20073,14 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "500": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "503": {
-            "description": "Unavailable.",
+          "500": {
+            "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -16628,21 +20088,37 @@ func init() {
         }
       }
     },
-    "/v2/infra-envs/{infra_env_id}/regenerate-signing-key": {
-      "post": {
-        "description": "Regenerate InfraEnv token signing key.",
+    "/v2/infra-envs/{infra_env_id}/role-bindings/{role_binding_id}": {
+      "delete": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Revokes a role granted on the infra-env.",
         "tags": [
           "installer"
         ],
-        "operationId": "RegenerateInfraEnvSigningKey",
+        "operationId": "v2DeleteInfraEnvRoleBinding",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The target InfraEnv.",
+            "description": "The infra-env that the role is granted on.",
             "name": "infra_env_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The role binding to be deleted.",
+            "name": "role_binding_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -16948,7 +20424,8 @@ func init() {
               "none",
               "nutanix",
               "vsphere",
-              "external"
+              "external",
+              "openstack"
             ],
             "type": "string",
             "description": "The provider platform type.",
@@ -17099,7 +20576,79 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/operator-properties"
+              "$ref": "#/definitions/operator-properties"
+            }
+          },
+          "401": {
+            "description": "Unauthorized.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "403": {
+            "description": "Forbidden.",
+            "schema": {
+              "$ref": "#/definitions/infra_error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/v2/supported-operators/{operator_name}/install-options": {
+      "get": {
+        "security": [
+          {
+            "userAuth": [
+              "admin",
+              "read-only-admin",
+              "user"
+            ]
+          }
+        ],
+        "description": "Lists the channels, catalog sources and install plan approval modes that can be selected to install an\noperator on a given OpenShift version.\n",
+        "tags": [
+          "operators"
+        ],
+        "operationId": "V2ListOperatorInstallOptions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The operator name.",
+            "name": "operator_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Version of the OpenShift cluster.",
+            "name": "openshift_version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/operator-install-options"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "401": {
@@ -17349,6 +20898,89 @@ func init() {
         "MULTIARCH_RELEASE_IMAGE"
       ]
     },
+    "audit-record": {
+      "type": "object",
+      "properties": {
+        "actor": {
+          "description": "The user, agent or controller that made the change.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "auth_type": {
+          "description": "The security scheme that authenticated the actor, or controller for changes made by the controllers.",
+          "type": "string"
+        },
+        "changes": {
+          "description": "JSON document of the requested change with its secrets redacted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "cluster_id": {
+          "description": "The cluster that was changed.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "host_id": {
+          "description": "The host that was changed.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "Unique identifier of the audit record.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env that was changed.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "method": {
+          "description": "HTTP method of the request, empty for changes made by the controllers.",
+          "type": "string"
+        },
+        "operation_id": {
+          "description": "The operation that was called.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "request_id": {
+          "description": "The request identifier, as found in the service logs.",
+          "type": "string"
+        },
+        "status_code": {
+          "description": "HTTP status code of the response, zero for changes made by the controllers.",
+          "type": "integer"
+        }
+      }
+    },
+    "audit-record-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/audit-record"
+      }
+    },
     "bind-host-params": {
       "required": [
         "cluster_id"
@@ -17649,12 +21281,21 @@ func init() {
           "x-go-custom-tag": "gorm:\"type:text\"",
           "example": "{\"networking\":{\"networkType\": \"OVNKubernetes\"},\"fips\":true}"
         },
+        "install_schedule": {
+          "description": "Time window in which the installation is started automatically once the cluster is ready.",
+          "$ref": "#/definitions/install-schedule"
+        },
         "install_started_at": {
           "description": "The time that this cluster started installation.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "install_timeouts": {
+          "description": "JSON-formatted string containing per-cluster overrides of the installation timeouts of host stages and finalizing stages, for example {\"host_stages\": {\"Rebooting\": \"2h\"}, \"finalizing_stages\": {\"Waiting for cluster operators\": \"12h\"}}. The timeouts use the Go duration format and stages that are not listed keep the timeouts of the service.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "ip_collisions": {
           "description": "Json formatted string containing ip collisions detected in the cluster.",
           "type": "string",
@@ -17764,6 +21405,15 @@ func init() {
           "type": "boolean",
           "default": true
         },
+        "secure_boot_mode": {
+          "description": "The secure boot state required from the hosts of the cluster, 'any' or empty to accept both states.",
+          "type": "string",
+          "enum": [
+            "any",
+            "enabled",
+            "disabled"
+          ]
+        },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
@@ -18113,6 +21763,12 @@ func init() {
           "description": "Required installation disk speed in ms",
           "type": "integer"
         },
+        "network_bandwidth_mbps": {
+          "description": "Minimum network throughput at L3 for role, in Mbps.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
         "network_latency_threshold_ms": {
           "description": "Maximum network average latency (RTT) at L3 for role.",
           "type": "number",
@@ -18220,7 +21876,8 @@ func init() {
         "self-node-remediation-requirements-satisfied",
         "fence-agents-remediation-requirements-satisfied",
         "node-maintenance-requirements-satisfied",
-        "kube-descheduler-requirements-satisfied"
+        "kube-descheduler-requirements-satisfied",
+        "plugin-operators-requirements-satisfied"
       ]
     },
     "cluster_default_config": {
@@ -18386,6 +22043,12 @@ func init() {
           "items": {
             "$ref": "#/definitions/mtu-report"
           }
+        },
+        "throughput_report": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/throughput-report"
+          }
         }
       }
     },
@@ -18980,31 +22643,266 @@ func init() {
           "description": "Event Name.",
           "type": "string"
         },
-        "props": {
-          "description": "Additional properties for the event in JSON format.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "props": {
+          "description": "Additional properties for the event in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "request_id": {
+          "description": "Unique identifier of the request that caused this event to occur.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "event-subscription": {
+      "type": "object",
+      "required": [
+        "id",
+        "url"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster whose events are delivered.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "filter": {
+          "$ref": "#/definitions/event-subscription-filter"
+        },
+        "id": {
+          "description": "Unique identifier of the event subscription.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env whose events are delivered.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "signed": {
+          "description": "Whether the deliveries are signed with the secret of the subscription.",
+          "type": "boolean"
+        },
+        "url": {
+          "description": "The URL that the matching events are POSTed to.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "user_name": {
+          "description": "The user that created the event subscription.",
+          "type": "string"
+        }
+      }
+    },
+    "event-subscription-create-params": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster whose events are delivered. Exactly one of cluster_id and infra_env_id must be set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "filter": {
+          "$ref": "#/definitions/event-subscription-filter"
+        },
+        "infra_env_id": {
+          "description": "The infra-env whose events are delivered. Exactly one of cluster_id and infra_env_id must be set.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "secret": {
+          "description": "Secret used to sign the deliveries with HMAC-SHA256. Deliveries are not signed when empty.",
+          "type": "string"
+        },
+        "url": {
+          "description": "The http or https URL that the matching events are POSTed to.",
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "event-subscription-delivery": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "description": "Number of delivery attempts so far.",
+          "type": "integer"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "delivered_at": {
+          "description": "Time at which the event was delivered.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "event_name": {
+          "description": "Name of the delivered event.",
+          "type": "string"
+        },
+        "event_time": {
+          "description": "Time of the delivered event.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "id": {
+          "description": "Unique identifier of the delivery.",
+          "type": "integer",
+          "format": "int64",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "last_error": {
+          "description": "Error of the last failed attempt.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "response_code": {
+          "description": "HTTP status code of the last attempt, zero if no response was received.",
+          "type": "integer"
+        },
+        "status": {
+          "description": "pending until the event is delivered or all the attempts failed.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ],
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "subscription_id": {
+          "description": "The event subscription this delivery belongs to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "event-subscription-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event-subscription-delivery"
+      }
+    },
+    "event-subscription-filter": {
+      "description": "Selects the events delivered to an event subscription. An empty filter field matches every event.",
+      "type": "object",
+      "properties": {
+        "categories": {
+          "description": "Categories of the events to deliver (user, metrics). Only user events are delivered when empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
+        },
+        "message": {
+          "description": "Only deliver the events whose message contains this text, ignoring case.",
+          "type": "string"
         },
-        "request_id": {
-          "description": "Unique identifier of the request that caused this event to occur.",
-          "type": "string",
-          "format": "uuid"
+        "names": {
+          "description": "Names of the events to deliver, e.g. host_status_updated.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
         },
-        "severity": {
-          "type": "string",
-          "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+        "severities": {
+          "description": "Severities of the events to deliver (info, warning, error, critical).",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"type:text[]\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "github.com/lib/pq"
+            },
+            "type": "StringArray"
+          }
         }
-      }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:filter_\""
     },
-    "event-list": {
+    "event-subscription-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/event"
+        "$ref": "#/definitions/event-subscription"
       }
     },
     "feature-support-level-id": {
@@ -19053,7 +22951,10 @@ func init() {
         "SELF_NODE_REMEDIATION",
         "FENCE_AGENTS_REMEDIATION",
         "NODE_MAINTENANCE",
-        "KUBE_DESCHEDULER"
+        "KUBE_DESCHEDULER",
+        "EXTERNAL_PLATFORM_PROXMOX",
+        "OPENSTACK_INTEGRATION",
+        "OPERATOR_VERSION_PINNING"
       ]
     },
     "finalizing-stage": {
@@ -19069,6 +22970,41 @@ func init() {
         "Done"
       ]
     },
+    "firmware_inspection_response": {
+      "type": "object",
+      "properties": {
+        "iommu_enabled": {
+          "description": "Whether the IOMMU (Intel VT-d or AMD-Vi) is enabled in the firmware and in the kernel. Missing if it couldn't be determined.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "sriov_interfaces": {
+          "description": "The network interfaces that expose the SR-IOV capability.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/firmware_sriov_interface"
+          }
+        },
+        "virtualization_enabled": {
+          "description": "Whether the virtualization extensions of the CPU (Intel VT-x or AMD-V) are enabled in the firmware. Missing if it couldn't be determined.",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "firmware_sriov_interface": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "The name of the network interface.",
+          "type": "string"
+        },
+        "total_vfs": {
+          "description": "The maximal number of virtual functions of the network interface, 0 if SR-IOV is disabled in the firmware.",
+          "type": "integer"
+        }
+      }
+    },
     "free-addresses-list": {
       "type": "array",
       "items": {
@@ -19197,6 +23133,11 @@ func init() {
           },
           "x-nullable": false
         },
+        "diagnostics": {
+          "description": "Contains a serialized host-diagnostic-list, the on-demand diagnostics requested for the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "discovery_agent_version": {
           "type": "string"
         },
@@ -19215,10 +23156,20 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "firmware": {
+          "description": "Contains a serialized firmware_inspection_response",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "free_addresses": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "hardware_fingerprint": {
+          "description": "Contains a serialized host_fingerprint, the hypervisor or cloud that the host runs on.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "href": {
           "description": "Self link.",
           "type": "string"
@@ -19447,6 +23398,121 @@ func init() {
         }
       }
     },
+    "host-diagnostic": {
+      "type": "object",
+      "required": [
+        "id",
+        "type",
+        "status"
+      ],
+      "properties": {
+        "created_at": {
+          "description": "The time when the diagnostic was requested.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "disk_path": {
+          "description": "The disk whose performance is measured.",
+          "type": "string"
+        },
+        "domains": {
+          "description": "The domain names that the host resolves.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "Unique identifier of the diagnostic.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "image": {
+          "description": "The container image that the host pulls.",
+          "type": "string"
+        },
+        "ip_address": {
+          "description": "The IP address that the host checks its connectivity to.",
+          "type": "string"
+        },
+        "result": {
+          "description": "The output reported by the host for the diagnostic, formatted as JSON.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The status of the diagnostic.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "running",
+            "succeeded",
+            "failed"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information about the status of the diagnostic.",
+          "type": "string"
+        },
+        "step_id": {
+          "description": "The identifier of the step that the host runs for the diagnostic.",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/host-diagnostic-type"
+        },
+        "updated_at": {
+          "description": "The last time the status of the diagnostic changed.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "host-diagnostic-create-params": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "disk_path": {
+          "description": "The disk whose performance is measured. Defaults to the installation disk of the host.",
+          "type": "string"
+        },
+        "domains": {
+          "description": "The domain names that the host resolves. Required for the domain-resolution diagnostic.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "image": {
+          "description": "The container image that the host pulls. Required for the container-image-availability diagnostic.",
+          "type": "string"
+        },
+        "ip_address": {
+          "description": "The IP address that the host checks its connectivity to. Required for the connectivity diagnostic.",
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/host-diagnostic-type"
+        }
+      }
+    },
+    "host-diagnostic-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-diagnostic"
+      }
+    },
+    "host-diagnostic-type": {
+      "description": "The check that an on-demand diagnostic runs on the host.",
+      "type": "string",
+      "enum": [
+        "disk-performance",
+        "container-image-availability",
+        "domain-resolution",
+        "connectivity"
+      ]
+    },
     "host-ignition-params": {
       "properties": {
         "config": {
@@ -19454,6 +23520,28 @@ func init() {
         }
       }
     },
+    "host-installation-timeline": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "stages": {
+          "description": "The installation stages of the host, in the order in which they started.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-stage"
+          }
+        }
+      }
+    },
     "host-list": {
       "type": "array",
       "items": {
@@ -19660,6 +23748,7 @@ func init() {
         "cnv-requirements-satisfied",
         "sufficient-network-latency-requirement-for-role",
         "sufficient-packet-loss-requirement-for-role",
+        "sufficient-network-bandwidth-requirement-for-role",
         "has-default-route",
         "api-domain-name-resolved-correctly",
         "api-int-domain-name-resolved-correctly",
@@ -19673,6 +23762,8 @@ func init() {
         "compatible-agent",
         "no-skip-installation-disk",
         "no-skip-missing-disk",
+        "no-unhealthy-disks",
+        "compatible-secure-boot-state",
         "no-ip-collisions-in-network",
         "no-iscsi-nic-belongs-to-machine-cidr",
         "node-feature-discovery-requirements-satisfied",
@@ -19690,9 +23781,51 @@ func init() {
         "self-node-remediation-requirements-satisfied",
         "fence-agents-remediation-requirements-satisfied",
         "node-maintenance-requirements-satisfied",
-        "kube-descheduler-requirements-satisfied"
+        "kube-descheduler-requirements-satisfied",
+        "plugin-operators-requirements-satisfied"
       ]
     },
+    "host_fingerprint": {
+      "description": "The hypervisor or cloud that a host runs on, as detected from its inventory.",
+      "type": "object",
+      "properties": {
+        "confidence": {
+          "description": "How reliable the detection of the environment is.",
+          "type": "string",
+          "enum": [
+            "low",
+            "medium",
+            "high"
+          ]
+        },
+        "environment": {
+          "description": "The hypervisor or cloud that the host runs on.",
+          "type": "string",
+          "enum": [
+            "unknown",
+            "baremetal",
+            "vsphere",
+            "nutanix",
+            "openstack",
+            "oci",
+            "proxmox",
+            "kvm",
+            "hyperv",
+            "xen",
+            "virtualbox",
+            "aws",
+            "gcp"
+          ]
+        },
+        "signals": {
+          "description": "The inventory values that identified the environment.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "host_network": {
       "type": "object",
       "properties": {
@@ -20222,6 +24355,27 @@ func init() {
         }
       }
     },
+    "install-schedule": {
+      "description": "A time window in which the installation of the cluster is started automatically once the cluster is ready.",
+      "type": "object",
+      "properties": {
+        "not_after": {
+          "description": "The installation is not started after this time. If the cluster is not ready by then, the schedule is removed. Optional.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        },
+        "not_before": {
+          "description": "The installation is not started before this time.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:install_schedule_\""
+    },
     "install_cmd_request": {
       "type": "object",
       "required": [
@@ -20309,24 +24463,90 @@ func init() {
           "description": "Version of the OpenShift cluster.",
           "type": "string"
         },
-        "proxy": {
-          "$ref": "#/definitions/proxy"
+        "proxy": {
+          "$ref": "#/definitions/proxy"
+        },
+        "role": {
+          "$ref": "#/definitions/host-role"
+        },
+        "service_ips": {
+          "description": "List of service ips",
+          "type": "array",
+          "items": {
+            "description": "Service ip.",
+            "type": "string",
+            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
+          }
+        },
+        "skip_installation_disk_cleanup": {
+          "description": "Skip formatting installation disk",
+          "type": "boolean"
+        }
+      }
+    },
+    "installation-timeline": {
+      "type": "object",
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that was installed.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "finalizing_stages": {
+          "description": "The finalizing stages of the cluster, in the order in which they started.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/installation-timeline-stage"
+          }
+        },
+        "hosts": {
+          "description": "The installation stages of each host of the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-installation-timeline"
+          }
+        },
+        "install_completed_at": {
+          "description": "Time at which the installation completed, omitted while it is in progress.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "install_started_at": {
+          "description": "Time at which the installation started.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "installation-timeline-stage": {
+      "type": "object",
+      "properties": {
+        "duration_seconds": {
+          "description": "Time spent in the stage so far.",
+          "type": "number"
+        },
+        "ended_at": {
+          "description": "Time at which the stage ended, omitted while the stage is in progress.",
+          "type": "string",
+          "format": "date-time"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
+        "name": {
+          "description": "The host stage or the finalizing stage.",
+          "type": "string"
         },
-        "service_ips": {
-          "description": "List of service ips",
-          "type": "array",
-          "items": {
-            "description": "Service ip.",
-            "type": "string",
-            "pattern": "^(?:(?:(?:[0-9]{1,3}\\.){3}[0-9]{1,3})|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,}))$"
-          }
+        "result": {
+          "description": "in-progress until the stage ends, and then whether the stage succeeded or failed.",
+          "type": "string",
+          "enum": [
+            "in-progress",
+            "succeeded",
+            "failed"
+          ]
         },
-        "skip_installation_disk_cleanup": {
-          "description": "Skip formatting installation disk",
-          "type": "boolean"
+        "started_at": {
+          "description": "Time at which the stage started.",
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
@@ -20725,7 +24945,9 @@ func init() {
         "provider": {
           "type": "string",
           "enum": [
-            "route53"
+            "route53",
+            "rfc2136",
+            "powerdns"
           ]
         }
       }
@@ -20798,6 +25020,18 @@ func init() {
             "type": "StringArray"
           }
         },
+        "catalog_source": {
+          "description": "Name of the catalog source of the subscription. When empty the default catalog source of the operator is\nused. Must be one of the catalog sources returned by the install options of the operator, unless\ncatalog_source_image is also set.\n",
+          "type": "string"
+        },
+        "catalog_source_image": {
+          "description": "Index image of a catalog source that will be created in the 'openshift-marketplace' namespace, for example\na mirrored catalog in a disconnected environment.\n",
+          "type": "string"
+        },
+        "channel": {
+          "description": "Channel of the subscription of the operator. When empty the default channel for the OpenShift version of the\ncluster is used.\n",
+          "type": "string"
+        },
         "cluster_id": {
           "description": "The cluster that this operator is associated with.",
           "type": "string",
@@ -20808,6 +25042,9 @@ func init() {
           "description": "Whether the operator can't be installed without being required by another operator.",
           "type": "boolean"
         },
+        "install_plan_approval": {
+          "$ref": "#/definitions/operator-install-plan-approval"
+        },
         "name": {
           "description": "Unique name of the operator.",
           "type": "string",
@@ -20825,6 +25062,10 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "starting_csv": {
+          "description": "Name of the cluster service version that will be installed, for example 'odf-operator.v4.16.3'.",
+          "type": "string"
+        },
         "status": {
           "$ref": "#/definitions/operator-status"
         },
@@ -20872,6 +25113,53 @@ func init() {
         }
       }
     },
+    "network_throughput_request": {
+      "type": "object",
+      "required": [
+        "duration_seconds",
+        "remote_hosts"
+      ],
+      "properties": {
+        "duration_seconds": {
+          "description": "Duration of the throughput test with each remote host, in seconds.",
+          "type": "integer"
+        },
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network_throughput_request_host"
+          }
+        }
+      }
+    },
+    "network_throughput_request_host": {
+      "type": "object",
+      "required": [
+        "host_id",
+        "ip_address"
+      ],
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip_address": {
+          "description": "The address of the remote host to measure the throughput to.",
+          "type": "string"
+        }
+      }
+    },
+    "network_throughput_response": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
     "next_step_cmd_request": {
       "type": "object",
       "required": [
@@ -20990,9 +25278,46 @@ func init() {
         "$ref": "#/definitions/openshift-version"
       }
     },
+    "operator-catalog-source": {
+      "description": "Catalog source that can be used by the subscriptions of the operators.",
+      "type": "object",
+      "properties": {
+        "display_name": {
+          "description": "Human friendly name of the catalog source.",
+          "type": "string"
+        },
+        "image": {
+          "description": "Index image of the catalog source. Empty for the catalog sources that already exist in the cluster.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the catalog source.",
+          "type": "string"
+        },
+        "namespace": {
+          "description": "Namespace of the catalog source.",
+          "type": "string"
+        }
+      }
+    },
     "operator-create-params": {
       "type": "object",
       "properties": {
+        "catalog_source": {
+          "description": "Name of the catalog source of the subscription. When empty the default catalog source of the operator is\nused. Must be one of the catalog sources returned by the install options of the operator, unless\ncatalog_source_image is also set.\n",
+          "type": "string"
+        },
+        "catalog_source_image": {
+          "description": "Index image of a catalog source that will be created in the 'openshift-marketplace' namespace, for example\na mirrored catalog in a disconnected environment.\n",
+          "type": "string"
+        },
+        "channel": {
+          "description": "Channel of the subscription of the operator. When empty the default channel for the OpenShift version of the\ncluster is used.\n",
+          "type": "string"
+        },
+        "install_plan_approval": {
+          "$ref": "#/definitions/operator-install-plan-approval"
+        },
         "name": {
           "type": "string"
         },
@@ -21000,6 +25325,10 @@ func init() {
           "description": "Blob of operator-dependent parameters that are required for installation.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "starting_csv": {
+          "description": "Name of the cluster service version that will be installed, for example 'odf-operator.v4.16.3'.",
+          "type": "string"
         }
       }
     },
@@ -21035,6 +25364,59 @@ func init() {
         }
       }
     },
+    "operator-install-options": {
+      "description": "Choices available to install an operator on a given OpenShift version.",
+      "type": "object",
+      "properties": {
+        "catalog_sources": {
+          "description": "Catalog sources that can be selected for the operator.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-catalog-source"
+          }
+        },
+        "channels": {
+          "description": "Channels that can be selected for the operator.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "default_catalog_source": {
+          "description": "Name of the catalog source used when the cluster doesn't specify one.",
+          "type": "string"
+        },
+        "default_channel": {
+          "description": "Channel used when the cluster doesn't specify one.",
+          "type": "string"
+        },
+        "install_plan_approvals": {
+          "description": "Install plan approval modes that can be selected for the operator.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/operator-install-plan-approval"
+          }
+        },
+        "openshift_version": {
+          "description": "OpenShift version that the options apply to.",
+          "type": "string"
+        },
+        "operator_name": {
+          "description": "Name of the operator.",
+          "type": "string"
+        },
+        "support_level": {
+          "$ref": "#/definitions/support-level"
+        }
+      }
+    },
+    "operator-install-plan-approval": {
+      "description": "Approval mode of the install plans of the subscription. Only 'Automatic' is supported, the installation\nwaits for the operators and nothing else would approve their install plans.\n",
+      "type": "string",
+      "enum": [
+        "Automatic"
+      ]
+    },
     "operator-monitor-report": {
       "type": "object",
       "properties": {
@@ -21170,6 +25552,10 @@ func init() {
           "x-nullable": true,
           "$ref": "#/definitions/platform_external"
         },
+        "openstack": {
+          "x-nullable": true,
+          "$ref": "#/definitions/platform_openstack"
+        },
         "type": {
           "$ref": "#/definitions/platform_type"
         }
@@ -21199,6 +25585,40 @@ func init() {
       },
       "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:external_\""
     },
+    "platform_openstack": {
+      "description": "Configuration used when installing with the OpenStack platform type.",
+      "type": "object",
+      "properties": {
+        "api_floating_ip": {
+          "description": "The floating IP address to associate with the API load balancer.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cloud": {
+          "description": "The name of the cloud in the clouds.yaml file used to install and run the cluster.",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "clouds_yaml": {
+          "description": "The clouds.yaml file with the credentials of the cloud, used by the installer to generate the manifests of the cluster. It is stored with the secrets of the cluster and is never returned.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"-\"",
+          "x-nullable": true
+        },
+        "external_network": {
+          "description": "The name of the external network that provides the floating IP addresses.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ingress_floating_ip": {
+          "description": "The floating IP address to associate with the ingress load balancer.",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "x-go-custom-tag": "gorm:\"embedded;embeddedPrefix:openstack_\""
+    },
     "platform_type": {
       "type": "string",
       "enum": [
@@ -21206,7 +25626,8 @@ func init() {
         "nutanix",
         "vsphere",
         "none",
-        "external"
+        "external",
+        "openstack"
       ]
     },
     "preflight-hardware-requirements": {
@@ -21403,6 +25824,114 @@ func init() {
         "$ref": "#/definitions/release-source"
       }
     },
+    "role-binding": {
+      "type": "object",
+      "required": [
+        "id",
+        "subject_kind",
+        "subject",
+        "role"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster that the role is granted on.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\"",
+          "x-go-type": {
+            "hints": {
+              "noValidation": true
+            },
+            "import": {
+              "package": "time"
+            },
+            "type": "Time"
+          }
+        },
+        "id": {
+          "description": "Unique identifier of the role binding.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primaryKey\""
+        },
+        "infra_env_id": {
+          "description": "The infra-env that the role is granted on.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\"",
+          "x-nullable": true
+        },
+        "role": {
+          "description": "The role granted to the subject. A viewer can read the resource, an operator can also install, reset and cancel the installation, an editor can also update the resource.",
+          "type": "string",
+          "enum": [
+            "viewer",
+            "operator",
+            "editor"
+          ]
+        },
+        "subject": {
+          "description": "The name of the user or of the group that the role is granted to.",
+          "type": "string"
+        },
+        "subject_kind": {
+          "description": "Whether the subject is a user or a group.",
+          "type": "string",
+          "enum": [
+            "user",
+            "group"
+          ]
+        },
+        "user_name": {
+          "description": "The user that created the role binding.",
+          "type": "string"
+        }
+      }
+    },
+    "role-binding-create-params": {
+      "type": "object",
+      "required": [
+        "subject_kind",
+        "subject",
+        "role"
+      ],
+      "properties": {
+        "role": {
+          "description": "The role granted to the subject.",
+          "type": "string",
+          "enum": [
+            "viewer",
+            "operator",
+            "editor"
+          ]
+        },
+        "subject": {
+          "description": "The name of the user or of the group that the role is granted to.",
+          "type": "string",
+          "minLength": 1
+        },
+        "subject_kind": {
+          "description": "Whether the subject is a user or a group.",
+          "type": "string",
+          "enum": [
+            "user",
+            "group"
+          ]
+        }
+      }
+    },
+    "role-binding-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/role-binding"
+      }
+    },
     "route": {
       "type": "object",
       "properties": {
@@ -21524,7 +26053,9 @@ func init() {
         "upgrade-agent",
         "download-boot-artifacts",
         "reboot-for-reclaim",
-        "verify-vips"
+        "verify-vips",
+        "network-throughput",
+        "firmware-inspection"
       ]
     },
     "steps": {
@@ -21623,6 +26154,22 @@ func init() {
         }
       }
     },
+    "throughput-report": {
+      "type": "object",
+      "properties": {
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
+        },
+        "throughput_mbps": {
+          "description": "Throughput measured to the remote address, in Mbps.",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
     "update-manifest-params": {
       "type": "object",
       "required": [
@@ -21839,6 +26386,15 @@ func init() {
           },
           "x-nullable": true
         },
+        "install_schedule": {
+          "description": "Time window in which the installation is started automatically once the cluster is ready. Set an empty object to remove the schedule.",
+          "$ref": "#/definitions/install-schedule"
+        },
+        "install_timeouts": {
+          "description": "JSON-formatted string containing per-cluster overrides of the installation timeouts of host stages and finalizing stages, for example {\"host_stages\": {\"Rebooting\": \"2h\"}, \"finalizing_stages\": {\"Waiting for cluster operators\": \"12h\"}}. The timeouts use the Go duration format and stages that are not listed keep the timeouts of the service.",
+          "type": "string",
+          "x-nullable": true
+        },
         "load_balancer": {
           "$ref": "#/definitions/load_balancer"
         },
@@ -21897,6 +26453,16 @@ func init() {
           "type": "boolean",
           "default": false
         },
+        "secure_boot_mode": {
+          "description": "The secure boot state required from the hosts of the cluster, 'any' to accept both states.",
+          "type": "string",
+          "enum": [
+            "any",
+            "enabled",
+            "disabled"
+          ],
+          "x-nullable": true
+        },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
@@ -22078,6 +26644,10 @@ func init() {
       "description": "Agent-driven installation",
       "name": "Assisted installation"
     },
+    {
+      "description": "Audit trail of the changes made to clusters, hosts and infra-envs.",
+      "name": "audit"
+    },
     {
       "description": "Events related to a cluster installation.",
       "name": "events"
@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openshift/assisted-service/restapi/operations/audit"
 	"github.com/openshift/assisted-service/restapi/operations/events"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/openshift/assisted-service/restapi/operations/managed_domains"
@@ -108,9 +109,24 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		ManifestsV2CreateClusterManifestHandler: manifests.V2CreateClusterManifestHandlerFunc(func(params manifests.V2CreateClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2CreateClusterManifest has not yet been implemented")
 		}),
+		InstallerV2CreateClusterRoleBindingHandler: installer.V2CreateClusterRoleBindingHandlerFunc(func(params installer.V2CreateClusterRoleBindingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2CreateClusterRoleBinding has not yet been implemented")
+		}),
+		InstallerV2CreateInfraEnvRoleBindingHandler: installer.V2CreateInfraEnvRoleBindingHandlerFunc(func(params installer.V2CreateInfraEnvRoleBindingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2CreateInfraEnvRoleBinding has not yet been implemented")
+		}),
 		ManifestsV2DeleteClusterManifestHandler: manifests.V2DeleteClusterManifestHandlerFunc(func(params manifests.V2DeleteClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2DeleteClusterManifest has not yet been implemented")
 		}),
+		InstallerV2DeleteClusterRoleBindingHandler: installer.V2DeleteClusterRoleBindingHandlerFunc(func(params installer.V2DeleteClusterRoleBindingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DeleteClusterRoleBinding has not yet been implemented")
+		}),
+		InstallerV2DeleteInfraEnvRoleBindingHandler: installer.V2DeleteInfraEnvRoleBindingHandlerFunc(func(params installer.V2DeleteInfraEnvRoleBindingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DeleteInfraEnvRoleBinding has not yet been implemented")
+		}),
+		EventsV2DeregisterEventSubscriptionHandler: events.V2DeregisterEventSubscriptionHandlerFunc(func(params events.V2DeregisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.V2DeregisterEventSubscription has not yet been implemented")
+		}),
 		InstallerV2DownloadClusterCredentialsHandler: installer.V2DownloadClusterCredentialsHandlerFunc(func(params installer.V2DownloadClusterCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadClusterCredentials has not yet been implemented")
 		}),
@@ -126,36 +142,63 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2GetClusterDefaultConfigHandler: installer.V2GetClusterDefaultConfigHandlerFunc(func(params installer.V2GetClusterDefaultConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterDefaultConfig has not yet been implemented")
 		}),
+		InstallerV2GetClusterInstallationTimelineHandler: installer.V2GetClusterInstallationTimelineHandlerFunc(func(params installer.V2GetClusterInstallationTimelineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterInstallationTimeline has not yet been implemented")
+		}),
 		InstallerV2GetClusterUISettingsHandler: installer.V2GetClusterUISettingsHandlerFunc(func(params installer.V2GetClusterUISettingsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterUISettings has not yet been implemented")
 		}),
 		InstallerV2GetCredentialsHandler: installer.V2GetCredentialsHandlerFunc(func(params installer.V2GetCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetCredentials has not yet been implemented")
 		}),
+		EventsV2GetEventSubscriptionHandler: events.V2GetEventSubscriptionHandlerFunc(func(params events.V2GetEventSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.V2GetEventSubscription has not yet been implemented")
+		}),
 		InstallerV2GetPresignedForClusterCredentialsHandler: installer.V2GetPresignedForClusterCredentialsHandlerFunc(func(params installer.V2GetPresignedForClusterCredentialsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetPresignedForClusterCredentials has not yet been implemented")
 		}),
 		InstallerV2GetPresignedForClusterFilesHandler: installer.V2GetPresignedForClusterFilesHandlerFunc(func(params installer.V2GetPresignedForClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetPresignedForClusterFiles has not yet been implemented")
 		}),
+		AuditV2ListAuditRecordsHandler: audit.V2ListAuditRecordsHandlerFunc(func(params audit.V2ListAuditRecordsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation audit.V2ListAuditRecords has not yet been implemented")
+		}),
 		OperatorsV2ListBundlesHandler: operators.V2ListBundlesHandlerFunc(func(params operators.V2ListBundlesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ListBundles has not yet been implemented")
 		}),
 		ManifestsV2ListClusterManifestsHandler: manifests.V2ListClusterManifestsHandlerFunc(func(params manifests.V2ListClusterManifestsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2ListClusterManifests has not yet been implemented")
 		}),
+		InstallerV2ListClusterRoleBindingsHandler: installer.V2ListClusterRoleBindingsHandlerFunc(func(params installer.V2ListClusterRoleBindingsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListClusterRoleBindings has not yet been implemented")
+		}),
+		InstallerV2ListInfraEnvRoleBindingsHandler: installer.V2ListInfraEnvRoleBindingsHandlerFunc(func(params installer.V2ListInfraEnvRoleBindingsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListInfraEnvRoleBindings has not yet been implemented")
+		}),
 		ManagedDomainsV2ListManagedDomainsHandler: managed_domains.V2ListManagedDomainsHandlerFunc(func(params managed_domains.V2ListManagedDomainsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation managed_domains.V2ListManagedDomains has not yet been implemented")
 		}),
+		EventsV2ListEventSubscriptionDeliveriesHandler: events.V2ListEventSubscriptionDeliveriesHandlerFunc(func(params events.V2ListEventSubscriptionDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.V2ListEventSubscriptionDeliveries has not yet been implemented")
+		}),
+		EventsV2ListEventSubscriptionsHandler: events.V2ListEventSubscriptionsHandlerFunc(func(params events.V2ListEventSubscriptionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.V2ListEventSubscriptions has not yet been implemented")
+		}),
 		OperatorsV2ListOfClusterOperatorsHandler: operators.V2ListOfClusterOperatorsHandlerFunc(func(params operators.V2ListOfClusterOperatorsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ListOfClusterOperators has not yet been implemented")
 		}),
+		OperatorsV2ListOperatorInstallOptionsHandler: operators.V2ListOperatorInstallOptionsHandlerFunc(func(params operators.V2ListOperatorInstallOptionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2ListOperatorInstallOptions has not yet been implemented")
+		}),
 		OperatorsV2ListOperatorPropertiesHandler: operators.V2ListOperatorPropertiesHandlerFunc(func(params operators.V2ListOperatorPropertiesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ListOperatorProperties has not yet been implemented")
 		}),
 		OperatorsV2ListSupportedOperatorsHandler: operators.V2ListSupportedOperatorsHandlerFunc(func(params operators.V2ListSupportedOperatorsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operators.V2ListSupportedOperators has not yet been implemented")
 		}),
+		EventsV2RegisterEventSubscriptionHandler: events.V2RegisterEventSubscriptionHandlerFunc(func(params events.V2RegisterEventSubscriptionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation events.V2RegisterEventSubscription has not yet been implemented")
+		}),
 		InstallerV2UpdateClusterHandler: installer.V2UpdateClusterHandlerFunc(func(params installer.V2UpdateClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateCluster has not yet been implemented")
 		}),
@@ -171,6 +214,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2CompleteInstallationHandler: installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2CompleteInstallation has not yet been implemented")
 		}),
+		InstallerV2CreateHostDiagnosticHandler: installer.V2CreateHostDiagnosticHandlerFunc(func(params installer.V2CreateHostDiagnosticParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2CreateHostDiagnostic has not yet been implemented")
+		}),
 		InstallerV2DeregisterClusterHandler: installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterCluster has not yet been implemented")
 		}),
@@ -225,6 +271,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		EventsV2ListEventsHandler: events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2ListEvents has not yet been implemented")
 		}),
+		InstallerV2ListHostDiagnosticsHandler: installer.V2ListHostDiagnosticsHandlerFunc(func(params installer.V2ListHostDiagnosticsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListHostDiagnostics has not yet been implemented")
+		}),
 		InstallerV2ListHostsHandler: installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListHosts has not yet been implemented")
 		}),
@@ -288,6 +337,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UploadClusterIngressCertHandler: installer.V2UploadClusterIngressCertHandlerFunc(func(params installer.V2UploadClusterIngressCertParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadClusterIngressCert has not yet been implemented")
 		}),
+		InstallerV2WatchClusterHandler: installer.V2WatchClusterHandlerFunc(func(params installer.V2WatchClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2WatchCluster has not yet been implemented")
+		}),
 
 		// Applies when the "X-Secret-Key" header is set
 		AgentAuthAuth: func(token string) (interface{}, error) {
@@ -422,8 +474,18 @@ type AssistedInstallAPI struct {
 	InstallerV2CancelInstallationHandler installer.V2CancelInstallationHandler
 	// ManifestsV2CreateClusterManifestHandler sets the operation handler for the v2 create cluster manifest operation
 	ManifestsV2CreateClusterManifestHandler manifests.V2CreateClusterManifestHandler
+	// InstallerV2CreateClusterRoleBindingHandler sets the operation handler for the v2 create cluster role binding operation
+	InstallerV2CreateClusterRoleBindingHandler installer.V2CreateClusterRoleBindingHandler
+	// InstallerV2CreateInfraEnvRoleBindingHandler sets the operation handler for the v2 create infra env role binding operation
+	InstallerV2CreateInfraEnvRoleBindingHandler installer.V2CreateInfraEnvRoleBindingHandler
 	// ManifestsV2DeleteClusterManifestHandler sets the operation handler for the v2 delete cluster manifest operation
 	ManifestsV2DeleteClusterManifestHandler manifests.V2DeleteClusterManifestHandler
+	// InstallerV2DeleteClusterRoleBindingHandler sets the operation handler for the v2 delete cluster role binding operation
+	InstallerV2DeleteClusterRoleBindingHandler installer.V2DeleteClusterRoleBindingHandler
+	// InstallerV2DeleteInfraEnvRoleBindingHandler sets the operation handler for the v2 delete infra env role binding operation
+	InstallerV2DeleteInfraEnvRoleBindingHandler installer.V2DeleteInfraEnvRoleBindingHandler
+	// EventsV2DeregisterEventSubscriptionHandler sets the operation handler for the v2 deregister event subscription operation
+	EventsV2DeregisterEventSubscriptionHandler events.V2DeregisterEventSubscriptionHandler
 	// InstallerV2DownloadClusterCredentialsHandler sets the operation handler for the v2 download cluster credentials operation
 	InstallerV2DownloadClusterCredentialsHandler installer.V2DownloadClusterCredentialsHandler
 	// InstallerV2DownloadClusterFilesHandler sets the operation handler for the v2 download cluster files operation
@@ -434,26 +496,44 @@ type AssistedInstallAPI struct {
 	OperatorsV2GetBundleHandler operators.V2GetBundleHandler
 	// InstallerV2GetClusterDefaultConfigHandler sets the operation handler for the v2 get cluster default config operation
 	InstallerV2GetClusterDefaultConfigHandler installer.V2GetClusterDefaultConfigHandler
+	// InstallerV2GetClusterInstallationTimelineHandler sets the operation handler for the v2 get cluster installation timeline operation
+	InstallerV2GetClusterInstallationTimelineHandler installer.V2GetClusterInstallationTimelineHandler
 	// InstallerV2GetClusterUISettingsHandler sets the operation handler for the v2 get cluster UI settings operation
 	InstallerV2GetClusterUISettingsHandler installer.V2GetClusterUISettingsHandler
 	// InstallerV2GetCredentialsHandler sets the operation handler for the v2 get credentials operation
 	InstallerV2GetCredentialsHandler installer.V2GetCredentialsHandler
+	// EventsV2GetEventSubscriptionHandler sets the operation handler for the v2 get event subscription operation
+	EventsV2GetEventSubscriptionHandler events.V2GetEventSubscriptionHandler
 	// InstallerV2GetPresignedForClusterCredentialsHandler sets the operation handler for the v2 get presigned for cluster credentials operation
 	InstallerV2GetPresignedForClusterCredentialsHandler installer.V2GetPresignedForClusterCredentialsHandler
 	// InstallerV2GetPresignedForClusterFilesHandler sets the operation handler for the v2 get presigned for cluster files operation
 	InstallerV2GetPresignedForClusterFilesHandler installer.V2GetPresignedForClusterFilesHandler
+	// AuditV2ListAuditRecordsHandler sets the operation handler for the v2 list audit records operation
+	AuditV2ListAuditRecordsHandler audit.V2ListAuditRecordsHandler
 	// OperatorsV2ListBundlesHandler sets the operation handler for the v2 list bundles operation
 	OperatorsV2ListBundlesHandler operators.V2ListBundlesHandler
 	// ManifestsV2ListClusterManifestsHandler sets the operation handler for the v2 list cluster manifests operation
 	ManifestsV2ListClusterManifestsHandler manifests.V2ListClusterManifestsHandler
+	// InstallerV2ListClusterRoleBindingsHandler sets the operation handler for the v2 list cluster role bindings operation
+	InstallerV2ListClusterRoleBindingsHandler installer.V2ListClusterRoleBindingsHandler
+	// InstallerV2ListInfraEnvRoleBindingsHandler sets the operation handler for the v2 list infra env role bindings operation
+	InstallerV2ListInfraEnvRoleBindingsHandler installer.V2ListInfraEnvRoleBindingsHandler
 	// ManagedDomainsV2ListManagedDomainsHandler sets the operation handler for the v2 list managed domains operation
 	ManagedDomainsV2ListManagedDomainsHandler managed_domains.V2ListManagedDomainsHandler
+	// EventsV2ListEventSubscriptionDeliveriesHandler sets the operation handler for the v2 list event subscription deliveries operation
+	EventsV2ListEventSubscriptionDeliveriesHandler events.V2ListEventSubscriptionDeliveriesHandler
+	// EventsV2ListEventSubscriptionsHandler sets the operation handler for the v2 list event subscriptions operation
+	EventsV2ListEventSubscriptionsHandler events.V2ListEventSubscriptionsHandler
 	// OperatorsV2ListOfClusterOperatorsHandler sets the operation handler for the v2 list of cluster operators operation
 	OperatorsV2ListOfClusterOperatorsHandler operators.V2ListOfClusterOperatorsHandler
+	// OperatorsV2ListOperatorInstallOptionsHandler sets the operation handler for the v2 list operator install options operation
+	OperatorsV2ListOperatorInstallOptionsHandler operators.V2ListOperatorInstallOptionsHandler
 	// OperatorsV2ListOperatorPropertiesHandler sets the operation handler for the v2 list operator properties operation
 	OperatorsV2ListOperatorPropertiesHandler operators.V2ListOperatorPropertiesHandler
 	// OperatorsV2ListSupportedOperatorsHandler sets the operation handler for the v2 list supported operators operation
 	OperatorsV2ListSupportedOperatorsHandler operators.V2ListSupportedOperatorsHandler
+	// EventsV2RegisterEventSubscriptionHandler sets the operation handler for the v2 register event subscription operation
+	EventsV2RegisterEventSubscriptionHandler events.V2RegisterEventSubscriptionHandler
 	// InstallerV2UpdateClusterHandler sets the operation handler for the v2 update cluster operation
 	InstallerV2UpdateClusterHandler installer.V2UpdateClusterHandler
 	// ManifestsV2UpdateClusterManifestHandler sets the operation handler for the v2 update cluster manifest operation
@@ -464,6 +544,8 @@ type AssistedInstallAPI struct {
 	InstallerV2UploadLogsHandler installer.V2UploadLogsHandler
 	// InstallerV2CompleteInstallationHandler sets the operation handler for the v2 complete installation operation
 	InstallerV2CompleteInstallationHandler installer.V2CompleteInstallationHandler
+	// InstallerV2CreateHostDiagnosticHandler sets the operation handler for the v2 create host diagnostic operation
+	InstallerV2CreateHostDiagnosticHandler installer.V2CreateHostDiagnosticHandler
 	// InstallerV2DeregisterClusterHandler sets the operation handler for the v2 deregister cluster operation
 	InstallerV2DeregisterClusterHandler installer.V2DeregisterClusterHandler
 	// InstallerV2DeregisterHostHandler sets the operation handler for the v2 deregister host operation
@@ -500,6 +582,8 @@ type AssistedInstallAPI struct {
 	VersionsV2ListComponentVersionsHandler versions.V2ListComponentVersionsHandler
 	// EventsV2ListEventsHandler sets the operation handler for the v2 list events operation
 	EventsV2ListEventsHandler events.V2ListEventsHandler
+	// InstallerV2ListHostDiagnosticsHandler sets the operation handler for the v2 list host diagnostics operation
+	InstallerV2ListHostDiagnosticsHandler installer.V2ListHostDiagnosticsHandler
 	// InstallerV2ListHostsHandler sets the operation handler for the v2 list hosts operation
 	InstallerV2ListHostsHandler installer.V2ListHostsHandler
 	// VersionsV2ListReleaseSourcesHandler sets the operation handler for the v2 list release sources operation
@@ -542,6 +626,8 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateHostLogsProgressHandler installer.V2UpdateHostLogsProgressHandler
 	// InstallerV2UploadClusterIngressCertHandler sets the operation handler for the v2 upload cluster ingress cert operation
 	InstallerV2UploadClusterIngressCertHandler installer.V2UploadClusterIngressCertHandler
+	// InstallerV2WatchClusterHandler sets the operation handler for the v2 watch cluster operation
+	InstallerV2WatchClusterHandler installer.V2WatchClusterHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -701,9 +787,24 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.ManifestsV2CreateClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.V2CreateClusterManifestHandler")
 	}
+	if o.InstallerV2CreateClusterRoleBindingHandler == nil {
+		unregistered = append(unregistered, "installer.V2CreateClusterRoleBindingHandler")
+	}
+	if o.InstallerV2CreateInfraEnvRoleBindingHandler == nil {
+		unregistered = append(unregistered, "installer.V2CreateInfraEnvRoleBindingHandler")
+	}
 	if o.ManifestsV2DeleteClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.V2DeleteClusterManifestHandler")
 	}
+	if o.InstallerV2DeleteClusterRoleBindingHandler == nil {
+		unregistered = append(unregistered, "installer.V2DeleteClusterRoleBindingHandler")
+	}
+	if o.InstallerV2DeleteInfraEnvRoleBindingHandler == nil {
+		unregistered = append(unregistered, "installer.V2DeleteInfraEnvRoleBindingHandler")
+	}
+	if o.EventsV2DeregisterEventSubscriptionHandler == nil {
+		unregistered = append(unregistered, "events.V2DeregisterEventSubscriptionHandler")
+	}
 	if o.InstallerV2DownloadClusterCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadClusterCredentialsHandler")
 	}
@@ -719,36 +820,63 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2GetClusterDefaultConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterDefaultConfigHandler")
 	}
+	if o.InstallerV2GetClusterInstallationTimelineHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterInstallationTimelineHandler")
+	}
 	if o.InstallerV2GetClusterUISettingsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterUISettingsHandler")
 	}
 	if o.InstallerV2GetCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetCredentialsHandler")
 	}
+	if o.EventsV2GetEventSubscriptionHandler == nil {
+		unregistered = append(unregistered, "events.V2GetEventSubscriptionHandler")
+	}
 	if o.InstallerV2GetPresignedForClusterCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetPresignedForClusterCredentialsHandler")
 	}
 	if o.InstallerV2GetPresignedForClusterFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetPresignedForClusterFilesHandler")
 	}
+	if o.AuditV2ListAuditRecordsHandler == nil {
+		unregistered = append(unregistered, "audit.V2ListAuditRecordsHandler")
+	}
 	if o.OperatorsV2ListBundlesHandler == nil {
 		unregistered = append(unregistered, "operators.V2ListBundlesHandler")
 	}
 	if o.ManifestsV2ListClusterManifestsHandler == nil {
 		unregistered = append(unregistered, "manifests.V2ListClusterManifestsHandler")
 	}
+	if o.InstallerV2ListClusterRoleBindingsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListClusterRoleBindingsHandler")
+	}
+	if o.InstallerV2ListInfraEnvRoleBindingsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListInfraEnvRoleBindingsHandler")
+	}
 	if o.ManagedDomainsV2ListManagedDomainsHandler == nil {
 		unregistered = append(unregistered, "managed_domains.V2ListManagedDomainsHandler")
 	}
+	if o.EventsV2ListEventSubscriptionDeliveriesHandler == nil {
+		unregistered = append(unregistered, "events.V2ListEventSubscriptionDeliveriesHandler")
+	}
+	if o.EventsV2ListEventSubscriptionsHandler == nil {
+		unregistered = append(unregistered, "events.V2ListEventSubscriptionsHandler")
+	}
 	if o.OperatorsV2ListOfClusterOperatorsHandler == nil {
 		unregistered = append(unregistered, "operators.V2ListOfClusterOperatorsHandler")
 	}
+	if o.OperatorsV2ListOperatorInstallOptionsHandler == nil {
+		unregistered = append(unregistered, "operators.V2ListOperatorInstallOptionsHandler")
+	}
 	if o.OperatorsV2ListOperatorPropertiesHandler == nil {
 		unregistered = append(unregistered, "operators.V2ListOperatorPropertiesHandler")
 	}
 	if o.OperatorsV2ListSupportedOperatorsHandler == nil {
 		unregistered = append(unregistered, "operators.V2ListSupportedOperatorsHandler")
 	}
+	if o.EventsV2RegisterEventSubscriptionHandler == nil {
+		unregistered = append(unregistered, "events.V2RegisterEventSubscriptionHandler")
+	}
 	if o.InstallerV2UpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterHandler")
 	}
@@ -764,6 +892,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2CompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.V2CompleteInstallationHandler")
 	}
+	if o.InstallerV2CreateHostDiagnosticHandler == nil {
+		unregistered = append(unregistered, "installer.V2CreateHostDiagnosticHandler")
+	}
 	if o.InstallerV2DeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterClusterHandler")
 	}
@@ -818,6 +949,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.EventsV2ListEventsHandler == nil {
 		unregistered = append(unregistered, "events.V2ListEventsHandler")
 	}
+	if o.InstallerV2ListHostDiagnosticsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListHostDiagnosticsHandler")
+	}
 	if o.InstallerV2ListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListHostsHandler")
 	}
@@ -881,6 +1015,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadClusterIngressCertHandler")
 	}
+	if o.InstallerV2WatchClusterHandler == nil {
+		unregistered = append(unregistered, "installer.V2WatchClusterHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1078,10 +1215,30 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/manifests"] = manifests.NewV2CreateClusterManifest(o.context, o.ManifestsV2CreateClusterManifestHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/role-bindings"] = installer.NewV2CreateClusterRoleBinding(o.context, o.InstallerV2CreateClusterRoleBindingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/role-bindings"] = installer.NewV2CreateInfraEnvRoleBinding(o.context, o.InstallerV2CreateInfraEnvRoleBindingHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/v2/clusters/{cluster_id}/manifests"] = manifests.NewV2DeleteClusterManifest(o.context, o.ManifestsV2DeleteClusterManifestHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/clusters/{cluster_id}/role-bindings/{role_binding_id}"] = installer.NewV2DeleteClusterRoleBinding(o.context, o.InstallerV2DeleteClusterRoleBindingHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/infra-envs/{infra_env_id}/role-bindings/{role_binding_id}"] = installer.NewV2DeleteInfraEnvRoleBinding(o.context, o.InstallerV2DeleteInfraEnvRoleBindingHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v2/events/subscriptions/{subscription_id}"] = events.NewV2DeregisterEventSubscription(o.context, o.EventsV2DeregisterEventSubscriptionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1105,6 +1262,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/installation-timeline"] = installer.NewV2GetClusterInstallationTimeline(o.context, o.InstallerV2GetClusterInstallationTimelineHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/ui-settings"] = installer.NewV2GetClusterUISettings(o.context, o.InstallerV2GetClusterUISettingsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1113,6 +1274,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/events/subscriptions/{subscription_id}"] = events.NewV2GetEventSubscription(o.context, o.EventsV2GetEventSubscriptionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/downloads/credentials-presigned"] = installer.NewV2GetPresignedForClusterCredentials(o.context, o.InstallerV2GetPresignedForClusterCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1121,6 +1286,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/audit-records"] = audit.NewV2ListAuditRecords(o.context, o.AuditV2ListAuditRecordsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/operators/bundles"] = operators.NewV2ListBundles(o.context, o.OperatorsV2ListBundlesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1129,6 +1298,22 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/role-bindings"] = installer.NewV2ListClusterRoleBindings(o.context, o.InstallerV2ListClusterRoleBindingsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/events/subscriptions/{subscription_id}/deliveries"] = events.NewV2ListEventSubscriptionDeliveries(o.context, o.EventsV2ListEventSubscriptionDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/events/subscriptions"] = events.NewV2ListEventSubscriptions(o.context, o.EventsV2ListEventSubscriptionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/role-bindings"] = installer.NewV2ListInfraEnvRoleBindings(o.context, o.InstallerV2ListInfraEnvRoleBindingsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/domains"] = managed_domains.NewV2ListManagedDomains(o.context, o.ManagedDomainsV2ListManagedDomainsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1137,11 +1322,19 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/supported-operators/{operator_name}/install-options"] = operators.NewV2ListOperatorInstallOptions(o.context, o.OperatorsV2ListOperatorInstallOptionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/supported-operators/{operator_name}"] = operators.NewV2ListOperatorProperties(o.context, o.OperatorsV2ListOperatorPropertiesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/supported-operators"] = operators.NewV2ListSupportedOperators(o.context, o.OperatorsV2ListSupportedOperatorsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/events/subscriptions"] = events.NewV2RegisterEventSubscription(o.context, o.EventsV2RegisterEventSubscriptionHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -1162,6 +1355,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/complete-installation"] = installer.NewV2CompleteInstallation(o.context, o.InstallerV2CompleteInstallationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics"] = installer.NewV2CreateHostDiagnostic(o.context, o.InstallerV2CreateHostDiagnosticHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -1237,6 +1434,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/diagnostics"] = installer.NewV2ListHostDiagnostics(o.context, o.InstallerV2ListHostDiagnosticsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2ListHosts(o.context, o.InstallerV2ListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1318,6 +1519,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/uploads/ingress-cert"] = installer.NewV2UploadClusterIngressCert(o.context, o.InstallerV2UploadClusterIngressCertHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/watch"] = installer.NewV2WatchCluster(o.context, o.InstallerV2WatchClusterHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
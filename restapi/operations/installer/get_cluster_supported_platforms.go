@@ -32,7 +32,7 @@ func NewGetClusterSupportedPlatforms(ctx *middleware.Context, handler GetCluster
 /*
 	GetClusterSupportedPlatforms swagger:route GET /v2/clusters/{cluster_id}/supported-platforms installer getClusterSupportedPlatforms
 
-A list of platforms that this cluster can support in its current configuration.
+A list of platforms that this cluster can support in its current configuration. The platform suggested by the hardware fingerprints of the hosts is listed first.
 */
 type GetClusterSupportedPlatforms struct {
 	Context *middleware.Context
@@ -133,6 +133,12 @@ func (e *{{eventName}}) FormatMessage() string {
 }
 
 {% endfor -%}
+// EventNames lists the names of all the events
+var EventNames = []string{
+{%- for event in generator %}
+    {{event.event_class()}}Name,
+{%- endfor %}
+}
 ''')
 
 
@@ -315,7 +321,7 @@ def main():
     args = parser.parse_args()
 
     events, extra_imports = parse(yaml_path=args.source)
-    generated_code = EVENT_TEMPLATE.render(generator=(EventGenerator(e) for e in events),
+    generated_code = EVENT_TEMPLATE.render(generator=[EventGenerator(e) for e in events],
                                            extra_imports=extra_imports,
                                            package_name=args.package_name)
 